@@ -11,10 +11,21 @@ import (
 
 	"github.com/cloud-scan/cloudscan-orchestrator/internal/clients"
 	"github.com/cloud-scan/cloudscan-orchestrator/internal/config"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/credentials"
 	"github.com/cloud-scan/cloudscan-orchestrator/internal/database"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/events"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/gitwebhooks"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/health"
 	grpcserver "github.com/cloud-scan/cloudscan-orchestrator/internal/grpc"
 	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/jobprofiles"
 	"github.com/cloud-scan/cloudscan-orchestrator/internal/k8s"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/kms"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/metrics"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/scanners"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/tracing"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/webhooks"
 	"github.com/cloud-scan/cloudscan-orchestrator/internal/workers"
 	log "github.com/sirupsen/logrus"
 )
@@ -41,6 +52,21 @@ func main() {
 		log.WithError(err).Fatal("Failed to load configuration")
 	}
 
+	// "cloudscan-orchestrator migrate ..." manages the schema and exits
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		os.Exit(runMigrate(cfg, os.Args[2:]))
+	}
+
+	// Initialize tracing before any instrumented client is created
+	shutdownTracing, err := tracing.Init(context.Background(), tracing.Config{
+		Enabled:  cfg.Observability.JaegerEnabled,
+		Endpoint: cfg.Observability.JaegerURL,
+		Version:  version,
+	})
+	if err != nil {
+		log.WithError(err).Fatal("Failed to initialize tracing")
+	}
+
 	// Initialize database connection
 	db, err := database.NewPostgresDB(
 		cfg.Database.DSN(),
@@ -54,14 +80,38 @@ func main() {
 
 	log.Info("Database connection established")
 
-	// Run migrations
-	if err := database.RunMigrations(db.DB, cfg.Database.MigrationsPath); err != nil {
-		log.WithError(err).Fatal("Failed to run database migrations")
+	// Run migrations. Replicas starting together serialize on an advisory lock.
+	if cfg.Database.AutoMigrate {
+		if err := database.RunMigrations(context.Background(), db.DB, cfg.Database.MigrationsPath); err != nil {
+			log.WithError(err).Fatal("Failed to run database migrations")
+		}
+	} else {
+		log.Info("Automatic migrations disabled (set DB_AUTO_MIGRATE=true to enable)")
 	}
 
 	// Initialize repositories
 	scanRepo := database.NewScanRepository(db)
+	subScanRepo := database.NewSubScanRepository(db)
+	scannerRepo := database.NewScannerRepository(db)
 	findingRepo := database.NewFindingRepository(db)
+	scheduleRepo := database.NewScheduleRepository(db)
+	integrationRepo := database.NewIntegrationRepository(db)
+	webhookRepo := database.NewWebhookRepository(db)
+	commitStatusRepo := database.NewCommitStatusRepository(db)
+	outboxRepo := database.NewEventOutboxRepository(db)
+	partitionRepo := database.NewPartitionRepository(db)
+	operationRepo := database.NewOperationRepository(db)
+	runnerRequestRepo := database.NewRunnerRequestRepository(db)
+	retentionRepo := database.NewRetentionRepository(db)
+	legalHoldRepo := database.NewLegalHoldRepository(db)
+	jobProfileRepo := database.NewJobProfileRepository(db)
+	jobProfiles := jobprofiles.NewResolver(jobProfileRepo, cfg.JobLimits.DefaultLimits())
+	credentialRepo := database.NewCredentialRepository(db)
+	credentialProvider := credentials.NewProvider(
+		credentialRepo,
+		setupKeyManager(&cfg.Credentials),
+		clients.NewGitHubAppClient(cfg.Credentials.GitHubAPIURL),
+	)
 
 	// Initialize Kubernetes client
 	k8sClient, err := k8s.NewKubernetesClient(
@@ -79,14 +129,64 @@ func main() {
 	}
 
 	// Initialize job dispatcher
+	scannerConfigs := make([]interfaces.ScannerConfig, len(cfg.Kubernetes.Scanners))
+	for i, scanner := range cfg.Kubernetes.Scanners {
+		scannerConfigs[i] = interfaces.ScannerConfig{
+			ScanType: scanner.ScanType,
+			Name:     scanner.Name,
+			Image:    scanner.Image,
+			Version:  scanner.Version,
+			Digest:   scanner.Digest,
+			Enabled:  scanner.Enabled,
+			Args:     scanner.Args,
+			Env:      scanner.Env,
+			Resources: interfaces.JobResources{
+				Requests: interfaces.ResourceList(scanner.Resources.Requests),
+				Limits:   interfaces.ResourceList(scanner.Resources.Limits),
+			},
+			Timeout: scanner.Timeout,
+		}
+	}
+	staticRegistry, err := scanners.NewStaticRegistry(scannerConfigs)
+	if err != nil {
+		log.WithError(err).Fatal("Failed to create scanner registry")
+	}
+	var scannerRegistry interfaces.ScannerRegistry = staticRegistry
+	if cfg.Kubernetes.ScannerRegistrySource == "database" {
+		scannerRegistry = scanners.NewDatabaseRegistry(scannerRepo, staticRegistry, cfg.Kubernetes.ScannerRegistryCacheTTL)
+	}
+
 	jobConfig := &interfaces.JobConfig{
 		Namespace:               cfg.Kubernetes.Namespace,
 		ServiceAccount:          cfg.Kubernetes.ServiceAccount,
 		RunnerImage:             cfg.Kubernetes.RunnerImage,
 		RunnerVersion:           cfg.Kubernetes.RunnerVersion,
+		Scanners:                scannerConfigs,
 		TTLSecondsAfterFinished: int32Ptr(int32(cfg.Kubernetes.TTLSecondsAfterFinished)),
 		BackoffLimit:            int32Ptr(int32(cfg.Kubernetes.BackoffLimit)),
 		ActiveDeadlineSeconds:   int64Ptr(int64(cfg.Kubernetes.ActiveDeadlineSeconds)),
+		EphemeralStorage:        cfg.Kubernetes.EphemeralStorage,
+		Security: interfaces.PodSecurity{
+			RunAsNonRoot:                 cfg.Kubernetes.Security.RunAsNonRoot,
+			RunAsUser:                    optionalInt64(cfg.Kubernetes.Security.RunAsUser),
+			RunAsGroup:                   optionalInt64(cfg.Kubernetes.Security.RunAsGroup),
+			FSGroup:                      optionalInt64(cfg.Kubernetes.Security.FSGroup),
+			ReadOnlyRootFilesystem:       cfg.Kubernetes.Security.ReadOnlyRootFilesystem,
+			DropAllCapabilities:          cfg.Kubernetes.Security.DropAllCapabilities,
+			SeccompProfile:               cfg.Kubernetes.Security.SeccompProfile,
+			AutomountServiceAccountToken: cfg.Kubernetes.Security.AutomountServiceAccountToken,
+		},
+		NetworkPolicy: interfaces.NetworkPolicyConfig{
+			Enabled:              cfg.Kubernetes.NetworkPolicy.Enabled,
+			OrchestratorSelector: cfg.Kubernetes.NetworkPolicy.OrchestratorSelector,
+			StorageSelector:      cfg.Kubernetes.NetworkPolicy.StorageSelector,
+			DNSNamespaceSelector: cfg.Kubernetes.NetworkPolicy.DNSNamespaceSelector,
+			DNSSelector:          cfg.Kubernetes.NetworkPolicy.DNSSelector,
+			GitPorts:             int32s(cfg.Kubernetes.NetworkPolicy.GitPorts),
+			AllowedCIDRs:         cfg.Kubernetes.NetworkPolicy.AllowedCIDRs,
+			GitHosts:             cfg.Kubernetes.NetworkPolicy.GitHosts,
+			BlockedCIDRs:         cfg.Kubernetes.NetworkPolicy.BlockedCIDRs,
+		},
 		OrchestratorEndpoint:    fmt.Sprintf("cloudscan-orchestrator.%s.svc.cluster.local:%s", cfg.Kubernetes.Namespace, cfg.Server.GRPCPort),
 		StorageServiceEndpoint:  cfg.StorageService.Endpoint,
 		Resources: interfaces.JobResources{
@@ -117,63 +217,332 @@ func main() {
 	// Initialize job dispatcher with storage client
 	jobDispatcher := k8s.NewJobDispatcher(k8sClient, jobConfig, storageClient)
 
+	// Initialize commit status notifier (reports finished scans to Git providers)
+	statusClients := setupCommitStatusClients(&cfg.CommitStatus)
+	failOn := domain.Severity(cfg.CommitStatus.FailOn)
+	if failOn == "none" {
+		failOn = ""
+	}
+	statusNotifier := workers.NewCommitStatusNotifier(commitStatusRepo, statusClients, workers.CommitStatusConfig{
+		Context:        cfg.CommitStatus.Context,
+		UIBaseURL:      cfg.CommitStatus.UIBaseURL,
+		FailOn:         failOn,
+		Interval:       cfg.CommitStatus.Interval,
+		BatchSize:      cfg.CommitStatus.BatchSize,
+		MaxAttempts:    cfg.CommitStatus.MaxAttempts,
+		InitialBackoff: cfg.CommitStatus.InitialBackoff,
+		MaxBackoff:     cfg.CommitStatus.MaxBackoff,
+	})
+
+	// Webhook URLs are checked when subscriptions are saved and on every delivery
+	webhookEndpoints := webhooks.EndpointPolicy{
+		AllowHTTP:             cfg.Webhooks.AllowHTTP,
+		AllowPrivateAddresses: cfg.Webhooks.AllowPrivateURLs,
+	}
+
+	// Events relayed from the outbox go to the webhook outbox, the commit status
+	// notifier and, if configured, the message broker
+	eventSinks := []interfaces.EventPublisher{
+		webhooks.NewPublisher(webhookRepo),
+		statusNotifier,
+	}
+	broker := setupMessageBroker(&cfg.Events)
+	if broker != nil {
+		defer broker.Close()
+		eventSinks = append(eventSinks, events.NewBrokerPublisher(broker, cfg.Events.SubjectPrefix))
+	}
+	eventPublisher := events.NewFanout(eventSinks...)
+
 	// Initialize gRPC service
 	scanService := grpcserver.NewScanServiceServer(
 		scanRepo,
+		subScanRepo,
 		findingRepo,
+		operationRepo,
+		runnerRequestRepo,
 		storageClient,
 		jobDispatcher,
+		cfg.Kubernetes.Namespace,
+		scannerRegistry,
+		jobProfiles,
+		cfg.ScanRetry.Policy(),
 	)
 
+	scheduleService := grpcserver.NewScheduleServiceServer(scheduleRepo)
+	integrationService := grpcserver.NewIntegrationServiceServer(integrationRepo)
+	webhookService := grpcserver.NewWebhookServiceServer(webhookRepo, webhookEndpoints)
+	retentionService := grpcserver.NewRetentionServiceServer(retentionRepo, cfg.Retention.DefaultPolicy())
+	legalHoldService := grpcserver.NewLegalHoldServiceServer(legalHoldRepo)
+	jobProfileService := grpcserver.NewJobProfileServiceServer(jobProfileRepo, jobProfiles)
+	credentialService := grpcserver.NewCredentialServiceServer(credentialRepo, credentialProvider)
+
+	// Initialize health monitor. Probes and the gRPC health service read its
+	// cached results.
+	healthMonitor := health.NewMonitor(health.MonitorConfig{
+		Interval:       cfg.Health.CheckInterval,
+		Timeout:        cfg.Health.CheckTimeout,
+		HeartbeatGrace: cfg.Health.HeartbeatGrace,
+		Build:          health.BuildInfo{Version: version, Commit: commit, BuildDate: buildDate},
+	})
+	healthMonitor.AddCheck("postgres", true, db.HealthCheck)
+	healthMonitor.AddCheck("kubernetes", true, func(ctx context.Context) error {
+		return k8s.HealthCheck(ctx, k8sClient)
+	})
+	healthMonitor.AddCheck("storage", true, storageClient.HealthCheck)
+	if cfg.Redis.Enabled {
+		healthMonitor.AddCheck("redis", false, health.RedisCheck(cfg.Redis.GetAddr(), cfg.Redis.Password, cfg.Redis.TLS))
+	}
+
 	// Initialize gRPC server
-	grpcSrv := grpcserver.NewServer(cfg.Server.GRPCPort, scanService)
+	grpcSrv := grpcserver.NewServer(
+		cfg.Server.GRPCPort,
+		scanService,
+		scheduleService,
+		integrationService,
+		webhookService,
+		retentionService,
+		legalHoldService,
+		jobProfileService,
+		credentialService,
+		healthMonitor.GRPCHealthServer(),
+	)
+
+	// Git provider webhooks create scans through the same path as the API
+	webhookHandler := gitwebhooks.NewHandler(integrationRepo, scanRepo, scanService)
 
-	// Initialize HTTP server for health checks and metrics
+	// Initialize HTTP server for health checks and Git webhooks
 	httpSrv := &http.Server{
 		Addr:         fmt.Sprintf(":%s", cfg.Server.HTTPPort),
-		Handler:      setupHTTPHandlers(),
+		Handler:      setupHTTPHandlers(healthMonitor, webhookHandler),
 		ReadTimeout:  15 * time.Second,
 		WriteTimeout: 15 * time.Second,
 		IdleTimeout:  60 * time.Second,
 	}
 
+	// Initialize metrics server on the dedicated metrics port (may be nil if disabled)
+	var metricsSrv *http.Server
+	if cfg.Observability.PrometheusEnabled {
+		metrics.BuildInfo.WithLabelValues(version, commit, buildDate).Set(1)
+		metrics.Registry.MustRegister(metrics.NewScanCollector(scanRepo))
+
+		metricsMux := http.NewServeMux()
+		metricsMux.Handle("/metrics", metrics.Handler())
+		metricsSrv = &http.Server{
+			Addr:         fmt.Sprintf(":%s", cfg.Server.MetricsPort),
+			Handler:      metricsMux,
+			ReadTimeout:  15 * time.Second,
+			WriteTimeout: 30 * time.Second,
+		}
+	} else {
+		log.Info("Prometheus metrics disabled (set PROMETHEUS_ENABLED=true to enable)")
+	}
+
+	// Initialize log archiver (may be nil if disabled)
+	var logArchiver *workers.LogArchiver
+	if cfg.LogArchive.Enabled {
+		logArchiver = workers.NewLogArchiver(
+			scanRepo,
+			subScanRepo,
+			jobDispatcher,
+			storageClient,
+			cfg.Kubernetes.Namespace,
+			workers.LogArchiveConfig{
+				Interval:  cfg.LogArchive.Interval,
+				BatchSize: cfg.LogArchive.BatchSize,
+				Window:    cfg.LogArchive.Window,
+				MaxBytes:  int64(cfg.LogArchive.MaxBytes),
+			},
+		)
+		healthMonitor.WatchWorker("log-archiver", cfg.LogArchive.Interval)
+		log.WithField("window", cfg.LogArchive.Window).Info("Log archiver worker enabled")
+	} else {
+		log.Info("Log archiver worker disabled (set LOG_ARCHIVE_ENABLED=true to enable)")
+	}
+
 	// Initialize workers
+	dispatchInterval := 10 * time.Second // Check every 10 seconds for queued scans
 	dispatcher := workers.NewDispatcher(
+		scanRepo,
+		subScanRepo,
+		scannerRegistry,
+		jobProfiles,
+		credentialProvider,
+		jobDispatcher,
+		logArchiver,
+		dispatchInterval,
+	)
+	healthMonitor.WatchWorker("dispatcher", dispatchInterval)
+
+	credentialReaper := workers.NewCredentialReaper(
 		scanRepo,
 		jobDispatcher,
-		10*time.Second, // Check every 10 seconds for queued scans
+		cfg.Kubernetes.Namespace,
+		cfg.Credentials.ReapInterval,
+		cfg.Credentials.SecretMaxAge,
 	)
+	healthMonitor.WatchWorker("credential-reaper", cfg.Credentials.ReapInterval)
 
+	sweepInterval := 30 * time.Second // Check every 30 seconds
 	sweeper := workers.NewSweeper(
 		scanRepo,
+		subScanRepo,
 		jobDispatcher,
-		30*time.Second,          // Check every 30 seconds
+		cfg.ScanRetry.Policy(),
+		cfg.StuckScans.Policy(),
+		sweepInterval,
 		cfg.Kubernetes.Namespace, // Default namespace for jobs
 	)
+	healthMonitor.WatchWorker("sweeper", sweepInterval)
+	healthMonitor.WatchWorker("commit-status-notifier", cfg.CommitStatus.Interval)
 
 	// Initialize cleaner (may be nil if disabled)
 	var cleaner *workers.Cleaner
 	if cfg.Workers.EnableCleaner {
-		cleaner = workers.NewCleaner(
+		cleaner = workers.NewCleaner(retentionRepo, operationRepo, workers.CleanerConfig{
+			CleanupTime:   cfg.Retention.CleanupTime,
+			BatchSize:     cfg.Retention.BatchSize,
+			DryRun:        cfg.Retention.DryRun,
+			DefaultPolicy: cfg.Retention.DefaultPolicy(),
+		})
+		log.WithField("dry_run", cfg.Retention.DryRun).Info("Cleaner worker enabled")
+	} else {
+		log.Info("Cleaner worker disabled (set ENABLE_CLEANER=true to enable)")
+	}
+
+	// Initialize scheduler (may be nil if disabled)
+	var scheduler *workers.Scheduler
+	if cfg.Workers.EnableScheduler {
+		scheduler = workers.NewScheduler(
+			scheduleRepo,
 			scanRepo,
+			cfg.Workers.SchedulerInterval,
+			cfg.Workers.ScheduleCatchUpWindow,
+		)
+		healthMonitor.WatchWorker("scheduler", cfg.Workers.SchedulerInterval)
+		log.Info("Scheduler worker enabled")
+	} else {
+		log.Info("Scheduler worker disabled (set ENABLE_SCHEDULER=true to enable)")
+	}
+
+	// Initialize webhook delivery worker (may be nil if disabled)
+	var webhookDeliverer *workers.WebhookDeliverer
+	if cfg.Webhooks.DeliveryEnabled {
+		webhookDeliverer = workers.NewWebhookDeliverer(webhookRepo, workers.WebhookDeliveryConfig{
+			Interval:       cfg.Webhooks.DeliveryInterval,
+			BatchSize:      cfg.Webhooks.BatchSize,
+			Timeout:        cfg.Webhooks.Timeout,
+			MaxAttempts:    cfg.Webhooks.MaxAttempts,
+			InitialBackoff: cfg.Webhooks.InitialBackoff,
+			MaxBackoff:     cfg.Webhooks.MaxBackoff,
+			Endpoints:      webhookEndpoints,
+		})
+		healthMonitor.WatchWorker("webhook-deliverer", cfg.Webhooks.DeliveryInterval)
+		log.Info("Webhook delivery worker enabled")
+	} else {
+		log.Info("Webhook delivery worker disabled (set WEBHOOK_DELIVERY_ENABLED=true to enable)")
+	}
+
+	// Initialize event relay (may be nil if disabled)
+	var eventRelay *workers.EventRelay
+	if cfg.Events.RelayEnabled {
+		eventRelay = workers.NewEventRelay(outboxRepo, eventPublisher, workers.EventRelayConfig{
+			Interval:       cfg.Events.RelayInterval,
+			BatchSize:      cfg.Events.BatchSize,
+			InitialBackoff: cfg.Events.InitialBackoff,
+			MaxBackoff:     cfg.Events.MaxBackoff,
+			Retention:      cfg.Events.Retention,
+		})
+		healthMonitor.WatchWorker("event-relay", cfg.Events.RelayInterval)
+		log.Info("Event relay worker enabled")
+	} else {
+		log.Info("Event relay worker disabled (set EVENT_RELAY_ENABLED=true to enable)")
+	}
+
+	// Initialize orphan finding reconciler (may be nil if disabled)
+	var findingReconciler *workers.FindingReconciler
+	if cfg.Workers.EnableFindingReconciler {
+		findingReconciler = workers.NewFindingReconciler(
 			findingRepo,
+			cfg.Workers.FindingReconcileInterval,
+			cfg.Workers.FindingReconcileBatchSize,
+		)
+		healthMonitor.WatchWorker("finding-reconciler", cfg.Workers.FindingReconcileInterval)
+		log.Info("Finding reconciler worker enabled")
+	} else {
+		log.Info("Finding reconciler worker disabled (set ENABLE_FINDING_RECONCILER=true to enable)")
+	}
+
+	// Initialize partition maintenance worker (may be nil if disabled)
+	var partitionMaintainer *workers.PartitionMaintainer
+	if cfg.Partitions.MaintenanceEnabled {
+		partitionMaintainer = workers.NewPartitionMaintainer(partitionRepo, storageClient, workers.PartitionMaintenanceConfig{
+			Interval:        cfg.Partitions.MaintenanceInterval,
+			MonthsAhead:     cfg.Partitions.MonthsAhead,
+			RetentionMonths: cfg.Partitions.RetentionMonths,
+			RetentionAction: cfg.Partitions.RetentionAction,
+			ArchiveSchema:   cfg.Partitions.ArchiveSchema,
+		})
+		healthMonitor.WatchWorker("partition-maintainer", cfg.Partitions.MaintenanceInterval)
+		log.Info("Partition maintenance worker enabled")
+	} else {
+		log.Info("Partition maintenance worker disabled (set PARTITION_MAINTENANCE_ENABLED=true to enable)")
+	}
+
+	// Initialize scan deletion worker (may be nil if disabled)
+	var scanDeleter *workers.ScanDeleter
+	if cfg.Deletion.Enabled {
+		scanDeleter = workers.NewScanDeleter(
+			operationRepo,
+			scanRepo,
+			subScanRepo,
+			legalHoldRepo,
 			storageClient,
 			jobDispatcher,
-			90,                       // 90 days retention
-			"00:00",                  // Cleanup at midnight
-			cfg.Kubernetes.Namespace, // Default namespace for jobs
+			cfg.Kubernetes.Namespace,
+			workers.ScanDeletionConfig{
+				Interval:       cfg.Deletion.Interval,
+				BatchSize:      cfg.Deletion.BatchSize,
+				MaxAttempts:    cfg.Deletion.MaxAttempts,
+				InitialBackoff: cfg.Deletion.InitialBackoff,
+				MaxBackoff:     cfg.Deletion.MaxBackoff,
+			},
 		)
-		log.Info("Cleaner worker enabled")
+		healthMonitor.WatchWorker("scan-deleter", cfg.Deletion.Interval)
+		log.Info("Scan deletion worker enabled")
 	} else {
-		log.Info("Cleaner worker disabled (set ENABLE_CLEANER=true to enable)")
+		log.Info("Scan deletion worker disabled (set SCAN_DELETION_ENABLED=true to enable)")
 	}
 
-	// Start background workers
+	// Start health monitor and background workers
+	go healthMonitor.Start(ctx)
 	go dispatcher.Start(ctx)
 	go sweeper.Start(ctx)
+	go credentialReaper.Start(ctx)
+	go statusNotifier.Start(ctx)
 	if cleaner != nil {
 		go cleaner.Start(ctx)
 	}
+	if scheduler != nil {
+		go scheduler.Start(ctx)
+	}
+	if webhookDeliverer != nil {
+		go webhookDeliverer.Start(ctx)
+	}
+	if eventRelay != nil {
+		go eventRelay.Start(ctx)
+	}
+	if partitionMaintainer != nil {
+		go partitionMaintainer.Start(ctx)
+	}
+	if findingReconciler != nil {
+		go findingReconciler.Start(ctx)
+	}
+	if scanDeleter != nil {
+		go scanDeleter.Start(ctx)
+	}
+	if logArchiver != nil {
+		go logArchiver.Start(ctx)
+	}
 
 	// Start HTTP server
 	go func() {
@@ -183,6 +552,16 @@ func main() {
 		}
 	}()
 
+	// Start metrics server
+	if metricsSrv != nil {
+		go func() {
+			log.WithField("port", cfg.Server.MetricsPort).Info("Starting metrics server")
+			if err := metricsSrv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				log.WithError(err).Fatal("Metrics server failed")
+			}
+		}()
+	}
+
 	// Start gRPC server
 	go func() {
 		if err := grpcSrv.Start(); err != nil {
@@ -201,21 +580,59 @@ func main() {
 	shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
+	// Report not ready first so traffic drains while the rest stops
+	healthMonitor.Stop()
+
 	// Stop workers
 	dispatcher.Stop()
 	sweeper.Stop()
+	credentialReaper.Stop()
+	statusNotifier.Stop()
 	if cleaner != nil {
 		cleaner.Stop()
 	}
+	if scheduler != nil {
+		scheduler.Stop()
+	}
+	if webhookDeliverer != nil {
+		webhookDeliverer.Stop()
+	}
+	if eventRelay != nil {
+		eventRelay.Stop()
+	}
+	if partitionMaintainer != nil {
+		partitionMaintainer.Stop()
+	}
+	if findingReconciler != nil {
+		findingReconciler.Stop()
+	}
+	if scanDeleter != nil {
+		scanDeleter.Stop()
+	}
+	if logArchiver != nil {
+		logArchiver.Stop()
+	}
 
 	// Stop HTTP server
 	if err := httpSrv.Shutdown(shutdownCtx); err != nil {
 		log.WithError(err).Error("HTTP server shutdown error")
 	}
 
+	// Stop metrics server
+	if metricsSrv != nil {
+		if err := metricsSrv.Shutdown(shutdownCtx); err != nil {
+			log.WithError(err).Error("Metrics server shutdown error")
+		}
+	}
+
 	// Stop gRPC server
 	grpcSrv.Stop()
 
+	// Flush pending spans
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		log.WithError(err).Error("Tracing shutdown error")
+	}
+
 	log.Info("Server stopped")
 }
 
@@ -239,37 +656,82 @@ func initLogger() {
 	log.SetOutput(os.Stdout)
 }
 
-// setupHTTPHandlers configures HTTP routes for health and metrics
-func setupHTTPHandlers() http.Handler {
+// setupHTTPHandlers configures HTTP routes for health checks and Git webhooks
+func setupHTTPHandlers(healthMonitor *health.Monitor, webhookHandler *gitwebhooks.Handler) http.Handler {
 	mux := http.NewServeMux()
 
-	// Health check endpoint
-	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusOK)
-		fmt.Fprintf(w, `{"status":"healthy","service":"orchestrator","version":"%s","commit":"%s","buildDate":"%s"}`, version, commit, buildDate)
-	})
-
-	// Readiness check endpoint
-	mux.HandleFunc("/ready", func(w http.ResponseWriter, r *http.Request) {
-		// TODO: Add readiness checks (DB connection, K8s API, etc.)
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusOK)
-		w.Write([]byte(`{"status":"ready"}`))
-	})
+	// Liveness, readiness and detailed status endpoints
+	healthMonitor.Register(mux)
 
-	// Metrics endpoint (Prometheus format)
-	mux.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
-		w.WriteHeader(http.StatusOK)
-		fmt.Fprintf(w, "# HELP cloudscan_orchestrator_info Build info\n")
-		fmt.Fprintf(w, "# TYPE cloudscan_orchestrator_info gauge\n")
-		fmt.Fprintf(w, "cloudscan_orchestrator_info{version=\"%s\",commit=\"%s\",buildDate=\"%s\"} 1\n", version, commit, buildDate)
-	})
+	// Git provider webhooks (push / pull request)
+	webhookHandler.Register(mux)
 
 	return mux
 }
 
+// setupCommitStatusClients creates a client for every configured provider.
+// Providers without a token are skipped.
+func setupCommitStatusClients(cfg *config.CommitStatusConfig) []interfaces.CommitStatusClient {
+	if !cfg.Enabled {
+		log.Info("Commit status reporting disabled (set COMMIT_STATUS_ENABLED=true to enable)")
+		return nil
+	}
+
+	var statusClients []interfaces.CommitStatusClient
+	if cfg.GitHubToken != "" {
+		statusClients = append(statusClients, clients.NewGitHubStatusClient(cfg.GitHubAPIURL, cfg.GitHubHost, cfg.GitHubToken))
+	}
+	if cfg.GitLabToken != "" {
+		statusClients = append(statusClients, clients.NewGitLabStatusClient(cfg.GitLabAPIURL, cfg.GitLabHost, cfg.GitLabToken))
+	}
+	if cfg.WebhookURL != "" {
+		statusClients = append(statusClients, clients.NewHTTPStatusClient(cfg.WebhookURL, cfg.WebhookToken))
+	}
+
+	log.WithField("clients", len(statusClients)).Info("Commit status reporting enabled")
+	return statusClients
+}
+
+// setupMessageBroker connects to the configured message broker. It returns nil
+// when no broker is configured.
+func setupMessageBroker(cfg *config.EventsConfig) interfaces.MessageBroker {
+	switch cfg.Broker {
+	case "nats":
+		broker, err := events.NewNATSBroker(cfg.NATSURL, cfg.PublishTimeout)
+		if err != nil {
+			log.WithError(err).Fatal("Failed to connect to message broker")
+		}
+		log.WithField("subject_prefix", cfg.SubjectPrefix).Info("Publishing events to NATS")
+		return broker
+	case "kafka":
+		log.WithFields(log.Fields{
+			"subject_prefix": cfg.SubjectPrefix,
+			"topic":          cfg.KafkaTopic,
+		}).Info("Publishing events to Kafka")
+		return events.NewKafkaBroker(cfg.KafkaBrokers, cfg.KafkaTopic, cfg.PublishTimeout)
+	default:
+		log.Info("Event broker disabled (set EVENT_BROKER=nats or kafka to enable)")
+		return nil
+	}
+}
+
+// setupKeyManager creates the key manager sealing repository credentials, or
+// returns nil if none is configured
+func setupKeyManager(cfg *config.CredentialsConfig) interfaces.KeyManager {
+	switch cfg.KMSProvider {
+	case "local":
+		keyManager, err := kms.NewLocalKeyManager(cfg.KMSLocalKeyFile)
+		if err != nil {
+			log.WithError(err).Fatal("Failed to load local key")
+		}
+		log.WithField("key_id", keyManager.KeyID()).Warn("Sealing repository credentials with a local key; use a key management service in production")
+		return keyManager
+	default:
+		log.Info("Repository credentials disabled (set KMS_PROVIDER to enable)")
+		return nil
+	}
+}
+
 // Helper functions for pointer conversion
 func int32Ptr(i int32) *int32 {
 	return &i
@@ -278,3 +740,19 @@ func int32Ptr(i int32) *int32 {
 func int64Ptr(i int64) *int64 {
 	return &i
 }
+
+// optionalInt64 returns nil for 0, which leaves the setting to the image
+func optionalInt64(i int) *int64 {
+	if i == 0 {
+		return nil
+	}
+	return int64Ptr(int64(i))
+}
+
+func int32s(ints []int) []int32 {
+	result := make([]int32, len(ints))
+	for i, v := range ints {
+		result[i] = int32(v)
+	}
+	return result
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/config"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/database"
+)
+
+const migrateUsage = `Usage: cloudscan-orchestrator migrate <command>
+
+Commands:
+  status        List migrations and whether they are applied
+  up            Apply all pending migrations
+  down [N]      Revert the last N applied migrations (default 1)
+  to VERSION    Migrate up or down to VERSION (0 reverts everything)
+
+Migrations are read from DB_MIGRATIONS_PATH ("embedded" or a directory).`
+
+// runMigrate runs the migrate subcommand and returns the exit code
+func runMigrate(cfg *config.Config, args []string) int {
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, migrateUsage)
+		return 2
+	}
+
+	db, err := database.NewPostgresDB(cfg.Database.DSN(), 1, 1)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to connect to database: %v\n", err)
+		return 1
+	}
+	defer db.Close()
+
+	fsys, err := database.MigrationsSource(cfg.Database.MigrationsPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load migrations: %v\n", err)
+		return 1
+	}
+
+	migrator, err := database.NewMigrator(db.DB, fsys)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load migrations: %v\n", err)
+		return 1
+	}
+
+	ctx := context.Background()
+
+	switch args[0] {
+	case "status":
+		err = printMigrationStatus(ctx, migrator)
+	case "up":
+		err = migrator.Up(ctx)
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			if steps, err = strconv.Atoi(args[1]); err != nil {
+				fmt.Fprintf(os.Stderr, "Invalid number of migrations: %s\n", args[1])
+				return 2
+			}
+		}
+		err = migrator.Down(ctx, steps)
+	case "to":
+		if len(args) < 2 {
+			fmt.Fprintln(os.Stderr, migrateUsage)
+			return 2
+		}
+		version, convErr := strconv.Atoi(args[1])
+		if convErr != nil {
+			fmt.Fprintf(os.Stderr, "Invalid migration version: %s\n", args[1])
+			return 2
+		}
+		err = migrator.To(ctx, version)
+	default:
+		fmt.Fprintln(os.Stderr, migrateUsage)
+		return 2
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Migration failed: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// printMigrationStatus prints a table of all migrations
+func printMigrationStatus(ctx context.Context, migrator *database.Migrator) error {
+	statuses, err := migrator.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tNAME\tSTATE\tAPPLIED AT")
+	for _, status := range statuses {
+		appliedAt := "-"
+		if status.AppliedAt != nil {
+			appliedAt = status.AppliedAt.Format(time.RFC3339)
+		}
+		fmt.Fprintf(w, "%03d\t%s\t%s\t%s\n", status.Version, status.Name, status.State, appliedAt)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,475 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v5.29.3
+// source: credentials.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// RepositoryCredential is the credential of a project, without its secret
+type RepositoryCredential struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId       string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	ProjectId            string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Type                 string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`                                                                // deploy_key, https_token or github_app
+	Username             string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`                                                        // https_token
+	KnownHosts           string                 `protobuf:"bytes,5,opt,name=known_hosts,json=knownHosts,proto3" json:"known_hosts,omitempty"`                                  // deploy_key
+	Fingerprint          string                 `protobuf:"bytes,6,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`                                                  // deploy_key: SHA256 fingerprint of the public key
+	GithubAppId          int64                  `protobuf:"varint,7,opt,name=github_app_id,json=githubAppId,proto3" json:"github_app_id,omitempty"`                            // github_app
+	GithubInstallationId int64                  `protobuf:"varint,8,opt,name=github_installation_id,json=githubInstallationId,proto3" json:"github_installation_id,omitempty"` // github_app
+	KeyId                string                 `protobuf:"bytes,9,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`                                                 // Key the secret is encrypted with
+	UpdatedBy            string                 `protobuf:"bytes,10,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *RepositoryCredential) Reset() {
+	*x = RepositoryCredential{}
+	mi := &file_credentials_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RepositoryCredential) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepositoryCredential) ProtoMessage() {}
+
+func (x *RepositoryCredential) ProtoReflect() protoreflect.Message {
+	mi := &file_credentials_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepositoryCredential.ProtoReflect.Descriptor instead.
+func (*RepositoryCredential) Descriptor() ([]byte, []int) {
+	return file_credentials_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RepositoryCredential) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *RepositoryCredential) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *RepositoryCredential) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *RepositoryCredential) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *RepositoryCredential) GetKnownHosts() string {
+	if x != nil {
+		return x.KnownHosts
+	}
+	return ""
+}
+
+func (x *RepositoryCredential) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+func (x *RepositoryCredential) GetGithubAppId() int64 {
+	if x != nil {
+		return x.GithubAppId
+	}
+	return 0
+}
+
+func (x *RepositoryCredential) GetGithubInstallationId() int64 {
+	if x != nil {
+		return x.GithubInstallationId
+	}
+	return 0
+}
+
+func (x *RepositoryCredential) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *RepositoryCredential) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *RepositoryCredential) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *RepositoryCredential) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// SetRepositoryCredentialRequest creates the project's credential or replaces it
+type SetRepositoryCredentialRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	ProjectId      string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Type           string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// The unencrypted private key (PEM or OpenSSH, without passphrase) of a
+	// deploy key or GitHub App, or the token
+	Secret               string `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`
+	Username             string `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`                       // https_token; defaults to x-access-token
+	KnownHosts           string `protobuf:"bytes,6,opt,name=known_hosts,json=knownHosts,proto3" json:"known_hosts,omitempty"` // deploy_key; empty accepts the host key on first use
+	GithubAppId          int64  `protobuf:"varint,7,opt,name=github_app_id,json=githubAppId,proto3" json:"github_app_id,omitempty"`
+	GithubInstallationId int64  `protobuf:"varint,8,opt,name=github_installation_id,json=githubInstallationId,proto3" json:"github_installation_id,omitempty"`
+	UserId               string `protobuf:"bytes,9,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // User ID from JWT token
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *SetRepositoryCredentialRequest) Reset() {
+	*x = SetRepositoryCredentialRequest{}
+	mi := &file_credentials_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRepositoryCredentialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRepositoryCredentialRequest) ProtoMessage() {}
+
+func (x *SetRepositoryCredentialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_credentials_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRepositoryCredentialRequest.ProtoReflect.Descriptor instead.
+func (*SetRepositoryCredentialRequest) Descriptor() ([]byte, []int) {
+	return file_credentials_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SetRepositoryCredentialRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *SetRepositoryCredentialRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *SetRepositoryCredentialRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SetRepositoryCredentialRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *SetRepositoryCredentialRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *SetRepositoryCredentialRequest) GetKnownHosts() string {
+	if x != nil {
+		return x.KnownHosts
+	}
+	return ""
+}
+
+func (x *SetRepositoryCredentialRequest) GetGithubAppId() int64 {
+	if x != nil {
+		return x.GithubAppId
+	}
+	return 0
+}
+
+func (x *SetRepositoryCredentialRequest) GetGithubInstallationId() int64 {
+	if x != nil {
+		return x.GithubInstallationId
+	}
+	return 0
+}
+
+func (x *SetRepositoryCredentialRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// GetRepositoryCredentialRequest
+type GetRepositoryCredentialRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRepositoryCredentialRequest) Reset() {
+	*x = GetRepositoryCredentialRequest{}
+	mi := &file_credentials_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRepositoryCredentialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRepositoryCredentialRequest) ProtoMessage() {}
+
+func (x *GetRepositoryCredentialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_credentials_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRepositoryCredentialRequest.ProtoReflect.Descriptor instead.
+func (*GetRepositoryCredentialRequest) Descriptor() ([]byte, []int) {
+	return file_credentials_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetRepositoryCredentialRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+// DeleteRepositoryCredentialRequest
+type DeleteRepositoryCredentialRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // User ID from JWT token
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRepositoryCredentialRequest) Reset() {
+	*x = DeleteRepositoryCredentialRequest{}
+	mi := &file_credentials_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRepositoryCredentialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRepositoryCredentialRequest) ProtoMessage() {}
+
+func (x *DeleteRepositoryCredentialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_credentials_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRepositoryCredentialRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRepositoryCredentialRequest) Descriptor() ([]byte, []int) {
+	return file_credentials_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeleteRepositoryCredentialRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *DeleteRepositoryCredentialRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+var File_credentials_proto protoreflect.FileDescriptor
+
+const file_credentials_proto_rawDesc = "" +
+	"\n" +
+	"\x11credentials.proto\x12\tcloudscan\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xd7\x03\n" +
+	"\x14RepositoryCredential\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1a\n" +
+	"\busername\x18\x04 \x01(\tR\busername\x12\x1f\n" +
+	"\vknown_hosts\x18\x05 \x01(\tR\n" +
+	"knownHosts\x12 \n" +
+	"\vfingerprint\x18\x06 \x01(\tR\vfingerprint\x12\"\n" +
+	"\rgithub_app_id\x18\a \x01(\x03R\vgithubAppId\x124\n" +
+	"\x16github_installation_id\x18\b \x01(\x03R\x14githubInstallationId\x12\x15\n" +
+	"\x06key_id\x18\t \x01(\tR\x05keyId\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\n" +
+	" \x01(\tR\tupdatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xc4\x02\n" +
+	"\x1eSetRepositoryCredentialRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x16\n" +
+	"\x06secret\x18\x04 \x01(\tR\x06secret\x12\x1a\n" +
+	"\busername\x18\x05 \x01(\tR\busername\x12\x1f\n" +
+	"\vknown_hosts\x18\x06 \x01(\tR\n" +
+	"knownHosts\x12\"\n" +
+	"\rgithub_app_id\x18\a \x01(\x03R\vgithubAppId\x124\n" +
+	"\x16github_installation_id\x18\b \x01(\x03R\x14githubInstallationId\x12\x17\n" +
+	"\auser_id\x18\t \x01(\tR\x06userId\"?\n" +
+	"\x1eGetRepositoryCredentialRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\"[\n" +
+	"!DeleteRepositoryCredentialRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId2\xc5\x02\n" +
+	"\x11CredentialService\x12e\n" +
+	"\x17SetRepositoryCredential\x12).cloudscan.SetRepositoryCredentialRequest\x1a\x1f.cloudscan.RepositoryCredential\x12e\n" +
+	"\x17GetRepositoryCredential\x12).cloudscan.GetRepositoryCredentialRequest\x1a\x1f.cloudscan.RepositoryCredential\x12b\n" +
+	"\x1aDeleteRepositoryCredential\x12,.cloudscan.DeleteRepositoryCredentialRequest\x1a\x16.google.protobuf.EmptyB>Z<github.com/cloud-scan/cloudscan-orchestrator/generated/protob\x06proto3"
+
+var (
+	file_credentials_proto_rawDescOnce sync.Once
+	file_credentials_proto_rawDescData []byte
+)
+
+func file_credentials_proto_rawDescGZIP() []byte {
+	file_credentials_proto_rawDescOnce.Do(func() {
+		file_credentials_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_credentials_proto_rawDesc), len(file_credentials_proto_rawDesc)))
+	})
+	return file_credentials_proto_rawDescData
+}
+
+var file_credentials_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_credentials_proto_goTypes = []any{
+	(*RepositoryCredential)(nil),              // 0: cloudscan.RepositoryCredential
+	(*SetRepositoryCredentialRequest)(nil),    // 1: cloudscan.SetRepositoryCredentialRequest
+	(*GetRepositoryCredentialRequest)(nil),    // 2: cloudscan.GetRepositoryCredentialRequest
+	(*DeleteRepositoryCredentialRequest)(nil), // 3: cloudscan.DeleteRepositoryCredentialRequest
+	(*timestamppb.Timestamp)(nil),             // 4: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                     // 5: google.protobuf.Empty
+}
+var file_credentials_proto_depIdxs = []int32{
+	4, // 0: cloudscan.RepositoryCredential.created_at:type_name -> google.protobuf.Timestamp
+	4, // 1: cloudscan.RepositoryCredential.updated_at:type_name -> google.protobuf.Timestamp
+	1, // 2: cloudscan.CredentialService.SetRepositoryCredential:input_type -> cloudscan.SetRepositoryCredentialRequest
+	2, // 3: cloudscan.CredentialService.GetRepositoryCredential:input_type -> cloudscan.GetRepositoryCredentialRequest
+	3, // 4: cloudscan.CredentialService.DeleteRepositoryCredential:input_type -> cloudscan.DeleteRepositoryCredentialRequest
+	0, // 5: cloudscan.CredentialService.SetRepositoryCredential:output_type -> cloudscan.RepositoryCredential
+	0, // 6: cloudscan.CredentialService.GetRepositoryCredential:output_type -> cloudscan.RepositoryCredential
+	5, // 7: cloudscan.CredentialService.DeleteRepositoryCredential:output_type -> google.protobuf.Empty
+	5, // [5:8] is the sub-list for method output_type
+	2, // [2:5] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_credentials_proto_init() }
+func file_credentials_proto_init() {
+	if File_credentials_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_credentials_proto_rawDesc), len(file_credentials_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_credentials_proto_goTypes,
+		DependencyIndexes: file_credentials_proto_depIdxs,
+		MessageInfos:      file_credentials_proto_msgTypes,
+	}.Build()
+	File_credentials_proto = out.File
+	file_credentials_proto_goTypes = nil
+	file_credentials_proto_depIdxs = nil
+}
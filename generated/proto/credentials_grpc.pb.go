@@ -0,0 +1,206 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v5.29.3
+// source: credentials.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CredentialService_SetRepositoryCredential_FullMethodName    = "/cloudscan.CredentialService/SetRepositoryCredential"
+	CredentialService_GetRepositoryCredential_FullMethodName    = "/cloudscan.CredentialService/GetRepositoryCredential"
+	CredentialService_DeleteRepositoryCredential_FullMethodName = "/cloudscan.CredentialService/DeleteRepositoryCredential"
+)
+
+// CredentialServiceClient is the client API for CredentialService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// CredentialService manages the credentials git scans of a project clone
+// private repositories with. Secrets are write-only: they are stored
+// encrypted and never returned. Every change is recorded in the audit log.
+type CredentialServiceClient interface {
+	SetRepositoryCredential(ctx context.Context, in *SetRepositoryCredentialRequest, opts ...grpc.CallOption) (*RepositoryCredential, error)
+	GetRepositoryCredential(ctx context.Context, in *GetRepositoryCredentialRequest, opts ...grpc.CallOption) (*RepositoryCredential, error)
+	DeleteRepositoryCredential(ctx context.Context, in *DeleteRepositoryCredentialRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type credentialServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCredentialServiceClient(cc grpc.ClientConnInterface) CredentialServiceClient {
+	return &credentialServiceClient{cc}
+}
+
+func (c *credentialServiceClient) SetRepositoryCredential(ctx context.Context, in *SetRepositoryCredentialRequest, opts ...grpc.CallOption) (*RepositoryCredential, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RepositoryCredential)
+	err := c.cc.Invoke(ctx, CredentialService_SetRepositoryCredential_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *credentialServiceClient) GetRepositoryCredential(ctx context.Context, in *GetRepositoryCredentialRequest, opts ...grpc.CallOption) (*RepositoryCredential, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RepositoryCredential)
+	err := c.cc.Invoke(ctx, CredentialService_GetRepositoryCredential_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *credentialServiceClient) DeleteRepositoryCredential(ctx context.Context, in *DeleteRepositoryCredentialRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CredentialService_DeleteRepositoryCredential_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CredentialServiceServer is the server API for CredentialService service.
+// All implementations must embed UnimplementedCredentialServiceServer
+// for forward compatibility.
+//
+// CredentialService manages the credentials git scans of a project clone
+// private repositories with. Secrets are write-only: they are stored
+// encrypted and never returned. Every change is recorded in the audit log.
+type CredentialServiceServer interface {
+	SetRepositoryCredential(context.Context, *SetRepositoryCredentialRequest) (*RepositoryCredential, error)
+	GetRepositoryCredential(context.Context, *GetRepositoryCredentialRequest) (*RepositoryCredential, error)
+	DeleteRepositoryCredential(context.Context, *DeleteRepositoryCredentialRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedCredentialServiceServer()
+}
+
+// UnimplementedCredentialServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCredentialServiceServer struct{}
+
+func (UnimplementedCredentialServiceServer) SetRepositoryCredential(context.Context, *SetRepositoryCredentialRequest) (*RepositoryCredential, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetRepositoryCredential not implemented")
+}
+func (UnimplementedCredentialServiceServer) GetRepositoryCredential(context.Context, *GetRepositoryCredentialRequest) (*RepositoryCredential, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRepositoryCredential not implemented")
+}
+func (UnimplementedCredentialServiceServer) DeleteRepositoryCredential(context.Context, *DeleteRepositoryCredentialRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteRepositoryCredential not implemented")
+}
+func (UnimplementedCredentialServiceServer) mustEmbedUnimplementedCredentialServiceServer() {}
+func (UnimplementedCredentialServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeCredentialServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CredentialServiceServer will
+// result in compilation errors.
+type UnsafeCredentialServiceServer interface {
+	mustEmbedUnimplementedCredentialServiceServer()
+}
+
+func RegisterCredentialServiceServer(s grpc.ServiceRegistrar, srv CredentialServiceServer) {
+	// If the following call panics, it indicates UnimplementedCredentialServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CredentialService_ServiceDesc, srv)
+}
+
+func _CredentialService_SetRepositoryCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRepositoryCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CredentialServiceServer).SetRepositoryCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CredentialService_SetRepositoryCredential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CredentialServiceServer).SetRepositoryCredential(ctx, req.(*SetRepositoryCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CredentialService_GetRepositoryCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRepositoryCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CredentialServiceServer).GetRepositoryCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CredentialService_GetRepositoryCredential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CredentialServiceServer).GetRepositoryCredential(ctx, req.(*GetRepositoryCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CredentialService_DeleteRepositoryCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRepositoryCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CredentialServiceServer).DeleteRepositoryCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CredentialService_DeleteRepositoryCredential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CredentialServiceServer).DeleteRepositoryCredential(ctx, req.(*DeleteRepositoryCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CredentialService_ServiceDesc is the grpc.ServiceDesc for CredentialService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CredentialService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cloudscan.CredentialService",
+	HandlerType: (*CredentialServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetRepositoryCredential",
+			Handler:    _CredentialService_SetRepositoryCredential_Handler,
+		},
+		{
+			MethodName: "GetRepositoryCredential",
+			Handler:    _CredentialService_GetRepositoryCredential_Handler,
+		},
+		{
+			MethodName: "DeleteRepositoryCredential",
+			Handler:    _CredentialService_DeleteRepositoryCredential_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "credentials.proto",
+}
@@ -0,0 +1,538 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v5.29.3
+// source: events.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EventEnvelope wraps every event published to the bus
+type EventEnvelope struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                             // Unique per event; consumers use it for deduplication
+	Type           string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`                                         // e.g. "scan.completed", "finding.critical"
+	SchemaVersion  int32                  `protobuf:"varint,3,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"` // Version of this package, currently 1
+	OrganizationId string                 `protobuf:"bytes,4,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	OccurredAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*EventEnvelope_Scan
+	//	*EventEnvelope_Finding
+	Payload       isEventEnvelope_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventEnvelope) Reset() {
+	*x = EventEnvelope{}
+	mi := &file_events_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventEnvelope) ProtoMessage() {}
+
+func (x *EventEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventEnvelope.ProtoReflect.Descriptor instead.
+func (*EventEnvelope) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EventEnvelope) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EventEnvelope) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *EventEnvelope) GetSchemaVersion() int32 {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return 0
+}
+
+func (x *EventEnvelope) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *EventEnvelope) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *EventEnvelope) GetPayload() isEventEnvelope_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *EventEnvelope) GetScan() *ScanEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*EventEnvelope_Scan); ok {
+			return x.Scan
+		}
+	}
+	return nil
+}
+
+func (x *EventEnvelope) GetFinding() *FindingEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*EventEnvelope_Finding); ok {
+			return x.Finding
+		}
+	}
+	return nil
+}
+
+type isEventEnvelope_Payload interface {
+	isEventEnvelope_Payload()
+}
+
+type EventEnvelope_Scan struct {
+	Scan *ScanEvent `protobuf:"bytes,10,opt,name=scan,proto3,oneof"`
+}
+
+type EventEnvelope_Finding struct {
+	Finding *FindingEvent `protobuf:"bytes,11,opt,name=finding,proto3,oneof"`
+}
+
+func (*EventEnvelope_Scan) isEventEnvelope_Payload() {}
+
+func (*EventEnvelope_Finding) isEventEnvelope_Payload() {}
+
+// ScanEvent is published when a scan changes status
+type ScanEvent struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ScanId             string                 `protobuf:"bytes,1,opt,name=scan_id,json=scanId,proto3" json:"scan_id,omitempty"`
+	ProjectId          string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	UserId             string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status             ScanStatus             `protobuf:"varint,4,opt,name=status,proto3,enum=cloudscan.ScanStatus" json:"status,omitempty"`
+	ScanTypes          []ScanType             `protobuf:"varint,5,rep,packed,name=scan_types,json=scanTypes,proto3,enum=cloudscan.ScanType" json:"scan_types,omitempty"`
+	RepositoryUrl      string                 `protobuf:"bytes,6,opt,name=repository_url,json=repositoryUrl,proto3" json:"repository_url,omitempty"`
+	Branch             string                 `protobuf:"bytes,7,opt,name=branch,proto3" json:"branch,omitempty"`
+	CommitSha          string                 `protobuf:"bytes,8,opt,name=commit_sha,json=commitSha,proto3" json:"commit_sha,omitempty"`
+	FindingsCount      int32                  `protobuf:"varint,9,opt,name=findings_count,json=findingsCount,proto3" json:"findings_count,omitempty"`
+	FindingsBySeverity map[string]int32       `protobuf:"bytes,10,rep,name=findings_by_severity,json=findingsBySeverity,proto3" json:"findings_by_severity,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	ErrorMessage       string                 `protobuf:"bytes,11,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	StartedAt          *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	CompletedAt        *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ScanEvent) Reset() {
+	*x = ScanEvent{}
+	mi := &file_events_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanEvent) ProtoMessage() {}
+
+func (x *ScanEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanEvent.ProtoReflect.Descriptor instead.
+func (*ScanEvent) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ScanEvent) GetScanId() string {
+	if x != nil {
+		return x.ScanId
+	}
+	return ""
+}
+
+func (x *ScanEvent) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ScanEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ScanEvent) GetStatus() ScanStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ScanStatus_SCAN_STATUS_UNSPECIFIED
+}
+
+func (x *ScanEvent) GetScanTypes() []ScanType {
+	if x != nil {
+		return x.ScanTypes
+	}
+	return nil
+}
+
+func (x *ScanEvent) GetRepositoryUrl() string {
+	if x != nil {
+		return x.RepositoryUrl
+	}
+	return ""
+}
+
+func (x *ScanEvent) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *ScanEvent) GetCommitSha() string {
+	if x != nil {
+		return x.CommitSha
+	}
+	return ""
+}
+
+func (x *ScanEvent) GetFindingsCount() int32 {
+	if x != nil {
+		return x.FindingsCount
+	}
+	return 0
+}
+
+func (x *ScanEvent) GetFindingsBySeverity() map[string]int32 {
+	if x != nil {
+		return x.FindingsBySeverity
+	}
+	return nil
+}
+
+func (x *ScanEvent) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *ScanEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ScanEvent) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *ScanEvent) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+// FindingEvent is published when a critical finding is reported
+type FindingEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FindingId     string                 `protobuf:"bytes,1,opt,name=finding_id,json=findingId,proto3" json:"finding_id,omitempty"`
+	ScanId        string                 `protobuf:"bytes,2,opt,name=scan_id,json=scanId,proto3" json:"scan_id,omitempty"`
+	ScanType      ScanType               `protobuf:"varint,3,opt,name=scan_type,json=scanType,proto3,enum=cloudscan.ScanType" json:"scan_type,omitempty"`
+	Severity      Severity               `protobuf:"varint,4,opt,name=severity,proto3,enum=cloudscan.Severity" json:"severity,omitempty"`
+	ToolName      string                 `protobuf:"bytes,5,opt,name=tool_name,json=toolName,proto3" json:"tool_name,omitempty"`
+	Title         string                 `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
+	FilePath      string                 `protobuf:"bytes,7,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`
+	StartLine     int32                  `protobuf:"varint,8,opt,name=start_line,json=startLine,proto3" json:"start_line,omitempty"`
+	CweId         string                 `protobuf:"bytes,9,opt,name=cwe_id,json=cweId,proto3" json:"cwe_id,omitempty"`
+	CveId         string                 `protobuf:"bytes,10,opt,name=cve_id,json=cveId,proto3" json:"cve_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindingEvent) Reset() {
+	*x = FindingEvent{}
+	mi := &file_events_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindingEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindingEvent) ProtoMessage() {}
+
+func (x *FindingEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindingEvent.ProtoReflect.Descriptor instead.
+func (*FindingEvent) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FindingEvent) GetFindingId() string {
+	if x != nil {
+		return x.FindingId
+	}
+	return ""
+}
+
+func (x *FindingEvent) GetScanId() string {
+	if x != nil {
+		return x.ScanId
+	}
+	return ""
+}
+
+func (x *FindingEvent) GetScanType() ScanType {
+	if x != nil {
+		return x.ScanType
+	}
+	return ScanType_SCAN_TYPE_UNSPECIFIED
+}
+
+func (x *FindingEvent) GetSeverity() Severity {
+	if x != nil {
+		return x.Severity
+	}
+	return Severity_SEVERITY_UNSPECIFIED
+}
+
+func (x *FindingEvent) GetToolName() string {
+	if x != nil {
+		return x.ToolName
+	}
+	return ""
+}
+
+func (x *FindingEvent) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *FindingEvent) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
+	}
+	return ""
+}
+
+func (x *FindingEvent) GetStartLine() int32 {
+	if x != nil {
+		return x.StartLine
+	}
+	return 0
+}
+
+func (x *FindingEvent) GetCweId() string {
+	if x != nil {
+		return x.CweId
+	}
+	return ""
+}
+
+func (x *FindingEvent) GetCveId() string {
+	if x != nil {
+		return x.CveId
+	}
+	return ""
+}
+
+var File_events_proto protoreflect.FileDescriptor
+
+const file_events_proto_rawDesc = "" +
+	"\n" +
+	"\fevents.proto\x12\x13cloudscan.events.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\vscans.proto\"\xc0\x02\n" +
+	"\rEventEnvelope\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12%\n" +
+	"\x0eschema_version\x18\x03 \x01(\x05R\rschemaVersion\x12'\n" +
+	"\x0forganization_id\x18\x04 \x01(\tR\x0eorganizationId\x12;\n" +
+	"\voccurred_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x124\n" +
+	"\x04scan\x18\n" +
+	" \x01(\v2\x1e.cloudscan.events.v1.ScanEventH\x00R\x04scan\x12=\n" +
+	"\afinding\x18\v \x01(\v2!.cloudscan.events.v1.FindingEventH\x00R\afindingB\t\n" +
+	"\apayload\"\xcf\x05\n" +
+	"\tScanEvent\x12\x17\n" +
+	"\ascan_id\x18\x01 \x01(\tR\x06scanId\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12-\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x15.cloudscan.ScanStatusR\x06status\x122\n" +
+	"\n" +
+	"scan_types\x18\x05 \x03(\x0e2\x13.cloudscan.ScanTypeR\tscanTypes\x12%\n" +
+	"\x0erepository_url\x18\x06 \x01(\tR\rrepositoryUrl\x12\x16\n" +
+	"\x06branch\x18\a \x01(\tR\x06branch\x12\x1d\n" +
+	"\n" +
+	"commit_sha\x18\b \x01(\tR\tcommitSha\x12%\n" +
+	"\x0efindings_count\x18\t \x01(\x05R\rfindingsCount\x12h\n" +
+	"\x14findings_by_severity\x18\n" +
+	" \x03(\v26.cloudscan.events.v1.ScanEvent.FindingsBySeverityEntryR\x12findingsBySeverity\x12#\n" +
+	"\rerror_message\x18\v \x01(\tR\ferrorMessage\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"started_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12=\n" +
+	"\fcompleted_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x1aE\n" +
+	"\x17FindingsBySeverityEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xc6\x02\n" +
+	"\fFindingEvent\x12\x1d\n" +
+	"\n" +
+	"finding_id\x18\x01 \x01(\tR\tfindingId\x12\x17\n" +
+	"\ascan_id\x18\x02 \x01(\tR\x06scanId\x120\n" +
+	"\tscan_type\x18\x03 \x01(\x0e2\x13.cloudscan.ScanTypeR\bscanType\x12/\n" +
+	"\bseverity\x18\x04 \x01(\x0e2\x13.cloudscan.SeverityR\bseverity\x12\x1b\n" +
+	"\ttool_name\x18\x05 \x01(\tR\btoolName\x12\x14\n" +
+	"\x05title\x18\x06 \x01(\tR\x05title\x12\x1b\n" +
+	"\tfile_path\x18\a \x01(\tR\bfilePath\x12\x1d\n" +
+	"\n" +
+	"start_line\x18\b \x01(\x05R\tstartLine\x12\x15\n" +
+	"\x06cwe_id\x18\t \x01(\tR\x05cweId\x12\x15\n" +
+	"\x06cve_id\x18\n" +
+	" \x01(\tR\x05cveIdB>Z<github.com/cloud-scan/cloudscan-orchestrator/generated/protob\x06proto3"
+
+var (
+	file_events_proto_rawDescOnce sync.Once
+	file_events_proto_rawDescData []byte
+)
+
+func file_events_proto_rawDescGZIP() []byte {
+	file_events_proto_rawDescOnce.Do(func() {
+		file_events_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_events_proto_rawDesc), len(file_events_proto_rawDesc)))
+	})
+	return file_events_proto_rawDescData
+}
+
+var file_events_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_events_proto_goTypes = []any{
+	(*EventEnvelope)(nil),         // 0: cloudscan.events.v1.EventEnvelope
+	(*ScanEvent)(nil),             // 1: cloudscan.events.v1.ScanEvent
+	(*FindingEvent)(nil),          // 2: cloudscan.events.v1.FindingEvent
+	nil,                           // 3: cloudscan.events.v1.ScanEvent.FindingsBySeverityEntry
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+	(ScanStatus)(0),               // 5: cloudscan.ScanStatus
+	(ScanType)(0),                 // 6: cloudscan.ScanType
+	(Severity)(0),                 // 7: cloudscan.Severity
+}
+var file_events_proto_depIdxs = []int32{
+	4,  // 0: cloudscan.events.v1.EventEnvelope.occurred_at:type_name -> google.protobuf.Timestamp
+	1,  // 1: cloudscan.events.v1.EventEnvelope.scan:type_name -> cloudscan.events.v1.ScanEvent
+	2,  // 2: cloudscan.events.v1.EventEnvelope.finding:type_name -> cloudscan.events.v1.FindingEvent
+	5,  // 3: cloudscan.events.v1.ScanEvent.status:type_name -> cloudscan.ScanStatus
+	6,  // 4: cloudscan.events.v1.ScanEvent.scan_types:type_name -> cloudscan.ScanType
+	3,  // 5: cloudscan.events.v1.ScanEvent.findings_by_severity:type_name -> cloudscan.events.v1.ScanEvent.FindingsBySeverityEntry
+	4,  // 6: cloudscan.events.v1.ScanEvent.created_at:type_name -> google.protobuf.Timestamp
+	4,  // 7: cloudscan.events.v1.ScanEvent.started_at:type_name -> google.protobuf.Timestamp
+	4,  // 8: cloudscan.events.v1.ScanEvent.completed_at:type_name -> google.protobuf.Timestamp
+	6,  // 9: cloudscan.events.v1.FindingEvent.scan_type:type_name -> cloudscan.ScanType
+	7,  // 10: cloudscan.events.v1.FindingEvent.severity:type_name -> cloudscan.Severity
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_events_proto_init() }
+func file_events_proto_init() {
+	if File_events_proto != nil {
+		return
+	}
+	file_scans_proto_init()
+	file_events_proto_msgTypes[0].OneofWrappers = []any{
+		(*EventEnvelope_Scan)(nil),
+		(*EventEnvelope_Finding)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_proto_rawDesc), len(file_events_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_events_proto_goTypes,
+		DependencyIndexes: file_events_proto_depIdxs,
+		MessageInfos:      file_events_proto_msgTypes,
+	}.Build()
+	File_events_proto = out.File
+	file_events_proto_goTypes = nil
+	file_events_proto_depIdxs = nil
+}
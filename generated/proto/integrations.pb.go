@@ -0,0 +1,544 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v5.29.3
+// source: integrations.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GitProvider enum
+type GitProvider int32
+
+const (
+	GitProvider_GIT_PROVIDER_UNSPECIFIED GitProvider = 0
+	GitProvider_GITHUB                   GitProvider = 1
+	GitProvider_GITLAB                   GitProvider = 2
+	GitProvider_BITBUCKET                GitProvider = 3
+)
+
+// Enum value maps for GitProvider.
+var (
+	GitProvider_name = map[int32]string{
+		0: "GIT_PROVIDER_UNSPECIFIED",
+		1: "GITHUB",
+		2: "GITLAB",
+		3: "BITBUCKET",
+	}
+	GitProvider_value = map[string]int32{
+		"GIT_PROVIDER_UNSPECIFIED": 0,
+		"GITHUB":                   1,
+		"GITLAB":                   2,
+		"BITBUCKET":                3,
+	}
+)
+
+func (x GitProvider) Enum() *GitProvider {
+	p := new(GitProvider)
+	*p = x
+	return p
+}
+
+func (x GitProvider) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GitProvider) Descriptor() protoreflect.EnumDescriptor {
+	return file_integrations_proto_enumTypes[0].Descriptor()
+}
+
+func (GitProvider) Type() protoreflect.EnumType {
+	return &file_integrations_proto_enumTypes[0]
+}
+
+func (x GitProvider) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GitProvider.Descriptor instead.
+func (GitProvider) EnumDescriptor() ([]byte, []int) {
+	return file_integrations_proto_rawDescGZIP(), []int{0}
+}
+
+// RepositoryIntegration links a repository to a project
+type RepositoryIntegration struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	ProjectId      string                 `protobuf:"bytes,3,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Provider       GitProvider            `protobuf:"varint,4,opt,name=provider,proto3,enum=cloudscan.GitProvider" json:"provider,omitempty"`
+	RepositoryUrl  string                 `protobuf:"bytes,5,opt,name=repository_url,json=repositoryUrl,proto3" json:"repository_url,omitempty"` // Normalized as host/owner/repo
+	ScanTypes      []ScanType             `protobuf:"varint,6,rep,packed,name=scan_types,json=scanTypes,proto3,enum=cloudscan.ScanType" json:"scan_types,omitempty"`
+	Enabled        bool                   `protobuf:"varint,7,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	WebhookSecret  string                 `protobuf:"bytes,8,opt,name=webhook_secret,json=webhookSecret,proto3" json:"webhook_secret,omitempty"` // Only returned by CreateRepositoryIntegration
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RepositoryIntegration) Reset() {
+	*x = RepositoryIntegration{}
+	mi := &file_integrations_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RepositoryIntegration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepositoryIntegration) ProtoMessage() {}
+
+func (x *RepositoryIntegration) ProtoReflect() protoreflect.Message {
+	mi := &file_integrations_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepositoryIntegration.ProtoReflect.Descriptor instead.
+func (*RepositoryIntegration) Descriptor() ([]byte, []int) {
+	return file_integrations_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RepositoryIntegration) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RepositoryIntegration) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *RepositoryIntegration) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *RepositoryIntegration) GetProvider() GitProvider {
+	if x != nil {
+		return x.Provider
+	}
+	return GitProvider_GIT_PROVIDER_UNSPECIFIED
+}
+
+func (x *RepositoryIntegration) GetRepositoryUrl() string {
+	if x != nil {
+		return x.RepositoryUrl
+	}
+	return ""
+}
+
+func (x *RepositoryIntegration) GetScanTypes() []ScanType {
+	if x != nil {
+		return x.ScanTypes
+	}
+	return nil
+}
+
+func (x *RepositoryIntegration) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *RepositoryIntegration) GetWebhookSecret() string {
+	if x != nil {
+		return x.WebhookSecret
+	}
+	return ""
+}
+
+func (x *RepositoryIntegration) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *RepositoryIntegration) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// CreateRepositoryIntegrationRequest
+type CreateRepositoryIntegrationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	ProjectId      string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Provider       GitProvider            `protobuf:"varint,3,opt,name=provider,proto3,enum=cloudscan.GitProvider" json:"provider,omitempty"`
+	RepositoryUrl  string                 `protobuf:"bytes,4,opt,name=repository_url,json=repositoryUrl,proto3" json:"repository_url,omitempty"`
+	ScanTypes      []ScanType             `protobuf:"varint,5,rep,packed,name=scan_types,json=scanTypes,proto3,enum=cloudscan.ScanType" json:"scan_types,omitempty"`
+	WebhookSecret  string                 `protobuf:"bytes,6,opt,name=webhook_secret,json=webhookSecret,proto3" json:"webhook_secret,omitempty"` // Generated when empty
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateRepositoryIntegrationRequest) Reset() {
+	*x = CreateRepositoryIntegrationRequest{}
+	mi := &file_integrations_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRepositoryIntegrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRepositoryIntegrationRequest) ProtoMessage() {}
+
+func (x *CreateRepositoryIntegrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_integrations_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRepositoryIntegrationRequest.ProtoReflect.Descriptor instead.
+func (*CreateRepositoryIntegrationRequest) Descriptor() ([]byte, []int) {
+	return file_integrations_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateRepositoryIntegrationRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *CreateRepositoryIntegrationRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *CreateRepositoryIntegrationRequest) GetProvider() GitProvider {
+	if x != nil {
+		return x.Provider
+	}
+	return GitProvider_GIT_PROVIDER_UNSPECIFIED
+}
+
+func (x *CreateRepositoryIntegrationRequest) GetRepositoryUrl() string {
+	if x != nil {
+		return x.RepositoryUrl
+	}
+	return ""
+}
+
+func (x *CreateRepositoryIntegrationRequest) GetScanTypes() []ScanType {
+	if x != nil {
+		return x.ScanTypes
+	}
+	return nil
+}
+
+func (x *CreateRepositoryIntegrationRequest) GetWebhookSecret() string {
+	if x != nil {
+		return x.WebhookSecret
+	}
+	return ""
+}
+
+// ListRepositoryIntegrationsRequest
+type ListRepositoryIntegrationsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	ProjectId      string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListRepositoryIntegrationsRequest) Reset() {
+	*x = ListRepositoryIntegrationsRequest{}
+	mi := &file_integrations_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRepositoryIntegrationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRepositoryIntegrationsRequest) ProtoMessage() {}
+
+func (x *ListRepositoryIntegrationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_integrations_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRepositoryIntegrationsRequest.ProtoReflect.Descriptor instead.
+func (*ListRepositoryIntegrationsRequest) Descriptor() ([]byte, []int) {
+	return file_integrations_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListRepositoryIntegrationsRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ListRepositoryIntegrationsRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+// ListRepositoryIntegrationsResponse
+type ListRepositoryIntegrationsResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Integrations  []*RepositoryIntegration `protobuf:"bytes,1,rep,name=integrations,proto3" json:"integrations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRepositoryIntegrationsResponse) Reset() {
+	*x = ListRepositoryIntegrationsResponse{}
+	mi := &file_integrations_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRepositoryIntegrationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRepositoryIntegrationsResponse) ProtoMessage() {}
+
+func (x *ListRepositoryIntegrationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_integrations_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRepositoryIntegrationsResponse.ProtoReflect.Descriptor instead.
+func (*ListRepositoryIntegrationsResponse) Descriptor() ([]byte, []int) {
+	return file_integrations_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListRepositoryIntegrationsResponse) GetIntegrations() []*RepositoryIntegration {
+	if x != nil {
+		return x.Integrations
+	}
+	return nil
+}
+
+// DeleteRepositoryIntegrationRequest
+type DeleteRepositoryIntegrationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRepositoryIntegrationRequest) Reset() {
+	*x = DeleteRepositoryIntegrationRequest{}
+	mi := &file_integrations_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRepositoryIntegrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRepositoryIntegrationRequest) ProtoMessage() {}
+
+func (x *DeleteRepositoryIntegrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_integrations_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRepositoryIntegrationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRepositoryIntegrationRequest) Descriptor() ([]byte, []int) {
+	return file_integrations_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteRepositoryIntegrationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+var File_integrations_proto protoreflect.FileDescriptor
+
+const file_integrations_proto_rawDesc = "" +
+	"\n" +
+	"\x12integrations.proto\x12\tcloudscan\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\vscans.proto\"\xb5\x03\n" +
+	"\x15RepositoryIntegration\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x03 \x01(\tR\tprojectId\x122\n" +
+	"\bprovider\x18\x04 \x01(\x0e2\x16.cloudscan.GitProviderR\bprovider\x12%\n" +
+	"\x0erepository_url\x18\x05 \x01(\tR\rrepositoryUrl\x122\n" +
+	"\n" +
+	"scan_types\x18\x06 \x03(\x0e2\x13.cloudscan.ScanTypeR\tscanTypes\x12\x18\n" +
+	"\aenabled\x18\a \x01(\bR\aenabled\x12%\n" +
+	"\x0ewebhook_secret\x18\b \x01(\tR\rwebhookSecret\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xa2\x02\n" +
+	"\"CreateRepositoryIntegrationRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\x122\n" +
+	"\bprovider\x18\x03 \x01(\x0e2\x16.cloudscan.GitProviderR\bprovider\x12%\n" +
+	"\x0erepository_url\x18\x04 \x01(\tR\rrepositoryUrl\x122\n" +
+	"\n" +
+	"scan_types\x18\x05 \x03(\x0e2\x13.cloudscan.ScanTypeR\tscanTypes\x12%\n" +
+	"\x0ewebhook_secret\x18\x06 \x01(\tR\rwebhookSecret\"k\n" +
+	"!ListRepositoryIntegrationsRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\"j\n" +
+	"\"ListRepositoryIntegrationsResponse\x12D\n" +
+	"\fintegrations\x18\x01 \x03(\v2 .cloudscan.RepositoryIntegrationR\fintegrations\"4\n" +
+	"\"DeleteRepositoryIntegrationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id*R\n" +
+	"\vGitProvider\x12\x1c\n" +
+	"\x18GIT_PROVIDER_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06GITHUB\x10\x01\x12\n" +
+	"\n" +
+	"\x06GITLAB\x10\x02\x12\r\n" +
+	"\tBITBUCKET\x10\x032\xe5\x02\n" +
+	"\x12IntegrationService\x12n\n" +
+	"\x1bCreateRepositoryIntegration\x12-.cloudscan.CreateRepositoryIntegrationRequest\x1a .cloudscan.RepositoryIntegration\x12y\n" +
+	"\x1aListRepositoryIntegrations\x12,.cloudscan.ListRepositoryIntegrationsRequest\x1a-.cloudscan.ListRepositoryIntegrationsResponse\x12d\n" +
+	"\x1bDeleteRepositoryIntegration\x12-.cloudscan.DeleteRepositoryIntegrationRequest\x1a\x16.google.protobuf.EmptyB>Z<github.com/cloud-scan/cloudscan-orchestrator/generated/protob\x06proto3"
+
+var (
+	file_integrations_proto_rawDescOnce sync.Once
+	file_integrations_proto_rawDescData []byte
+)
+
+func file_integrations_proto_rawDescGZIP() []byte {
+	file_integrations_proto_rawDescOnce.Do(func() {
+		file_integrations_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_integrations_proto_rawDesc), len(file_integrations_proto_rawDesc)))
+	})
+	return file_integrations_proto_rawDescData
+}
+
+var file_integrations_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_integrations_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_integrations_proto_goTypes = []any{
+	(GitProvider)(0),                           // 0: cloudscan.GitProvider
+	(*RepositoryIntegration)(nil),              // 1: cloudscan.RepositoryIntegration
+	(*CreateRepositoryIntegrationRequest)(nil), // 2: cloudscan.CreateRepositoryIntegrationRequest
+	(*ListRepositoryIntegrationsRequest)(nil),  // 3: cloudscan.ListRepositoryIntegrationsRequest
+	(*ListRepositoryIntegrationsResponse)(nil), // 4: cloudscan.ListRepositoryIntegrationsResponse
+	(*DeleteRepositoryIntegrationRequest)(nil), // 5: cloudscan.DeleteRepositoryIntegrationRequest
+	(ScanType)(0),                              // 6: cloudscan.ScanType
+	(*timestamppb.Timestamp)(nil),              // 7: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                      // 8: google.protobuf.Empty
+}
+var file_integrations_proto_depIdxs = []int32{
+	0,  // 0: cloudscan.RepositoryIntegration.provider:type_name -> cloudscan.GitProvider
+	6,  // 1: cloudscan.RepositoryIntegration.scan_types:type_name -> cloudscan.ScanType
+	7,  // 2: cloudscan.RepositoryIntegration.created_at:type_name -> google.protobuf.Timestamp
+	7,  // 3: cloudscan.RepositoryIntegration.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 4: cloudscan.CreateRepositoryIntegrationRequest.provider:type_name -> cloudscan.GitProvider
+	6,  // 5: cloudscan.CreateRepositoryIntegrationRequest.scan_types:type_name -> cloudscan.ScanType
+	1,  // 6: cloudscan.ListRepositoryIntegrationsResponse.integrations:type_name -> cloudscan.RepositoryIntegration
+	2,  // 7: cloudscan.IntegrationService.CreateRepositoryIntegration:input_type -> cloudscan.CreateRepositoryIntegrationRequest
+	3,  // 8: cloudscan.IntegrationService.ListRepositoryIntegrations:input_type -> cloudscan.ListRepositoryIntegrationsRequest
+	5,  // 9: cloudscan.IntegrationService.DeleteRepositoryIntegration:input_type -> cloudscan.DeleteRepositoryIntegrationRequest
+	1,  // 10: cloudscan.IntegrationService.CreateRepositoryIntegration:output_type -> cloudscan.RepositoryIntegration
+	4,  // 11: cloudscan.IntegrationService.ListRepositoryIntegrations:output_type -> cloudscan.ListRepositoryIntegrationsResponse
+	8,  // 12: cloudscan.IntegrationService.DeleteRepositoryIntegration:output_type -> google.protobuf.Empty
+	10, // [10:13] is the sub-list for method output_type
+	7,  // [7:10] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_integrations_proto_init() }
+func file_integrations_proto_init() {
+	if File_integrations_proto != nil {
+		return
+	}
+	file_scans_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_integrations_proto_rawDesc), len(file_integrations_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_integrations_proto_goTypes,
+		DependencyIndexes: file_integrations_proto_depIdxs,
+		EnumInfos:         file_integrations_proto_enumTypes,
+		MessageInfos:      file_integrations_proto_msgTypes,
+	}.Build()
+	File_integrations_proto = out.File
+	file_integrations_proto_goTypes = nil
+	file_integrations_proto_depIdxs = nil
+}
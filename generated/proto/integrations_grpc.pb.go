@@ -0,0 +1,202 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v5.29.3
+// source: integrations.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	IntegrationService_CreateRepositoryIntegration_FullMethodName = "/cloudscan.IntegrationService/CreateRepositoryIntegration"
+	IntegrationService_ListRepositoryIntegrations_FullMethodName  = "/cloudscan.IntegrationService/ListRepositoryIntegrations"
+	IntegrationService_DeleteRepositoryIntegration_FullMethodName = "/cloudscan.IntegrationService/DeleteRepositoryIntegration"
+)
+
+// IntegrationServiceClient is the client API for IntegrationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// IntegrationService manages Git repository integrations that trigger scans from webhooks
+type IntegrationServiceClient interface {
+	CreateRepositoryIntegration(ctx context.Context, in *CreateRepositoryIntegrationRequest, opts ...grpc.CallOption) (*RepositoryIntegration, error)
+	ListRepositoryIntegrations(ctx context.Context, in *ListRepositoryIntegrationsRequest, opts ...grpc.CallOption) (*ListRepositoryIntegrationsResponse, error)
+	DeleteRepositoryIntegration(ctx context.Context, in *DeleteRepositoryIntegrationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type integrationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewIntegrationServiceClient(cc grpc.ClientConnInterface) IntegrationServiceClient {
+	return &integrationServiceClient{cc}
+}
+
+func (c *integrationServiceClient) CreateRepositoryIntegration(ctx context.Context, in *CreateRepositoryIntegrationRequest, opts ...grpc.CallOption) (*RepositoryIntegration, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RepositoryIntegration)
+	err := c.cc.Invoke(ctx, IntegrationService_CreateRepositoryIntegration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *integrationServiceClient) ListRepositoryIntegrations(ctx context.Context, in *ListRepositoryIntegrationsRequest, opts ...grpc.CallOption) (*ListRepositoryIntegrationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRepositoryIntegrationsResponse)
+	err := c.cc.Invoke(ctx, IntegrationService_ListRepositoryIntegrations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *integrationServiceClient) DeleteRepositoryIntegration(ctx context.Context, in *DeleteRepositoryIntegrationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, IntegrationService_DeleteRepositoryIntegration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// IntegrationServiceServer is the server API for IntegrationService service.
+// All implementations must embed UnimplementedIntegrationServiceServer
+// for forward compatibility.
+//
+// IntegrationService manages Git repository integrations that trigger scans from webhooks
+type IntegrationServiceServer interface {
+	CreateRepositoryIntegration(context.Context, *CreateRepositoryIntegrationRequest) (*RepositoryIntegration, error)
+	ListRepositoryIntegrations(context.Context, *ListRepositoryIntegrationsRequest) (*ListRepositoryIntegrationsResponse, error)
+	DeleteRepositoryIntegration(context.Context, *DeleteRepositoryIntegrationRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedIntegrationServiceServer()
+}
+
+// UnimplementedIntegrationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedIntegrationServiceServer struct{}
+
+func (UnimplementedIntegrationServiceServer) CreateRepositoryIntegration(context.Context, *CreateRepositoryIntegrationRequest) (*RepositoryIntegration, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateRepositoryIntegration not implemented")
+}
+func (UnimplementedIntegrationServiceServer) ListRepositoryIntegrations(context.Context, *ListRepositoryIntegrationsRequest) (*ListRepositoryIntegrationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRepositoryIntegrations not implemented")
+}
+func (UnimplementedIntegrationServiceServer) DeleteRepositoryIntegration(context.Context, *DeleteRepositoryIntegrationRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteRepositoryIntegration not implemented")
+}
+func (UnimplementedIntegrationServiceServer) mustEmbedUnimplementedIntegrationServiceServer() {}
+func (UnimplementedIntegrationServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeIntegrationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to IntegrationServiceServer will
+// result in compilation errors.
+type UnsafeIntegrationServiceServer interface {
+	mustEmbedUnimplementedIntegrationServiceServer()
+}
+
+func RegisterIntegrationServiceServer(s grpc.ServiceRegistrar, srv IntegrationServiceServer) {
+	// If the following call panics, it indicates UnimplementedIntegrationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&IntegrationService_ServiceDesc, srv)
+}
+
+func _IntegrationService_CreateRepositoryIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRepositoryIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IntegrationServiceServer).CreateRepositoryIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IntegrationService_CreateRepositoryIntegration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IntegrationServiceServer).CreateRepositoryIntegration(ctx, req.(*CreateRepositoryIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IntegrationService_ListRepositoryIntegrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRepositoryIntegrationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IntegrationServiceServer).ListRepositoryIntegrations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IntegrationService_ListRepositoryIntegrations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IntegrationServiceServer).ListRepositoryIntegrations(ctx, req.(*ListRepositoryIntegrationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IntegrationService_DeleteRepositoryIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRepositoryIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IntegrationServiceServer).DeleteRepositoryIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IntegrationService_DeleteRepositoryIntegration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IntegrationServiceServer).DeleteRepositoryIntegration(ctx, req.(*DeleteRepositoryIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// IntegrationService_ServiceDesc is the grpc.ServiceDesc for IntegrationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var IntegrationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cloudscan.IntegrationService",
+	HandlerType: (*IntegrationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRepositoryIntegration",
+			Handler:    _IntegrationService_CreateRepositoryIntegration_Handler,
+		},
+		{
+			MethodName: "ListRepositoryIntegrations",
+			Handler:    _IntegrationService_ListRepositoryIntegrations_Handler,
+		},
+		{
+			MethodName: "DeleteRepositoryIntegration",
+			Handler:    _IntegrationService_DeleteRepositoryIntegration_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "integrations.proto",
+}
@@ -0,0 +1,900 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v5.29.3
+// source: job_profiles.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// JobSettings customize the jobs of a scan. Unset fields keep the value of
+// the broader settings: a scan's override its project's profile, which
+// overrides the scanner and the service-wide job config.
+type JobSettings struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	RequestsCpu           string                 `protobuf:"bytes,1,opt,name=requests_cpu,json=requestsCpu,proto3" json:"requests_cpu,omitempty"`          // e.g. 500m
+	RequestsMemory        string                 `protobuf:"bytes,2,opt,name=requests_memory,json=requestsMemory,proto3" json:"requests_memory,omitempty"` // e.g. 2Gi
+	LimitsCpu             string                 `protobuf:"bytes,3,opt,name=limits_cpu,json=limitsCpu,proto3" json:"limits_cpu,omitempty"`
+	LimitsMemory          string                 `protobuf:"bytes,4,opt,name=limits_memory,json=limitsMemory,proto3" json:"limits_memory,omitempty"`
+	EphemeralStorage      string                 `protobuf:"bytes,5,opt,name=ephemeral_storage,json=ephemeralStorage,proto3" json:"ephemeral_storage,omitempty"` // Size of the checkout volume, e.g. 20Gi
+	ActiveDeadlineSeconds *int64                 `protobuf:"varint,6,opt,name=active_deadline_seconds,json=activeDeadlineSeconds,proto3,oneof" json:"active_deadline_seconds,omitempty"`
+	BackoffLimit          *int32                 `protobuf:"varint,7,opt,name=backoff_limit,json=backoffLimit,proto3,oneof" json:"backoff_limit,omitempty"`
+	NodeSelector          map[string]string      `protobuf:"bytes,8,rep,name=node_selector,json=nodeSelector,proto3" json:"node_selector,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Tolerations           []*Toleration          `protobuf:"bytes,9,rep,name=tolerations,proto3" json:"tolerations,omitempty"`
+	PriorityClassName     string                 `protobuf:"bytes,10,opt,name=priority_class_name,json=priorityClassName,proto3" json:"priority_class_name,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *JobSettings) Reset() {
+	*x = JobSettings{}
+	mi := &file_job_profiles_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobSettings) ProtoMessage() {}
+
+func (x *JobSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_job_profiles_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobSettings.ProtoReflect.Descriptor instead.
+func (*JobSettings) Descriptor() ([]byte, []int) {
+	return file_job_profiles_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *JobSettings) GetRequestsCpu() string {
+	if x != nil {
+		return x.RequestsCpu
+	}
+	return ""
+}
+
+func (x *JobSettings) GetRequestsMemory() string {
+	if x != nil {
+		return x.RequestsMemory
+	}
+	return ""
+}
+
+func (x *JobSettings) GetLimitsCpu() string {
+	if x != nil {
+		return x.LimitsCpu
+	}
+	return ""
+}
+
+func (x *JobSettings) GetLimitsMemory() string {
+	if x != nil {
+		return x.LimitsMemory
+	}
+	return ""
+}
+
+func (x *JobSettings) GetEphemeralStorage() string {
+	if x != nil {
+		return x.EphemeralStorage
+	}
+	return ""
+}
+
+func (x *JobSettings) GetActiveDeadlineSeconds() int64 {
+	if x != nil && x.ActiveDeadlineSeconds != nil {
+		return *x.ActiveDeadlineSeconds
+	}
+	return 0
+}
+
+func (x *JobSettings) GetBackoffLimit() int32 {
+	if x != nil && x.BackoffLimit != nil {
+		return *x.BackoffLimit
+	}
+	return 0
+}
+
+func (x *JobSettings) GetNodeSelector() map[string]string {
+	if x != nil {
+		return x.NodeSelector
+	}
+	return nil
+}
+
+func (x *JobSettings) GetTolerations() []*Toleration {
+	if x != nil {
+		return x.Tolerations
+	}
+	return nil
+}
+
+func (x *JobSettings) GetPriorityClassName() string {
+	if x != nil {
+		return x.PriorityClassName
+	}
+	return ""
+}
+
+// Toleration lets the jobs be scheduled onto nodes with a matching taint
+type Toleration struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Key               string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Operator          string                 `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"` // Equal (default) or Exists
+	Value             string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Effect            string                 `protobuf:"bytes,4,opt,name=effect,proto3" json:"effect,omitempty"`                                                       // NoSchedule, PreferNoSchedule or NoExecute; empty matches all
+	TolerationSeconds *int64                 `protobuf:"varint,5,opt,name=toleration_seconds,json=tolerationSeconds,proto3,oneof" json:"toleration_seconds,omitempty"` // NoExecute only
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Toleration) Reset() {
+	*x = Toleration{}
+	mi := &file_job_profiles_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Toleration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Toleration) ProtoMessage() {}
+
+func (x *Toleration) ProtoReflect() protoreflect.Message {
+	mi := &file_job_profiles_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Toleration.ProtoReflect.Descriptor instead.
+func (*Toleration) Descriptor() ([]byte, []int) {
+	return file_job_profiles_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Toleration) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Toleration) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *Toleration) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Toleration) GetEffect() string {
+	if x != nil {
+		return x.Effect
+	}
+	return ""
+}
+
+func (x *Toleration) GetTolerationSeconds() int64 {
+	if x != nil && x.TolerationSeconds != nil {
+		return *x.TolerationSeconds
+	}
+	return 0
+}
+
+// JobProfile holds the job settings of a project's scans
+type JobProfile struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	ProjectId      string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Settings       *JobSettings           `protobuf:"bytes,3,opt,name=settings,proto3" json:"settings,omitempty"`
+	UpdatedBy      string                 `protobuf:"bytes,4,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *JobProfile) Reset() {
+	*x = JobProfile{}
+	mi := &file_job_profiles_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobProfile) ProtoMessage() {}
+
+func (x *JobProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_job_profiles_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobProfile.ProtoReflect.Descriptor instead.
+func (*JobProfile) Descriptor() ([]byte, []int) {
+	return file_job_profiles_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *JobProfile) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *JobProfile) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *JobProfile) GetSettings() *JobSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *JobProfile) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *JobProfile) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *JobProfile) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// JobLimits cap the job settings of an organization's projects and scans.
+// Empty maximums are unlimited.
+type JobLimits struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId         string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	MaxCpu                 string                 `protobuf:"bytes,2,opt,name=max_cpu,json=maxCpu,proto3" json:"max_cpu,omitempty"`
+	MaxMemory              string                 `protobuf:"bytes,3,opt,name=max_memory,json=maxMemory,proto3" json:"max_memory,omitempty"`
+	MaxEphemeralStorage    string                 `protobuf:"bytes,4,opt,name=max_ephemeral_storage,json=maxEphemeralStorage,proto3" json:"max_ephemeral_storage,omitempty"`
+	MaxDeadlineSeconds     int64                  `protobuf:"varint,5,opt,name=max_deadline_seconds,json=maxDeadlineSeconds,proto3" json:"max_deadline_seconds,omitempty"` // 0 is unlimited
+	MaxBackoffLimit        *int32                 `protobuf:"varint,6,opt,name=max_backoff_limit,json=maxBackoffLimit,proto3,oneof" json:"max_backoff_limit,omitempty"`
+	AllowedPriorityClasses []string               `protobuf:"bytes,7,rep,name=allowed_priority_classes,json=allowedPriorityClasses,proto3" json:"allowed_priority_classes,omitempty"` // Only these may be used
+	IsDefault              bool                   `protobuf:"varint,8,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`                                         // The organization has no limits of its own
+	UpdatedBy              string                 `protobuf:"bytes,9,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	UpdatedAt              *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *JobLimits) Reset() {
+	*x = JobLimits{}
+	mi := &file_job_profiles_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobLimits) ProtoMessage() {}
+
+func (x *JobLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_job_profiles_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobLimits.ProtoReflect.Descriptor instead.
+func (*JobLimits) Descriptor() ([]byte, []int) {
+	return file_job_profiles_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *JobLimits) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *JobLimits) GetMaxCpu() string {
+	if x != nil {
+		return x.MaxCpu
+	}
+	return ""
+}
+
+func (x *JobLimits) GetMaxMemory() string {
+	if x != nil {
+		return x.MaxMemory
+	}
+	return ""
+}
+
+func (x *JobLimits) GetMaxEphemeralStorage() string {
+	if x != nil {
+		return x.MaxEphemeralStorage
+	}
+	return ""
+}
+
+func (x *JobLimits) GetMaxDeadlineSeconds() int64 {
+	if x != nil {
+		return x.MaxDeadlineSeconds
+	}
+	return 0
+}
+
+func (x *JobLimits) GetMaxBackoffLimit() int32 {
+	if x != nil && x.MaxBackoffLimit != nil {
+		return *x.MaxBackoffLimit
+	}
+	return 0
+}
+
+func (x *JobLimits) GetAllowedPriorityClasses() []string {
+	if x != nil {
+		return x.AllowedPriorityClasses
+	}
+	return nil
+}
+
+func (x *JobLimits) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *JobLimits) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *JobLimits) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// SetJobProfileRequest creates the project's profile or replaces its settings
+type SetJobProfileRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	ProjectId      string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Settings       *JobSettings           `protobuf:"bytes,3,opt,name=settings,proto3" json:"settings,omitempty"`
+	UserId         string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // User ID from JWT token
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetJobProfileRequest) Reset() {
+	*x = SetJobProfileRequest{}
+	mi := &file_job_profiles_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetJobProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetJobProfileRequest) ProtoMessage() {}
+
+func (x *SetJobProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_job_profiles_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetJobProfileRequest.ProtoReflect.Descriptor instead.
+func (*SetJobProfileRequest) Descriptor() ([]byte, []int) {
+	return file_job_profiles_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SetJobProfileRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *SetJobProfileRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *SetJobProfileRequest) GetSettings() *JobSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *SetJobProfileRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// GetJobProfileRequest
+type GetJobProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobProfileRequest) Reset() {
+	*x = GetJobProfileRequest{}
+	mi := &file_job_profiles_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobProfileRequest) ProtoMessage() {}
+
+func (x *GetJobProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_job_profiles_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetJobProfileRequest) Descriptor() ([]byte, []int) {
+	return file_job_profiles_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetJobProfileRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+// DeleteJobProfileRequest
+type DeleteJobProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteJobProfileRequest) Reset() {
+	*x = DeleteJobProfileRequest{}
+	mi := &file_job_profiles_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteJobProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteJobProfileRequest) ProtoMessage() {}
+
+func (x *DeleteJobProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_job_profiles_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteJobProfileRequest.ProtoReflect.Descriptor instead.
+func (*DeleteJobProfileRequest) Descriptor() ([]byte, []int) {
+	return file_job_profiles_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteJobProfileRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+// SetJobLimitsRequest creates the organization's limits or replaces them
+type SetJobLimitsRequest struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId         string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	MaxCpu                 string                 `protobuf:"bytes,2,opt,name=max_cpu,json=maxCpu,proto3" json:"max_cpu,omitempty"`
+	MaxMemory              string                 `protobuf:"bytes,3,opt,name=max_memory,json=maxMemory,proto3" json:"max_memory,omitempty"`
+	MaxEphemeralStorage    string                 `protobuf:"bytes,4,opt,name=max_ephemeral_storage,json=maxEphemeralStorage,proto3" json:"max_ephemeral_storage,omitempty"`
+	MaxDeadlineSeconds     int64                  `protobuf:"varint,5,opt,name=max_deadline_seconds,json=maxDeadlineSeconds,proto3" json:"max_deadline_seconds,omitempty"`
+	MaxBackoffLimit        *int32                 `protobuf:"varint,6,opt,name=max_backoff_limit,json=maxBackoffLimit,proto3,oneof" json:"max_backoff_limit,omitempty"`
+	AllowedPriorityClasses []string               `protobuf:"bytes,7,rep,name=allowed_priority_classes,json=allowedPriorityClasses,proto3" json:"allowed_priority_classes,omitempty"`
+	UserId                 string                 `protobuf:"bytes,8,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // User ID from JWT token
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *SetJobLimitsRequest) Reset() {
+	*x = SetJobLimitsRequest{}
+	mi := &file_job_profiles_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetJobLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetJobLimitsRequest) ProtoMessage() {}
+
+func (x *SetJobLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_job_profiles_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetJobLimitsRequest.ProtoReflect.Descriptor instead.
+func (*SetJobLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_job_profiles_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SetJobLimitsRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *SetJobLimitsRequest) GetMaxCpu() string {
+	if x != nil {
+		return x.MaxCpu
+	}
+	return ""
+}
+
+func (x *SetJobLimitsRequest) GetMaxMemory() string {
+	if x != nil {
+		return x.MaxMemory
+	}
+	return ""
+}
+
+func (x *SetJobLimitsRequest) GetMaxEphemeralStorage() string {
+	if x != nil {
+		return x.MaxEphemeralStorage
+	}
+	return ""
+}
+
+func (x *SetJobLimitsRequest) GetMaxDeadlineSeconds() int64 {
+	if x != nil {
+		return x.MaxDeadlineSeconds
+	}
+	return 0
+}
+
+func (x *SetJobLimitsRequest) GetMaxBackoffLimit() int32 {
+	if x != nil && x.MaxBackoffLimit != nil {
+		return *x.MaxBackoffLimit
+	}
+	return 0
+}
+
+func (x *SetJobLimitsRequest) GetAllowedPriorityClasses() []string {
+	if x != nil {
+		return x.AllowedPriorityClasses
+	}
+	return nil
+}
+
+func (x *SetJobLimitsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// GetJobLimitsRequest
+type GetJobLimitsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetJobLimitsRequest) Reset() {
+	*x = GetJobLimitsRequest{}
+	mi := &file_job_profiles_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobLimitsRequest) ProtoMessage() {}
+
+func (x *GetJobLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_job_profiles_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobLimitsRequest.ProtoReflect.Descriptor instead.
+func (*GetJobLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_job_profiles_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetJobLimitsRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+var File_job_profiles_proto protoreflect.FileDescriptor
+
+const file_job_profiles_proto_rawDesc = "" +
+	"\n" +
+	"\x12job_profiles.proto\x12\tcloudscan\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xd8\x04\n" +
+	"\vJobSettings\x12!\n" +
+	"\frequests_cpu\x18\x01 \x01(\tR\vrequestsCpu\x12'\n" +
+	"\x0frequests_memory\x18\x02 \x01(\tR\x0erequestsMemory\x12\x1d\n" +
+	"\n" +
+	"limits_cpu\x18\x03 \x01(\tR\tlimitsCpu\x12#\n" +
+	"\rlimits_memory\x18\x04 \x01(\tR\flimitsMemory\x12+\n" +
+	"\x11ephemeral_storage\x18\x05 \x01(\tR\x10ephemeralStorage\x12;\n" +
+	"\x17active_deadline_seconds\x18\x06 \x01(\x03H\x00R\x15activeDeadlineSeconds\x88\x01\x01\x12(\n" +
+	"\rbackoff_limit\x18\a \x01(\x05H\x01R\fbackoffLimit\x88\x01\x01\x12M\n" +
+	"\rnode_selector\x18\b \x03(\v2(.cloudscan.JobSettings.NodeSelectorEntryR\fnodeSelector\x127\n" +
+	"\vtolerations\x18\t \x03(\v2\x15.cloudscan.TolerationR\vtolerations\x12.\n" +
+	"\x13priority_class_name\x18\n" +
+	" \x01(\tR\x11priorityClassName\x1a?\n" +
+	"\x11NodeSelectorEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x1a\n" +
+	"\x18_active_deadline_secondsB\x10\n" +
+	"\x0e_backoff_limit\"\xb3\x01\n" +
+	"\n" +
+	"Toleration\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1a\n" +
+	"\boperator\x18\x02 \x01(\tR\boperator\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12\x16\n" +
+	"\x06effect\x18\x04 \x01(\tR\x06effect\x122\n" +
+	"\x12toleration_seconds\x18\x05 \x01(\x03H\x00R\x11tolerationSeconds\x88\x01\x01B\x15\n" +
+	"\x13_toleration_seconds\"\x9d\x02\n" +
+	"\n" +
+	"JobProfile\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\x122\n" +
+	"\bsettings\x18\x03 \x01(\v2\x16.cloudscan.JobSettingsR\bsettings\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x04 \x01(\tR\tupdatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xcc\x03\n" +
+	"\tJobLimits\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x17\n" +
+	"\amax_cpu\x18\x02 \x01(\tR\x06maxCpu\x12\x1d\n" +
+	"\n" +
+	"max_memory\x18\x03 \x01(\tR\tmaxMemory\x122\n" +
+	"\x15max_ephemeral_storage\x18\x04 \x01(\tR\x13maxEphemeralStorage\x120\n" +
+	"\x14max_deadline_seconds\x18\x05 \x01(\x03R\x12maxDeadlineSeconds\x12/\n" +
+	"\x11max_backoff_limit\x18\x06 \x01(\x05H\x00R\x0fmaxBackoffLimit\x88\x01\x01\x128\n" +
+	"\x18allowed_priority_classes\x18\a \x03(\tR\x16allowedPriorityClasses\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\b \x01(\bR\tisDefault\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\t \x01(\tR\tupdatedBy\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x14\n" +
+	"\x12_max_backoff_limit\"\xab\x01\n" +
+	"\x14SetJobProfileRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\x122\n" +
+	"\bsettings\x18\x03 \x01(\v2\x16.cloudscan.JobSettingsR\bsettings\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\"5\n" +
+	"\x14GetJobProfileRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\"8\n" +
+	"\x17DeleteJobProfileRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\"\xf6\x02\n" +
+	"\x13SetJobLimitsRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x17\n" +
+	"\amax_cpu\x18\x02 \x01(\tR\x06maxCpu\x12\x1d\n" +
+	"\n" +
+	"max_memory\x18\x03 \x01(\tR\tmaxMemory\x122\n" +
+	"\x15max_ephemeral_storage\x18\x04 \x01(\tR\x13maxEphemeralStorage\x120\n" +
+	"\x14max_deadline_seconds\x18\x05 \x01(\x03R\x12maxDeadlineSeconds\x12/\n" +
+	"\x11max_backoff_limit\x18\x06 \x01(\x05H\x00R\x0fmaxBackoffLimit\x88\x01\x01\x128\n" +
+	"\x18allowed_priority_classes\x18\a \x03(\tR\x16allowedPriorityClasses\x12\x17\n" +
+	"\auser_id\x18\b \x01(\tR\x06userIdB\x14\n" +
+	"\x12_max_backoff_limit\">\n" +
+	"\x13GetJobLimitsRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId2\x81\x03\n" +
+	"\x11JobProfileService\x12G\n" +
+	"\rSetJobProfile\x12\x1f.cloudscan.SetJobProfileRequest\x1a\x15.cloudscan.JobProfile\x12G\n" +
+	"\rGetJobProfile\x12\x1f.cloudscan.GetJobProfileRequest\x1a\x15.cloudscan.JobProfile\x12N\n" +
+	"\x10DeleteJobProfile\x12\".cloudscan.DeleteJobProfileRequest\x1a\x16.google.protobuf.Empty\x12D\n" +
+	"\fSetJobLimits\x12\x1e.cloudscan.SetJobLimitsRequest\x1a\x14.cloudscan.JobLimits\x12D\n" +
+	"\fGetJobLimits\x12\x1e.cloudscan.GetJobLimitsRequest\x1a\x14.cloudscan.JobLimitsB>Z<github.com/cloud-scan/cloudscan-orchestrator/generated/protob\x06proto3"
+
+var (
+	file_job_profiles_proto_rawDescOnce sync.Once
+	file_job_profiles_proto_rawDescData []byte
+)
+
+func file_job_profiles_proto_rawDescGZIP() []byte {
+	file_job_profiles_proto_rawDescOnce.Do(func() {
+		file_job_profiles_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_job_profiles_proto_rawDesc), len(file_job_profiles_proto_rawDesc)))
+	})
+	return file_job_profiles_proto_rawDescData
+}
+
+var file_job_profiles_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_job_profiles_proto_goTypes = []any{
+	(*JobSettings)(nil),             // 0: cloudscan.JobSettings
+	(*Toleration)(nil),              // 1: cloudscan.Toleration
+	(*JobProfile)(nil),              // 2: cloudscan.JobProfile
+	(*JobLimits)(nil),               // 3: cloudscan.JobLimits
+	(*SetJobProfileRequest)(nil),    // 4: cloudscan.SetJobProfileRequest
+	(*GetJobProfileRequest)(nil),    // 5: cloudscan.GetJobProfileRequest
+	(*DeleteJobProfileRequest)(nil), // 6: cloudscan.DeleteJobProfileRequest
+	(*SetJobLimitsRequest)(nil),     // 7: cloudscan.SetJobLimitsRequest
+	(*GetJobLimitsRequest)(nil),     // 8: cloudscan.GetJobLimitsRequest
+	nil,                             // 9: cloudscan.JobSettings.NodeSelectorEntry
+	(*timestamppb.Timestamp)(nil),   // 10: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),           // 11: google.protobuf.Empty
+}
+var file_job_profiles_proto_depIdxs = []int32{
+	9,  // 0: cloudscan.JobSettings.node_selector:type_name -> cloudscan.JobSettings.NodeSelectorEntry
+	1,  // 1: cloudscan.JobSettings.tolerations:type_name -> cloudscan.Toleration
+	0,  // 2: cloudscan.JobProfile.settings:type_name -> cloudscan.JobSettings
+	10, // 3: cloudscan.JobProfile.created_at:type_name -> google.protobuf.Timestamp
+	10, // 4: cloudscan.JobProfile.updated_at:type_name -> google.protobuf.Timestamp
+	10, // 5: cloudscan.JobLimits.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 6: cloudscan.SetJobProfileRequest.settings:type_name -> cloudscan.JobSettings
+	4,  // 7: cloudscan.JobProfileService.SetJobProfile:input_type -> cloudscan.SetJobProfileRequest
+	5,  // 8: cloudscan.JobProfileService.GetJobProfile:input_type -> cloudscan.GetJobProfileRequest
+	6,  // 9: cloudscan.JobProfileService.DeleteJobProfile:input_type -> cloudscan.DeleteJobProfileRequest
+	7,  // 10: cloudscan.JobProfileService.SetJobLimits:input_type -> cloudscan.SetJobLimitsRequest
+	8,  // 11: cloudscan.JobProfileService.GetJobLimits:input_type -> cloudscan.GetJobLimitsRequest
+	2,  // 12: cloudscan.JobProfileService.SetJobProfile:output_type -> cloudscan.JobProfile
+	2,  // 13: cloudscan.JobProfileService.GetJobProfile:output_type -> cloudscan.JobProfile
+	11, // 14: cloudscan.JobProfileService.DeleteJobProfile:output_type -> google.protobuf.Empty
+	3,  // 15: cloudscan.JobProfileService.SetJobLimits:output_type -> cloudscan.JobLimits
+	3,  // 16: cloudscan.JobProfileService.GetJobLimits:output_type -> cloudscan.JobLimits
+	12, // [12:17] is the sub-list for method output_type
+	7,  // [7:12] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_job_profiles_proto_init() }
+func file_job_profiles_proto_init() {
+	if File_job_profiles_proto != nil {
+		return
+	}
+	file_job_profiles_proto_msgTypes[0].OneofWrappers = []any{}
+	file_job_profiles_proto_msgTypes[1].OneofWrappers = []any{}
+	file_job_profiles_proto_msgTypes[3].OneofWrappers = []any{}
+	file_job_profiles_proto_msgTypes[7].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_job_profiles_proto_rawDesc), len(file_job_profiles_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_job_profiles_proto_goTypes,
+		DependencyIndexes: file_job_profiles_proto_depIdxs,
+		MessageInfos:      file_job_profiles_proto_msgTypes,
+	}.Build()
+	File_job_profiles_proto = out.File
+	file_job_profiles_proto_goTypes = nil
+	file_job_profiles_proto_depIdxs = nil
+}
@@ -0,0 +1,282 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v5.29.3
+// source: job_profiles.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	JobProfileService_SetJobProfile_FullMethodName    = "/cloudscan.JobProfileService/SetJobProfile"
+	JobProfileService_GetJobProfile_FullMethodName    = "/cloudscan.JobProfileService/GetJobProfile"
+	JobProfileService_DeleteJobProfile_FullMethodName = "/cloudscan.JobProfileService/DeleteJobProfile"
+	JobProfileService_SetJobLimits_FullMethodName     = "/cloudscan.JobProfileService/SetJobLimits"
+	JobProfileService_GetJobLimits_FullMethodName     = "/cloudscan.JobProfileService/GetJobLimits"
+)
+
+// JobProfileServiceClient is the client API for JobProfileService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// JobProfileService manages the Kubernetes job settings of projects and the
+// limits organizations put on them
+type JobProfileServiceClient interface {
+	SetJobProfile(ctx context.Context, in *SetJobProfileRequest, opts ...grpc.CallOption) (*JobProfile, error)
+	GetJobProfile(ctx context.Context, in *GetJobProfileRequest, opts ...grpc.CallOption) (*JobProfile, error)
+	DeleteJobProfile(ctx context.Context, in *DeleteJobProfileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Organizations without limits of their own get the service-wide defaults
+	SetJobLimits(ctx context.Context, in *SetJobLimitsRequest, opts ...grpc.CallOption) (*JobLimits, error)
+	GetJobLimits(ctx context.Context, in *GetJobLimitsRequest, opts ...grpc.CallOption) (*JobLimits, error)
+}
+
+type jobProfileServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewJobProfileServiceClient(cc grpc.ClientConnInterface) JobProfileServiceClient {
+	return &jobProfileServiceClient{cc}
+}
+
+func (c *jobProfileServiceClient) SetJobProfile(ctx context.Context, in *SetJobProfileRequest, opts ...grpc.CallOption) (*JobProfile, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobProfile)
+	err := c.cc.Invoke(ctx, JobProfileService_SetJobProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobProfileServiceClient) GetJobProfile(ctx context.Context, in *GetJobProfileRequest, opts ...grpc.CallOption) (*JobProfile, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobProfile)
+	err := c.cc.Invoke(ctx, JobProfileService_GetJobProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobProfileServiceClient) DeleteJobProfile(ctx context.Context, in *DeleteJobProfileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, JobProfileService_DeleteJobProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobProfileServiceClient) SetJobLimits(ctx context.Context, in *SetJobLimitsRequest, opts ...grpc.CallOption) (*JobLimits, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobLimits)
+	err := c.cc.Invoke(ctx, JobProfileService_SetJobLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobProfileServiceClient) GetJobLimits(ctx context.Context, in *GetJobLimitsRequest, opts ...grpc.CallOption) (*JobLimits, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobLimits)
+	err := c.cc.Invoke(ctx, JobProfileService_GetJobLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// JobProfileServiceServer is the server API for JobProfileService service.
+// All implementations must embed UnimplementedJobProfileServiceServer
+// for forward compatibility.
+//
+// JobProfileService manages the Kubernetes job settings of projects and the
+// limits organizations put on them
+type JobProfileServiceServer interface {
+	SetJobProfile(context.Context, *SetJobProfileRequest) (*JobProfile, error)
+	GetJobProfile(context.Context, *GetJobProfileRequest) (*JobProfile, error)
+	DeleteJobProfile(context.Context, *DeleteJobProfileRequest) (*emptypb.Empty, error)
+	// Organizations without limits of their own get the service-wide defaults
+	SetJobLimits(context.Context, *SetJobLimitsRequest) (*JobLimits, error)
+	GetJobLimits(context.Context, *GetJobLimitsRequest) (*JobLimits, error)
+	mustEmbedUnimplementedJobProfileServiceServer()
+}
+
+// UnimplementedJobProfileServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedJobProfileServiceServer struct{}
+
+func (UnimplementedJobProfileServiceServer) SetJobProfile(context.Context, *SetJobProfileRequest) (*JobProfile, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetJobProfile not implemented")
+}
+func (UnimplementedJobProfileServiceServer) GetJobProfile(context.Context, *GetJobProfileRequest) (*JobProfile, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetJobProfile not implemented")
+}
+func (UnimplementedJobProfileServiceServer) DeleteJobProfile(context.Context, *DeleteJobProfileRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteJobProfile not implemented")
+}
+func (UnimplementedJobProfileServiceServer) SetJobLimits(context.Context, *SetJobLimitsRequest) (*JobLimits, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetJobLimits not implemented")
+}
+func (UnimplementedJobProfileServiceServer) GetJobLimits(context.Context, *GetJobLimitsRequest) (*JobLimits, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetJobLimits not implemented")
+}
+func (UnimplementedJobProfileServiceServer) mustEmbedUnimplementedJobProfileServiceServer() {}
+func (UnimplementedJobProfileServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeJobProfileServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to JobProfileServiceServer will
+// result in compilation errors.
+type UnsafeJobProfileServiceServer interface {
+	mustEmbedUnimplementedJobProfileServiceServer()
+}
+
+func RegisterJobProfileServiceServer(s grpc.ServiceRegistrar, srv JobProfileServiceServer) {
+	// If the following call panics, it indicates UnimplementedJobProfileServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&JobProfileService_ServiceDesc, srv)
+}
+
+func _JobProfileService_SetJobProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetJobProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobProfileServiceServer).SetJobProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobProfileService_SetJobProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobProfileServiceServer).SetJobProfile(ctx, req.(*SetJobProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobProfileService_GetJobProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobProfileServiceServer).GetJobProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobProfileService_GetJobProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobProfileServiceServer).GetJobProfile(ctx, req.(*GetJobProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobProfileService_DeleteJobProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteJobProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobProfileServiceServer).DeleteJobProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobProfileService_DeleteJobProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobProfileServiceServer).DeleteJobProfile(ctx, req.(*DeleteJobProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobProfileService_SetJobLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetJobLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobProfileServiceServer).SetJobLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobProfileService_SetJobLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobProfileServiceServer).SetJobLimits(ctx, req.(*SetJobLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobProfileService_GetJobLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobProfileServiceServer).GetJobLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobProfileService_GetJobLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobProfileServiceServer).GetJobLimits(ctx, req.(*GetJobLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// JobProfileService_ServiceDesc is the grpc.ServiceDesc for JobProfileService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var JobProfileService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cloudscan.JobProfileService",
+	HandlerType: (*JobProfileServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetJobProfile",
+			Handler:    _JobProfileService_SetJobProfile_Handler,
+		},
+		{
+			MethodName: "GetJobProfile",
+			Handler:    _JobProfileService_GetJobProfile_Handler,
+		},
+		{
+			MethodName: "DeleteJobProfile",
+			Handler:    _JobProfileService_DeleteJobProfile_Handler,
+		},
+		{
+			MethodName: "SetJobLimits",
+			Handler:    _JobProfileService_SetJobLimits_Handler,
+		},
+		{
+			MethodName: "GetJobLimits",
+			Handler:    _JobProfileService_GetJobLimits_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "job_profiles.proto",
+}
@@ -0,0 +1,243 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v5.29.3
+// source: legal_holds.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	LegalHoldService_PlaceLegalHold_FullMethodName   = "/cloudscan.LegalHoldService/PlaceLegalHold"
+	LegalHoldService_ReleaseLegalHold_FullMethodName = "/cloudscan.LegalHoldService/ReleaseLegalHold"
+	LegalHoldService_GetLegalHold_FullMethodName     = "/cloudscan.LegalHoldService/GetLegalHold"
+	LegalHoldService_ListLegalHolds_FullMethodName   = "/cloudscan.LegalHoldService/ListLegalHolds"
+)
+
+// LegalHoldServiceClient is the client API for LegalHoldService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// LegalHoldService freezes scans as evidence. Held scans can't be deleted by
+// DeleteScan, DeleteProjectScans, retention or partition maintenance. Every
+// hold change is recorded in the audit log.
+type LegalHoldServiceClient interface {
+	PlaceLegalHold(ctx context.Context, in *PlaceLegalHoldRequest, opts ...grpc.CallOption) (*LegalHold, error)
+	ReleaseLegalHold(ctx context.Context, in *ReleaseLegalHoldRequest, opts ...grpc.CallOption) (*LegalHold, error)
+	GetLegalHold(ctx context.Context, in *GetLegalHoldRequest, opts ...grpc.CallOption) (*LegalHold, error)
+	ListLegalHolds(ctx context.Context, in *ListLegalHoldsRequest, opts ...grpc.CallOption) (*ListLegalHoldsResponse, error)
+}
+
+type legalHoldServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLegalHoldServiceClient(cc grpc.ClientConnInterface) LegalHoldServiceClient {
+	return &legalHoldServiceClient{cc}
+}
+
+func (c *legalHoldServiceClient) PlaceLegalHold(ctx context.Context, in *PlaceLegalHoldRequest, opts ...grpc.CallOption) (*LegalHold, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LegalHold)
+	err := c.cc.Invoke(ctx, LegalHoldService_PlaceLegalHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *legalHoldServiceClient) ReleaseLegalHold(ctx context.Context, in *ReleaseLegalHoldRequest, opts ...grpc.CallOption) (*LegalHold, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LegalHold)
+	err := c.cc.Invoke(ctx, LegalHoldService_ReleaseLegalHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *legalHoldServiceClient) GetLegalHold(ctx context.Context, in *GetLegalHoldRequest, opts ...grpc.CallOption) (*LegalHold, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LegalHold)
+	err := c.cc.Invoke(ctx, LegalHoldService_GetLegalHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *legalHoldServiceClient) ListLegalHolds(ctx context.Context, in *ListLegalHoldsRequest, opts ...grpc.CallOption) (*ListLegalHoldsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLegalHoldsResponse)
+	err := c.cc.Invoke(ctx, LegalHoldService_ListLegalHolds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LegalHoldServiceServer is the server API for LegalHoldService service.
+// All implementations must embed UnimplementedLegalHoldServiceServer
+// for forward compatibility.
+//
+// LegalHoldService freezes scans as evidence. Held scans can't be deleted by
+// DeleteScan, DeleteProjectScans, retention or partition maintenance. Every
+// hold change is recorded in the audit log.
+type LegalHoldServiceServer interface {
+	PlaceLegalHold(context.Context, *PlaceLegalHoldRequest) (*LegalHold, error)
+	ReleaseLegalHold(context.Context, *ReleaseLegalHoldRequest) (*LegalHold, error)
+	GetLegalHold(context.Context, *GetLegalHoldRequest) (*LegalHold, error)
+	ListLegalHolds(context.Context, *ListLegalHoldsRequest) (*ListLegalHoldsResponse, error)
+	mustEmbedUnimplementedLegalHoldServiceServer()
+}
+
+// UnimplementedLegalHoldServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLegalHoldServiceServer struct{}
+
+func (UnimplementedLegalHoldServiceServer) PlaceLegalHold(context.Context, *PlaceLegalHoldRequest) (*LegalHold, error) {
+	return nil, status.Error(codes.Unimplemented, "method PlaceLegalHold not implemented")
+}
+func (UnimplementedLegalHoldServiceServer) ReleaseLegalHold(context.Context, *ReleaseLegalHoldRequest) (*LegalHold, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReleaseLegalHold not implemented")
+}
+func (UnimplementedLegalHoldServiceServer) GetLegalHold(context.Context, *GetLegalHoldRequest) (*LegalHold, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetLegalHold not implemented")
+}
+func (UnimplementedLegalHoldServiceServer) ListLegalHolds(context.Context, *ListLegalHoldsRequest) (*ListLegalHoldsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListLegalHolds not implemented")
+}
+func (UnimplementedLegalHoldServiceServer) mustEmbedUnimplementedLegalHoldServiceServer() {}
+func (UnimplementedLegalHoldServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeLegalHoldServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LegalHoldServiceServer will
+// result in compilation errors.
+type UnsafeLegalHoldServiceServer interface {
+	mustEmbedUnimplementedLegalHoldServiceServer()
+}
+
+func RegisterLegalHoldServiceServer(s grpc.ServiceRegistrar, srv LegalHoldServiceServer) {
+	// If the following call panics, it indicates UnimplementedLegalHoldServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&LegalHoldService_ServiceDesc, srv)
+}
+
+func _LegalHoldService_PlaceLegalHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceLegalHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LegalHoldServiceServer).PlaceLegalHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LegalHoldService_PlaceLegalHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LegalHoldServiceServer).PlaceLegalHold(ctx, req.(*PlaceLegalHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LegalHoldService_ReleaseLegalHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseLegalHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LegalHoldServiceServer).ReleaseLegalHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LegalHoldService_ReleaseLegalHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LegalHoldServiceServer).ReleaseLegalHold(ctx, req.(*ReleaseLegalHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LegalHoldService_GetLegalHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLegalHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LegalHoldServiceServer).GetLegalHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LegalHoldService_GetLegalHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LegalHoldServiceServer).GetLegalHold(ctx, req.(*GetLegalHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LegalHoldService_ListLegalHolds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLegalHoldsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LegalHoldServiceServer).ListLegalHolds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LegalHoldService_ListLegalHolds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LegalHoldServiceServer).ListLegalHolds(ctx, req.(*ListLegalHoldsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LegalHoldService_ServiceDesc is the grpc.ServiceDesc for LegalHoldService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LegalHoldService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cloudscan.LegalHoldService",
+	HandlerType: (*LegalHoldServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PlaceLegalHold",
+			Handler:    _LegalHoldService_PlaceLegalHold_Handler,
+		},
+		{
+			MethodName: "ReleaseLegalHold",
+			Handler:    _LegalHoldService_ReleaseLegalHold_Handler,
+		},
+		{
+			MethodName: "GetLegalHold",
+			Handler:    _LegalHoldService_GetLegalHold_Handler,
+		},
+		{
+			MethodName: "ListLegalHolds",
+			Handler:    _LegalHoldService_ListLegalHolds_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "legal_holds.proto",
+}
@@ -0,0 +1,684 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v5.29.3
+// source: retention.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// RetentionPolicy applies to the scans of an organization, or of one project
+// if project_id is set. A scan is deleted only if no rule keeps it.
+type RetentionPolicy struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Id                      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrganizationId          string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	ProjectId               string                 `protobuf:"bytes,3,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`                                                // Empty for the organization-wide policy
+	KeepDays                int32                  `protobuf:"varint,4,opt,name=keep_days,json=keepDays,proto3" json:"keep_days,omitempty"`                                                  // Keep scans younger than this; 0 disables
+	KeepLastPerBranch       int32                  `protobuf:"varint,5,opt,name=keep_last_per_branch,json=keepLastPerBranch,proto3" json:"keep_last_per_branch,omitempty"`                   // Keep the newest N scans per branch; 0 disables
+	KeepLatestDefaultBranch bool                   `protobuf:"varint,6,opt,name=keep_latest_default_branch,json=keepLatestDefaultBranch,proto3" json:"keep_latest_default_branch,omitempty"` // Keep the latest completed scan of the default branch
+	DefaultBranch           string                 `protobuf:"bytes,7,opt,name=default_branch,json=defaultBranch,proto3" json:"default_branch,omitempty"`                                    // Project policies only; defaults to the project's
+	CreatedAt               *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt               *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *RetentionPolicy) Reset() {
+	*x = RetentionPolicy{}
+	mi := &file_retention_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetentionPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetentionPolicy) ProtoMessage() {}
+
+func (x *RetentionPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_retention_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetentionPolicy.ProtoReflect.Descriptor instead.
+func (*RetentionPolicy) Descriptor() ([]byte, []int) {
+	return file_retention_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RetentionPolicy) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RetentionPolicy) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *RetentionPolicy) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *RetentionPolicy) GetKeepDays() int32 {
+	if x != nil {
+		return x.KeepDays
+	}
+	return 0
+}
+
+func (x *RetentionPolicy) GetKeepLastPerBranch() int32 {
+	if x != nil {
+		return x.KeepLastPerBranch
+	}
+	return 0
+}
+
+func (x *RetentionPolicy) GetKeepLatestDefaultBranch() bool {
+	if x != nil {
+		return x.KeepLatestDefaultBranch
+	}
+	return false
+}
+
+func (x *RetentionPolicy) GetDefaultBranch() string {
+	if x != nil {
+		return x.DefaultBranch
+	}
+	return ""
+}
+
+func (x *RetentionPolicy) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *RetentionPolicy) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// SetRetentionPolicyRequest creates the policy of the organization or
+// project, or replaces its rules
+type SetRetentionPolicyRequest struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId          string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	ProjectId               string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	KeepDays                int32                  `protobuf:"varint,3,opt,name=keep_days,json=keepDays,proto3" json:"keep_days,omitempty"`
+	KeepLastPerBranch       int32                  `protobuf:"varint,4,opt,name=keep_last_per_branch,json=keepLastPerBranch,proto3" json:"keep_last_per_branch,omitempty"`
+	KeepLatestDefaultBranch bool                   `protobuf:"varint,5,opt,name=keep_latest_default_branch,json=keepLatestDefaultBranch,proto3" json:"keep_latest_default_branch,omitempty"`
+	DefaultBranch           string                 `protobuf:"bytes,6,opt,name=default_branch,json=defaultBranch,proto3" json:"default_branch,omitempty"`
+	UserId                  string                 `protobuf:"bytes,7,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // User ID from JWT token
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *SetRetentionPolicyRequest) Reset() {
+	*x = SetRetentionPolicyRequest{}
+	mi := &file_retention_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRetentionPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRetentionPolicyRequest) ProtoMessage() {}
+
+func (x *SetRetentionPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_retention_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRetentionPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetRetentionPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_retention_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SetRetentionPolicyRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *SetRetentionPolicyRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *SetRetentionPolicyRequest) GetKeepDays() int32 {
+	if x != nil {
+		return x.KeepDays
+	}
+	return 0
+}
+
+func (x *SetRetentionPolicyRequest) GetKeepLastPerBranch() int32 {
+	if x != nil {
+		return x.KeepLastPerBranch
+	}
+	return 0
+}
+
+func (x *SetRetentionPolicyRequest) GetKeepLatestDefaultBranch() bool {
+	if x != nil {
+		return x.KeepLatestDefaultBranch
+	}
+	return false
+}
+
+func (x *SetRetentionPolicyRequest) GetDefaultBranch() string {
+	if x != nil {
+		return x.DefaultBranch
+	}
+	return ""
+}
+
+func (x *SetRetentionPolicyRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// GetRetentionPolicyRequest
+type GetRetentionPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRetentionPolicyRequest) Reset() {
+	*x = GetRetentionPolicyRequest{}
+	mi := &file_retention_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRetentionPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRetentionPolicyRequest) ProtoMessage() {}
+
+func (x *GetRetentionPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_retention_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRetentionPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetRetentionPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_retention_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetRetentionPolicyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// ListRetentionPoliciesRequest
+type ListRetentionPoliciesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	ProjectId      string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	PageSize       int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListRetentionPoliciesRequest) Reset() {
+	*x = ListRetentionPoliciesRequest{}
+	mi := &file_retention_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRetentionPoliciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRetentionPoliciesRequest) ProtoMessage() {}
+
+func (x *ListRetentionPoliciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_retention_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRetentionPoliciesRequest.ProtoReflect.Descriptor instead.
+func (*ListRetentionPoliciesRequest) Descriptor() ([]byte, []int) {
+	return file_retention_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListRetentionPoliciesRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ListRetentionPoliciesRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ListRetentionPoliciesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ListRetentionPoliciesResponse
+type ListRetentionPoliciesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policies      []*RetentionPolicy     `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRetentionPoliciesResponse) Reset() {
+	*x = ListRetentionPoliciesResponse{}
+	mi := &file_retention_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRetentionPoliciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRetentionPoliciesResponse) ProtoMessage() {}
+
+func (x *ListRetentionPoliciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_retention_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRetentionPoliciesResponse.ProtoReflect.Descriptor instead.
+func (*ListRetentionPoliciesResponse) Descriptor() ([]byte, []int) {
+	return file_retention_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListRetentionPoliciesResponse) GetPolicies() []*RetentionPolicy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+func (x *ListRetentionPoliciesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// DeleteRetentionPolicyRequest
+type DeleteRetentionPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRetentionPolicyRequest) Reset() {
+	*x = DeleteRetentionPolicyRequest{}
+	mi := &file_retention_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRetentionPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRetentionPolicyRequest) ProtoMessage() {}
+
+func (x *DeleteRetentionPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_retention_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRetentionPolicyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRetentionPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_retention_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteRetentionPolicyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// PreviewRetentionRequest
+type PreviewRetentionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	ProjectId      string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	PageSize       int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // Default 100, at most 1000
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PreviewRetentionRequest) Reset() {
+	*x = PreviewRetentionRequest{}
+	mi := &file_retention_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewRetentionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewRetentionRequest) ProtoMessage() {}
+
+func (x *PreviewRetentionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_retention_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewRetentionRequest.ProtoReflect.Descriptor instead.
+func (*PreviewRetentionRequest) Descriptor() ([]byte, []int) {
+	return file_retention_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PreviewRetentionRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *PreviewRetentionRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *PreviewRetentionRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// PreviewRetentionResponse lists expired scans, oldest first
+type PreviewRetentionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scans         []*Scan                `protobuf:"bytes,1,rep,name=scans,proto3" json:"scans,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Truncated     bool                   `protobuf:"varint,3,opt,name=truncated,proto3" json:"truncated,omitempty"` // More scans are expired than were returned
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewRetentionResponse) Reset() {
+	*x = PreviewRetentionResponse{}
+	mi := &file_retention_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewRetentionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewRetentionResponse) ProtoMessage() {}
+
+func (x *PreviewRetentionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_retention_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewRetentionResponse.ProtoReflect.Descriptor instead.
+func (*PreviewRetentionResponse) Descriptor() ([]byte, []int) {
+	return file_retention_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PreviewRetentionResponse) GetScans() []*Scan {
+	if x != nil {
+		return x.Scans
+	}
+	return nil
+}
+
+func (x *PreviewRetentionResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *PreviewRetentionResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+var File_retention_proto protoreflect.FileDescriptor
+
+const file_retention_proto_rawDesc = "" +
+	"\n" +
+	"\x0fretention.proto\x12\tcloudscan\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\vscans.proto\"\x91\x03\n" +
+	"\x0fRetentionPolicy\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x03 \x01(\tR\tprojectId\x12\x1b\n" +
+	"\tkeep_days\x18\x04 \x01(\x05R\bkeepDays\x12/\n" +
+	"\x14keep_last_per_branch\x18\x05 \x01(\x05R\x11keepLastPerBranch\x12;\n" +
+	"\x1akeep_latest_default_branch\x18\x06 \x01(\bR\x17keepLatestDefaultBranch\x12%\n" +
+	"\x0edefault_branch\x18\a \x01(\tR\rdefaultBranch\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xae\x02\n" +
+	"\x19SetRetentionPolicyRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\x12\x1b\n" +
+	"\tkeep_days\x18\x03 \x01(\x05R\bkeepDays\x12/\n" +
+	"\x14keep_last_per_branch\x18\x04 \x01(\x05R\x11keepLastPerBranch\x12;\n" +
+	"\x1akeep_latest_default_branch\x18\x05 \x01(\bR\x17keepLatestDefaultBranch\x12%\n" +
+	"\x0edefault_branch\x18\x06 \x01(\tR\rdefaultBranch\x12\x17\n" +
+	"\auser_id\x18\a \x01(\tR\x06userId\"+\n" +
+	"\x19GetRetentionPolicyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x83\x01\n" +
+	"\x1cListRetentionPoliciesRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"x\n" +
+	"\x1dListRetentionPoliciesResponse\x126\n" +
+	"\bpolicies\x18\x01 \x03(\v2\x1a.cloudscan.RetentionPolicyR\bpolicies\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\".\n" +
+	"\x1cDeleteRetentionPolicyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"~\n" +
+	"\x17PreviewRetentionRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\x80\x01\n" +
+	"\x18PreviewRetentionResponse\x12%\n" +
+	"\x05scans\x18\x01 \x03(\v2\x0f.cloudscan.ScanR\x05scans\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x1c\n" +
+	"\ttruncated\x18\x03 \x01(\bR\ttruncated2\xe5\x03\n" +
+	"\x10RetentionService\x12V\n" +
+	"\x12SetRetentionPolicy\x12$.cloudscan.SetRetentionPolicyRequest\x1a\x1a.cloudscan.RetentionPolicy\x12V\n" +
+	"\x12GetRetentionPolicy\x12$.cloudscan.GetRetentionPolicyRequest\x1a\x1a.cloudscan.RetentionPolicy\x12j\n" +
+	"\x15ListRetentionPolicies\x12'.cloudscan.ListRetentionPoliciesRequest\x1a(.cloudscan.ListRetentionPoliciesResponse\x12X\n" +
+	"\x15DeleteRetentionPolicy\x12'.cloudscan.DeleteRetentionPolicyRequest\x1a\x16.google.protobuf.Empty\x12[\n" +
+	"\x10PreviewRetention\x12\".cloudscan.PreviewRetentionRequest\x1a#.cloudscan.PreviewRetentionResponseB>Z<github.com/cloud-scan/cloudscan-orchestrator/generated/protob\x06proto3"
+
+var (
+	file_retention_proto_rawDescOnce sync.Once
+	file_retention_proto_rawDescData []byte
+)
+
+func file_retention_proto_rawDescGZIP() []byte {
+	file_retention_proto_rawDescOnce.Do(func() {
+		file_retention_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_retention_proto_rawDesc), len(file_retention_proto_rawDesc)))
+	})
+	return file_retention_proto_rawDescData
+}
+
+var file_retention_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_retention_proto_goTypes = []any{
+	(*RetentionPolicy)(nil),               // 0: cloudscan.RetentionPolicy
+	(*SetRetentionPolicyRequest)(nil),     // 1: cloudscan.SetRetentionPolicyRequest
+	(*GetRetentionPolicyRequest)(nil),     // 2: cloudscan.GetRetentionPolicyRequest
+	(*ListRetentionPoliciesRequest)(nil),  // 3: cloudscan.ListRetentionPoliciesRequest
+	(*ListRetentionPoliciesResponse)(nil), // 4: cloudscan.ListRetentionPoliciesResponse
+	(*DeleteRetentionPolicyRequest)(nil),  // 5: cloudscan.DeleteRetentionPolicyRequest
+	(*PreviewRetentionRequest)(nil),       // 6: cloudscan.PreviewRetentionRequest
+	(*PreviewRetentionResponse)(nil),      // 7: cloudscan.PreviewRetentionResponse
+	(*timestamppb.Timestamp)(nil),         // 8: google.protobuf.Timestamp
+	(*Scan)(nil),                          // 9: cloudscan.Scan
+	(*emptypb.Empty)(nil),                 // 10: google.protobuf.Empty
+}
+var file_retention_proto_depIdxs = []int32{
+	8,  // 0: cloudscan.RetentionPolicy.created_at:type_name -> google.protobuf.Timestamp
+	8,  // 1: cloudscan.RetentionPolicy.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 2: cloudscan.ListRetentionPoliciesResponse.policies:type_name -> cloudscan.RetentionPolicy
+	9,  // 3: cloudscan.PreviewRetentionResponse.scans:type_name -> cloudscan.Scan
+	1,  // 4: cloudscan.RetentionService.SetRetentionPolicy:input_type -> cloudscan.SetRetentionPolicyRequest
+	2,  // 5: cloudscan.RetentionService.GetRetentionPolicy:input_type -> cloudscan.GetRetentionPolicyRequest
+	3,  // 6: cloudscan.RetentionService.ListRetentionPolicies:input_type -> cloudscan.ListRetentionPoliciesRequest
+	5,  // 7: cloudscan.RetentionService.DeleteRetentionPolicy:input_type -> cloudscan.DeleteRetentionPolicyRequest
+	6,  // 8: cloudscan.RetentionService.PreviewRetention:input_type -> cloudscan.PreviewRetentionRequest
+	0,  // 9: cloudscan.RetentionService.SetRetentionPolicy:output_type -> cloudscan.RetentionPolicy
+	0,  // 10: cloudscan.RetentionService.GetRetentionPolicy:output_type -> cloudscan.RetentionPolicy
+	4,  // 11: cloudscan.RetentionService.ListRetentionPolicies:output_type -> cloudscan.ListRetentionPoliciesResponse
+	10, // 12: cloudscan.RetentionService.DeleteRetentionPolicy:output_type -> google.protobuf.Empty
+	7,  // 13: cloudscan.RetentionService.PreviewRetention:output_type -> cloudscan.PreviewRetentionResponse
+	9,  // [9:14] is the sub-list for method output_type
+	4,  // [4:9] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_retention_proto_init() }
+func file_retention_proto_init() {
+	if File_retention_proto != nil {
+		return
+	}
+	file_scans_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_retention_proto_rawDesc), len(file_retention_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_retention_proto_goTypes,
+		DependencyIndexes: file_retention_proto_depIdxs,
+		MessageInfos:      file_retention_proto_msgTypes,
+	}.Build()
+	File_retention_proto = out.File
+	file_retention_proto_goTypes = nil
+	file_retention_proto_depIdxs = nil
+}
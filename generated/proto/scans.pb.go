@@ -23,6 +23,62 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ProgressPhase is the step a runner is at
+type ProgressPhase int32
+
+const (
+	ProgressPhase_PROGRESS_PHASE_UNSPECIFIED ProgressPhase = 0
+	ProgressPhase_DOWNLOADING                ProgressPhase = 1 // Downloading the source archive
+	ProgressPhase_CLONING                    ProgressPhase = 2 // Cloning the repository
+	ProgressPhase_SCANNING                   ProgressPhase = 3 // Running the scanner
+	ProgressPhase_UPLOADING                  ProgressPhase = 4 // Reporting findings and artifacts
+)
+
+// Enum value maps for ProgressPhase.
+var (
+	ProgressPhase_name = map[int32]string{
+		0: "PROGRESS_PHASE_UNSPECIFIED",
+		1: "DOWNLOADING",
+		2: "CLONING",
+		3: "SCANNING",
+		4: "UPLOADING",
+	}
+	ProgressPhase_value = map[string]int32{
+		"PROGRESS_PHASE_UNSPECIFIED": 0,
+		"DOWNLOADING":                1,
+		"CLONING":                    2,
+		"SCANNING":                   3,
+		"UPLOADING":                  4,
+	}
+)
+
+func (x ProgressPhase) Enum() *ProgressPhase {
+	p := new(ProgressPhase)
+	*p = x
+	return p
+}
+
+func (x ProgressPhase) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProgressPhase) Descriptor() protoreflect.EnumDescriptor {
+	return file_scans_proto_enumTypes[0].Descriptor()
+}
+
+func (ProgressPhase) Type() protoreflect.EnumType {
+	return &file_scans_proto_enumTypes[0]
+}
+
+func (x ProgressPhase) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProgressPhase.Descriptor instead.
+func (ProgressPhase) EnumDescriptor() ([]byte, []int) {
+	return file_scans_proto_rawDescGZIP(), []int{0}
+}
+
 // ScanStatus represents the state of a scan
 type ScanStatus int32
 
@@ -33,6 +89,8 @@ const (
 	ScanStatus_COMPLETED               ScanStatus = 3
 	ScanStatus_FAILED                  ScanStatus = 4
 	ScanStatus_CANCELLED               ScanStatus = 5
+	ScanStatus_DELETING                ScanStatus = 6 // Job, artifacts and rows are being removed
+	ScanStatus_PARTIAL                 ScanStatus = 7 // Some scan types completed, others failed or were cancelled
 )
 
 // Enum value maps for ScanStatus.
@@ -44,6 +102,8 @@ var (
 		3: "COMPLETED",
 		4: "FAILED",
 		5: "CANCELLED",
+		6: "DELETING",
+		7: "PARTIAL",
 	}
 	ScanStatus_value = map[string]int32{
 		"SCAN_STATUS_UNSPECIFIED": 0,
@@ -52,6 +112,8 @@ var (
 		"COMPLETED":               3,
 		"FAILED":                  4,
 		"CANCELLED":               5,
+		"DELETING":                6,
+		"PARTIAL":                 7,
 	}
 )
 
@@ -66,11 +128,11 @@ func (x ScanStatus) String() string {
 }
 
 func (ScanStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_scans_proto_enumTypes[0].Descriptor()
+	return file_scans_proto_enumTypes[1].Descriptor()
 }
 
 func (ScanStatus) Type() protoreflect.EnumType {
-	return &file_scans_proto_enumTypes[0]
+	return &file_scans_proto_enumTypes[1]
 }
 
 func (x ScanStatus) Number() protoreflect.EnumNumber {
@@ -79,7 +141,7 @@ func (x ScanStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ScanStatus.Descriptor instead.
 func (ScanStatus) EnumDescriptor() ([]byte, []int) {
-	return file_scans_proto_rawDescGZIP(), []int{0}
+	return file_scans_proto_rawDescGZIP(), []int{1}
 }
 
 // ScanType represents types of security scans
@@ -122,11 +184,11 @@ func (x ScanType) String() string {
 }
 
 func (ScanType) Descriptor() protoreflect.EnumDescriptor {
-	return file_scans_proto_enumTypes[1].Descriptor()
+	return file_scans_proto_enumTypes[2].Descriptor()
 }
 
 func (ScanType) Type() protoreflect.EnumType {
-	return &file_scans_proto_enumTypes[1]
+	return &file_scans_proto_enumTypes[2]
 }
 
 func (x ScanType) Number() protoreflect.EnumNumber {
@@ -135,7 +197,7 @@ func (x ScanType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ScanType.Descriptor instead.
 func (ScanType) EnumDescriptor() ([]byte, []int) {
-	return file_scans_proto_rawDescGZIP(), []int{1}
+	return file_scans_proto_rawDescGZIP(), []int{2}
 }
 
 // Severity levels for findings
@@ -181,11 +243,11 @@ func (x Severity) String() string {
 }
 
 func (Severity) Descriptor() protoreflect.EnumDescriptor {
-	return file_scans_proto_enumTypes[2].Descriptor()
+	return file_scans_proto_enumTypes[3].Descriptor()
 }
 
 func (Severity) Type() protoreflect.EnumType {
-	return &file_scans_proto_enumTypes[2]
+	return &file_scans_proto_enumTypes[3]
 }
 
 func (x Severity) Number() protoreflect.EnumNumber {
@@ -194,7 +256,7 @@ func (x Severity) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Severity.Descriptor instead.
 func (Severity) EnumDescriptor() ([]byte, []int) {
-	return file_scans_proto_rawDescGZIP(), []int{2}
+	return file_scans_proto_rawDescGZIP(), []int{3}
 }
 
 // Scan represents a security scan
@@ -214,6 +276,10 @@ type Scan struct {
 	TotalFindings      int32                  `protobuf:"varint,12,opt,name=total_findings,json=totalFindings,proto3" json:"total_findings,omitempty"`
 	FindingsBySeverity map[string]int32       `protobuf:"bytes,13,rep,name=findings_by_severity,json=findingsBySeverity,proto3" json:"findings_by_severity,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // critical, high, medium, low
 	ErrorMessage       string                 `protobuf:"bytes,14,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	SubScans           []*SubScan             `protobuf:"bytes,15,rep,name=sub_scans,json=subScans,proto3" json:"sub_scans,omitempty"`                // Only set by GetScan and UpdateScan
+	JobSettings        *JobSettings           `protobuf:"bytes,16,opt,name=job_settings,json=jobSettings,proto3" json:"job_settings,omitempty"`       // Set if the scan overrides its project's job profile
+	FailureReason      string                 `protobuf:"bytes,17,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"` // Classified cause of a failure, e.g. oom_killed; see SubScan
+	Progress           *Progress              `protobuf:"bytes,18,opt,name=progress,proto3" json:"progress,omitempty"`                                // Last reported progress, combined over the sub-scans
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
@@ -346,6 +412,273 @@ func (x *Scan) GetErrorMessage() string {
 	return ""
 }
 
+func (x *Scan) GetSubScans() []*SubScan {
+	if x != nil {
+		return x.SubScans
+	}
+	return nil
+}
+
+func (x *Scan) GetJobSettings() *JobSettings {
+	if x != nil {
+		return x.JobSettings
+	}
+	return nil
+}
+
+func (x *Scan) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+func (x *Scan) GetProgress() *Progress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+// SubScan is the job running one scan type of a scan. The scan's status and
+// counts are aggregated from its sub-scans.
+type SubScan struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ScanType           ScanType               `protobuf:"varint,1,opt,name=scan_type,json=scanType,proto3,enum=cloudscan.ScanType" json:"scan_type,omitempty"`
+	ScannerName        string                 `protobuf:"bytes,2,opt,name=scanner_name,json=scannerName,proto3" json:"scanner_name,omitempty"`
+	ScannerImage       string                 `protobuf:"bytes,3,opt,name=scanner_image,json=scannerImage,proto3" json:"scanner_image,omitempty"`
+	Status             ScanStatus             `protobuf:"varint,4,opt,name=status,proto3,enum=cloudscan.ScanStatus" json:"status,omitempty"` // Never PARTIAL or DELETING
+	JobName            string                 `protobuf:"bytes,5,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	TotalFindings      int32                  `protobuf:"varint,6,opt,name=total_findings,json=totalFindings,proto3" json:"total_findings,omitempty"`
+	FindingsBySeverity map[string]int32       `protobuf:"bytes,7,rep,name=findings_by_severity,json=findingsBySeverity,proto3" json:"findings_by_severity,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // critical, high, medium, low
+	ErrorMessage       string                 `protobuf:"bytes,8,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	StartedAt          *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	CompletedAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	// Classified cause of the last failure: oom_killed, deadline_exceeded,
+	// image_pull, evicted, storage_download, clone, scanner_error,
+	// results_upload, runner_error, dispatch_failed, job_lost, unschedulable,
+	// pod_pending, heartbeat_timeout or unknown
+	FailureReason   string                 `protobuf:"bytes,11,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	Attempt         int32                  `protobuf:"varint,12,opt,name=attempt,proto3" json:"attempt,omitempty"`                                         // Job attempt, starting at 1; transient failures are retried
+	RetryAt         *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=retry_at,json=retryAt,proto3" json:"retry_at,omitempty"`                           // Set while a retry is queued
+	LastHeartbeatAt *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=last_heartbeat_at,json=lastHeartbeatAt,proto3" json:"last_heartbeat_at,omitempty"` // Last runner callback of the current attempt
+	Progress        *Progress              `protobuf:"bytes,15,opt,name=progress,proto3" json:"progress,omitempty"`                                        // Last progress reported by the runner of the current attempt
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SubScan) Reset() {
+	*x = SubScan{}
+	mi := &file_scans_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubScan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubScan) ProtoMessage() {}
+
+func (x *SubScan) ProtoReflect() protoreflect.Message {
+	mi := &file_scans_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubScan.ProtoReflect.Descriptor instead.
+func (*SubScan) Descriptor() ([]byte, []int) {
+	return file_scans_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SubScan) GetScanType() ScanType {
+	if x != nil {
+		return x.ScanType
+	}
+	return ScanType_SCAN_TYPE_UNSPECIFIED
+}
+
+func (x *SubScan) GetScannerName() string {
+	if x != nil {
+		return x.ScannerName
+	}
+	return ""
+}
+
+func (x *SubScan) GetScannerImage() string {
+	if x != nil {
+		return x.ScannerImage
+	}
+	return ""
+}
+
+func (x *SubScan) GetStatus() ScanStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ScanStatus_SCAN_STATUS_UNSPECIFIED
+}
+
+func (x *SubScan) GetJobName() string {
+	if x != nil {
+		return x.JobName
+	}
+	return ""
+}
+
+func (x *SubScan) GetTotalFindings() int32 {
+	if x != nil {
+		return x.TotalFindings
+	}
+	return 0
+}
+
+func (x *SubScan) GetFindingsBySeverity() map[string]int32 {
+	if x != nil {
+		return x.FindingsBySeverity
+	}
+	return nil
+}
+
+func (x *SubScan) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *SubScan) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *SubScan) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *SubScan) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+func (x *SubScan) GetAttempt() int32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *SubScan) GetRetryAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RetryAt
+	}
+	return nil
+}
+
+func (x *SubScan) GetLastHeartbeatAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastHeartbeatAt
+	}
+	return nil
+}
+
+func (x *SubScan) GetProgress() *Progress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+// Progress is what a runner last reported doing
+type Progress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Phase         ProgressPhase          `protobuf:"varint,1,opt,name=phase,proto3,enum=cloudscan.ProgressPhase" json:"phase,omitempty"`
+	Scanner       string                 `protobuf:"bytes,2,opt,name=scanner,proto3" json:"scanner,omitempty"`  // Scanner currently running
+	Percent       int32                  `protobuf:"varint,3,opt,name=percent,proto3" json:"percent,omitempty"` // 0 to 100
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Progress) Reset() {
+	*x = Progress{}
+	mi := &file_scans_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Progress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Progress) ProtoMessage() {}
+
+func (x *Progress) ProtoReflect() protoreflect.Message {
+	mi := &file_scans_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Progress.ProtoReflect.Descriptor instead.
+func (*Progress) Descriptor() ([]byte, []int) {
+	return file_scans_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Progress) GetPhase() ProgressPhase {
+	if x != nil {
+		return x.Phase
+	}
+	return ProgressPhase_PROGRESS_PHASE_UNSPECIFIED
+}
+
+func (x *Progress) GetScanner() string {
+	if x != nil {
+		return x.Scanner
+	}
+	return ""
+}
+
+func (x *Progress) GetPercent() int32 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *Progress) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Progress) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
 // Finding represents a security vulnerability
 type Finding struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -368,7 +701,7 @@ type Finding struct {
 
 func (x *Finding) Reset() {
 	*x = Finding{}
-	mi := &file_scans_proto_msgTypes[1]
+	mi := &file_scans_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -380,7 +713,7 @@ func (x *Finding) String() string {
 func (*Finding) ProtoMessage() {}
 
 func (x *Finding) ProtoReflect() protoreflect.Message {
-	mi := &file_scans_proto_msgTypes[1]
+	mi := &file_scans_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -393,7 +726,7 @@ func (x *Finding) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Finding.ProtoReflect.Descriptor instead.
 func (*Finding) Descriptor() ([]byte, []int) {
-	return file_scans_proto_rawDescGZIP(), []int{1}
+	return file_scans_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Finding) GetId() string {
@@ -498,13 +831,14 @@ type CreateScanRequest struct {
 	GitCommit        string                 `protobuf:"bytes,6,opt,name=git_commit,json=gitCommit,proto3" json:"git_commit,omitempty"`
 	SourceArtifactId string                 `protobuf:"bytes,7,opt,name=source_artifact_id,json=sourceArtifactId,proto3" json:"source_artifact_id,omitempty"` // Artifact ID from storage service (already uploaded by UI)
 	UserId           string                 `protobuf:"bytes,8,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                 // User ID from JWT token
+	JobSettings      *JobSettings           `protobuf:"bytes,9,opt,name=job_settings,json=jobSettings,proto3" json:"job_settings,omitempty"`                  // Overrides the project's job profile
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
 func (x *CreateScanRequest) Reset() {
 	*x = CreateScanRequest{}
-	mi := &file_scans_proto_msgTypes[2]
+	mi := &file_scans_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -516,7 +850,7 @@ func (x *CreateScanRequest) String() string {
 func (*CreateScanRequest) ProtoMessage() {}
 
 func (x *CreateScanRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_scans_proto_msgTypes[2]
+	mi := &file_scans_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -529,7 +863,7 @@ func (x *CreateScanRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateScanRequest.ProtoReflect.Descriptor instead.
 func (*CreateScanRequest) Descriptor() ([]byte, []int) {
-	return file_scans_proto_rawDescGZIP(), []int{2}
+	return file_scans_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CreateScanRequest) GetOrganizationId() string {
@@ -588,6 +922,13 @@ func (x *CreateScanRequest) GetUserId() string {
 	return ""
 }
 
+func (x *CreateScanRequest) GetJobSettings() *JobSettings {
+	if x != nil {
+		return x.JobSettings
+	}
+	return nil
+}
+
 // CreateScanResponse
 type CreateScanResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -598,7 +939,7 @@ type CreateScanResponse struct {
 
 func (x *CreateScanResponse) Reset() {
 	*x = CreateScanResponse{}
-	mi := &file_scans_proto_msgTypes[3]
+	mi := &file_scans_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -610,7 +951,7 @@ func (x *CreateScanResponse) String() string {
 func (*CreateScanResponse) ProtoMessage() {}
 
 func (x *CreateScanResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_scans_proto_msgTypes[3]
+	mi := &file_scans_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -623,7 +964,7 @@ func (x *CreateScanResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateScanResponse.ProtoReflect.Descriptor instead.
 func (*CreateScanResponse) Descriptor() ([]byte, []int) {
-	return file_scans_proto_rawDescGZIP(), []int{3}
+	return file_scans_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CreateScanResponse) GetScan() *Scan {
@@ -643,7 +984,7 @@ type GetScanRequest struct {
 
 func (x *GetScanRequest) Reset() {
 	*x = GetScanRequest{}
-	mi := &file_scans_proto_msgTypes[4]
+	mi := &file_scans_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -655,7 +996,7 @@ func (x *GetScanRequest) String() string {
 func (*GetScanRequest) ProtoMessage() {}
 
 func (x *GetScanRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_scans_proto_msgTypes[4]
+	mi := &file_scans_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -668,7 +1009,7 @@ func (x *GetScanRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetScanRequest.ProtoReflect.Descriptor instead.
 func (*GetScanRequest) Descriptor() ([]byte, []int) {
-	return file_scans_proto_rawDescGZIP(), []int{4}
+	return file_scans_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetScanRequest) GetId() string {
@@ -692,7 +1033,7 @@ type ListScansRequest struct {
 
 func (x *ListScansRequest) Reset() {
 	*x = ListScansRequest{}
-	mi := &file_scans_proto_msgTypes[5]
+	mi := &file_scans_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -704,7 +1045,7 @@ func (x *ListScansRequest) String() string {
 func (*ListScansRequest) ProtoMessage() {}
 
 func (x *ListScansRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_scans_proto_msgTypes[5]
+	mi := &file_scans_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -717,7 +1058,7 @@ func (x *ListScansRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListScansRequest.ProtoReflect.Descriptor instead.
 func (*ListScansRequest) Descriptor() ([]byte, []int) {
-	return file_scans_proto_rawDescGZIP(), []int{5}
+	return file_scans_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListScansRequest) GetOrganizationId() string {
@@ -767,7 +1108,7 @@ type ListScansResponse struct {
 
 func (x *ListScansResponse) Reset() {
 	*x = ListScansResponse{}
-	mi := &file_scans_proto_msgTypes[6]
+	mi := &file_scans_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -779,7 +1120,7 @@ func (x *ListScansResponse) String() string {
 func (*ListScansResponse) ProtoMessage() {}
 
 func (x *ListScansResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_scans_proto_msgTypes[6]
+	mi := &file_scans_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -792,7 +1133,7 @@ func (x *ListScansResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListScansResponse.ProtoReflect.Descriptor instead.
 func (*ListScansResponse) Descriptor() ([]byte, []int) {
-	return file_scans_proto_rawDescGZIP(), []int{6}
+	return file_scans_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListScansResponse) GetScans() []*Scan {
@@ -826,7 +1167,7 @@ type CancelScanRequest struct {
 
 func (x *CancelScanRequest) Reset() {
 	*x = CancelScanRequest{}
-	mi := &file_scans_proto_msgTypes[7]
+	mi := &file_scans_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -838,7 +1179,7 @@ func (x *CancelScanRequest) String() string {
 func (*CancelScanRequest) ProtoMessage() {}
 
 func (x *CancelScanRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_scans_proto_msgTypes[7]
+	mi := &file_scans_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -851,7 +1192,7 @@ func (x *CancelScanRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelScanRequest.ProtoReflect.Descriptor instead.
 func (*CancelScanRequest) Descriptor() ([]byte, []int) {
-	return file_scans_proto_rawDescGZIP(), []int{7}
+	return file_scans_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *CancelScanRequest) GetId() string {
@@ -875,7 +1216,7 @@ type GetFindingsRequest struct {
 
 func (x *GetFindingsRequest) Reset() {
 	*x = GetFindingsRequest{}
-	mi := &file_scans_proto_msgTypes[8]
+	mi := &file_scans_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -887,7 +1228,7 @@ func (x *GetFindingsRequest) String() string {
 func (*GetFindingsRequest) ProtoMessage() {}
 
 func (x *GetFindingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_scans_proto_msgTypes[8]
+	mi := &file_scans_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -900,7 +1241,7 @@ func (x *GetFindingsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFindingsRequest.ProtoReflect.Descriptor instead.
 func (*GetFindingsRequest) Descriptor() ([]byte, []int) {
-	return file_scans_proto_rawDescGZIP(), []int{8}
+	return file_scans_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetFindingsRequest) GetScanId() string {
@@ -950,7 +1291,7 @@ type GetFindingsResponse struct {
 
 func (x *GetFindingsResponse) Reset() {
 	*x = GetFindingsResponse{}
-	mi := &file_scans_proto_msgTypes[9]
+	mi := &file_scans_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -962,7 +1303,7 @@ func (x *GetFindingsResponse) String() string {
 func (*GetFindingsResponse) ProtoMessage() {}
 
 func (x *GetFindingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_scans_proto_msgTypes[9]
+	mi := &file_scans_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -975,7 +1316,7 @@ func (x *GetFindingsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFindingsResponse.ProtoReflect.Descriptor instead.
 func (*GetFindingsResponse) Descriptor() ([]byte, []int) {
-	return file_scans_proto_rawDescGZIP(), []int{9}
+	return file_scans_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetFindingsResponse) GetFindings() []*Finding {
@@ -1007,13 +1348,17 @@ type UpdateScanRequest struct {
 	TotalFindings      int32                  `protobuf:"varint,3,opt,name=total_findings,json=totalFindings,proto3" json:"total_findings,omitempty"`
 	FindingsBySeverity map[string]int32       `protobuf:"bytes,4,rep,name=findings_by_severity,json=findingsBySeverity,proto3" json:"findings_by_severity,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
 	ErrorMessage       string                 `protobuf:"bytes,5,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	ScanType           ScanType               `protobuf:"varint,6,opt,name=scan_type,json=scanType,proto3,enum=cloudscan.ScanType" json:"scan_type,omitempty"` // Sub-scan reported on; required for scans with a job per scan type
+	FailureReason      string                 `protobuf:"bytes,7,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`           // Cause of a FAILED status, see SubScan; transient causes are retried
+	RequestId          string                 `protobuf:"bytes,8,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`                       // Idempotency key, unique per scan; replays return the original response
+	Attempt            int32                  `protobuf:"varint,9,opt,name=attempt,proto3" json:"attempt,omitempty"`                                           // The runner's SCAN_ATTEMPT, required with scan_type; callbacks of replaced attempts are rejected
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
 
 func (x *UpdateScanRequest) Reset() {
 	*x = UpdateScanRequest{}
-	mi := &file_scans_proto_msgTypes[10]
+	mi := &file_scans_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1025,7 +1370,7 @@ func (x *UpdateScanRequest) String() string {
 func (*UpdateScanRequest) ProtoMessage() {}
 
 func (x *UpdateScanRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_scans_proto_msgTypes[10]
+	mi := &file_scans_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1038,7 +1383,7 @@ func (x *UpdateScanRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateScanRequest.ProtoReflect.Descriptor instead.
 func (*UpdateScanRequest) Descriptor() ([]byte, []int) {
-	return file_scans_proto_rawDescGZIP(), []int{10}
+	return file_scans_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *UpdateScanRequest) GetId() string {
@@ -1076,18 +1421,49 @@ func (x *UpdateScanRequest) GetErrorMessage() string {
 	return ""
 }
 
+func (x *UpdateScanRequest) GetScanType() ScanType {
+	if x != nil {
+		return x.ScanType
+	}
+	return ScanType_SCAN_TYPE_UNSPECIFIED
+}
+
+func (x *UpdateScanRequest) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+func (x *UpdateScanRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *UpdateScanRequest) GetAttempt() int32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
 // CreateFindingsRequest (called by runner to upload findings)
 type CreateFindingsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ScanId        string                 `protobuf:"bytes,1,opt,name=scan_id,json=scanId,proto3" json:"scan_id,omitempty"`
 	Findings      []*Finding             `protobuf:"bytes,2,rep,name=findings,proto3" json:"findings,omitempty"`
+	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`                       // Idempotency key, unique per scan; replays return the original response
+	ScanType      ScanType               `protobuf:"varint,4,opt,name=scan_type,json=scanType,proto3,enum=cloudscan.ScanType" json:"scan_type,omitempty"` // The runner's SCAN_TYPE, required for scans with a job per scan type
+	Attempt       int32                  `protobuf:"varint,5,opt,name=attempt,proto3" json:"attempt,omitempty"`                                           // The runner's SCAN_ATTEMPT, 1 if unset; findings of replaced attempts are rejected
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateFindingsRequest) Reset() {
 	*x = CreateFindingsRequest{}
-	mi := &file_scans_proto_msgTypes[11]
+	mi := &file_scans_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1099,7 +1475,7 @@ func (x *CreateFindingsRequest) String() string {
 func (*CreateFindingsRequest) ProtoMessage() {}
 
 func (x *CreateFindingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_scans_proto_msgTypes[11]
+	mi := &file_scans_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1112,7 +1488,7 @@ func (x *CreateFindingsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateFindingsRequest.ProtoReflect.Descriptor instead.
 func (*CreateFindingsRequest) Descriptor() ([]byte, []int) {
-	return file_scans_proto_rawDescGZIP(), []int{11}
+	return file_scans_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *CreateFindingsRequest) GetScanId() string {
@@ -1129,6 +1505,27 @@ func (x *CreateFindingsRequest) GetFindings() []*Finding {
 	return nil
 }
 
+func (x *CreateFindingsRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *CreateFindingsRequest) GetScanType() ScanType {
+	if x != nil {
+		return x.ScanType
+	}
+	return ScanType_SCAN_TYPE_UNSPECIFIED
+}
+
+func (x *CreateFindingsRequest) GetAttempt() int32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
 // CreateFindingsResponse
 type CreateFindingsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1139,7 +1536,7 @@ type CreateFindingsResponse struct {
 
 func (x *CreateFindingsResponse) Reset() {
 	*x = CreateFindingsResponse{}
-	mi := &file_scans_proto_msgTypes[12]
+	mi := &file_scans_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1151,7 +1548,7 @@ func (x *CreateFindingsResponse) String() string {
 func (*CreateFindingsResponse) ProtoMessage() {}
 
 func (x *CreateFindingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_scans_proto_msgTypes[12]
+	mi := &file_scans_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1164,7 +1561,7 @@ func (x *CreateFindingsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateFindingsResponse.ProtoReflect.Descriptor instead.
 func (*CreateFindingsResponse) Descriptor() ([]byte, []int) {
-	return file_scans_proto_rawDescGZIP(), []int{12}
+	return file_scans_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *CreateFindingsResponse) GetCreatedCount() int32 {
@@ -1174,29 +1571,37 @@ func (x *CreateFindingsResponse) GetCreatedCount() int32 {
 	return 0
 }
 
-// DeleteScanRequest
-type DeleteScanRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+// UploadFindingsRequest is one batch of an upload (called by runner). An
+// upload is keyed by scan, scan type and attempt; scan_id, scan_type and
+// attempt are read from the first message.
+type UploadFindingsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	ScanId   string                 `protobuf:"bytes,1,opt,name=scan_id,json=scanId,proto3" json:"scan_id,omitempty"`
+	ScanType ScanType               `protobuf:"varint,2,opt,name=scan_type,json=scanType,proto3,enum=cloudscan.ScanType" json:"scan_type,omitempty"` // Required for scans with a job per scan type
+	Attempt  int32                  `protobuf:"varint,3,opt,name=attempt,proto3" json:"attempt,omitempty"`                                           // SCAN_ATTEMPT of the runner, 1 if unset
+	// Batch number, starting at 1 and increasing by 1. A message with
+	// sequence 0 and no findings only asks for the last committed batch.
+	Sequence      int64      `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Findings      []*Finding `protobuf:"bytes,5,rep,name=findings,proto3" json:"findings,omitempty"` // At most 5000 per batch
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteScanRequest) Reset() {
-	*x = DeleteScanRequest{}
-	mi := &file_scans_proto_msgTypes[13]
+func (x *UploadFindingsRequest) Reset() {
+	*x = UploadFindingsRequest{}
+	mi := &file_scans_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteScanRequest) String() string {
+func (x *UploadFindingsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteScanRequest) ProtoMessage() {}
+func (*UploadFindingsRequest) ProtoMessage() {}
 
-func (x *DeleteScanRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_scans_proto_msgTypes[13]
+func (x *UploadFindingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scans_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1207,41 +1612,72 @@ func (x *DeleteScanRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteScanRequest.ProtoReflect.Descriptor instead.
-func (*DeleteScanRequest) Descriptor() ([]byte, []int) {
-	return file_scans_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use UploadFindingsRequest.ProtoReflect.Descriptor instead.
+func (*UploadFindingsRequest) Descriptor() ([]byte, []int) {
+	return file_scans_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *DeleteScanRequest) GetId() string {
+func (x *UploadFindingsRequest) GetScanId() string {
 	if x != nil {
-		return x.Id
+		return x.ScanId
 	}
 	return ""
 }
 
-// DeleteProjectScansRequest
-type DeleteProjectScansRequest struct {
+func (x *UploadFindingsRequest) GetScanType() ScanType {
+	if x != nil {
+		return x.ScanType
+	}
+	return ScanType_SCAN_TYPE_UNSPECIFIED
+}
+
+func (x *UploadFindingsRequest) GetAttempt() int32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *UploadFindingsRequest) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *UploadFindingsRequest) GetFindings() []*Finding {
+	if x != nil {
+		return x.Findings
+	}
+	return nil
+}
+
+// UploadFindingsResponse acknowledges a batch once it is committed
+type UploadFindingsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Sequence      int64                  `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`                             // Batch acknowledged
+	LastSequence  int64                  `protobuf:"varint,2,opt,name=last_sequence,json=lastSequence,proto3" json:"last_sequence,omitempty"` // Last committed batch of the upload; resume after it
+	CreatedCount  int32                  `protobuf:"varint,3,opt,name=created_count,json=createdCount,proto3" json:"created_count,omitempty"`
+	Duplicate     bool                   `protobuf:"varint,4,opt,name=duplicate,proto3" json:"duplicate,omitempty"` // The batch was committed before and is skipped
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteProjectScansRequest) Reset() {
-	*x = DeleteProjectScansRequest{}
-	mi := &file_scans_proto_msgTypes[14]
+func (x *UploadFindingsResponse) Reset() {
+	*x = UploadFindingsResponse{}
+	mi := &file_scans_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteProjectScansRequest) String() string {
+func (x *UploadFindingsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProjectScansRequest) ProtoMessage() {}
+func (*UploadFindingsResponse) ProtoMessage() {}
 
-func (x *DeleteProjectScansRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_scans_proto_msgTypes[14]
+func (x *UploadFindingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scans_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1252,41 +1688,68 @@ func (x *DeleteProjectScansRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProjectScansRequest.ProtoReflect.Descriptor instead.
-func (*DeleteProjectScansRequest) Descriptor() ([]byte, []int) {
-	return file_scans_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use UploadFindingsResponse.ProtoReflect.Descriptor instead.
+func (*UploadFindingsResponse) Descriptor() ([]byte, []int) {
+	return file_scans_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *DeleteProjectScansRequest) GetProjectId() string {
+func (x *UploadFindingsResponse) GetSequence() int64 {
 	if x != nil {
-		return x.ProjectId
+		return x.Sequence
 	}
-	return ""
+	return 0
 }
 
-// DeleteProjectScansResponse
-type DeleteProjectScansResponse struct {
+func (x *UploadFindingsResponse) GetLastSequence() int64 {
+	if x != nil {
+		return x.LastSequence
+	}
+	return 0
+}
+
+func (x *UploadFindingsResponse) GetCreatedCount() int32 {
+	if x != nil {
+		return x.CreatedCount
+	}
+	return 0
+}
+
+func (x *UploadFindingsResponse) GetDuplicate() bool {
+	if x != nil {
+		return x.Duplicate
+	}
+	return false
+}
+
+// ReportProgressRequest (called by runner while it works)
+type ReportProgressRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DeletedCount  int32                  `protobuf:"varint,1,opt,name=deleted_count,json=deletedCount,proto3" json:"deleted_count,omitempty"`
+	ScanId        string                 `protobuf:"bytes,1,opt,name=scan_id,json=scanId,proto3" json:"scan_id,omitempty"`
+	ScanType      ScanType               `protobuf:"varint,2,opt,name=scan_type,json=scanType,proto3,enum=cloudscan.ScanType" json:"scan_type,omitempty"` // Sub-scan reported on; required for scans with a job per scan type
+	Phase         ProgressPhase          `protobuf:"varint,3,opt,name=phase,proto3,enum=cloudscan.ProgressPhase" json:"phase,omitempty"`
+	Scanner       string                 `protobuf:"bytes,4,opt,name=scanner,proto3" json:"scanner,omitempty"`
+	Percent       int32                  `protobuf:"varint,5,opt,name=percent,proto3" json:"percent,omitempty"` // 0 to 100
+	Message       string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	Attempt       int32                  `protobuf:"varint,7,opt,name=attempt,proto3" json:"attempt,omitempty"` // The runner's SCAN_ATTEMPT, 1 if unset; reports of replaced attempts are rejected
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteProjectScansResponse) Reset() {
-	*x = DeleteProjectScansResponse{}
-	mi := &file_scans_proto_msgTypes[15]
+func (x *ReportProgressRequest) Reset() {
+	*x = ReportProgressRequest{}
+	mi := &file_scans_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteProjectScansResponse) String() string {
+func (x *ReportProgressRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProjectScansResponse) ProtoMessage() {}
+func (*ReportProgressRequest) ProtoMessage() {}
 
-func (x *DeleteProjectScansResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_scans_proto_msgTypes[15]
+func (x *ReportProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scans_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1297,87 +1760,1030 @@ func (x *DeleteProjectScansResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProjectScansResponse.ProtoReflect.Descriptor instead.
-func (*DeleteProjectScansResponse) Descriptor() ([]byte, []int) {
-	return file_scans_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ReportProgressRequest.ProtoReflect.Descriptor instead.
+func (*ReportProgressRequest) Descriptor() ([]byte, []int) {
+	return file_scans_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *DeleteProjectScansResponse) GetDeletedCount() int32 {
+func (x *ReportProgressRequest) GetScanId() string {
 	if x != nil {
-		return x.DeletedCount
+		return x.ScanId
+	}
+	return ""
+}
+
+func (x *ReportProgressRequest) GetScanType() ScanType {
+	if x != nil {
+		return x.ScanType
+	}
+	return ScanType_SCAN_TYPE_UNSPECIFIED
+}
+
+func (x *ReportProgressRequest) GetPhase() ProgressPhase {
+	if x != nil {
+		return x.Phase
+	}
+	return ProgressPhase_PROGRESS_PHASE_UNSPECIFIED
+}
+
+func (x *ReportProgressRequest) GetScanner() string {
+	if x != nil {
+		return x.Scanner
+	}
+	return ""
+}
+
+func (x *ReportProgressRequest) GetPercent() int32 {
+	if x != nil {
+		return x.Percent
 	}
 	return 0
 }
 
-var File_scans_proto protoreflect.FileDescriptor
+func (x *ReportProgressRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
 
-const file_scans_proto_rawDesc = "" +
-	"\n" +
-	"\vscans.proto\x12\tcloudscan\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xbb\x05\n" +
-	"\x04Scan\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
-	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x1d\n" +
-	"\n" +
-	"project_id\x18\x03 \x01(\tR\tprojectId\x12-\n" +
-	"\x06status\x18\x04 \x01(\x0e2\x15.cloudscan.ScanStatusR\x06status\x122\n" +
-	"\n" +
-	"scan_types\x18\x05 \x03(\x0e2\x13.cloudscan.ScanTypeR\tscanTypes\x129\n" +
-	"\n" +
-	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12=\n" +
-	"\fcompleted_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12\x17\n" +
-	"\agit_url\x18\t \x01(\tR\x06gitUrl\x12\x1d\n" +
-	"\n" +
-	"git_branch\x18\n" +
-	" \x01(\tR\tgitBranch\x12\x1d\n" +
-	"\n" +
-	"git_commit\x18\v \x01(\tR\tgitCommit\x12%\n" +
-	"\x0etotal_findings\x18\f \x01(\x05R\rtotalFindings\x12Y\n" +
-	"\x14findings_by_severity\x18\r \x03(\v2'.cloudscan.Scan.FindingsBySeverityEntryR\x12findingsBySeverity\x12#\n" +
-	"\rerror_message\x18\x0e \x01(\tR\ferrorMessage\x1aE\n" +
-	"\x17FindingsBySeverityEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xb7\x03\n" +
-	"\aFinding\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
-	"\ascan_id\x18\x02 \x01(\tR\x06scanId\x120\n" +
-	"\tscan_type\x18\x03 \x01(\x0e2\x13.cloudscan.ScanTypeR\bscanType\x12/\n" +
-	"\bseverity\x18\x04 \x01(\x0e2\x13.cloudscan.SeverityR\bseverity\x12\x14\n" +
-	"\x05title\x18\x05 \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\x06 \x01(\tR\vdescription\x12\x1b\n" +
-	"\tfile_path\x18\a \x01(\tR\bfilePath\x12\x1f\n" +
-	"\vline_number\x18\b \x01(\x05R\n" +
-	"lineNumber\x12!\n" +
-	"\fcode_snippet\x18\t \x01(\tR\vcodeSnippet\x12\x15\n" +
-	"\x06cve_id\x18\n" +
-	" \x01(\tR\x05cveId\x12\x15\n" +
-	"\x06cwe_id\x18\v \x01(\tR\x05cweId\x12\x1e\n" +
-	"\n" +
-	"references\x18\f \x03(\tR\n" +
-	"references\x129\n" +
-	"\n" +
-	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xad\x02\n" +
-	"\x11CreateScanRequest\x12'\n" +
-	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1d\n" +
-	"\n" +
-	"project_id\x18\x02 \x01(\tR\tprojectId\x122\n" +
-	"\n" +
-	"scan_types\x18\x03 \x03(\x0e2\x13.cloudscan.ScanTypeR\tscanTypes\x12\x17\n" +
-	"\agit_url\x18\x04 \x01(\tR\x06gitUrl\x12\x1d\n" +
-	"\n" +
-	"git_branch\x18\x05 \x01(\tR\tgitBranch\x12\x1d\n" +
-	"\n" +
-	"git_commit\x18\x06 \x01(\tR\tgitCommit\x12,\n" +
-	"\x12source_artifact_id\x18\a \x01(\tR\x10sourceArtifactId\x12\x17\n" +
-	"\auser_id\x18\b \x01(\tR\x06userId\"9\n" +
-	"\x12CreateScanResponse\x12#\n" +
-	"\x04scan\x18\x01 \x01(\v2\x0f.cloudscan.ScanR\x04scan\" \n" +
-	"\x0eGetScanRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\xc5\x01\n" +
-	"\x10ListScansRequest\x12'\n" +
-	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1d\n" +
-	"\n" +
+func (x *ReportProgressRequest) GetAttempt() int32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+// ReportProgressResponse
+type ReportProgressResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Status of the sub-scan, or of the scan if it has none; a runner whose
+	// sub-scan is no longer RUNNING, e.g. CANCELLED, should stop
+	Status        ScanStatus `protobuf:"varint,1,opt,name=status,proto3,enum=cloudscan.ScanStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportProgressResponse) Reset() {
+	*x = ReportProgressResponse{}
+	mi := &file_scans_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportProgressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportProgressResponse) ProtoMessage() {}
+
+func (x *ReportProgressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scans_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportProgressResponse.ProtoReflect.Descriptor instead.
+func (*ReportProgressResponse) Descriptor() ([]byte, []int) {
+	return file_scans_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ReportProgressResponse) GetStatus() ScanStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ScanStatus_SCAN_STATUS_UNSPECIFIED
+}
+
+// DeleteScanRequest
+type DeleteScanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteScanRequest) Reset() {
+	*x = DeleteScanRequest{}
+	mi := &file_scans_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteScanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteScanRequest) ProtoMessage() {}
+
+func (x *DeleteScanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scans_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteScanRequest.ProtoReflect.Descriptor instead.
+func (*DeleteScanRequest) Descriptor() ([]byte, []int) {
+	return file_scans_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DeleteScanRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// DeleteProjectScansRequest
+type DeleteProjectScansRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProjectScansRequest) Reset() {
+	*x = DeleteProjectScansRequest{}
+	mi := &file_scans_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProjectScansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProjectScansRequest) ProtoMessage() {}
+
+func (x *DeleteProjectScansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scans_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProjectScansRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProjectScansRequest) Descriptor() ([]byte, []int) {
+	return file_scans_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeleteProjectScansRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+// DeleteScanResponse
+type DeleteScanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Operation     *Operation             `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"` // Poll with GetOperation until done
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteScanResponse) Reset() {
+	*x = DeleteScanResponse{}
+	mi := &file_scans_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteScanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteScanResponse) ProtoMessage() {}
+
+func (x *DeleteScanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scans_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteScanResponse.ProtoReflect.Descriptor instead.
+func (*DeleteScanResponse) Descriptor() ([]byte, []int) {
+	return file_scans_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeleteScanResponse) GetOperation() *Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+// DeleteProjectScansResponse
+type DeleteProjectScansResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeletedCount  int32                  `protobuf:"varint,1,opt,name=deleted_count,json=deletedCount,proto3" json:"deleted_count,omitempty"` // Scans scheduled for deletion
+	Operation     *Operation             `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`                            // Poll with GetOperation until done
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProjectScansResponse) Reset() {
+	*x = DeleteProjectScansResponse{}
+	mi := &file_scans_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProjectScansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProjectScansResponse) ProtoMessage() {}
+
+func (x *DeleteProjectScansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scans_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProjectScansResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProjectScansResponse) Descriptor() ([]byte, []int) {
+	return file_scans_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DeleteProjectScansResponse) GetDeletedCount() int32 {
+	if x != nil {
+		return x.DeletedCount
+	}
+	return 0
+}
+
+func (x *DeleteProjectScansResponse) GetOperation() *Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+// Operation tracks work that continues after the RPC that started it returned
+type Operation struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type           string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`     // delete_scan, delete_project_scans
+	Status         string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // running, succeeded, failed
+	ScanId         string                 `protobuf:"bytes,4,opt,name=scan_id,json=scanId,proto3" json:"scan_id,omitempty"`
+	ProjectId      string                 `protobuf:"bytes,5,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	TotalItems     int32                  `protobuf:"varint,6,opt,name=total_items,json=totalItems,proto3" json:"total_items,omitempty"`
+	CompletedItems int32                  `protobuf:"varint,7,opt,name=completed_items,json=completedItems,proto3" json:"completed_items,omitempty"`
+	FailedItems    int32                  `protobuf:"varint,8,opt,name=failed_items,json=failedItems,proto3" json:"failed_items,omitempty"`
+	LastError      string                 `protobuf:"bytes,9,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CompletedAt    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	Done           bool                   `protobuf:"varint,13,opt,name=done,proto3" json:"done,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Operation) Reset() {
+	*x = Operation{}
+	mi := &file_scans_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Operation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Operation) ProtoMessage() {}
+
+func (x *Operation) ProtoReflect() protoreflect.Message {
+	mi := &file_scans_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Operation.ProtoReflect.Descriptor instead.
+func (*Operation) Descriptor() ([]byte, []int) {
+	return file_scans_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Operation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Operation) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Operation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Operation) GetScanId() string {
+	if x != nil {
+		return x.ScanId
+	}
+	return ""
+}
+
+func (x *Operation) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *Operation) GetTotalItems() int32 {
+	if x != nil {
+		return x.TotalItems
+	}
+	return 0
+}
+
+func (x *Operation) GetCompletedItems() int32 {
+	if x != nil {
+		return x.CompletedItems
+	}
+	return 0
+}
+
+func (x *Operation) GetFailedItems() int32 {
+	if x != nil {
+		return x.FailedItems
+	}
+	return 0
+}
+
+func (x *Operation) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *Operation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Operation) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Operation) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *Operation) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+// GetOperationRequest
+type GetOperationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOperationRequest) Reset() {
+	*x = GetOperationRequest{}
+	mi := &file_scans_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOperationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOperationRequest) ProtoMessage() {}
+
+func (x *GetOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scans_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOperationRequest.ProtoReflect.Descriptor instead.
+func (*GetOperationRequest) Descriptor() ([]byte, []int) {
+	return file_scans_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetOperationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetScanLogsRequest
+type GetScanLogsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScanId        string                 `protobuf:"bytes,1,opt,name=scan_id,json=scanId,proto3" json:"scan_id,omitempty"`
+	Follow        bool                   `protobuf:"varint,2,opt,name=follow,proto3" json:"follow,omitempty"`                                             // Keep streaming new lines until the scan's job exits
+	ScanType      ScanType               `protobuf:"varint,3,opt,name=scan_type,json=scanType,proto3,enum=cloudscan.ScanType" json:"scan_type,omitempty"` // Log of one sub-scan; required to follow a scan with several
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScanLogsRequest) Reset() {
+	*x = GetScanLogsRequest{}
+	mi := &file_scans_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScanLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScanLogsRequest) ProtoMessage() {}
+
+func (x *GetScanLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scans_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScanLogsRequest.ProtoReflect.Descriptor instead.
+func (*GetScanLogsRequest) Descriptor() ([]byte, []int) {
+	return file_scans_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetScanLogsRequest) GetScanId() string {
+	if x != nil {
+		return x.ScanId
+	}
+	return ""
+}
+
+func (x *GetScanLogsRequest) GetFollow() bool {
+	if x != nil {
+		return x.Follow
+	}
+	return false
+}
+
+func (x *GetScanLogsRequest) GetScanType() ScanType {
+	if x != nil {
+		return x.ScanType
+	}
+	return ScanType_SCAN_TYPE_UNSPECIFIED
+}
+
+// ScanLogChunk carries either the download URL of the archived logs, in a
+// single message, or the next chunk of the live log
+type ScanLogChunk struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Data                 []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	DownloadUrl          string                 `protobuf:"bytes,2,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"`
+	DownloadUrlExpiresAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=download_url_expires_at,json=downloadUrlExpiresAt,proto3" json:"download_url_expires_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ScanLogChunk) Reset() {
+	*x = ScanLogChunk{}
+	mi := &file_scans_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanLogChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanLogChunk) ProtoMessage() {}
+
+func (x *ScanLogChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_scans_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanLogChunk.ProtoReflect.Descriptor instead.
+func (*ScanLogChunk) Descriptor() ([]byte, []int) {
+	return file_scans_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ScanLogChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ScanLogChunk) GetDownloadUrl() string {
+	if x != nil {
+		return x.DownloadUrl
+	}
+	return ""
+}
+
+func (x *ScanLogChunk) GetDownloadUrlExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DownloadUrlExpiresAt
+	}
+	return nil
+}
+
+// ListScannersRequest
+type ListScannersRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IncludeDisabled bool                   `protobuf:"varint,1,opt,name=include_disabled,json=includeDisabled,proto3" json:"include_disabled,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListScannersRequest) Reset() {
+	*x = ListScannersRequest{}
+	mi := &file_scans_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScannersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScannersRequest) ProtoMessage() {}
+
+func (x *ListScannersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scans_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScannersRequest.ProtoReflect.Descriptor instead.
+func (*ListScannersRequest) Descriptor() ([]byte, []int) {
+	return file_scans_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListScannersRequest) GetIncludeDisabled() bool {
+	if x != nil {
+		return x.IncludeDisabled
+	}
+	return false
+}
+
+// ListScannersResponse
+type ListScannersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scanners      []*Scanner             `protobuf:"bytes,1,rep,name=scanners,proto3" json:"scanners,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScannersResponse) Reset() {
+	*x = ListScannersResponse{}
+	mi := &file_scans_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScannersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScannersResponse) ProtoMessage() {}
+
+func (x *ListScannersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scans_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScannersResponse.ProtoReflect.Descriptor instead.
+func (*ListScannersResponse) Descriptor() ([]byte, []int) {
+	return file_scans_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListScannersResponse) GetScanners() []*Scanner {
+	if x != nil {
+		return x.Scanners
+	}
+	return nil
+}
+
+// SetScannerRequest
+type SetScannerRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ScanType       ScanType               `protobuf:"varint,1,opt,name=scan_type,json=scanType,proto3,enum=cloudscan.ScanType" json:"scan_type,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Image          string                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	Version        string                 `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	Digest         string                 `protobuf:"bytes,5,opt,name=digest,proto3" json:"digest,omitempty"` // Pins the image, e.g. sha256:...; optional
+	Enabled        bool                   `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Args           []string               `protobuf:"bytes,7,rep,name=args,proto3" json:"args,omitempty"`
+	Env            map[string]string      `protobuf:"bytes,8,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	RequestsCpu    string                 `protobuf:"bytes,9,opt,name=requests_cpu,json=requestsCpu,proto3" json:"requests_cpu,omitempty"` // Unset resources fall back to the runner's
+	RequestsMemory string                 `protobuf:"bytes,10,opt,name=requests_memory,json=requestsMemory,proto3" json:"requests_memory,omitempty"`
+	LimitsCpu      string                 `protobuf:"bytes,11,opt,name=limits_cpu,json=limitsCpu,proto3" json:"limits_cpu,omitempty"`
+	LimitsMemory   string                 `protobuf:"bytes,12,opt,name=limits_memory,json=limitsMemory,proto3" json:"limits_memory,omitempty"`
+	TimeoutSeconds int64                  `protobuf:"varint,13,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"` // 0 falls back to the default job deadline
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetScannerRequest) Reset() {
+	*x = SetScannerRequest{}
+	mi := &file_scans_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetScannerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetScannerRequest) ProtoMessage() {}
+
+func (x *SetScannerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scans_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetScannerRequest.ProtoReflect.Descriptor instead.
+func (*SetScannerRequest) Descriptor() ([]byte, []int) {
+	return file_scans_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SetScannerRequest) GetScanType() ScanType {
+	if x != nil {
+		return x.ScanType
+	}
+	return ScanType_SCAN_TYPE_UNSPECIFIED
+}
+
+func (x *SetScannerRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetScannerRequest) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *SetScannerRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *SetScannerRequest) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+func (x *SetScannerRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SetScannerRequest) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *SetScannerRequest) GetEnv() map[string]string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *SetScannerRequest) GetRequestsCpu() string {
+	if x != nil {
+		return x.RequestsCpu
+	}
+	return ""
+}
+
+func (x *SetScannerRequest) GetRequestsMemory() string {
+	if x != nil {
+		return x.RequestsMemory
+	}
+	return ""
+}
+
+func (x *SetScannerRequest) GetLimitsCpu() string {
+	if x != nil {
+		return x.LimitsCpu
+	}
+	return ""
+}
+
+func (x *SetScannerRequest) GetLimitsMemory() string {
+	if x != nil {
+		return x.LimitsMemory
+	}
+	return ""
+}
+
+func (x *SetScannerRequest) GetTimeoutSeconds() int64 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+// Scanner is the tool that runs one scan type
+type Scanner struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ScanType       ScanType               `protobuf:"varint,1,opt,name=scan_type,json=scanType,proto3,enum=cloudscan.ScanType" json:"scan_type,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Image          string                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	Version        string                 `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	Digest         string                 `protobuf:"bytes,5,opt,name=digest,proto3" json:"digest,omitempty"` // Set if the image is pinned
+	Enabled        bool                   `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	TimeoutSeconds int64                  `protobuf:"varint,7,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"` // 0 if the default job deadline applies
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Scanner) Reset() {
+	*x = Scanner{}
+	mi := &file_scans_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Scanner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Scanner) ProtoMessage() {}
+
+func (x *Scanner) ProtoReflect() protoreflect.Message {
+	mi := &file_scans_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Scanner.ProtoReflect.Descriptor instead.
+func (*Scanner) Descriptor() ([]byte, []int) {
+	return file_scans_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Scanner) GetScanType() ScanType {
+	if x != nil {
+		return x.ScanType
+	}
+	return ScanType_SCAN_TYPE_UNSPECIFIED
+}
+
+func (x *Scanner) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Scanner) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *Scanner) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *Scanner) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+func (x *Scanner) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Scanner) GetTimeoutSeconds() int64 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+var File_scans_proto protoreflect.FileDescriptor
+
+const file_scans_proto_rawDesc = "" +
+	"\n" +
+	"\vscans.proto\x12\tcloudscan\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x12job_profiles.proto\"\xff\x06\n" +
+	"\x04Scan\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x03 \x01(\tR\tprojectId\x12-\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x15.cloudscan.ScanStatusR\x06status\x122\n" +
+	"\n" +
+	"scan_types\x18\x05 \x03(\x0e2\x13.cloudscan.ScanTypeR\tscanTypes\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12=\n" +
+	"\fcompleted_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12\x17\n" +
+	"\agit_url\x18\t \x01(\tR\x06gitUrl\x12\x1d\n" +
+	"\n" +
+	"git_branch\x18\n" +
+	" \x01(\tR\tgitBranch\x12\x1d\n" +
+	"\n" +
+	"git_commit\x18\v \x01(\tR\tgitCommit\x12%\n" +
+	"\x0etotal_findings\x18\f \x01(\x05R\rtotalFindings\x12Y\n" +
+	"\x14findings_by_severity\x18\r \x03(\v2'.cloudscan.Scan.FindingsBySeverityEntryR\x12findingsBySeverity\x12#\n" +
+	"\rerror_message\x18\x0e \x01(\tR\ferrorMessage\x12/\n" +
+	"\tsub_scans\x18\x0f \x03(\v2\x12.cloudscan.SubScanR\bsubScans\x129\n" +
+	"\fjob_settings\x18\x10 \x01(\v2\x16.cloudscan.JobSettingsR\vjobSettings\x12%\n" +
+	"\x0efailure_reason\x18\x11 \x01(\tR\rfailureReason\x12/\n" +
+	"\bprogress\x18\x12 \x01(\v2\x13.cloudscan.ProgressR\bprogress\x1aE\n" +
+	"\x17FindingsBySeverityEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xa9\x06\n" +
+	"\aSubScan\x120\n" +
+	"\tscan_type\x18\x01 \x01(\x0e2\x13.cloudscan.ScanTypeR\bscanType\x12!\n" +
+	"\fscanner_name\x18\x02 \x01(\tR\vscannerName\x12#\n" +
+	"\rscanner_image\x18\x03 \x01(\tR\fscannerImage\x12-\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x15.cloudscan.ScanStatusR\x06status\x12\x19\n" +
+	"\bjob_name\x18\x05 \x01(\tR\ajobName\x12%\n" +
+	"\x0etotal_findings\x18\x06 \x01(\x05R\rtotalFindings\x12\\\n" +
+	"\x14findings_by_severity\x18\a \x03(\v2*.cloudscan.SubScan.FindingsBySeverityEntryR\x12findingsBySeverity\x12#\n" +
+	"\rerror_message\x18\b \x01(\tR\ferrorMessage\x129\n" +
+	"\n" +
+	"started_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12=\n" +
+	"\fcompleted_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12%\n" +
+	"\x0efailure_reason\x18\v \x01(\tR\rfailureReason\x12\x18\n" +
+	"\aattempt\x18\f \x01(\x05R\aattempt\x125\n" +
+	"\bretry_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\aretryAt\x12F\n" +
+	"\x11last_heartbeat_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\x0flastHeartbeatAt\x12/\n" +
+	"\bprogress\x18\x0f \x01(\v2\x13.cloudscan.ProgressR\bprogress\x1aE\n" +
+	"\x17FindingsBySeverityEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xc3\x01\n" +
+	"\bProgress\x12.\n" +
+	"\x05phase\x18\x01 \x01(\x0e2\x18.cloudscan.ProgressPhaseR\x05phase\x12\x18\n" +
+	"\ascanner\x18\x02 \x01(\tR\ascanner\x12\x18\n" +
+	"\apercent\x18\x03 \x01(\x05R\apercent\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xb7\x03\n" +
+	"\aFinding\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\ascan_id\x18\x02 \x01(\tR\x06scanId\x120\n" +
+	"\tscan_type\x18\x03 \x01(\x0e2\x13.cloudscan.ScanTypeR\bscanType\x12/\n" +
+	"\bseverity\x18\x04 \x01(\x0e2\x13.cloudscan.SeverityR\bseverity\x12\x14\n" +
+	"\x05title\x18\x05 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12\x1b\n" +
+	"\tfile_path\x18\a \x01(\tR\bfilePath\x12\x1f\n" +
+	"\vline_number\x18\b \x01(\x05R\n" +
+	"lineNumber\x12!\n" +
+	"\fcode_snippet\x18\t \x01(\tR\vcodeSnippet\x12\x15\n" +
+	"\x06cve_id\x18\n" +
+	" \x01(\tR\x05cveId\x12\x15\n" +
+	"\x06cwe_id\x18\v \x01(\tR\x05cweId\x12\x1e\n" +
+	"\n" +
+	"references\x18\f \x03(\tR\n" +
+	"references\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xe8\x02\n" +
+	"\x11CreateScanRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\x122\n" +
+	"\n" +
+	"scan_types\x18\x03 \x03(\x0e2\x13.cloudscan.ScanTypeR\tscanTypes\x12\x17\n" +
+	"\agit_url\x18\x04 \x01(\tR\x06gitUrl\x12\x1d\n" +
+	"\n" +
+	"git_branch\x18\x05 \x01(\tR\tgitBranch\x12\x1d\n" +
+	"\n" +
+	"git_commit\x18\x06 \x01(\tR\tgitCommit\x12,\n" +
+	"\x12source_artifact_id\x18\a \x01(\tR\x10sourceArtifactId\x12\x17\n" +
+	"\auser_id\x18\b \x01(\tR\x06userId\x129\n" +
+	"\fjob_settings\x18\t \x01(\v2\x16.cloudscan.JobSettingsR\vjobSettings\"9\n" +
+	"\x12CreateScanResponse\x12#\n" +
+	"\x04scan\x18\x01 \x01(\v2\x0f.cloudscan.ScanR\x04scan\" \n" +
+	"\x0eGetScanRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xc5\x01\n" +
+	"\x10ListScansRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1d\n" +
+	"\n" +
 	"project_id\x18\x02 \x01(\tR\tprojectId\x12-\n" +
 	"\x06status\x18\x03 \x01(\x0e2\x15.cloudscan.ScanStatusR\x06status\x12\x1b\n" +
 	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
@@ -1401,28 +2807,128 @@ const file_scans_proto_rawDesc = "" +
 	"\bfindings\x18\x01 \x03(\v2\x12.cloudscan.FindingR\bfindings\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
 	"\vtotal_count\x18\x03 \x01(\x05R\n" +
-	"totalCount\"\xcd\x02\n" +
+	"totalCount\"\xdf\x03\n" +
 	"\x11UpdateScanRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12-\n" +
 	"\x06status\x18\x02 \x01(\x0e2\x15.cloudscan.ScanStatusR\x06status\x12%\n" +
 	"\x0etotal_findings\x18\x03 \x01(\x05R\rtotalFindings\x12f\n" +
 	"\x14findings_by_severity\x18\x04 \x03(\v24.cloudscan.UpdateScanRequest.FindingsBySeverityEntryR\x12findingsBySeverity\x12#\n" +
-	"\rerror_message\x18\x05 \x01(\tR\ferrorMessage\x1aE\n" +
+	"\rerror_message\x18\x05 \x01(\tR\ferrorMessage\x120\n" +
+	"\tscan_type\x18\x06 \x01(\x0e2\x13.cloudscan.ScanTypeR\bscanType\x12%\n" +
+	"\x0efailure_reason\x18\a \x01(\tR\rfailureReason\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\b \x01(\tR\trequestId\x12\x18\n" +
+	"\aattempt\x18\t \x01(\x05R\aattempt\x1aE\n" +
 	"\x17FindingsBySeverityEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"`\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xcb\x01\n" +
 	"\x15CreateFindingsRequest\x12\x17\n" +
 	"\ascan_id\x18\x01 \x01(\tR\x06scanId\x12.\n" +
-	"\bfindings\x18\x02 \x03(\v2\x12.cloudscan.FindingR\bfindings\"=\n" +
+	"\bfindings\x18\x02 \x03(\v2\x12.cloudscan.FindingR\bfindings\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x03 \x01(\tR\trequestId\x120\n" +
+	"\tscan_type\x18\x04 \x01(\x0e2\x13.cloudscan.ScanTypeR\bscanType\x12\x18\n" +
+	"\aattempt\x18\x05 \x01(\x05R\aattempt\"=\n" +
 	"\x16CreateFindingsResponse\x12#\n" +
-	"\rcreated_count\x18\x01 \x01(\x05R\fcreatedCount\"#\n" +
+	"\rcreated_count\x18\x01 \x01(\x05R\fcreatedCount\"\xc8\x01\n" +
+	"\x15UploadFindingsRequest\x12\x17\n" +
+	"\ascan_id\x18\x01 \x01(\tR\x06scanId\x120\n" +
+	"\tscan_type\x18\x02 \x01(\x0e2\x13.cloudscan.ScanTypeR\bscanType\x12\x18\n" +
+	"\aattempt\x18\x03 \x01(\x05R\aattempt\x12\x1a\n" +
+	"\bsequence\x18\x04 \x01(\x03R\bsequence\x12.\n" +
+	"\bfindings\x18\x05 \x03(\v2\x12.cloudscan.FindingR\bfindings\"\x9c\x01\n" +
+	"\x16UploadFindingsResponse\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x03R\bsequence\x12#\n" +
+	"\rlast_sequence\x18\x02 \x01(\x03R\flastSequence\x12#\n" +
+	"\rcreated_count\x18\x03 \x01(\x05R\fcreatedCount\x12\x1c\n" +
+	"\tduplicate\x18\x04 \x01(\bR\tduplicate\"\xfa\x01\n" +
+	"\x15ReportProgressRequest\x12\x17\n" +
+	"\ascan_id\x18\x01 \x01(\tR\x06scanId\x120\n" +
+	"\tscan_type\x18\x02 \x01(\x0e2\x13.cloudscan.ScanTypeR\bscanType\x12.\n" +
+	"\x05phase\x18\x03 \x01(\x0e2\x18.cloudscan.ProgressPhaseR\x05phase\x12\x18\n" +
+	"\ascanner\x18\x04 \x01(\tR\ascanner\x12\x18\n" +
+	"\apercent\x18\x05 \x01(\x05R\apercent\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\x12\x18\n" +
+	"\aattempt\x18\a \x01(\x05R\aattempt\"G\n" +
+	"\x16ReportProgressResponse\x12-\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x15.cloudscan.ScanStatusR\x06status\"#\n" +
 	"\x11DeleteScanRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\":\n" +
 	"\x19DeleteProjectScansRequest\x12\x1d\n" +
 	"\n" +
-	"project_id\x18\x01 \x01(\tR\tprojectId\"A\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\"H\n" +
+	"\x12DeleteScanResponse\x122\n" +
+	"\toperation\x18\x01 \x01(\v2\x14.cloudscan.OperationR\toperation\"u\n" +
 	"\x1aDeleteProjectScansResponse\x12#\n" +
-	"\rdeleted_count\x18\x01 \x01(\x05R\fdeletedCount*l\n" +
+	"\rdeleted_count\x18\x01 \x01(\x05R\fdeletedCount\x122\n" +
+	"\toperation\x18\x02 \x01(\v2\x14.cloudscan.OperationR\toperation\"\xd4\x03\n" +
+	"\tOperation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x17\n" +
+	"\ascan_id\x18\x04 \x01(\tR\x06scanId\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x05 \x01(\tR\tprojectId\x12\x1f\n" +
+	"\vtotal_items\x18\x06 \x01(\x05R\n" +
+	"totalItems\x12'\n" +
+	"\x0fcompleted_items\x18\a \x01(\x05R\x0ecompletedItems\x12!\n" +
+	"\ffailed_items\x18\b \x01(\x05R\vfailedItems\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\t \x01(\tR\tlastError\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12=\n" +
+	"\fcompleted_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12\x12\n" +
+	"\x04done\x18\r \x01(\bR\x04done\"%\n" +
+	"\x13GetOperationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"w\n" +
+	"\x12GetScanLogsRequest\x12\x17\n" +
+	"\ascan_id\x18\x01 \x01(\tR\x06scanId\x12\x16\n" +
+	"\x06follow\x18\x02 \x01(\bR\x06follow\x120\n" +
+	"\tscan_type\x18\x03 \x01(\x0e2\x13.cloudscan.ScanTypeR\bscanType\"\x98\x01\n" +
+	"\fScanLogChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12!\n" +
+	"\fdownload_url\x18\x02 \x01(\tR\vdownloadUrl\x12Q\n" +
+	"\x17download_url_expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x14downloadUrlExpiresAt\"@\n" +
+	"\x13ListScannersRequest\x12)\n" +
+	"\x10include_disabled\x18\x01 \x01(\bR\x0fincludeDisabled\"F\n" +
+	"\x14ListScannersResponse\x12.\n" +
+	"\bscanners\x18\x01 \x03(\v2\x12.cloudscan.ScannerR\bscanners\"\xf9\x03\n" +
+	"\x11SetScannerRequest\x120\n" +
+	"\tscan_type\x18\x01 \x01(\x0e2\x13.cloudscan.ScanTypeR\bscanType\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05image\x18\x03 \x01(\tR\x05image\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\tR\aversion\x12\x16\n" +
+	"\x06digest\x18\x05 \x01(\tR\x06digest\x12\x18\n" +
+	"\aenabled\x18\x06 \x01(\bR\aenabled\x12\x12\n" +
+	"\x04args\x18\a \x03(\tR\x04args\x127\n" +
+	"\x03env\x18\b \x03(\v2%.cloudscan.SetScannerRequest.EnvEntryR\x03env\x12!\n" +
+	"\frequests_cpu\x18\t \x01(\tR\vrequestsCpu\x12'\n" +
+	"\x0frequests_memory\x18\n" +
+	" \x01(\tR\x0erequestsMemory\x12\x1d\n" +
+	"\n" +
+	"limits_cpu\x18\v \x01(\tR\tlimitsCpu\x12#\n" +
+	"\rlimits_memory\x18\f \x01(\tR\flimitsMemory\x12'\n" +
+	"\x0ftimeout_seconds\x18\r \x01(\x03R\x0etimeoutSeconds\x1a6\n" +
+	"\bEnvEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xda\x01\n" +
+	"\aScanner\x120\n" +
+	"\tscan_type\x18\x01 \x01(\x0e2\x13.cloudscan.ScanTypeR\bscanType\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05image\x18\x03 \x01(\tR\x05image\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\tR\aversion\x12\x16\n" +
+	"\x06digest\x18\x05 \x01(\tR\x06digest\x12\x18\n" +
+	"\aenabled\x18\x06 \x01(\bR\aenabled\x12'\n" +
+	"\x0ftimeout_seconds\x18\a \x01(\x03R\x0etimeoutSeconds*j\n" +
+	"\rProgressPhase\x12\x1e\n" +
+	"\x1aPROGRESS_PHASE_UNSPECIFIED\x10\x00\x12\x0f\n" +
+	"\vDOWNLOADING\x10\x01\x12\v\n" +
+	"\aCLONING\x10\x02\x12\f\n" +
+	"\bSCANNING\x10\x03\x12\r\n" +
+	"\tUPLOADING\x10\x04*\x87\x01\n" +
 	"\n" +
 	"ScanStatus\x12\x1b\n" +
 	"\x17SCAN_STATUS_UNSPECIFIED\x10\x00\x12\n" +
@@ -1432,7 +2938,9 @@ const file_scans_proto_rawDesc = "" +
 	"\tCOMPLETED\x10\x03\x12\n" +
 	"\n" +
 	"\x06FAILED\x10\x04\x12\r\n" +
-	"\tCANCELLED\x10\x05*R\n" +
+	"\tCANCELLED\x10\x05\x12\f\n" +
+	"\bDELETING\x10\x06\x12\v\n" +
+	"\aPARTIAL\x10\a*R\n" +
 	"\bScanType\x12\x19\n" +
 	"\x15SCAN_TYPE_UNSPECIFIED\x10\x00\x12\b\n" +
 	"\x04SAST\x10\x01\x12\a\n" +
@@ -1446,7 +2954,7 @@ const file_scans_proto_rawDesc = "" +
 	"\n" +
 	"\x06MEDIUM\x10\x03\x12\a\n" +
 	"\x03LOW\x10\x04\x12\b\n" +
-	"\x04INFO\x10\x052\xa4\x05\n" +
+	"\x04INFO\x10\x052\xfd\b\n" +
 	"\vScanService\x12I\n" +
 	"\n" +
 	"CreateScan\x12\x1c.cloudscan.CreateScanRequest\x1a\x1d.cloudscan.CreateScanResponse\x125\n" +
@@ -1454,13 +2962,20 @@ const file_scans_proto_rawDesc = "" +
 	"\tListScans\x12\x1b.cloudscan.ListScansRequest\x1a\x1c.cloudscan.ListScansResponse\x12B\n" +
 	"\n" +
 	"CancelScan\x12\x1c.cloudscan.CancelScanRequest\x1a\x16.google.protobuf.Empty\x12L\n" +
-	"\vGetFindings\x12\x1d.cloudscan.GetFindingsRequest\x1a\x1e.cloudscan.GetFindingsResponse\x12B\n" +
+	"\vGetFindings\x12\x1d.cloudscan.GetFindingsRequest\x1a\x1e.cloudscan.GetFindingsResponse\x12I\n" +
+	"\n" +
+	"DeleteScan\x12\x1c.cloudscan.DeleteScanRequest\x1a\x1d.cloudscan.DeleteScanResponse\x12a\n" +
+	"\x12DeleteProjectScans\x12$.cloudscan.DeleteProjectScansRequest\x1a%.cloudscan.DeleteProjectScansResponse\x12D\n" +
+	"\fGetOperation\x12\x1e.cloudscan.GetOperationRequest\x1a\x14.cloudscan.Operation\x12G\n" +
+	"\vGetScanLogs\x12\x1d.cloudscan.GetScanLogsRequest\x1a\x17.cloudscan.ScanLogChunk0\x01\x12O\n" +
+	"\fListScanners\x12\x1e.cloudscan.ListScannersRequest\x1a\x1f.cloudscan.ListScannersResponse\x12>\n" +
 	"\n" +
-	"DeleteScan\x12\x1c.cloudscan.DeleteScanRequest\x1a\x16.google.protobuf.Empty\x12a\n" +
-	"\x12DeleteProjectScans\x12$.cloudscan.DeleteProjectScansRequest\x1a%.cloudscan.DeleteProjectScansResponse\x12;\n" +
+	"SetScanner\x12\x1c.cloudscan.SetScannerRequest\x1a\x12.cloudscan.Scanner\x12;\n" +
 	"\n" +
 	"UpdateScan\x12\x1c.cloudscan.UpdateScanRequest\x1a\x0f.cloudscan.Scan\x12U\n" +
-	"\x0eCreateFindings\x12 .cloudscan.CreateFindingsRequest\x1a!.cloudscan.CreateFindingsResponseB>Z<github.com/cloud-scan/cloudscan-orchestrator/generated/protob\x06proto3"
+	"\x0eCreateFindings\x12 .cloudscan.CreateFindingsRequest\x1a!.cloudscan.CreateFindingsResponse\x12Y\n" +
+	"\x0eUploadFindings\x12 .cloudscan.UploadFindingsRequest\x1a!.cloudscan.UploadFindingsResponse(\x010\x01\x12U\n" +
+	"\x0eReportProgress\x12 .cloudscan.ReportProgressRequest\x1a!.cloudscan.ReportProgressResponseB>Z<github.com/cloud-scan/cloudscan-orchestrator/generated/protob\x06proto3"
 
 var (
 	file_scans_proto_rawDescOnce sync.Once
@@ -1474,76 +2989,139 @@ func file_scans_proto_rawDescGZIP() []byte {
 	return file_scans_proto_rawDescData
 }
 
-var file_scans_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_scans_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_scans_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_scans_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
 var file_scans_proto_goTypes = []any{
-	(ScanStatus)(0),                    // 0: cloudscan.ScanStatus
-	(ScanType)(0),                      // 1: cloudscan.ScanType
-	(Severity)(0),                      // 2: cloudscan.Severity
-	(*Scan)(nil),                       // 3: cloudscan.Scan
-	(*Finding)(nil),                    // 4: cloudscan.Finding
-	(*CreateScanRequest)(nil),          // 5: cloudscan.CreateScanRequest
-	(*CreateScanResponse)(nil),         // 6: cloudscan.CreateScanResponse
-	(*GetScanRequest)(nil),             // 7: cloudscan.GetScanRequest
-	(*ListScansRequest)(nil),           // 8: cloudscan.ListScansRequest
-	(*ListScansResponse)(nil),          // 9: cloudscan.ListScansResponse
-	(*CancelScanRequest)(nil),          // 10: cloudscan.CancelScanRequest
-	(*GetFindingsRequest)(nil),         // 11: cloudscan.GetFindingsRequest
-	(*GetFindingsResponse)(nil),        // 12: cloudscan.GetFindingsResponse
-	(*UpdateScanRequest)(nil),          // 13: cloudscan.UpdateScanRequest
-	(*CreateFindingsRequest)(nil),      // 14: cloudscan.CreateFindingsRequest
-	(*CreateFindingsResponse)(nil),     // 15: cloudscan.CreateFindingsResponse
-	(*DeleteScanRequest)(nil),          // 16: cloudscan.DeleteScanRequest
-	(*DeleteProjectScansRequest)(nil),  // 17: cloudscan.DeleteProjectScansRequest
-	(*DeleteProjectScansResponse)(nil), // 18: cloudscan.DeleteProjectScansResponse
-	nil,                                // 19: cloudscan.Scan.FindingsBySeverityEntry
-	nil,                                // 20: cloudscan.UpdateScanRequest.FindingsBySeverityEntry
-	(*timestamppb.Timestamp)(nil),      // 21: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),              // 22: google.protobuf.Empty
+	(ProgressPhase)(0),                 // 0: cloudscan.ProgressPhase
+	(ScanStatus)(0),                    // 1: cloudscan.ScanStatus
+	(ScanType)(0),                      // 2: cloudscan.ScanType
+	(Severity)(0),                      // 3: cloudscan.Severity
+	(*Scan)(nil),                       // 4: cloudscan.Scan
+	(*SubScan)(nil),                    // 5: cloudscan.SubScan
+	(*Progress)(nil),                   // 6: cloudscan.Progress
+	(*Finding)(nil),                    // 7: cloudscan.Finding
+	(*CreateScanRequest)(nil),          // 8: cloudscan.CreateScanRequest
+	(*CreateScanResponse)(nil),         // 9: cloudscan.CreateScanResponse
+	(*GetScanRequest)(nil),             // 10: cloudscan.GetScanRequest
+	(*ListScansRequest)(nil),           // 11: cloudscan.ListScansRequest
+	(*ListScansResponse)(nil),          // 12: cloudscan.ListScansResponse
+	(*CancelScanRequest)(nil),          // 13: cloudscan.CancelScanRequest
+	(*GetFindingsRequest)(nil),         // 14: cloudscan.GetFindingsRequest
+	(*GetFindingsResponse)(nil),        // 15: cloudscan.GetFindingsResponse
+	(*UpdateScanRequest)(nil),          // 16: cloudscan.UpdateScanRequest
+	(*CreateFindingsRequest)(nil),      // 17: cloudscan.CreateFindingsRequest
+	(*CreateFindingsResponse)(nil),     // 18: cloudscan.CreateFindingsResponse
+	(*UploadFindingsRequest)(nil),      // 19: cloudscan.UploadFindingsRequest
+	(*UploadFindingsResponse)(nil),     // 20: cloudscan.UploadFindingsResponse
+	(*ReportProgressRequest)(nil),      // 21: cloudscan.ReportProgressRequest
+	(*ReportProgressResponse)(nil),     // 22: cloudscan.ReportProgressResponse
+	(*DeleteScanRequest)(nil),          // 23: cloudscan.DeleteScanRequest
+	(*DeleteProjectScansRequest)(nil),  // 24: cloudscan.DeleteProjectScansRequest
+	(*DeleteScanResponse)(nil),         // 25: cloudscan.DeleteScanResponse
+	(*DeleteProjectScansResponse)(nil), // 26: cloudscan.DeleteProjectScansResponse
+	(*Operation)(nil),                  // 27: cloudscan.Operation
+	(*GetOperationRequest)(nil),        // 28: cloudscan.GetOperationRequest
+	(*GetScanLogsRequest)(nil),         // 29: cloudscan.GetScanLogsRequest
+	(*ScanLogChunk)(nil),               // 30: cloudscan.ScanLogChunk
+	(*ListScannersRequest)(nil),        // 31: cloudscan.ListScannersRequest
+	(*ListScannersResponse)(nil),       // 32: cloudscan.ListScannersResponse
+	(*SetScannerRequest)(nil),          // 33: cloudscan.SetScannerRequest
+	(*Scanner)(nil),                    // 34: cloudscan.Scanner
+	nil,                                // 35: cloudscan.Scan.FindingsBySeverityEntry
+	nil,                                // 36: cloudscan.SubScan.FindingsBySeverityEntry
+	nil,                                // 37: cloudscan.UpdateScanRequest.FindingsBySeverityEntry
+	nil,                                // 38: cloudscan.SetScannerRequest.EnvEntry
+	(*timestamppb.Timestamp)(nil),      // 39: google.protobuf.Timestamp
+	(*JobSettings)(nil),                // 40: cloudscan.JobSettings
+	(*emptypb.Empty)(nil),              // 41: google.protobuf.Empty
 }
 var file_scans_proto_depIdxs = []int32{
-	0,  // 0: cloudscan.Scan.status:type_name -> cloudscan.ScanStatus
-	1,  // 1: cloudscan.Scan.scan_types:type_name -> cloudscan.ScanType
-	21, // 2: cloudscan.Scan.created_at:type_name -> google.protobuf.Timestamp
-	21, // 3: cloudscan.Scan.updated_at:type_name -> google.protobuf.Timestamp
-	21, // 4: cloudscan.Scan.completed_at:type_name -> google.protobuf.Timestamp
-	19, // 5: cloudscan.Scan.findings_by_severity:type_name -> cloudscan.Scan.FindingsBySeverityEntry
-	1,  // 6: cloudscan.Finding.scan_type:type_name -> cloudscan.ScanType
-	2,  // 7: cloudscan.Finding.severity:type_name -> cloudscan.Severity
-	21, // 8: cloudscan.Finding.created_at:type_name -> google.protobuf.Timestamp
-	1,  // 9: cloudscan.CreateScanRequest.scan_types:type_name -> cloudscan.ScanType
-	3,  // 10: cloudscan.CreateScanResponse.scan:type_name -> cloudscan.Scan
-	0,  // 11: cloudscan.ListScansRequest.status:type_name -> cloudscan.ScanStatus
-	3,  // 12: cloudscan.ListScansResponse.scans:type_name -> cloudscan.Scan
-	1,  // 13: cloudscan.GetFindingsRequest.scan_type:type_name -> cloudscan.ScanType
-	2,  // 14: cloudscan.GetFindingsRequest.severity:type_name -> cloudscan.Severity
-	4,  // 15: cloudscan.GetFindingsResponse.findings:type_name -> cloudscan.Finding
-	0,  // 16: cloudscan.UpdateScanRequest.status:type_name -> cloudscan.ScanStatus
-	20, // 17: cloudscan.UpdateScanRequest.findings_by_severity:type_name -> cloudscan.UpdateScanRequest.FindingsBySeverityEntry
-	4,  // 18: cloudscan.CreateFindingsRequest.findings:type_name -> cloudscan.Finding
-	5,  // 19: cloudscan.ScanService.CreateScan:input_type -> cloudscan.CreateScanRequest
-	7,  // 20: cloudscan.ScanService.GetScan:input_type -> cloudscan.GetScanRequest
-	8,  // 21: cloudscan.ScanService.ListScans:input_type -> cloudscan.ListScansRequest
-	10, // 22: cloudscan.ScanService.CancelScan:input_type -> cloudscan.CancelScanRequest
-	11, // 23: cloudscan.ScanService.GetFindings:input_type -> cloudscan.GetFindingsRequest
-	16, // 24: cloudscan.ScanService.DeleteScan:input_type -> cloudscan.DeleteScanRequest
-	17, // 25: cloudscan.ScanService.DeleteProjectScans:input_type -> cloudscan.DeleteProjectScansRequest
-	13, // 26: cloudscan.ScanService.UpdateScan:input_type -> cloudscan.UpdateScanRequest
-	14, // 27: cloudscan.ScanService.CreateFindings:input_type -> cloudscan.CreateFindingsRequest
-	6,  // 28: cloudscan.ScanService.CreateScan:output_type -> cloudscan.CreateScanResponse
-	3,  // 29: cloudscan.ScanService.GetScan:output_type -> cloudscan.Scan
-	9,  // 30: cloudscan.ScanService.ListScans:output_type -> cloudscan.ListScansResponse
-	22, // 31: cloudscan.ScanService.CancelScan:output_type -> google.protobuf.Empty
-	12, // 32: cloudscan.ScanService.GetFindings:output_type -> cloudscan.GetFindingsResponse
-	22, // 33: cloudscan.ScanService.DeleteScan:output_type -> google.protobuf.Empty
-	18, // 34: cloudscan.ScanService.DeleteProjectScans:output_type -> cloudscan.DeleteProjectScansResponse
-	3,  // 35: cloudscan.ScanService.UpdateScan:output_type -> cloudscan.Scan
-	15, // 36: cloudscan.ScanService.CreateFindings:output_type -> cloudscan.CreateFindingsResponse
-	28, // [28:37] is the sub-list for method output_type
-	19, // [19:28] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	1,  // 0: cloudscan.Scan.status:type_name -> cloudscan.ScanStatus
+	2,  // 1: cloudscan.Scan.scan_types:type_name -> cloudscan.ScanType
+	39, // 2: cloudscan.Scan.created_at:type_name -> google.protobuf.Timestamp
+	39, // 3: cloudscan.Scan.updated_at:type_name -> google.protobuf.Timestamp
+	39, // 4: cloudscan.Scan.completed_at:type_name -> google.protobuf.Timestamp
+	35, // 5: cloudscan.Scan.findings_by_severity:type_name -> cloudscan.Scan.FindingsBySeverityEntry
+	5,  // 6: cloudscan.Scan.sub_scans:type_name -> cloudscan.SubScan
+	40, // 7: cloudscan.Scan.job_settings:type_name -> cloudscan.JobSettings
+	6,  // 8: cloudscan.Scan.progress:type_name -> cloudscan.Progress
+	2,  // 9: cloudscan.SubScan.scan_type:type_name -> cloudscan.ScanType
+	1,  // 10: cloudscan.SubScan.status:type_name -> cloudscan.ScanStatus
+	36, // 11: cloudscan.SubScan.findings_by_severity:type_name -> cloudscan.SubScan.FindingsBySeverityEntry
+	39, // 12: cloudscan.SubScan.started_at:type_name -> google.protobuf.Timestamp
+	39, // 13: cloudscan.SubScan.completed_at:type_name -> google.protobuf.Timestamp
+	39, // 14: cloudscan.SubScan.retry_at:type_name -> google.protobuf.Timestamp
+	39, // 15: cloudscan.SubScan.last_heartbeat_at:type_name -> google.protobuf.Timestamp
+	6,  // 16: cloudscan.SubScan.progress:type_name -> cloudscan.Progress
+	0,  // 17: cloudscan.Progress.phase:type_name -> cloudscan.ProgressPhase
+	39, // 18: cloudscan.Progress.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 19: cloudscan.Finding.scan_type:type_name -> cloudscan.ScanType
+	3,  // 20: cloudscan.Finding.severity:type_name -> cloudscan.Severity
+	39, // 21: cloudscan.Finding.created_at:type_name -> google.protobuf.Timestamp
+	2,  // 22: cloudscan.CreateScanRequest.scan_types:type_name -> cloudscan.ScanType
+	40, // 23: cloudscan.CreateScanRequest.job_settings:type_name -> cloudscan.JobSettings
+	4,  // 24: cloudscan.CreateScanResponse.scan:type_name -> cloudscan.Scan
+	1,  // 25: cloudscan.ListScansRequest.status:type_name -> cloudscan.ScanStatus
+	4,  // 26: cloudscan.ListScansResponse.scans:type_name -> cloudscan.Scan
+	2,  // 27: cloudscan.GetFindingsRequest.scan_type:type_name -> cloudscan.ScanType
+	3,  // 28: cloudscan.GetFindingsRequest.severity:type_name -> cloudscan.Severity
+	7,  // 29: cloudscan.GetFindingsResponse.findings:type_name -> cloudscan.Finding
+	1,  // 30: cloudscan.UpdateScanRequest.status:type_name -> cloudscan.ScanStatus
+	37, // 31: cloudscan.UpdateScanRequest.findings_by_severity:type_name -> cloudscan.UpdateScanRequest.FindingsBySeverityEntry
+	2,  // 32: cloudscan.UpdateScanRequest.scan_type:type_name -> cloudscan.ScanType
+	7,  // 33: cloudscan.CreateFindingsRequest.findings:type_name -> cloudscan.Finding
+	2,  // 34: cloudscan.CreateFindingsRequest.scan_type:type_name -> cloudscan.ScanType
+	2,  // 35: cloudscan.UploadFindingsRequest.scan_type:type_name -> cloudscan.ScanType
+	7,  // 36: cloudscan.UploadFindingsRequest.findings:type_name -> cloudscan.Finding
+	2,  // 37: cloudscan.ReportProgressRequest.scan_type:type_name -> cloudscan.ScanType
+	0,  // 38: cloudscan.ReportProgressRequest.phase:type_name -> cloudscan.ProgressPhase
+	1,  // 39: cloudscan.ReportProgressResponse.status:type_name -> cloudscan.ScanStatus
+	27, // 40: cloudscan.DeleteScanResponse.operation:type_name -> cloudscan.Operation
+	27, // 41: cloudscan.DeleteProjectScansResponse.operation:type_name -> cloudscan.Operation
+	39, // 42: cloudscan.Operation.created_at:type_name -> google.protobuf.Timestamp
+	39, // 43: cloudscan.Operation.updated_at:type_name -> google.protobuf.Timestamp
+	39, // 44: cloudscan.Operation.completed_at:type_name -> google.protobuf.Timestamp
+	2,  // 45: cloudscan.GetScanLogsRequest.scan_type:type_name -> cloudscan.ScanType
+	39, // 46: cloudscan.ScanLogChunk.download_url_expires_at:type_name -> google.protobuf.Timestamp
+	34, // 47: cloudscan.ListScannersResponse.scanners:type_name -> cloudscan.Scanner
+	2,  // 48: cloudscan.SetScannerRequest.scan_type:type_name -> cloudscan.ScanType
+	38, // 49: cloudscan.SetScannerRequest.env:type_name -> cloudscan.SetScannerRequest.EnvEntry
+	2,  // 50: cloudscan.Scanner.scan_type:type_name -> cloudscan.ScanType
+	8,  // 51: cloudscan.ScanService.CreateScan:input_type -> cloudscan.CreateScanRequest
+	10, // 52: cloudscan.ScanService.GetScan:input_type -> cloudscan.GetScanRequest
+	11, // 53: cloudscan.ScanService.ListScans:input_type -> cloudscan.ListScansRequest
+	13, // 54: cloudscan.ScanService.CancelScan:input_type -> cloudscan.CancelScanRequest
+	14, // 55: cloudscan.ScanService.GetFindings:input_type -> cloudscan.GetFindingsRequest
+	23, // 56: cloudscan.ScanService.DeleteScan:input_type -> cloudscan.DeleteScanRequest
+	24, // 57: cloudscan.ScanService.DeleteProjectScans:input_type -> cloudscan.DeleteProjectScansRequest
+	28, // 58: cloudscan.ScanService.GetOperation:input_type -> cloudscan.GetOperationRequest
+	29, // 59: cloudscan.ScanService.GetScanLogs:input_type -> cloudscan.GetScanLogsRequest
+	31, // 60: cloudscan.ScanService.ListScanners:input_type -> cloudscan.ListScannersRequest
+	33, // 61: cloudscan.ScanService.SetScanner:input_type -> cloudscan.SetScannerRequest
+	16, // 62: cloudscan.ScanService.UpdateScan:input_type -> cloudscan.UpdateScanRequest
+	17, // 63: cloudscan.ScanService.CreateFindings:input_type -> cloudscan.CreateFindingsRequest
+	19, // 64: cloudscan.ScanService.UploadFindings:input_type -> cloudscan.UploadFindingsRequest
+	21, // 65: cloudscan.ScanService.ReportProgress:input_type -> cloudscan.ReportProgressRequest
+	9,  // 66: cloudscan.ScanService.CreateScan:output_type -> cloudscan.CreateScanResponse
+	4,  // 67: cloudscan.ScanService.GetScan:output_type -> cloudscan.Scan
+	12, // 68: cloudscan.ScanService.ListScans:output_type -> cloudscan.ListScansResponse
+	41, // 69: cloudscan.ScanService.CancelScan:output_type -> google.protobuf.Empty
+	15, // 70: cloudscan.ScanService.GetFindings:output_type -> cloudscan.GetFindingsResponse
+	25, // 71: cloudscan.ScanService.DeleteScan:output_type -> cloudscan.DeleteScanResponse
+	26, // 72: cloudscan.ScanService.DeleteProjectScans:output_type -> cloudscan.DeleteProjectScansResponse
+	27, // 73: cloudscan.ScanService.GetOperation:output_type -> cloudscan.Operation
+	30, // 74: cloudscan.ScanService.GetScanLogs:output_type -> cloudscan.ScanLogChunk
+	32, // 75: cloudscan.ScanService.ListScanners:output_type -> cloudscan.ListScannersResponse
+	34, // 76: cloudscan.ScanService.SetScanner:output_type -> cloudscan.Scanner
+	4,  // 77: cloudscan.ScanService.UpdateScan:output_type -> cloudscan.Scan
+	18, // 78: cloudscan.ScanService.CreateFindings:output_type -> cloudscan.CreateFindingsResponse
+	20, // 79: cloudscan.ScanService.UploadFindings:output_type -> cloudscan.UploadFindingsResponse
+	22, // 80: cloudscan.ScanService.ReportProgress:output_type -> cloudscan.ReportProgressResponse
+	66, // [66:81] is the sub-list for method output_type
+	51, // [51:66] is the sub-list for method input_type
+	51, // [51:51] is the sub-list for extension type_name
+	51, // [51:51] is the sub-list for extension extendee
+	0,  // [0:51] is the sub-list for field type_name
 }
 
 func init() { file_scans_proto_init() }
@@ -1551,13 +3129,14 @@ func file_scans_proto_init() {
 	if File_scans_proto != nil {
 		return
 	}
+	file_job_profiles_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_scans_proto_rawDesc), len(file_scans_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   18,
+			NumEnums:      4,
+			NumMessages:   35,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
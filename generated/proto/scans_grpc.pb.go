@@ -27,8 +27,14 @@ const (
 	ScanService_GetFindings_FullMethodName        = "/cloudscan.ScanService/GetFindings"
 	ScanService_DeleteScan_FullMethodName         = "/cloudscan.ScanService/DeleteScan"
 	ScanService_DeleteProjectScans_FullMethodName = "/cloudscan.ScanService/DeleteProjectScans"
+	ScanService_GetOperation_FullMethodName       = "/cloudscan.ScanService/GetOperation"
+	ScanService_GetScanLogs_FullMethodName        = "/cloudscan.ScanService/GetScanLogs"
+	ScanService_ListScanners_FullMethodName       = "/cloudscan.ScanService/ListScanners"
+	ScanService_SetScanner_FullMethodName         = "/cloudscan.ScanService/SetScanner"
 	ScanService_UpdateScan_FullMethodName         = "/cloudscan.ScanService/UpdateScan"
 	ScanService_CreateFindings_FullMethodName     = "/cloudscan.ScanService/CreateFindings"
+	ScanService_UploadFindings_FullMethodName     = "/cloudscan.ScanService/UploadFindings"
+	ScanService_ReportProgress_FullMethodName     = "/cloudscan.ScanService/ReportProgress"
 )
 
 // ScanServiceClient is the client API for ScanService service.
@@ -43,11 +49,27 @@ type ScanServiceClient interface {
 	ListScans(ctx context.Context, in *ListScansRequest, opts ...grpc.CallOption) (*ListScansResponse, error)
 	CancelScan(ctx context.Context, in *CancelScanRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetFindings(ctx context.Context, in *GetFindingsRequest, opts ...grpc.CallOption) (*GetFindingsResponse, error)
-	DeleteScan(ctx context.Context, in *DeleteScanRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteScan(ctx context.Context, in *DeleteScanRequest, opts ...grpc.CallOption) (*DeleteScanResponse, error)
 	DeleteProjectScans(ctx context.Context, in *DeleteProjectScansRequest, opts ...grpc.CallOption) (*DeleteProjectScansResponse, error)
-	// Runner calls
+	GetOperation(ctx context.Context, in *GetOperationRequest, opts ...grpc.CallOption) (*Operation, error)
+	// GetScanLogs returns a download URL once the runner logs are archived,
+	// else streams the log of the scan's job, following it if requested
+	GetScanLogs(ctx context.Context, in *GetScanLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ScanLogChunk], error)
+	// ListScanners returns the scanner and version that runs each scan type
+	ListScanners(ctx context.Context, in *ListScannersRequest, opts ...grpc.CallOption) (*ListScannersResponse, error)
+	// SetScanner creates or replaces the scanner of a scan type in the scanners
+	// table; fails with FailedPrecondition unless SCANNER_REGISTRY_SOURCE is database
+	SetScanner(ctx context.Context, in *SetScannerRequest, opts ...grpc.CallOption) (*Scanner, error)
+	// Runner calls. UpdateScan and CreateFindings take a request ID: a replay
+	// with the same ID returns the original response without applying again.
 	UpdateScan(ctx context.Context, in *UpdateScanRequest, opts ...grpc.CallOption) (*Scan, error)
 	CreateFindings(ctx context.Context, in *CreateFindingsRequest, opts ...grpc.CallOption) (*CreateFindingsResponse, error)
+	// UploadFindings streams findings in numbered batches, each committed and
+	// acknowledged on its own; an interrupted upload resumes after the last
+	// acknowledged batch
+	UploadFindings(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[UploadFindingsRequest, UploadFindingsResponse], error)
+	// ReportProgress records what the runner is doing; each call is a heartbeat
+	ReportProgress(ctx context.Context, in *ReportProgressRequest, opts ...grpc.CallOption) (*ReportProgressResponse, error)
 }
 
 type scanServiceClient struct {
@@ -108,9 +130,9 @@ func (c *scanServiceClient) GetFindings(ctx context.Context, in *GetFindingsRequ
 	return out, nil
 }
 
-func (c *scanServiceClient) DeleteScan(ctx context.Context, in *DeleteScanRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *scanServiceClient) DeleteScan(ctx context.Context, in *DeleteScanRequest, opts ...grpc.CallOption) (*DeleteScanResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
+	out := new(DeleteScanResponse)
 	err := c.cc.Invoke(ctx, ScanService_DeleteScan_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -128,6 +150,55 @@ func (c *scanServiceClient) DeleteProjectScans(ctx context.Context, in *DeletePr
 	return out, nil
 }
 
+func (c *scanServiceClient) GetOperation(ctx context.Context, in *GetOperationRequest, opts ...grpc.CallOption) (*Operation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Operation)
+	err := c.cc.Invoke(ctx, ScanService_GetOperation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scanServiceClient) GetScanLogs(ctx context.Context, in *GetScanLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ScanLogChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ScanService_ServiceDesc.Streams[0], ScanService_GetScanLogs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetScanLogsRequest, ScanLogChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ScanService_GetScanLogsClient = grpc.ServerStreamingClient[ScanLogChunk]
+
+func (c *scanServiceClient) ListScanners(ctx context.Context, in *ListScannersRequest, opts ...grpc.CallOption) (*ListScannersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListScannersResponse)
+	err := c.cc.Invoke(ctx, ScanService_ListScanners_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scanServiceClient) SetScanner(ctx context.Context, in *SetScannerRequest, opts ...grpc.CallOption) (*Scanner, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Scanner)
+	err := c.cc.Invoke(ctx, ScanService_SetScanner_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *scanServiceClient) UpdateScan(ctx context.Context, in *UpdateScanRequest, opts ...grpc.CallOption) (*Scan, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Scan)
@@ -148,6 +219,29 @@ func (c *scanServiceClient) CreateFindings(ctx context.Context, in *CreateFindin
 	return out, nil
 }
 
+func (c *scanServiceClient) UploadFindings(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[UploadFindingsRequest, UploadFindingsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ScanService_ServiceDesc.Streams[1], ScanService_UploadFindings_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadFindingsRequest, UploadFindingsResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ScanService_UploadFindingsClient = grpc.BidiStreamingClient[UploadFindingsRequest, UploadFindingsResponse]
+
+func (c *scanServiceClient) ReportProgress(ctx context.Context, in *ReportProgressRequest, opts ...grpc.CallOption) (*ReportProgressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportProgressResponse)
+	err := c.cc.Invoke(ctx, ScanService_ReportProgress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ScanServiceServer is the server API for ScanService service.
 // All implementations must embed UnimplementedScanServiceServer
 // for forward compatibility.
@@ -160,11 +254,27 @@ type ScanServiceServer interface {
 	ListScans(context.Context, *ListScansRequest) (*ListScansResponse, error)
 	CancelScan(context.Context, *CancelScanRequest) (*emptypb.Empty, error)
 	GetFindings(context.Context, *GetFindingsRequest) (*GetFindingsResponse, error)
-	DeleteScan(context.Context, *DeleteScanRequest) (*emptypb.Empty, error)
+	DeleteScan(context.Context, *DeleteScanRequest) (*DeleteScanResponse, error)
 	DeleteProjectScans(context.Context, *DeleteProjectScansRequest) (*DeleteProjectScansResponse, error)
-	// Runner calls
+	GetOperation(context.Context, *GetOperationRequest) (*Operation, error)
+	// GetScanLogs returns a download URL once the runner logs are archived,
+	// else streams the log of the scan's job, following it if requested
+	GetScanLogs(*GetScanLogsRequest, grpc.ServerStreamingServer[ScanLogChunk]) error
+	// ListScanners returns the scanner and version that runs each scan type
+	ListScanners(context.Context, *ListScannersRequest) (*ListScannersResponse, error)
+	// SetScanner creates or replaces the scanner of a scan type in the scanners
+	// table; fails with FailedPrecondition unless SCANNER_REGISTRY_SOURCE is database
+	SetScanner(context.Context, *SetScannerRequest) (*Scanner, error)
+	// Runner calls. UpdateScan and CreateFindings take a request ID: a replay
+	// with the same ID returns the original response without applying again.
 	UpdateScan(context.Context, *UpdateScanRequest) (*Scan, error)
 	CreateFindings(context.Context, *CreateFindingsRequest) (*CreateFindingsResponse, error)
+	// UploadFindings streams findings in numbered batches, each committed and
+	// acknowledged on its own; an interrupted upload resumes after the last
+	// acknowledged batch
+	UploadFindings(grpc.BidiStreamingServer[UploadFindingsRequest, UploadFindingsResponse]) error
+	// ReportProgress records what the runner is doing; each call is a heartbeat
+	ReportProgress(context.Context, *ReportProgressRequest) (*ReportProgressResponse, error)
 	mustEmbedUnimplementedScanServiceServer()
 }
 
@@ -190,18 +300,36 @@ func (UnimplementedScanServiceServer) CancelScan(context.Context, *CancelScanReq
 func (UnimplementedScanServiceServer) GetFindings(context.Context, *GetFindingsRequest) (*GetFindingsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetFindings not implemented")
 }
-func (UnimplementedScanServiceServer) DeleteScan(context.Context, *DeleteScanRequest) (*emptypb.Empty, error) {
+func (UnimplementedScanServiceServer) DeleteScan(context.Context, *DeleteScanRequest) (*DeleteScanResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteScan not implemented")
 }
 func (UnimplementedScanServiceServer) DeleteProjectScans(context.Context, *DeleteProjectScansRequest) (*DeleteProjectScansResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteProjectScans not implemented")
 }
+func (UnimplementedScanServiceServer) GetOperation(context.Context, *GetOperationRequest) (*Operation, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOperation not implemented")
+}
+func (UnimplementedScanServiceServer) GetScanLogs(*GetScanLogsRequest, grpc.ServerStreamingServer[ScanLogChunk]) error {
+	return status.Error(codes.Unimplemented, "method GetScanLogs not implemented")
+}
+func (UnimplementedScanServiceServer) ListScanners(context.Context, *ListScannersRequest) (*ListScannersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListScanners not implemented")
+}
+func (UnimplementedScanServiceServer) SetScanner(context.Context, *SetScannerRequest) (*Scanner, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetScanner not implemented")
+}
 func (UnimplementedScanServiceServer) UpdateScan(context.Context, *UpdateScanRequest) (*Scan, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateScan not implemented")
 }
 func (UnimplementedScanServiceServer) CreateFindings(context.Context, *CreateFindingsRequest) (*CreateFindingsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateFindings not implemented")
 }
+func (UnimplementedScanServiceServer) UploadFindings(grpc.BidiStreamingServer[UploadFindingsRequest, UploadFindingsResponse]) error {
+	return status.Error(codes.Unimplemented, "method UploadFindings not implemented")
+}
+func (UnimplementedScanServiceServer) ReportProgress(context.Context, *ReportProgressRequest) (*ReportProgressResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReportProgress not implemented")
+}
 func (UnimplementedScanServiceServer) mustEmbedUnimplementedScanServiceServer() {}
 func (UnimplementedScanServiceServer) testEmbeddedByValue()                     {}
 
@@ -349,6 +477,71 @@ func _ScanService_DeleteProjectScans_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ScanService_GetOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScanServiceServer).GetOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScanService_GetOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScanServiceServer).GetOperation(ctx, req.(*GetOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScanService_GetScanLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetScanLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ScanServiceServer).GetScanLogs(m, &grpc.GenericServerStream[GetScanLogsRequest, ScanLogChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ScanService_GetScanLogsServer = grpc.ServerStreamingServer[ScanLogChunk]
+
+func _ScanService_ListScanners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScannersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScanServiceServer).ListScanners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScanService_ListScanners_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScanServiceServer).ListScanners(ctx, req.(*ListScannersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScanService_SetScanner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetScannerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScanServiceServer).SetScanner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScanService_SetScanner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScanServiceServer).SetScanner(ctx, req.(*SetScannerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ScanService_UpdateScan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateScanRequest)
 	if err := dec(in); err != nil {
@@ -385,6 +578,31 @@ func _ScanService_CreateFindings_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ScanService_UploadFindings_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ScanServiceServer).UploadFindings(&grpc.GenericServerStream[UploadFindingsRequest, UploadFindingsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ScanService_UploadFindingsServer = grpc.BidiStreamingServer[UploadFindingsRequest, UploadFindingsResponse]
+
+func _ScanService_ReportProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScanServiceServer).ReportProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScanService_ReportProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScanServiceServer).ReportProgress(ctx, req.(*ReportProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ScanService_ServiceDesc is the grpc.ServiceDesc for ScanService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -420,6 +638,18 @@ var ScanService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteProjectScans",
 			Handler:    _ScanService_DeleteProjectScans_Handler,
 		},
+		{
+			MethodName: "GetOperation",
+			Handler:    _ScanService_GetOperation_Handler,
+		},
+		{
+			MethodName: "ListScanners",
+			Handler:    _ScanService_ListScanners_Handler,
+		},
+		{
+			MethodName: "SetScanner",
+			Handler:    _ScanService_SetScanner_Handler,
+		},
 		{
 			MethodName: "UpdateScan",
 			Handler:    _ScanService_UpdateScan_Handler,
@@ -428,7 +658,23 @@ var ScanService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateFindings",
 			Handler:    _ScanService_CreateFindings_Handler,
 		},
+		{
+			MethodName: "ReportProgress",
+			Handler:    _ScanService_ReportProgress_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetScanLogs",
+			Handler:       _ScanService_GetScanLogs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadFindings",
+			Handler:       _ScanService_UploadFindings_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "scans.proto",
 }
@@ -0,0 +1,77 @@
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// commitStatusTimeout bounds a single status request; retries are handled by the notifier
+const commitStatusTimeout = 15 * time.Second
+
+// StatusResponseError is returned when a provider answers a status request with a non-2xx code
+type StatusResponseError struct {
+	Provider   string
+	StatusCode int
+	Body       string
+}
+
+func (e *StatusResponseError) Error() string {
+	return fmt.Sprintf("%s returned status %d: %s", e.Provider, e.StatusCode, e.Body)
+}
+
+// Temporary reports whether retrying the request may succeed (rate limits and server errors)
+func (e *StatusResponseError) Temporary() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= 500
+}
+
+// newStatusHTTPClient creates the HTTP client shared by the commit status clients
+func newStatusHTTPClient() *http.Client {
+	return &http.Client{Timeout: commitStatusTimeout}
+}
+
+// postJSON sends body as JSON and converts non-2xx responses into a StatusResponseError
+func postJSON(ctx context.Context, client *http.Client, provider, url string, headers map[string]string, body interface{}) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s status: %w", provider, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to create %s request: %w", provider, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send %s status: %w", provider, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return &StatusResponseError{
+			Provider:   provider,
+			StatusCode: resp.StatusCode,
+			Body:       strings.TrimSpace(string(respBody)),
+		}
+	}
+
+	return nil
+}
+
+// splitRepositoryPath splits a normalized "host/owner/repo" URL into host and "owner/repo".
+// GitLab repositories may be nested in subgroups, so the path keeps all remaining segments.
+func splitRepositoryPath(repositoryURL string) (host, path string) {
+	host, path, _ = strings.Cut(repositoryURL, "/")
+	return host, path
+}
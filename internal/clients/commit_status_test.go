@@ -0,0 +1,212 @@
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+)
+
+// recordedRequest is a status request received by the test server
+type recordedRequest struct {
+	method string
+	path   string
+	header http.Header
+	body   map[string]string
+}
+
+// newStatusServer starts a server answering every request with code and
+// recording the requests it receives
+func newStatusServer(t *testing.T, code int) (*httptest.Server, *[]recordedRequest) {
+	t.Helper()
+
+	var requests []recordedRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]string{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("failed to decode request body: %v", err)
+		}
+		requests = append(requests, recordedRequest{
+			method: r.Method,
+			path:   r.URL.EscapedPath(),
+			header: r.Header.Clone(),
+			body:   body,
+		})
+		w.WriteHeader(code)
+		w.Write([]byte(`{"message":"test"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &requests
+}
+
+func testCommitStatus(repositoryURL string, state interfaces.CommitState) *interfaces.CommitStatus {
+	return &interfaces.CommitStatus{
+		RepositoryURL: repositoryURL,
+		CommitSHA:     "0123456789abcdef0123456789abcdef01234567",
+		State:         state,
+		Context:       "cloudscan",
+		Description:   "2 critical, 5 high, 0 medium, 1 low",
+		TargetURL:     "https://ui.example.com/scans/1",
+	}
+}
+
+func TestGitHubStatusClientPostStatus(t *testing.T) {
+	server, requests := newStatusServer(t, http.StatusCreated)
+	client := NewGitHubStatusClient(server.URL+"/", "GitHub.com", "gh-token")
+
+	if !client.Supports("github.com/acme/app") {
+		t.Fatal("expected github.com repositories to be supported")
+	}
+	if client.Supports("gitlab.com/acme/app") {
+		t.Fatal("expected gitlab.com repositories not to be supported")
+	}
+
+	status := testCommitStatus("github.com/acme/app", interfaces.CommitStateFailure)
+	if err := client.PostStatus(context.Background(), status); err != nil {
+		t.Fatalf("PostStatus returned error: %v", err)
+	}
+
+	if len(*requests) != 1 {
+		t.Fatalf("expected 1 request, got %d", len(*requests))
+	}
+	req := (*requests)[0]
+
+	if req.method != http.MethodPost {
+		t.Errorf("method = %s, want POST", req.method)
+	}
+	if want := "/repos/acme/app/statuses/" + status.CommitSHA; req.path != want {
+		t.Errorf("path = %s, want %s", req.path, want)
+	}
+	if got := req.header.Get("Authorization"); got != "Bearer gh-token" {
+		t.Errorf("Authorization = %q, want %q", got, "Bearer gh-token")
+	}
+	if got := req.header.Get("Accept"); got != "application/vnd.github+json" {
+		t.Errorf("Accept = %q", got)
+	}
+
+	want := map[string]string{
+		"state":       "failure",
+		"context":     "cloudscan",
+		"description": status.Description,
+		"target_url":  status.TargetURL,
+	}
+	for key, value := range want {
+		if req.body[key] != value {
+			t.Errorf("body[%s] = %q, want %q", key, req.body[key], value)
+		}
+	}
+}
+
+func TestGitHubStatusClientTruncatesDescription(t *testing.T) {
+	server, requests := newStatusServer(t, http.StatusCreated)
+	client := NewGitHubStatusClient(server.URL, "github.com", "gh-token")
+
+	status := testCommitStatus("github.com/acme/app", interfaces.CommitStateSuccess)
+	for len(status.Description) <= githubDescriptionLimit {
+		status.Description += " and more"
+	}
+	status.TargetURL = ""
+
+	if err := client.PostStatus(context.Background(), status); err != nil {
+		t.Fatalf("PostStatus returned error: %v", err)
+	}
+
+	body := (*requests)[0].body
+	if len(body["description"]) != githubDescriptionLimit {
+		t.Errorf("description length = %d, want %d", len(body["description"]), githubDescriptionLimit)
+	}
+	if _, ok := body["target_url"]; ok {
+		t.Error("expected no target_url without a link")
+	}
+}
+
+func TestGitLabStatusClientPostStatus(t *testing.T) {
+	tests := []struct {
+		state interfaces.CommitState
+		want  string
+	}{
+		{interfaces.CommitStateSuccess, "success"},
+		{interfaces.CommitStateFailure, "failed"},
+		{interfaces.CommitStateError, "failed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.state), func(t *testing.T) {
+			server, requests := newStatusServer(t, http.StatusCreated)
+			client := NewGitLabStatusClient(server.URL+"/api/v4", "gitlab.com", "gl-token")
+
+			// Subgroups are part of the URL-encoded project path
+			status := testCommitStatus("gitlab.com/acme/platform/app", tt.state)
+			if !client.Supports(status.RepositoryURL) {
+				t.Fatal("expected gitlab.com repositories to be supported")
+			}
+			if err := client.PostStatus(context.Background(), status); err != nil {
+				t.Fatalf("PostStatus returned error: %v", err)
+			}
+
+			if len(*requests) != 1 {
+				t.Fatalf("expected 1 request, got %d", len(*requests))
+			}
+			req := (*requests)[0]
+
+			if want := "/api/v4/projects/acme%2Fplatform%2Fapp/statuses/" + status.CommitSHA; req.path != want {
+				t.Errorf("path = %s, want %s", req.path, want)
+			}
+			if got := req.header.Get("PRIVATE-TOKEN"); got != "gl-token" {
+				t.Errorf("PRIVATE-TOKEN = %q, want %q", got, "gl-token")
+			}
+			if req.body["state"] != tt.want {
+				t.Errorf("state = %q, want %q", req.body["state"], tt.want)
+			}
+			if req.body["name"] != "cloudscan" {
+				t.Errorf("name = %q, want %q", req.body["name"], "cloudscan")
+			}
+			if req.body["target_url"] != status.TargetURL {
+				t.Errorf("target_url = %q, want %q", req.body["target_url"], status.TargetURL)
+			}
+		})
+	}
+}
+
+func TestStatusClientErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		code      int
+		temporary bool
+	}{
+		{"rate limited", http.StatusTooManyRequests, true},
+		{"server error", http.StatusBadGateway, true},
+		{"not found", http.StatusNotFound, false},
+		{"invalid", http.StatusUnprocessableEntity, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := newStatusServer(t, tt.code)
+			statusClients := []interfaces.CommitStatusClient{
+				NewGitHubStatusClient(server.URL, "github.com", "gh-token"),
+				NewGitLabStatusClient(server.URL, "github.com", "gl-token"),
+			}
+
+			for _, client := range statusClients {
+				err := client.PostStatus(context.Background(), testCommitStatus("github.com/acme/app", interfaces.CommitStateSuccess))
+
+				var responseErr *StatusResponseError
+				if !errors.As(err, &responseErr) {
+					t.Fatalf("%s: expected StatusResponseError, got %v", client.Name(), err)
+				}
+				if responseErr.StatusCode != tt.code {
+					t.Errorf("%s: status code = %d, want %d", client.Name(), responseErr.StatusCode, tt.code)
+				}
+				if responseErr.Temporary() != tt.temporary {
+					t.Errorf("%s: temporary = %v, want %v", client.Name(), responseErr.Temporary(), tt.temporary)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package clients
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+)
+
+// githubAppJWTLifetime is how long the JWT authenticating as the app is
+// valid; GitHub accepts at most 10 minutes
+const githubAppJWTLifetime = 9 * time.Minute
+
+// GitHubAppClient mints installation tokens of GitHub Apps. Tokens are
+// restricted to reading repository contents and expire after an hour.
+type GitHubAppClient struct {
+	apiURL     string // e.g. https://api.github.com or https://ghe.example.com/api/v3
+	httpClient *http.Client
+}
+
+// NewGitHubAppClient creates a new GitHub App client
+func NewGitHubAppClient(apiURL string) interfaces.GitHubAppClient {
+	return &GitHubAppClient{
+		apiURL:     strings.TrimSuffix(apiURL, "/"),
+		httpClient: newStatusHTTPClient(),
+	}
+}
+
+// InstallationToken creates a token via POST /app/installations/{id}/access_tokens
+func (c *GitHubAppClient) InstallationToken(ctx context.Context, appID, installationID int64, privateKey []byte) (string, time.Time, error) {
+	key, err := ParseGitHubAppKey(privateKey)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	jwt, err := githubAppJWT(appID, key, time.Now())
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	body, err := json.Marshal(map[string]interface{}{
+		"permissions": map[string]string{"contents": "read"},
+	})
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to encode github token request: %w", err)
+	}
+
+	url := fmt.Sprintf("%s/app/installations/%d/access_tokens", c.apiURL, installationID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to create github request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+jwt)
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to request github installation token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return "", time.Time{}, &StatusResponseError{
+			Provider:   "github",
+			StatusCode: resp.StatusCode,
+			Body:       strings.TrimSpace(string(respBody)),
+		}
+	}
+
+	var token struct {
+		Token     string    `json:"token"`
+		ExpiresAt time.Time `json:"expires_at"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to decode github installation token: %w", err)
+	}
+
+	return token.Token, token.ExpiresAt, nil
+}
+
+// ParseGitHubAppKey parses the PEM-encoded private key of a GitHub App, in
+// the PKCS#1 form GitHub generates or in PKCS#8
+func ParseGitHubAppKey(privateKey []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(privateKey)
+	if block == nil {
+		return nil, fmt.Errorf("github app private key is not PEM-encoded")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse github app private key: %w", err)
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("github app private key is not an RSA key")
+	}
+	return key, nil
+}
+
+// githubAppJWT returns the RS256 JWT authenticating as the app. It is issued
+// a minute in the past to allow for clock drift.
+func githubAppJWT(appID int64, key *rsa.PrivateKey, now time.Time) (string, error) {
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT"})
+	if err != nil {
+		return "", err
+	}
+	claims, err := json.Marshal(map[string]interface{}{
+		"iat": now.Add(-time.Minute).Unix(),
+		"exp": now.Add(githubAppJWTLifetime).Unix(),
+		"iss": fmt.Sprint(appID),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(unsigned))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("failed to sign github app JWT: %w", err)
+	}
+
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
@@ -0,0 +1,71 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+)
+
+// githubDescriptionLimit is the maximum length GitHub accepts for a status description
+const githubDescriptionLimit = 140
+
+// GitHubStatusClient reports scan results as GitHub commit statuses, which are
+// shown as checks on pull requests containing the commit
+type GitHubStatusClient struct {
+	apiURL     string // e.g. https://api.github.com or https://ghe.example.com/api/v3
+	host       string // Repository host, e.g. github.com
+	token      string
+	httpClient *http.Client
+}
+
+// NewGitHubStatusClient creates a new GitHub commit status client
+func NewGitHubStatusClient(apiURL, host, token string) interfaces.CommitStatusClient {
+	return &GitHubStatusClient{
+		apiURL:     strings.TrimSuffix(apiURL, "/"),
+		host:       strings.ToLower(host),
+		token:      token,
+		httpClient: newStatusHTTPClient(),
+	}
+}
+
+// Name returns the client name
+func (c *GitHubStatusClient) Name() string {
+	return "github"
+}
+
+// Supports returns true for repositories hosted on the configured GitHub host
+func (c *GitHubStatusClient) Supports(repositoryURL string) bool {
+	host, _ := splitRepositoryPath(repositoryURL)
+	return host == c.host
+}
+
+// PostStatus creates a commit status via POST /repos/{owner}/{repo}/statuses/{sha}
+func (c *GitHubStatusClient) PostStatus(ctx context.Context, status *interfaces.CommitStatus) error {
+	_, path := splitRepositoryPath(status.RepositoryURL)
+	url := fmt.Sprintf("%s/repos/%s/statuses/%s", c.apiURL, path, status.CommitSHA)
+
+	body := map[string]string{
+		"state":       string(status.State), // success, failure and error are native GitHub states
+		"context":     status.Context,
+		"description": truncate(status.Description, githubDescriptionLimit),
+	}
+	if status.TargetURL != "" {
+		body["target_url"] = status.TargetURL
+	}
+
+	return postJSON(ctx, c.httpClient, c.Name(), url, map[string]string{
+		"Authorization":        "Bearer " + c.token,
+		"Accept":               "application/vnd.github+json",
+		"X-GitHub-Api-Version": "2022-11-28",
+	}, body)
+}
+
+func truncate(s string, limit int) string {
+	if len(s) <= limit {
+		return s
+	}
+	return s[:limit-3] + "..."
+}
@@ -0,0 +1,70 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+)
+
+// GitLabStatusClient reports scan results as GitLab commit statuses, which are
+// shown in the pipeline widget of merge requests containing the commit
+type GitLabStatusClient struct {
+	apiURL     string // e.g. https://gitlab.com/api/v4
+	host       string // Repository host, e.g. gitlab.com
+	token      string
+	httpClient *http.Client
+}
+
+// NewGitLabStatusClient creates a new GitLab commit status client
+func NewGitLabStatusClient(apiURL, host, token string) interfaces.CommitStatusClient {
+	return &GitLabStatusClient{
+		apiURL:     strings.TrimSuffix(apiURL, "/"),
+		host:       strings.ToLower(host),
+		token:      token,
+		httpClient: newStatusHTTPClient(),
+	}
+}
+
+// Name returns the client name
+func (c *GitLabStatusClient) Name() string {
+	return "gitlab"
+}
+
+// Supports returns true for repositories hosted on the configured GitLab host
+func (c *GitLabStatusClient) Supports(repositoryURL string) bool {
+	host, _ := splitRepositoryPath(repositoryURL)
+	return host == c.host
+}
+
+// PostStatus creates a commit status via POST /projects/{id}/statuses/{sha},
+// addressing the project by its URL-encoded path
+func (c *GitLabStatusClient) PostStatus(ctx context.Context, status *interfaces.CommitStatus) error {
+	_, path := splitRepositoryPath(status.RepositoryURL)
+	endpoint := fmt.Sprintf("%s/projects/%s/statuses/%s", c.apiURL, url.PathEscape(path), status.CommitSHA)
+
+	body := map[string]string{
+		"state":       gitlabState(status.State),
+		"name":        status.Context,
+		"description": status.Description,
+	}
+	if status.TargetURL != "" {
+		body["target_url"] = status.TargetURL
+	}
+
+	return postJSON(ctx, c.httpClient, c.Name(), endpoint, map[string]string{
+		"PRIVATE-TOKEN": c.token,
+	}, body)
+}
+
+// gitlabState maps a commit state to GitLab's pipeline states. GitLab has no
+// separate error state; the description tells failed scans and findings apart.
+func gitlabState(state interfaces.CommitState) string {
+	if state == interfaces.CommitStateSuccess {
+		return "success"
+	}
+	return "failed"
+}
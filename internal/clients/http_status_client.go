@@ -0,0 +1,86 @@
+package clients
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+)
+
+// HTTPStatusClient posts scan results as JSON to a generic HTTP endpoint, e.g. a
+// CI system or a self-hosted provider without a dedicated client
+type HTTPStatusClient struct {
+	url        string
+	token      string // Optional bearer token
+	httpClient *http.Client
+}
+
+// httpStatusPayload is the JSON document sent by HTTPStatusClient
+type httpStatusPayload struct {
+	ScanID         string         `json:"scan_id"`
+	OrganizationID string         `json:"organization_id"`
+	ProjectID      string         `json:"project_id"`
+	RepositoryURL  string         `json:"repository_url"`
+	CommitSHA      string         `json:"commit_sha"`
+	Branch         string         `json:"branch,omitempty"`
+	ScanStatus     string         `json:"scan_status"`
+	State          string         `json:"state"`
+	Context        string         `json:"context"`
+	Description    string         `json:"description"`
+	TargetURL      string         `json:"target_url,omitempty"`
+	FindingsCount  int            `json:"findings_count"`
+	BySeverity     map[string]int `json:"findings_by_severity"`
+}
+
+// NewHTTPStatusClient creates a new generic HTTP commit status client
+func NewHTTPStatusClient(url, token string) interfaces.CommitStatusClient {
+	return &HTTPStatusClient{
+		url:        url,
+		token:      token,
+		httpClient: newStatusHTTPClient(),
+	}
+}
+
+// Name returns the client name
+func (c *HTTPStatusClient) Name() string {
+	return "http"
+}
+
+// Supports returns true for every repository
+func (c *HTTPStatusClient) Supports(repositoryURL string) bool {
+	return true
+}
+
+// PostStatus posts the status and severity summary to the configured URL
+func (c *HTTPStatusClient) PostStatus(ctx context.Context, status *interfaces.CommitStatus) error {
+	scan := status.Scan
+	payload := httpStatusPayload{
+		ScanID:         scan.ID.String(),
+		OrganizationID: scan.OrganizationID.String(),
+		ProjectID:      scan.ProjectID.String(),
+		RepositoryURL:  status.RepositoryURL,
+		CommitSHA:      status.CommitSHA,
+		ScanStatus:     string(scan.Status),
+		State:          string(status.State),
+		Context:        status.Context,
+		Description:    status.Description,
+		TargetURL:      status.TargetURL,
+		FindingsCount:  scan.FindingsCount,
+		BySeverity: map[string]int{
+			"critical": scan.CriticalCount,
+			"high":     scan.HighCount,
+			"medium":   scan.MediumCount,
+			"low":      scan.LowCount,
+		},
+	}
+	if scan.Branch != nil {
+		payload.Branch = *scan.Branch
+	}
+
+	headers := map[string]string{}
+	if c.token != "" {
+		headers["Authorization"] = "Bearer " + c.token
+	}
+
+	return postJSON(ctx, c.httpClient, c.Name(), c.url, headers, payload)
+}
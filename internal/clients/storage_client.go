@@ -3,21 +3,30 @@ package clients
 import (
 	"context"
 	"fmt"
+	"io"
+	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/metrics"
 	storagepb "github.com/cloud-scan/cloudscan-storage/generated/proto"
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
 )
 
 // StorageGRPCClient implements the StorageClient interface using gRPC
 type StorageGRPCClient struct {
-	conn   *grpc.ClientConn
-	client storagepb.StorageServiceClient
-	logger *log.Entry
+	conn       *grpc.ClientConn
+	client     storagepb.StorageServiceClient
+	httpClient *http.Client // Transfers to and from presigned URLs; bounded by the caller's context
+	logger     *log.Entry
 }
 
 // NewStorageClient creates a new storage service gRPC client
@@ -29,6 +38,8 @@ func NewStorageClient(endpoint string, timeout time.Duration, useTLS bool) (inte
 	opts := []grpc.DialOption{
 		grpc.WithBlock(),
 		grpc.WithTimeout(timeout),
+		grpc.WithChainUnaryInterceptor(metrics.StorageClientInterceptor()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
 	}
 
 	// Add TLS credentials if enabled
@@ -49,9 +60,10 @@ func NewStorageClient(endpoint string, timeout time.Duration, useTLS bool) (inte
 	logger.Info("Successfully connected to storage service")
 
 	return &StorageGRPCClient{
-		conn:   conn,
-		client: client,
-		logger: logger,
+		conn:       conn,
+		client:     client,
+		httpClient: &http.Client{},
+		logger:     logger,
 	}, nil
 }
 
@@ -75,14 +87,22 @@ func (c *StorageGRPCClient) CreateArtifact(ctx context.Context, req *interfaces.
 		artifactType = storagepb.ArtifactType_ARTIFACT_TYPE_UNSPECIFIED
 	}
 
+	contentType := req.ContentType
+	if contentType == "" {
+		contentType = "application/zip"
+	}
+
+	// The size is optional; it's unknown until an archive is uploaded
+	sizeBytes, _ := strconv.ParseInt(req.FileSize, 10, 64)
+
 	// Map to protobuf request
 	pbReq := &storagepb.CreateArtifactRequest{
-		ScanId:         "",  // Will be set by caller if needed
-		OrganizationId: "",  // Will be set by caller if needed
+		ScanId:         req.ScanID,
+		OrganizationId: req.OrganizationID,
 		Type:           artifactType,
 		Filename:       req.FileName,
-		ContentType:    "application/zip",
-		SizeBytes:      0, // Size not known yet
+		ContentType:    contentType,
+		SizeBytes:      sizeBytes,
 		ExpiresInHours: 24,
 	}
 
@@ -101,6 +121,63 @@ func (c *StorageGRPCClient) CreateArtifact(ctx context.Context, req *interfaces.
 	}, nil
 }
 
+// UploadArtifact creates an artifact and PUTs its content to the presigned URL
+func (c *StorageGRPCClient) UploadArtifact(ctx context.Context, req *interfaces.CreateArtifactRequest, content io.Reader) (*interfaces.CreateArtifactResponse, error) {
+	size, err := strconv.ParseInt(req.FileSize, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid artifact size %q: %w", req.FileSize, err)
+	}
+
+	artifact, err := c.CreateArtifact(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.put(ctx, artifact.SignedURL, req.ContentType, size, content); err != nil {
+		// Nothing references an artifact without content; the cancellation of
+		// ctx mustn't keep its record from being deleted
+		deleteCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 30*time.Second)
+		defer cancel()
+		if deleteErr := c.DeleteArtifacts(deleteCtx, []string{artifact.ArtifactID}); deleteErr != nil {
+			c.logger.WithError(deleteErr).WithField("artifact_id", artifact.ArtifactID).Warn("Failed to delete artifact of failed upload")
+		}
+		return nil, fmt.Errorf("failed to upload artifact %s: %w", artifact.ArtifactID, err)
+	}
+
+	c.logger.WithFields(log.Fields{
+		"artifact_id": artifact.ArtifactID,
+		"type":        req.ArtifactType,
+		"size":        size,
+	}).Debug("Uploaded artifact")
+
+	return artifact, nil
+}
+
+// put uploads the content to a presigned URL
+func (c *StorageGRPCClient) put(ctx context.Context, url, contentType string, size int64, content io.Reader) error {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPut, url, content)
+	if err != nil {
+		return fmt.Errorf("failed to create upload request: %w", err)
+	}
+	httpReq.ContentLength = size
+	if contentType != "" {
+		httpReq.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("status %d: %s", resp.StatusCode, body)
+	}
+
+	return nil
+}
+
 // GetArtifact retrieves artifact info and returns presigned download URL
 func (c *StorageGRPCClient) GetArtifact(ctx context.Context, artifactID string) (*interfaces.GetArtifactResponse, error) {
 	c.logger.WithField("artifact_id", artifactID).Debug("Getting artifact")
@@ -123,22 +200,54 @@ func (c *StorageGRPCClient) GetArtifact(ctx context.Context, artifactID string)
 	}, nil
 }
 
-// DeleteArtifacts marks artifacts for deletion
+// DownloadArtifact opens the content of an artifact from its presigned
+// download URL. The caller closes the returned reader.
+func (c *StorageGRPCClient) DownloadArtifact(ctx context.Context, artifactID string) (io.ReadCloser, error) {
+	artifact, err := c.GetArtifact(ctx, artifactID)
+	if err != nil {
+		return nil, err
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, artifact.SignedURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create download request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download artifact %s: %w", artifactID, err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("failed to download artifact %s: status %d: %s", artifactID, resp.StatusCode, body)
+	}
+
+	return resp.Body, nil
+}
+
+// DeleteArtifacts marks artifacts for deletion. Artifacts that don't exist
+// count as deleted, so retrying is safe. Every artifact is attempted; the
+// first error is returned.
 func (c *StorageGRPCClient) DeleteArtifacts(ctx context.Context, artifactIDs []string) error {
 	c.logger.WithField("count", len(artifactIDs)).Debug("Deleting artifacts")
 
+	var firstErr error
+
 	// Delete each artifact (could be optimized with batch delete in future)
 	for _, id := range artifactIDs {
 		_, err := c.client.DeleteArtifact(ctx, &storagepb.DeleteArtifactRequest{
 			Id: id,
 		})
-		if err != nil {
+		if err != nil && status.Code(err) != codes.NotFound {
 			c.logger.WithError(err).WithField("artifact_id", id).Warn("Failed to delete artifact")
-			// Continue with other deletions
+			if firstErr == nil {
+				firstErr = fmt.Errorf("failed to delete artifact %s: %w", id, err)
+			}
 		}
 	}
 
-	return nil
+	return firstErr
 }
 
 // InitiateMultipartUpload starts a multipart upload session
@@ -244,6 +353,22 @@ func (c *StorageGRPCClient) AbortMultipartUpload(ctx context.Context, artifactID
 	return nil
 }
 
+// HealthCheck queries the storage service's grpc.health.v1 service. A storage
+// service without the health service counts as healthy, since it answered.
+func (c *StorageGRPCClient) HealthCheck(ctx context.Context) error {
+	resp, err := healthpb.NewHealthClient(c.conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	if status.Code(err) == codes.Unimplemented {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to check storage service health: %w", err)
+	}
+	if resp.Status != healthpb.HealthCheckResponse_SERVING {
+		return fmt.Errorf("storage service is %s", resp.Status)
+	}
+	return nil
+}
+
 // Close closes the gRPC connection
 func (c *StorageGRPCClient) Close() error {
 	c.logger.Info("Closing storage service connection")
@@ -2,9 +2,13 @@ package config
 
 import (
 	"fmt"
+	"net"
 	"os"
 	"strconv"
+	"strings"
 	"time"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
 )
 
 // Config holds all configuration for the orchestrator service
@@ -16,6 +20,18 @@ type Config struct {
 	Kubernetes     KubernetesConfig
 	Observability  ObservabilityConfig
 	Workers        WorkersConfig
+	CommitStatus   CommitStatusConfig
+	Webhooks       WebhooksConfig
+	Events         EventsConfig
+	Health         HealthConfig
+	Partitions     PartitionsConfig
+	Deletion       DeletionConfig
+	ScanRetry      ScanRetryConfig
+	StuckScans     StuckScansConfig
+	Retention      RetentionConfig
+	LogArchive     LogArchiveConfig
+	JobLimits      JobLimitsConfig
+	Credentials    CredentialsConfig
 }
 
 // ServerConfig holds HTTP/gRPC server configuration
@@ -37,11 +53,13 @@ type DatabaseConfig struct {
 	SSLMode         string
 	MaxConnections  int
 	MinConnections  int
-	MigrationsPath  string
+	MigrationsPath  string // "embedded" for the migrations built into the binary, or a directory
+	AutoMigrate     bool   // Apply pending migrations at startup
 }
 
 // RedisConfig holds Redis configuration
 type RedisConfig struct {
+	Enabled  bool // Redis is optional; when enabled it is part of the health checks
 	Host     string
 	Port     string
 	Password string
@@ -68,6 +86,40 @@ type KubernetesConfig struct {
 	BackoffLimit            int
 	ActiveDeadlineSeconds   int
 	Resources               ResourceConfig
+	EphemeralStorage        string          // Size of the checkout emptyDir and the runner's ephemeral-storage; empty is unlimited
+	Scanners                []ScannerConfig // One job per requested scan type runs its scanner
+	ScannersFile            string          // YAML or JSON file replacing the SCANNER_<TYPE>_* variables
+	ScannerRegistrySource   string          // config, or database to let the scanners table override the configured scanners
+	ScannerRegistryCacheTTL time.Duration   // How long the scanners table is cached
+	Security                PodSecurityConfig
+	NetworkPolicy           NetworkPolicyConfig
+}
+
+// PodSecurityConfig holds the security context of runner pods, which run
+// scanners over untrusted code
+type PodSecurityConfig struct {
+	RunAsNonRoot                 bool
+	RunAsUser                    int    // 0 keeps the image's user
+	RunAsGroup                   int    // 0 keeps the image's group
+	FSGroup                      int    // Owns the writable volumes; 0 is unset
+	ReadOnlyRootFilesystem       bool   // /workspace and /tmp stay writable
+	DropAllCapabilities          bool   // Also disallows privilege escalation
+	SeccompProfile               string // RuntimeDefault, Unconfined, or empty to leave unset
+	AutomountServiceAccountToken bool
+}
+
+// NetworkPolicyConfig holds the egress policy created for every runner job.
+// Selectors are label selectors matching pods in any namespace.
+type NetworkPolicyConfig struct {
+	Enabled              bool
+	OrchestratorSelector string
+	StorageSelector      string
+	DNSNamespaceSelector string // Namespace and pods of the cluster DNS, the only DNS servers allowed
+	DNSSelector          string
+	GitPorts             []int    // Ports allowed to the addresses of the scan's git host
+	AllowedCIDRs         []string // Further egress, e.g. an object store on a private network serving presigned URLs
+	GitHosts             []string // If set, the only git hosts scans may clone from
+	BlockedCIDRs         []string // Ranges git and object store hosts must not resolve to besides private and reserved ones, e.g. the cluster's
 }
 
 // ResourceConfig holds resource requests and limits
@@ -85,14 +137,155 @@ type ResourceList struct {
 // ObservabilityConfig holds observability configuration
 type ObservabilityConfig struct {
 	PrometheusEnabled bool
-	JaegerEnabled     bool
-	JaegerURL         string
+	JaegerEnabled     bool   // export traces over OTLP
+	JaegerURL         string // OTLP/gRPC collector endpoint, e.g. http://jaeger:4317
 	LogFormat         string // json or text
 }
 
 // WorkersConfig holds background workers configuration
 type WorkersConfig struct {
-	EnableCleaner bool // Enable cleanup worker (should be disabled, cleanup handled by API Gateway)
+	EnableCleaner         bool          // Enable cleanup worker (should be disabled, cleanup handled by API Gateway)
+	EnableScheduler       bool          // Enable scheduler worker for recurring scans
+	SchedulerInterval     time.Duration // How often to check for due schedules
+	ScheduleCatchUpWindow time.Duration // Missed runs older than this are skipped after downtime
+
+	EnableFindingReconciler   bool          // Link legacy findings to their scans and delete orphans
+	FindingReconcileInterval  time.Duration // How often to look for unreconciled findings
+	FindingReconcileBatchSize int
+}
+
+// CommitStatusConfig holds configuration for reporting scan results to Git providers
+type CommitStatusConfig struct {
+	Enabled        bool
+	Context        string        // Check name shown on commits and pull requests
+	UIBaseURL      string        // Base URL of the UI, used to link statuses to scans
+	FailOn         string        // Lowest severity that fails the check (critical, high, medium, low or none)
+	Interval       time.Duration // How often to poll for due deliveries
+	BatchSize      int           // Deliveries claimed per poll
+	MaxAttempts    int           // Delivery attempts per provider
+	InitialBackoff time.Duration // Delay before the first retry, doubled after each attempt
+	MaxBackoff     time.Duration
+	GitHubAPIURL   string
+	GitHubHost     string
+	GitHubToken    string
+	GitLabAPIURL   string
+	GitLabHost     string
+	GitLabToken    string
+	WebhookURL     string // Generic HTTP endpoint receiving every status as JSON
+	WebhookToken   string
+}
+
+// WebhooksConfig holds configuration for outbound webhook delivery
+type WebhooksConfig struct {
+	DeliveryEnabled  bool          // Run the delivery worker on this replica
+	DeliveryInterval time.Duration // How often to poll the outbox
+	BatchSize        int
+	Timeout          time.Duration // HTTP timeout per attempt
+	MaxAttempts      int
+	InitialBackoff   time.Duration // Delay before the first retry, doubled after each attempt
+	MaxBackoff       time.Duration
+	AllowHTTP        bool // Accept plain http webhook URLs
+	AllowPrivateURLs bool // Accept webhook URLs of loopback, private, link-local and reserved addresses
+}
+
+// EventsConfig holds configuration for the event outbox relay and message broker
+type EventsConfig struct {
+	RelayEnabled   bool          // Run the outbox relay on this replica
+	RelayInterval  time.Duration // How often to poll the outbox
+	BatchSize      int
+	InitialBackoff time.Duration // Delay before the first retry, doubled after each attempt
+	MaxBackoff     time.Duration
+	Retention      time.Duration // How long published events are kept in the outbox
+	Broker         string        // Message broker: none, nats or kafka
+	SubjectPrefix  string        // Events are published on <prefix>.<event type>
+	PublishTimeout time.Duration
+	NATSURL        string
+	KafkaBrokers   []string
+	KafkaTopic     string // Publish all events on one topic instead of a topic per subject
+}
+
+// HealthConfig holds configuration for dependency health checks and worker heartbeats
+type HealthConfig struct {
+	CheckInterval  time.Duration // How often dependencies are checked; probes serve the cached results
+	CheckTimeout   time.Duration // Timeout of a single dependency check
+	HeartbeatGrace time.Duration // Slack before a worker that missed heartbeats is reported stalled
+}
+
+// PartitionsConfig holds configuration for maintaining the scans table partitions
+type PartitionsConfig struct {
+	MaintenanceEnabled  bool          // Run the partition maintenance worker on this replica
+	MaintenanceInterval time.Duration // How often partitions are checked
+	MonthsAhead         int           // Months after the current one that must have a partition
+	RetentionMonths     int           // Expire partitions ending more than this many months ago; 0 keeps all
+	RetentionAction     string        // archive (detach into ArchiveSchema) or drop
+	ArchiveSchema       string
+}
+
+// DeletionConfig holds configuration for the asynchronous scan deletion worker
+type DeletionConfig struct {
+	Enabled        bool          // Run the deletion worker on this replica
+	Interval       time.Duration // How often to look for scans marked deleting
+	BatchSize      int
+	MaxAttempts    int           // Attempts before a scan deletion is marked failed
+	InitialBackoff time.Duration // Delay before the first retry, doubled after each attempt
+	MaxBackoff     time.Duration
+}
+
+// ScanRetryConfig holds the retry policy of sub-scans whose job failed
+// transiently (evicted, source download or results upload failures)
+type ScanRetryConfig struct {
+	MaxAttempts    int           // Attempts per sub-scan, including the first; 1 disables retries
+	InitialBackoff time.Duration // Delay before the first retry, doubled after each attempt
+	MaxBackoff     time.Duration
+}
+
+// StuckScansConfig holds how long the sweeper lets a job make no progress
+// before failing it. Zero disables a check.
+type StuckScansConfig struct {
+	PendingTimeout   time.Duration // Pod not created, scheduled or started
+	ImagePullTimeout time.Duration // Runner image failing to pull
+	HeartbeatTimeout time.Duration // Running runner without callbacks
+}
+
+// RetentionConfig holds configuration for the retention cleaner. The Default*
+// rules apply to scans whose organization and project have no policy.
+type RetentionConfig struct {
+	CleanupTime                    string // HH:MM, local time of the daily cleanup
+	BatchSize                      int    // Scans scheduled for deletion at a time
+	DryRun                         bool   // Only log the scans that would be deleted
+	DefaultKeepDays                int    // Keep scans younger than this many days; 0 disables
+	DefaultKeepLastPerBranch       int    // Keep the newest N scans of each branch; 0 disables
+	DefaultKeepLatestDefaultBranch bool   // Keep the latest completed scan of the default branch
+}
+
+// JobLimitsConfig holds the job limits of organizations that have none of
+// their own. Empty maximums are unlimited.
+type JobLimitsConfig struct {
+	MaxCPU                 string
+	MaxMemory              string
+	MaxEphemeralStorage    string
+	MaxDeadlineSeconds     int      // 0 is unlimited
+	MaxBackoffLimit        int      // Negative is unlimited
+	AllowedPriorityClasses []string // Priority classes profiles may use
+}
+
+// LogArchiveConfig holds configuration for archiving runner logs as artifacts
+type LogArchiveConfig struct {
+	Enabled   bool          // Run the log archiver on this replica
+	Interval  time.Duration // How often to look for finished scans
+	BatchSize int
+	Window    time.Duration // Skip scans that finished longer ago; defaults to the job TTL
+	MaxBytes  int           // Truncate logs after this many bytes
+}
+
+// CredentialsConfig holds configuration for the repository credentials of
+// projects and the Secrets they are handed to runners in
+type CredentialsConfig struct {
+	KMSProvider     string        // Key manager sealing credentials: none or local
+	KMSLocalKeyFile string        // File holding the local key, for development
+	GitHubAPIURL    string        // API of GitHub Apps minting installation tokens
+	ReapInterval    time.Duration // How often Secrets of finished scans are deleted
+	SecretMaxAge    time.Duration // Secrets are deleted after this long regardless; 0 disables
 }
 
 // LoadConfig loads configuration from environment variables
@@ -114,9 +307,11 @@ func LoadConfig() (*Config, error) {
 			SSLMode:         getEnv("DB_SSLMODE", "prefer"),
 			MaxConnections:  getEnvInt("DB_MAX_CONNS", 25),
 			MinConnections:  getEnvInt("DB_MIN_CONNS", 5),
-			MigrationsPath:  getEnv("DB_MIGRATIONS_PATH", "file://migrations"),
+			MigrationsPath:  getEnv("DB_MIGRATIONS_PATH", "embedded"),
+			AutoMigrate:     getEnvBool("DB_AUTO_MIGRATE", true),
 		},
 		Redis: RedisConfig{
+			Enabled:  getEnvBool("REDIS_ENABLED", false),
 			Host:     getEnv("REDIS_HOST", "localhost"),
 			Port:     getEnv("REDIS_PORT", "6379"),
 			Password: getEnv("REDIS_PASSWORD", ""),
@@ -138,6 +333,31 @@ func LoadConfig() (*Config, error) {
 			TTLSecondsAfterFinished: getEnvInt("JOB_TTL_SECONDS", 3600),
 			BackoffLimit:            getEnvInt("JOB_BACKOFF_LIMIT", 1),
 			ActiveDeadlineSeconds:   getEnvInt("JOB_DEADLINE_SECONDS", 3600),
+			EphemeralStorage:        getEnv("RUNNER_EPHEMERAL_STORAGE", ""),
+			ScannersFile:            getEnv("SCANNERS_FILE", ""),
+			ScannerRegistrySource:   getEnv("SCANNER_REGISTRY_SOURCE", "config"),
+			ScannerRegistryCacheTTL: getEnvDuration("SCANNER_REGISTRY_CACHE_TTL", 30*time.Second),
+			Security: PodSecurityConfig{
+				RunAsNonRoot:                 getEnvBool("RUNNER_RUN_AS_NON_ROOT", true),
+				RunAsUser:                    getEnvInt("RUNNER_RUN_AS_USER", 65532),
+				RunAsGroup:                   getEnvInt("RUNNER_RUN_AS_GROUP", 65532),
+				FSGroup:                      getEnvInt("RUNNER_FS_GROUP", 65532),
+				ReadOnlyRootFilesystem:       getEnvBool("RUNNER_READ_ONLY_ROOT_FILESYSTEM", true),
+				DropAllCapabilities:          getEnvBool("RUNNER_DROP_ALL_CAPABILITIES", true),
+				SeccompProfile:               getEnv("RUNNER_SECCOMP_PROFILE", "RuntimeDefault"),
+				AutomountServiceAccountToken: getEnvBool("RUNNER_AUTOMOUNT_SERVICE_ACCOUNT_TOKEN", false),
+			},
+			NetworkPolicy: NetworkPolicyConfig{
+				Enabled:              getEnvBool("RUNNER_NETWORK_POLICY_ENABLED", false),
+				OrchestratorSelector: getEnv("RUNNER_NETWORK_POLICY_ORCHESTRATOR_SELECTOR", "app=cloudscan-orchestrator"),
+				StorageSelector:      getEnv("RUNNER_NETWORK_POLICY_STORAGE_SELECTOR", "app=cloudscan-storage"),
+				DNSNamespaceSelector: getEnv("RUNNER_NETWORK_POLICY_DNS_NAMESPACE_SELECTOR", "kubernetes.io/metadata.name=kube-system"),
+				DNSSelector:          getEnv("RUNNER_NETWORK_POLICY_DNS_SELECTOR", "k8s-app=kube-dns"),
+				GitPorts:             getEnvIntList("RUNNER_NETWORK_POLICY_GIT_PORTS", []int{443, 22}),
+				AllowedCIDRs:         getEnvList("RUNNER_NETWORK_POLICY_ALLOWED_CIDRS"),
+				GitHosts:             getEnvList("RUNNER_NETWORK_POLICY_GIT_HOSTS"),
+				BlockedCIDRs:         getEnvList("RUNNER_NETWORK_POLICY_BLOCKED_CIDRS"),
+			},
 			Resources: ResourceConfig{
 				Requests: ResourceList{
 					CPU:    getEnv("RUNNER_REQUESTS_CPU", "500m"),
@@ -156,10 +376,133 @@ func LoadConfig() (*Config, error) {
 			LogFormat:         getEnv("LOG_FORMAT", "json"),
 		},
 		Workers: WorkersConfig{
-			EnableCleaner: getEnvBool("ENABLE_CLEANER", false), // Default false - cleanup handled by API Gateway
+			EnableCleaner:         getEnvBool("ENABLE_CLEANER", false), // Default false - cleanup handled by API Gateway
+			EnableScheduler:       getEnvBool("ENABLE_SCHEDULER", true),
+			SchedulerInterval:     getEnvDuration("SCHEDULER_INTERVAL", 30*time.Second),
+			ScheduleCatchUpWindow: getEnvDuration("SCHEDULE_CATCHUP_WINDOW", 6*time.Hour),
+
+			EnableFindingReconciler:   getEnvBool("ENABLE_FINDING_RECONCILER", true),
+			FindingReconcileInterval:  getEnvDuration("FINDING_RECONCILE_INTERVAL", time.Hour),
+			FindingReconcileBatchSize: getEnvInt("FINDING_RECONCILE_BATCH_SIZE", 1000),
+		},
+		CommitStatus: CommitStatusConfig{
+			Enabled:        getEnvBool("COMMIT_STATUS_ENABLED", false),
+			Context:        getEnv("COMMIT_STATUS_CONTEXT", "cloudscan"),
+			UIBaseURL:      getEnv("UI_BASE_URL", ""),
+			FailOn:         getEnv("COMMIT_STATUS_FAIL_ON", "critical"),
+			Interval:       getEnvDuration("COMMIT_STATUS_INTERVAL", 5*time.Second),
+			BatchSize:      getEnvInt("COMMIT_STATUS_BATCH_SIZE", 50),
+			MaxAttempts:    getEnvInt("COMMIT_STATUS_MAX_ATTEMPTS", 5),
+			InitialBackoff: getEnvDuration("COMMIT_STATUS_INITIAL_BACKOFF", 2*time.Second),
+			MaxBackoff:     getEnvDuration("COMMIT_STATUS_MAX_BACKOFF", 2*time.Minute),
+			GitHubAPIURL:   getEnv("GITHUB_API_URL", "https://api.github.com"),
+			GitHubHost:     getEnv("GITHUB_HOST", "github.com"),
+			GitHubToken:    getEnv("GITHUB_TOKEN", ""),
+			GitLabAPIURL:   getEnv("GITLAB_API_URL", "https://gitlab.com/api/v4"),
+			GitLabHost:     getEnv("GITLAB_HOST", "gitlab.com"),
+			GitLabToken:    getEnv("GITLAB_TOKEN", ""),
+			WebhookURL:     getEnv("COMMIT_STATUS_WEBHOOK_URL", ""),
+			WebhookToken:   getEnv("COMMIT_STATUS_WEBHOOK_TOKEN", ""),
+		},
+		Webhooks: WebhooksConfig{
+			DeliveryEnabled:  getEnvBool("WEBHOOK_DELIVERY_ENABLED", true),
+			DeliveryInterval: getEnvDuration("WEBHOOK_DELIVERY_INTERVAL", 5*time.Second),
+			BatchSize:        getEnvInt("WEBHOOK_DELIVERY_BATCH_SIZE", 50),
+			Timeout:          getEnvDuration("WEBHOOK_TIMEOUT", 10*time.Second),
+			MaxAttempts:      getEnvInt("WEBHOOK_MAX_ATTEMPTS", 10),
+			InitialBackoff:   getEnvDuration("WEBHOOK_INITIAL_BACKOFF", 30*time.Second),
+			MaxBackoff:       getEnvDuration("WEBHOOK_MAX_BACKOFF", 6*time.Hour),
+			AllowHTTP:        getEnvBool("WEBHOOK_ALLOW_HTTP", false),
+			AllowPrivateURLs: getEnvBool("WEBHOOK_ALLOW_PRIVATE_URLS", false),
+		},
+		Events: EventsConfig{
+			RelayEnabled:   getEnvBool("EVENT_RELAY_ENABLED", true),
+			RelayInterval:  getEnvDuration("EVENT_RELAY_INTERVAL", time.Second),
+			BatchSize:      getEnvInt("EVENT_RELAY_BATCH_SIZE", 100),
+			InitialBackoff: getEnvDuration("EVENT_RELAY_INITIAL_BACKOFF", 5*time.Second),
+			MaxBackoff:     getEnvDuration("EVENT_RELAY_MAX_BACKOFF", 5*time.Minute),
+			Retention:      getEnvDuration("EVENT_OUTBOX_RETENTION", 7*24*time.Hour),
+			Broker:         getEnv("EVENT_BROKER", "none"),
+			SubjectPrefix:  getEnv("EVENT_SUBJECT_PREFIX", "cloudscan.events.v1"),
+			PublishTimeout: getEnvDuration("EVENT_PUBLISH_TIMEOUT", 5*time.Second),
+			NATSURL:        getEnv("NATS_URL", "nats://localhost:4222"),
+			KafkaBrokers:   getEnvList("KAFKA_BROKERS"),
+			KafkaTopic:     getEnv("KAFKA_TOPIC", ""),
+		},
+		Health: HealthConfig{
+			CheckInterval:  getEnvDuration("HEALTH_CHECK_INTERVAL", 10*time.Second),
+			CheckTimeout:   getEnvDuration("HEALTH_CHECK_TIMEOUT", 2*time.Second),
+			HeartbeatGrace: getEnvDuration("HEALTH_HEARTBEAT_GRACE", time.Minute),
+		},
+		Partitions: PartitionsConfig{
+			MaintenanceEnabled:  getEnvBool("PARTITION_MAINTENANCE_ENABLED", true),
+			MaintenanceInterval: getEnvDuration("PARTITION_MAINTENANCE_INTERVAL", time.Hour),
+			MonthsAhead:         getEnvInt("PARTITION_MONTHS_AHEAD", 3),
+			RetentionMonths:     getEnvInt("PARTITION_RETENTION_MONTHS", 0),
+			RetentionAction:     getEnv("PARTITION_RETENTION_ACTION", "archive"),
+			ArchiveSchema:       getEnv("PARTITION_ARCHIVE_SCHEMA", "archive"),
+		},
+		Retention: RetentionConfig{
+			CleanupTime:                    getEnv("RETENTION_CLEANUP_TIME", "00:00"),
+			BatchSize:                      getEnvInt("RETENTION_BATCH_SIZE", 500),
+			DryRun:                         getEnvBool("RETENTION_DRY_RUN", false),
+			DefaultKeepDays:                getEnvInt("RETENTION_KEEP_DAYS", 90),
+			DefaultKeepLastPerBranch:       getEnvInt("RETENTION_KEEP_LAST_PER_BRANCH", 0),
+			DefaultKeepLatestDefaultBranch: getEnvBool("RETENTION_KEEP_LATEST_DEFAULT_BRANCH", true),
+		},
+		JobLimits: JobLimitsConfig{
+			MaxCPU:                 getEnv("JOB_MAX_CPU", "8"),
+			MaxMemory:              getEnv("JOB_MAX_MEMORY", "16Gi"),
+			MaxEphemeralStorage:    getEnv("JOB_MAX_EPHEMERAL_STORAGE", "50Gi"),
+			MaxDeadlineSeconds:     getEnvInt("JOB_MAX_DEADLINE_SECONDS", 4*3600),
+			MaxBackoffLimit:        getEnvInt("JOB_MAX_BACKOFF_LIMIT", 3),
+			AllowedPriorityClasses: getEnvList("JOB_ALLOWED_PRIORITY_CLASSES"),
+		},
+		Deletion: DeletionConfig{
+			Enabled:        getEnvBool("SCAN_DELETION_ENABLED", true),
+			Interval:       getEnvDuration("SCAN_DELETION_INTERVAL", 5*time.Second),
+			BatchSize:      getEnvInt("SCAN_DELETION_BATCH_SIZE", 50),
+			MaxAttempts:    getEnvInt("SCAN_DELETION_MAX_ATTEMPTS", 10),
+			InitialBackoff: getEnvDuration("SCAN_DELETION_INITIAL_BACKOFF", 30*time.Second),
+			MaxBackoff:     getEnvDuration("SCAN_DELETION_MAX_BACKOFF", time.Hour),
+		},
+		ScanRetry: ScanRetryConfig{
+			MaxAttempts:    getEnvInt("SCAN_RETRY_MAX_ATTEMPTS", 3),
+			InitialBackoff: getEnvDuration("SCAN_RETRY_INITIAL_BACKOFF", 30*time.Second),
+			MaxBackoff:     getEnvDuration("SCAN_RETRY_MAX_BACKOFF", 10*time.Minute),
+		},
+		StuckScans: StuckScansConfig{
+			PendingTimeout:   getEnvDuration("SCAN_PENDING_TIMEOUT", 15*time.Minute),
+			ImagePullTimeout: getEnvDuration("SCAN_IMAGE_PULL_TIMEOUT", 5*time.Minute),
+			HeartbeatTimeout: getEnvDuration("RUNNER_HEARTBEAT_TIMEOUT", 5*time.Minute),
+		},
+		Credentials: CredentialsConfig{
+			KMSProvider:     getEnv("KMS_PROVIDER", "none"),
+			KMSLocalKeyFile: getEnv("KMS_LOCAL_KEY_FILE", ""),
+			GitHubAPIURL:    getEnv("GITHUB_API_URL", "https://api.github.com"),
+			ReapInterval:    getEnvDuration("CREDENTIALS_REAP_INTERVAL", 30*time.Second),
+			SecretMaxAge:    getEnvDuration("CREDENTIALS_SECRET_MAX_AGE", 24*time.Hour),
+		},
+		LogArchive: LogArchiveConfig{
+			Enabled:   getEnvBool("LOG_ARCHIVE_ENABLED", true),
+			Interval:  getEnvDuration("LOG_ARCHIVE_INTERVAL", 30*time.Second),
+			BatchSize: getEnvInt("LOG_ARCHIVE_BATCH_SIZE", 20),
+			Window:    getEnvDuration("LOG_ARCHIVE_WINDOW", 0),
+			MaxBytes:  getEnvInt("LOG_ARCHIVE_MAX_BYTES", 50*1024*1024),
 		},
 	}
 
+	scanners, err := loadScanners(&cfg.Kubernetes)
+	if err != nil {
+		return nil, err
+	}
+	cfg.Kubernetes.Scanners = scanners
+
+	// Pods are gone once finished jobs reach their TTL
+	if cfg.LogArchive.Window == 0 {
+		cfg.LogArchive.Window = time.Duration(cfg.Kubernetes.TTLSecondsAfterFinished) * time.Second
+	}
+
 	if err := cfg.Validate(); err != nil {
 		return nil, fmt.Errorf("invalid configuration: %w", err)
 	}
@@ -190,6 +533,147 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("KUBE_NAMESPACE is required")
 	}
 
+	switch c.Kubernetes.Security.SeccompProfile {
+	case "", "RuntimeDefault", "Unconfined":
+	default:
+		return fmt.Errorf("RUNNER_SECCOMP_PROFILE must be one of RuntimeDefault, Unconfined or empty")
+	}
+	if c.Kubernetes.Security.RunAsNonRoot && c.Kubernetes.Security.RunAsUser < 0 {
+		return fmt.Errorf("RUNNER_RUN_AS_USER must not be negative")
+	}
+	if c.Kubernetes.NetworkPolicy.Enabled {
+		if c.Kubernetes.NetworkPolicy.OrchestratorSelector == "" || c.Kubernetes.NetworkPolicy.StorageSelector == "" {
+			return fmt.Errorf("RUNNER_NETWORK_POLICY_ORCHESTRATOR_SELECTOR and RUNNER_NETWORK_POLICY_STORAGE_SELECTOR are required")
+		}
+		if c.Kubernetes.NetworkPolicy.DNSNamespaceSelector == "" || c.Kubernetes.NetworkPolicy.DNSSelector == "" {
+			return fmt.Errorf("RUNNER_NETWORK_POLICY_DNS_NAMESPACE_SELECTOR and RUNNER_NETWORK_POLICY_DNS_SELECTOR are required")
+		}
+		for _, cidr := range c.Kubernetes.NetworkPolicy.AllowedCIDRs {
+			if _, _, err := net.ParseCIDR(cidr); err != nil {
+				return fmt.Errorf("invalid RUNNER_NETWORK_POLICY_ALLOWED_CIDRS entry %q: %w", cidr, err)
+			}
+		}
+		for _, cidr := range c.Kubernetes.NetworkPolicy.BlockedCIDRs {
+			if _, _, err := net.ParseCIDR(cidr); err != nil {
+				return fmt.Errorf("invalid RUNNER_NETWORK_POLICY_BLOCKED_CIDRS entry %q: %w", cidr, err)
+			}
+		}
+	}
+
+	switch c.Kubernetes.ScannerRegistrySource {
+	case "config", "database":
+	default:
+		return fmt.Errorf("SCANNER_REGISTRY_SOURCE must be one of config, database")
+	}
+	if c.Kubernetes.ScannerRegistryCacheTTL < 0 {
+		return fmt.Errorf("SCANNER_REGISTRY_CACHE_TTL must not be negative")
+	}
+	for _, scanner := range c.Kubernetes.Scanners {
+		if err := scanner.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Validate commit status config
+	switch c.CommitStatus.FailOn {
+	case "critical", "high", "medium", "low", "none":
+	default:
+		return fmt.Errorf("COMMIT_STATUS_FAIL_ON must be one of critical, high, medium, low, none")
+	}
+	if c.CommitStatus.Interval <= 0 {
+		return fmt.Errorf("COMMIT_STATUS_INTERVAL must be positive")
+	}
+	if c.CommitStatus.BatchSize < 1 {
+		return fmt.Errorf("COMMIT_STATUS_BATCH_SIZE must be at least 1")
+	}
+
+	// Validate event broker config
+	switch c.Events.Broker {
+	case "none", "nats":
+	case "kafka":
+		if len(c.Events.KafkaBrokers) == 0 {
+			return fmt.Errorf("KAFKA_BROKERS is required when EVENT_BROKER is kafka")
+		}
+	default:
+		return fmt.Errorf("EVENT_BROKER must be one of none, nats, kafka")
+	}
+
+	// Validate partition config
+	if c.Partitions.MonthsAhead < 1 {
+		return fmt.Errorf("PARTITION_MONTHS_AHEAD must be at least 1")
+	}
+	if c.Partitions.RetentionMonths < 0 {
+		return fmt.Errorf("PARTITION_RETENTION_MONTHS must not be negative")
+	}
+	switch c.Partitions.RetentionAction {
+	case "archive", "drop":
+	default:
+		return fmt.Errorf("PARTITION_RETENTION_ACTION must be one of archive, drop")
+	}
+
+	// Validate retention config
+	if _, err := time.Parse("15:04", c.Retention.CleanupTime); err != nil {
+		return fmt.Errorf("RETENTION_CLEANUP_TIME must be in HH:MM format")
+	}
+	if c.Retention.BatchSize < 1 {
+		return fmt.Errorf("RETENTION_BATCH_SIZE must be at least 1")
+	}
+	if c.Retention.DefaultKeepDays < 0 || c.Retention.DefaultKeepLastPerBranch < 0 {
+		return fmt.Errorf("RETENTION_KEEP_DAYS and RETENTION_KEEP_LAST_PER_BRANCH must not be negative")
+	}
+	if c.Retention.DefaultKeepDays == 0 && c.Retention.DefaultKeepLastPerBranch == 0 {
+		return fmt.Errorf("RETENTION_KEEP_DAYS or RETENTION_KEEP_LAST_PER_BRANCH must be set")
+	}
+
+	// Validate job limits
+	defaultLimits := c.JobLimits.DefaultLimits()
+	if err := defaultLimits.Validate(); err != nil {
+		return fmt.Errorf("invalid JOB_MAX_* setting: %w", err)
+	}
+
+	// Validate scan deletion config
+	if c.Deletion.MaxAttempts < 1 {
+		return fmt.Errorf("SCAN_DELETION_MAX_ATTEMPTS must be at least 1")
+	}
+
+	// Validate scan retry config
+	if c.ScanRetry.MaxAttempts < 1 {
+		return fmt.Errorf("SCAN_RETRY_MAX_ATTEMPTS must be at least 1")
+	}
+	if c.ScanRetry.InitialBackoff <= 0 || c.ScanRetry.MaxBackoff < c.ScanRetry.InitialBackoff {
+		return fmt.Errorf("SCAN_RETRY_INITIAL_BACKOFF must be positive and at most SCAN_RETRY_MAX_BACKOFF")
+	}
+
+	// Validate stuck scan detection config
+	if c.StuckScans.PendingTimeout < 0 || c.StuckScans.ImagePullTimeout < 0 || c.StuckScans.HeartbeatTimeout < 0 {
+		return fmt.Errorf("SCAN_PENDING_TIMEOUT, SCAN_IMAGE_PULL_TIMEOUT and RUNNER_HEARTBEAT_TIMEOUT must not be negative")
+	}
+
+	// Validate credentials config
+	switch c.Credentials.KMSProvider {
+	case "none":
+	case "local":
+		if c.Credentials.KMSLocalKeyFile == "" {
+			return fmt.Errorf("KMS_LOCAL_KEY_FILE is required with KMS_PROVIDER=local")
+		}
+	default:
+		return fmt.Errorf("KMS_PROVIDER must be one of none, local")
+	}
+	if c.Credentials.ReapInterval <= 0 {
+		return fmt.Errorf("CREDENTIALS_REAP_INTERVAL must be positive")
+	}
+	if c.Credentials.SecretMaxAge < 0 {
+		return fmt.Errorf("CREDENTIALS_SECRET_MAX_AGE must not be negative")
+	}
+
+	// Validate log archive config
+	if c.LogArchive.Enabled && c.LogArchive.Window <= 0 {
+		return fmt.Errorf("LOG_ARCHIVE_WINDOW or JOB_TTL_SECONDS must be positive to archive logs")
+	}
+	if c.LogArchive.MaxBytes < 1 {
+		return fmt.Errorf("LOG_ARCHIVE_MAX_BYTES must be at least 1")
+	}
+
 	return nil
 }
 
@@ -201,6 +685,49 @@ func (c *DatabaseConfig) DSN() string {
 	)
 }
 
+// DefaultPolicy returns the retention policy of scans without an organization or project policy
+func (c *RetentionConfig) DefaultPolicy() domain.RetentionPolicy {
+	return domain.RetentionPolicy{
+		KeepDays:                c.DefaultKeepDays,
+		KeepLastPerBranch:       c.DefaultKeepLastPerBranch,
+		KeepLatestDefaultBranch: c.DefaultKeepLatestDefaultBranch,
+	}
+}
+
+// Policy returns the retry policy of sub-scans
+func (c *ScanRetryConfig) Policy() domain.RetryPolicy {
+	return domain.RetryPolicy{
+		MaxAttempts:    c.MaxAttempts,
+		InitialBackoff: c.InitialBackoff,
+		MaxBackoff:     c.MaxBackoff,
+	}
+}
+
+// Policy returns the sweeper's limits for jobs that make no progress
+func (c *StuckScansConfig) Policy() domain.StuckScanPolicy {
+	return domain.StuckScanPolicy{
+		PendingTimeout:   c.PendingTimeout,
+		ImagePullTimeout: c.ImagePullTimeout,
+		HeartbeatTimeout: c.HeartbeatTimeout,
+	}
+}
+
+// DefaultLimits returns the job limits of organizations without limits of their own
+func (c *JobLimitsConfig) DefaultLimits() domain.JobLimits {
+	limits := domain.JobLimits{
+		MaxCPU:                 c.MaxCPU,
+		MaxMemory:              c.MaxMemory,
+		MaxEphemeralStorage:    c.MaxEphemeralStorage,
+		MaxDeadlineSeconds:     int64(c.MaxDeadlineSeconds),
+		AllowedPriorityClasses: c.AllowedPriorityClasses,
+	}
+	if c.MaxBackoffLimit >= 0 {
+		maxBackoffLimit := int32(c.MaxBackoffLimit)
+		limits.MaxBackoffLimit = &maxBackoffLimit
+	}
+	return limits
+}
+
 // GetRedisAddr returns the Redis address
 func (c *RedisConfig) GetAddr() string {
 	return fmt.Sprintf("%s:%s", c.Host, c.Port)
@@ -233,6 +760,34 @@ func getEnvBool(key string, defaultValue bool) bool {
 	return defaultValue
 }
 
+// getEnvList returns the comma-separated values of the variable, without empty ones
+func getEnvList(key string) []string {
+	var values []string
+	for _, value := range strings.Split(os.Getenv(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// getEnvIntList returns the comma-separated integers of the variable
+func getEnvIntList(key string, defaultValue []int) []int {
+	values := getEnvList(key)
+	if len(values) == 0 {
+		return defaultValue
+	}
+	ints := make([]int, 0, len(values))
+	for _, value := range values {
+		i, err := strconv.Atoi(value)
+		if err != nil {
+			return defaultValue
+		}
+		ints = append(ints, i)
+	}
+	return ints
+}
+
 func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
 	if value := os.Getenv(key); value != "" {
 		if duration, err := time.ParseDuration(value); err == nil {
@@ -0,0 +1,173 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+	"sigs.k8s.io/yaml"
+)
+
+// ScannerConfig holds the scanner that runs one scan type. The image defaults
+// to the runner image, which runs any scan type it is given. Empty resources
+// and a zero timeout fall back to the runner's.
+type ScannerConfig struct {
+	ScanType  domain.ScanType
+	Name      string
+	Image     string
+	Version   string
+	Digest    string // Pins the image, e.g. sha256:...
+	Enabled   bool
+	Args      []string
+	Env       map[string]string
+	Resources ResourceConfig
+	Timeout   time.Duration
+}
+
+// Validate checks that an enabled scanner can be run
+func (s *ScannerConfig) Validate() error {
+	if !s.Enabled {
+		return nil
+	}
+	if s.Name == "" || s.Image == "" || s.Version == "" {
+		return fmt.Errorf("scanner for %s needs a name, an image and a version", s.ScanType)
+	}
+	if s.Digest != "" && !strings.Contains(s.Digest, ":") {
+		return fmt.Errorf("scanner digest for %s must be of the form <algorithm>:<hex>", s.ScanType)
+	}
+	if s.Timeout < 0 {
+		return fmt.Errorf("scanner timeout for %s must not be negative", s.ScanType)
+	}
+	return nil
+}
+
+// defaultScanners are the tools run for each scan type
+var defaultScanners = []struct {
+	scanType domain.ScanType
+	name     string
+}{
+	{domain.ScanTypeSAST, "semgrep"},
+	{domain.ScanTypeSCA, "trivy"},
+	{domain.ScanTypeSecrets, "trufflehog"},
+	{domain.ScanTypeLicense, "scancode"},
+}
+
+// loadScanners loads the scanners from SCANNERS_FILE if it is set and
+// otherwise from SCANNER_<TYPE>_* variables
+func loadScanners(k8s *KubernetesConfig) ([]ScannerConfig, error) {
+	if k8s.ScannersFile != "" {
+		return loadScannersFile(k8s.ScannersFile, k8s.RunnerImage, k8s.RunnerVersion)
+	}
+
+	scanners := make([]ScannerConfig, len(defaultScanners))
+	for i, scanner := range defaultScanners {
+		prefix := "SCANNER_" + strings.ToUpper(string(scanner.scanType)) + "_"
+		scanners[i] = ScannerConfig{
+			ScanType: scanner.scanType,
+			Name:     getEnv(prefix+"NAME", scanner.name),
+			Image:    getEnv(prefix+"IMAGE", k8s.RunnerImage),
+			Version:  getEnv(prefix+"VERSION", k8s.RunnerVersion),
+			Digest:   getEnv(prefix+"DIGEST", ""),
+			Enabled:  getEnvBool(prefix+"ENABLED", true),
+			Args:     strings.Fields(getEnv(prefix+"ARGS", "")),
+			Resources: ResourceConfig{
+				Requests: ResourceList{
+					CPU:    getEnv(prefix+"REQUESTS_CPU", ""),
+					Memory: getEnv(prefix+"REQUESTS_MEMORY", ""),
+				},
+				Limits: ResourceList{
+					CPU:    getEnv(prefix+"LIMITS_CPU", ""),
+					Memory: getEnv(prefix+"LIMITS_MEMORY", ""),
+				},
+			},
+			Timeout: getEnvDuration(prefix+"TIMEOUT", 0),
+		}
+	}
+	return scanners, nil
+}
+
+// scannerFileEntry is a scanner in SCANNERS_FILE
+type scannerFileEntry struct {
+	ScanType  domain.ScanType   `json:"scan_type"`
+	Name      string            `json:"name"`
+	Image     string            `json:"image"`
+	Version   string            `json:"version"`
+	Digest    string            `json:"digest"`
+	Enabled   *bool             `json:"enabled"` // Defaults to true
+	Args      []string          `json:"args"`
+	Env       map[string]string `json:"env"`
+	Resources struct {
+		Requests scannerFileResources `json:"requests"`
+		Limits   scannerFileResources `json:"limits"`
+	} `json:"resources"`
+	Timeout string `json:"timeout"` // A Go duration, e.g. 30m
+}
+
+type scannerFileResources struct {
+	CPU    string `json:"cpu"`
+	Memory string `json:"memory"`
+}
+
+// isKnownScanType reports whether scans can request the scan type
+func isKnownScanType(scanType domain.ScanType) bool {
+	for _, scanner := range defaultScanners {
+		if scanner.scanType == scanType {
+			return true
+		}
+	}
+	return false
+}
+
+// loadScannersFile loads the scanners from a YAML or JSON file holding a
+// "scanners" list. Only the scan types listed are run.
+func loadScannersFile(path, runnerImage, runnerVersion string) ([]ScannerConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read SCANNERS_FILE: %w", err)
+	}
+
+	var file struct {
+		Scanners []scannerFileEntry `json:"scanners"`
+	}
+	if err := yaml.UnmarshalStrict(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse SCANNERS_FILE: %w", err)
+	}
+
+	scanners := make([]ScannerConfig, len(file.Scanners))
+	for i, entry := range file.Scanners {
+		if !isKnownScanType(entry.ScanType) {
+			return nil, fmt.Errorf("SCANNERS_FILE: unknown scan type %q", entry.ScanType)
+		}
+
+		scanner := ScannerConfig{
+			ScanType: entry.ScanType,
+			Name:     entry.Name,
+			Image:    entry.Image,
+			Version:  entry.Version,
+			Digest:   entry.Digest,
+			Enabled:  entry.Enabled == nil || *entry.Enabled,
+			Args:     entry.Args,
+			Env:      entry.Env,
+			Resources: ResourceConfig{
+				Requests: ResourceList(entry.Resources.Requests),
+				Limits:   ResourceList(entry.Resources.Limits),
+			},
+		}
+		if scanner.Image == "" {
+			scanner.Image = runnerImage
+		}
+		if scanner.Version == "" {
+			scanner.Version = runnerVersion
+		}
+		if entry.Timeout != "" {
+			if scanner.Timeout, err = time.ParseDuration(entry.Timeout); err != nil {
+				return nil, fmt.Errorf("SCANNERS_FILE: invalid timeout for %s: %w", entry.ScanType, err)
+			}
+		}
+
+		scanners[i] = scanner
+	}
+	return scanners, nil
+}
@@ -0,0 +1,174 @@
+// Package credentials stores the repository credentials of projects sealed
+// with the key manager and opens them into the git credentials of a scan.
+package credentials
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/clients"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/kms"
+	"golang.org/x/crypto/ssh"
+)
+
+// defaultTokenUsername is the user name sent with HTTPS tokens by default.
+// GitHub requires it for app tokens and GitHub and GitLab accept it for
+// personal access tokens.
+const defaultTokenUsername = "x-access-token"
+
+// ErrUnavailable is returned when no key manager is configured, so
+// credentials can't be stored
+var ErrUnavailable = errors.New("repository credentials are not configured")
+
+// ErrInvalidCredential wraps credentials that are malformed or can't be used.
+// Retrying doesn't help.
+var ErrInvalidCredential = errors.New("invalid repository credential")
+
+// Provider stores and opens repository credentials
+type Provider struct {
+	repo       interfaces.CredentialRepository
+	keyManager interfaces.KeyManager // nil if credentials are disabled
+	githubApps interfaces.GitHubAppClient
+}
+
+// NewProvider creates a provider. Without a key manager no credential can be
+// stored, and scans of projects with a stored credential can't be dispatched.
+func NewProvider(repo interfaces.CredentialRepository, keyManager interfaces.KeyManager, githubApps interfaces.GitHubAppClient) *Provider {
+	return &Provider{repo: repo, keyManager: keyManager, githubApps: githubApps}
+}
+
+// Set checks the credential and its secret, seals the secret and stores the
+// credential. ErrInvalidCredential is wrapped if the check fails.
+func (p *Provider) Set(ctx context.Context, credential *domain.RepositoryCredential, secret []byte, source domain.AuditSource) error {
+	if p.keyManager == nil {
+		return ErrUnavailable
+	}
+
+	if err := credential.Validate(); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidCredential, err)
+	}
+	if err := checkSecret(credential, secret); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidCredential, err)
+	}
+
+	sealed, err := kms.Seal(ctx, p.keyManager, secret, credential.ProjectID[:])
+	if err != nil {
+		return err
+	}
+	credential.Secret = sealed
+
+	return p.repo.Set(ctx, credential, source)
+}
+
+// checkSecret checks that the secret can be used as the credential's type
+// and sets the fingerprint of deploy keys
+func checkSecret(credential *domain.RepositoryCredential, secret []byte) error {
+	if len(secret) == 0 {
+		return fmt.Errorf("secret is required")
+	}
+
+	switch credential.Type {
+	case domain.CredentialTypeDeployKey:
+		signer, err := ssh.ParsePrivateKey(secret)
+		var passphraseErr *ssh.PassphraseMissingError
+		if errors.As(err, &passphraseErr) {
+			return fmt.Errorf("deploy keys must not be protected by a passphrase")
+		}
+		if err != nil {
+			return fmt.Errorf("failed to parse deploy key: %v", err)
+		}
+		credential.Fingerprint = ssh.FingerprintSHA256(signer.PublicKey())
+
+		rest := []byte(credential.KnownHosts)
+		for len(strings.TrimSpace(string(rest))) > 0 {
+			_, _, _, _, rest, err = ssh.ParseKnownHosts(rest)
+			if err != nil {
+				return fmt.Errorf("invalid known_hosts: %v", err)
+			}
+		}
+
+	case domain.CredentialTypeHTTPSToken:
+		if strings.ContainsAny(string(secret), "\r\n") {
+			return fmt.Errorf("tokens must be a single line")
+		}
+
+	case domain.CredentialTypeGitHubApp:
+		if _, err := clients.ParseGitHubAppKey(secret); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ForScan returns the git credentials of a scan, or nil if it doesn't clone
+// a repository or its project has no credential. GitHub App credentials mint
+// a new installation token. ErrInvalidCredential is wrapped if the credential
+// can't be opened or is refused by GitHub.
+func (p *Provider) ForScan(ctx context.Context, scan *domain.Scan) (*interfaces.GitCredentials, error) {
+	if scan.RepositoryURL == nil || *scan.RepositoryURL == "" {
+		return nil, nil
+	}
+
+	credential, err := p.repo.Get(ctx, scan.ProjectID)
+	if errors.Is(err, domain.ErrCredentialNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if credential.OrganizationID != scan.OrganizationID {
+		return nil, fmt.Errorf("%w: credential of project %s belongs to another organization", ErrInvalidCredential, scan.ProjectID)
+	}
+
+	if p.keyManager == nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCredential, ErrUnavailable)
+	}
+	secret, err := kms.Open(ctx, p.keyManager, credential.Secret, credential.ProjectID[:])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCredential, err)
+	}
+
+	switch credential.Type {
+	case domain.CredentialTypeDeployKey:
+		return &interfaces.GitCredentials{
+			Type:       interfaces.GitCredentialsSSH,
+			PrivateKey: secret,
+			KnownHosts: []byte(credential.KnownHosts),
+		}, nil
+
+	case domain.CredentialTypeHTTPSToken:
+		username := credential.Username
+		if username == "" {
+			username = defaultTokenUsername
+		}
+		return &interfaces.GitCredentials{
+			Type:     interfaces.GitCredentialsHTTPS,
+			Username: username,
+			Password: string(secret),
+		}, nil
+
+	case domain.CredentialTypeGitHubApp:
+		token, expiresAt, err := p.githubApps.InstallationToken(ctx, credential.GitHubAppID, credential.GitHubInstallationID, secret)
+		if err != nil {
+			// Rate limits and server errors pass; other refusals won't
+			var responseErr *clients.StatusResponseError
+			if errors.As(err, &responseErr) && !responseErr.Temporary() {
+				return nil, fmt.Errorf("%w: %v", ErrInvalidCredential, err)
+			}
+			return nil, err
+		}
+		return &interfaces.GitCredentials{
+			Type:      interfaces.GitCredentialsHTTPS,
+			Username:  defaultTokenUsername,
+			Password:  token,
+			ExpiresAt: &expiresAt,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("%w: unknown type %q", ErrInvalidCredential, credential.Type)
+}
@@ -0,0 +1,51 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+	"github.com/google/uuid"
+)
+
+// insertAuditLog records an action in audit_logs as part of the transaction
+// that performs it
+func insertAuditLog(
+	ctx context.Context,
+	tx *Tx,
+	organizationID, userID uuid.UUID,
+	action, resourceType string,
+	resourceID uuid.UUID,
+	metadata map[string]interface{},
+	source domain.AuditSource,
+	createdAt time.Time,
+) error {
+	metadataJSON, err := json.Marshal(metadata)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit metadata: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO audit_logs (
+			organization_id, user_id, action, resource_type, resource_id,
+			metadata, ip_address, user_agent, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7::inet, $8, $9)`,
+		organizationID,
+		userID,
+		action,
+		resourceType,
+		resourceID,
+		metadataJSON,
+		sql.NullString{String: source.IPAddress, Valid: source.IPAddress != ""},
+		sql.NullString{String: source.UserAgent, Valid: source.UserAgent != ""},
+		createdAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to write audit log: %w", err)
+	}
+
+	return nil
+}
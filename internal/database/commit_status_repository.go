@@ -0,0 +1,176 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+)
+
+// CommitStatusRepository implements interfaces.CommitStatusRepository using PostgreSQL
+type CommitStatusRepository struct {
+	db *DB
+}
+
+// NewCommitStatusRepository creates a new CommitStatusRepository
+func NewCommitStatusRepository(db *DB) interfaces.CommitStatusRepository {
+	return &CommitStatusRepository{db: db}
+}
+
+const commitStatusDeliveryColumns = `
+	id, event_id, scan_id, client, scan,
+	status, attempts, next_attempt_at, last_error,
+	delivered_at, created_at, updated_at
+`
+
+// EnqueueDeliveries inserts the deliveries, skipping events already enqueued for the client
+func (r *CommitStatusRepository) EnqueueDeliveries(ctx context.Context, deliveries []*domain.CommitStatusDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO commit_status_deliveries (
+			id, event_id, scan_id, client, scan,
+			status, attempts, next_attempt_at, created_at, updated_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+		)
+		ON CONFLICT (event_id, client) DO NOTHING
+	`
+
+	for _, d := range deliveries {
+		scan, err := json.Marshal(d.Scan)
+		if err != nil {
+			return fmt.Errorf("failed to encode scan: %w", err)
+		}
+
+		if _, err := tx.ExecContext(ctx, query,
+			d.ID,
+			d.EventID,
+			d.ScanID,
+			d.Client,
+			string(scan),
+			d.Status,
+			d.Attempts,
+			d.NextAttemptAt,
+			d.CreatedAt,
+			d.UpdatedAt,
+		); err != nil {
+			return fmt.Errorf("failed to enqueue commit status delivery: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit commit status deliveries: %w", err)
+	}
+
+	return nil
+}
+
+// ClaimDueDeliveries locks pending deliveries that are due, oldest first
+func (r *CommitStatusRepository) ClaimDueDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*domain.CommitStatusDelivery, error) {
+	query := `
+		WITH due AS (
+			SELECT id AS due_id
+			FROM commit_status_deliveries
+			WHERE status = 'pending' AND next_attempt_at <= $1
+			ORDER BY next_attempt_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		UPDATE commit_status_deliveries d
+		SET next_attempt_at = $2
+		FROM due
+		WHERE d.id = due.due_id
+		RETURNING ` + commitStatusDeliveryColumns
+
+	rows, err := r.db.QueryContext(ctx, query, now, now.Add(lease), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim commit status deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	deliveries := []*domain.CommitStatusDelivery{}
+	for rows.Next() {
+		delivery, err := scanCommitStatusDelivery(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, rows.Err()
+}
+
+// RecordAttempt stores the outcome of an attempt
+func (r *CommitStatusRepository) RecordAttempt(ctx context.Context, delivery *domain.CommitStatusDelivery) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE commit_status_deliveries SET
+			status = $2,
+			attempts = $3,
+			next_attempt_at = $4,
+			last_error = $5,
+			delivered_at = $6,
+			updated_at = NOW()
+		WHERE id = $1`,
+		delivery.ID,
+		delivery.Status,
+		delivery.Attempts,
+		delivery.NextAttemptAt,
+		delivery.LastError,
+		delivery.DeliveredAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update commit status delivery: %w", err)
+	}
+
+	return nil
+}
+
+// scanCommitStatusDelivery reads a delivery from a row selected with commitStatusDeliveryColumns
+func scanCommitStatusDelivery(row rowScanner) (*domain.CommitStatusDelivery, error) {
+	delivery := &domain.CommitStatusDelivery{}
+	var scan []byte
+	var lastError sql.NullString
+	var deliveredAt sql.NullTime
+
+	if err := row.Scan(
+		&delivery.ID,
+		&delivery.EventID,
+		&delivery.ScanID,
+		&delivery.Client,
+		&scan,
+		&delivery.Status,
+		&delivery.Attempts,
+		&delivery.NextAttemptAt,
+		&lastError,
+		&deliveredAt,
+		&delivery.CreatedAt,
+		&delivery.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(scan, &delivery.Scan); err != nil {
+		return nil, fmt.Errorf("failed to decode scan: %w", err)
+	}
+	if lastError.Valid {
+		delivery.LastError = &lastError.String
+	}
+	if deliveredAt.Valid {
+		delivery.DeliveredAt = &deliveredAt.Time
+	}
+
+	return delivery, nil
+}
@@ -0,0 +1,197 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+	"github.com/google/uuid"
+)
+
+// CredentialRepository implements interfaces.CredentialRepository using PostgreSQL
+type CredentialRepository struct {
+	db *DB
+}
+
+// NewCredentialRepository creates a new CredentialRepository
+func NewCredentialRepository(db *DB) interfaces.CredentialRepository {
+	return &CredentialRepository{db: db}
+}
+
+const credentialColumns = `
+	project_id, organization_id, type, username, known_hosts, fingerprint,
+	github_app_id, github_installation_id, key_id, wrapped_key, ciphertext,
+	updated_by, created_at, updated_at
+`
+
+// Set creates or replaces the project's credential and records it in the audit log
+func (r *CredentialRepository) Set(ctx context.Context, credential *domain.RepositoryCredential, source domain.AuditSource) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO repository_credentials (
+			project_id, organization_id, type, username, known_hosts, fingerprint,
+			github_app_id, github_installation_id, key_id, wrapped_key, ciphertext,
+			updated_by, created_at, updated_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
+		)
+		ON CONFLICT (project_id) DO UPDATE SET
+			organization_id = EXCLUDED.organization_id,
+			type = EXCLUDED.type,
+			username = EXCLUDED.username,
+			known_hosts = EXCLUDED.known_hosts,
+			fingerprint = EXCLUDED.fingerprint,
+			github_app_id = EXCLUDED.github_app_id,
+			github_installation_id = EXCLUDED.github_installation_id,
+			key_id = EXCLUDED.key_id,
+			wrapped_key = EXCLUDED.wrapped_key,
+			ciphertext = EXCLUDED.ciphertext,
+			updated_by = EXCLUDED.updated_by
+		RETURNING created_at, updated_at
+	`
+
+	err = tx.QueryRowContext(ctx, query,
+		credential.ProjectID,
+		credential.OrganizationID,
+		credential.Type,
+		sql.NullString{String: credential.Username, Valid: credential.Username != ""},
+		sql.NullString{String: credential.KnownHosts, Valid: credential.KnownHosts != ""},
+		sql.NullString{String: credential.Fingerprint, Valid: credential.Fingerprint != ""},
+		sql.NullInt64{Int64: credential.GitHubAppID, Valid: credential.GitHubAppID != 0},
+		sql.NullInt64{Int64: credential.GitHubInstallationID, Valid: credential.GitHubInstallationID != 0},
+		credential.Secret.KeyID,
+		credential.Secret.WrappedKey,
+		credential.Secret.Ciphertext,
+		nullUUID(credential.UpdatedBy),
+		credential.CreatedAt,
+		credential.UpdatedAt,
+	).Scan(&credential.CreatedAt, &credential.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to set repository credential: %w", err)
+	}
+
+	if err := insertAuditLog(ctx, tx,
+		credential.OrganizationID, credential.UpdatedBy,
+		domain.AuditActionCredentialSet, "repository_credential", credential.ProjectID,
+		credentialAuditMetadata(credential), source, credential.UpdatedAt,
+	); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// Get returns the project's credential
+func (r *CredentialRepository) Get(ctx context.Context, projectID uuid.UUID) (*domain.RepositoryCredential, error) {
+	query := `SELECT ` + credentialColumns + ` FROM repository_credentials WHERE project_id = $1`
+
+	credential, err := scanCredential(r.db.QueryRowContext(ctx, query, projectID))
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrCredentialNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repository credential: %w", err)
+	}
+
+	return credential, nil
+}
+
+// Delete deletes the project's credential and records it in the audit log
+func (r *CredentialRepository) Delete(ctx context.Context, projectID, deletedBy uuid.UUID, source domain.AuditSource) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	credential, err := scanCredential(tx.QueryRowContext(ctx,
+		`DELETE FROM repository_credentials WHERE project_id = $1 RETURNING `+credentialColumns,
+		projectID,
+	))
+	if err == sql.ErrNoRows {
+		return domain.ErrCredentialNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete repository credential: %w", err)
+	}
+
+	if err := insertAuditLog(ctx, tx,
+		credential.OrganizationID, deletedBy,
+		domain.AuditActionCredentialDeleted, "repository_credential", credential.ProjectID,
+		credentialAuditMetadata(credential), source, time.Now(),
+	); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// credentialAuditMetadata describes a credential in the audit log, without its secret
+func credentialAuditMetadata(credential *domain.RepositoryCredential) map[string]interface{} {
+	metadata := map[string]interface{}{
+		"project_id": credential.ProjectID.String(),
+		"type":       string(credential.Type),
+		"key_id":     credential.Secret.KeyID,
+	}
+	if credential.Fingerprint != "" {
+		metadata["fingerprint"] = credential.Fingerprint
+	}
+	if credential.GitHubAppID != 0 {
+		metadata["github_app_id"] = credential.GitHubAppID
+		metadata["github_installation_id"] = credential.GitHubInstallationID
+	}
+	return metadata
+}
+
+// scanCredential reads a credential from a row selected with credentialColumns
+func scanCredential(row rowScanner) (*domain.RepositoryCredential, error) {
+	credential := &domain.RepositoryCredential{}
+	var username, knownHosts, fingerprint sql.NullString
+	var githubAppID, githubInstallationID sql.NullInt64
+	var updatedBy uuid.NullUUID
+
+	err := row.Scan(
+		&credential.ProjectID,
+		&credential.OrganizationID,
+		&credential.Type,
+		&username,
+		&knownHosts,
+		&fingerprint,
+		&githubAppID,
+		&githubInstallationID,
+		&credential.Secret.KeyID,
+		&credential.Secret.WrappedKey,
+		&credential.Secret.Ciphertext,
+		&updatedBy,
+		&credential.CreatedAt,
+		&credential.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	credential.Username = username.String
+	credential.KnownHosts = knownHosts.String
+	credential.Fingerprint = fingerprint.String
+	credential.GitHubAppID = githubAppID.Int64
+	credential.GitHubInstallationID = githubInstallationID.Int64
+	credential.UpdatedBy = updatedBy.UUID
+
+	return credential, nil
+}
@@ -0,0 +1,219 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+	"github.com/google/uuid"
+)
+
+// EventOutboxRepository implements interfaces.EventOutboxRepository using PostgreSQL
+type EventOutboxRepository struct {
+	db *DB
+}
+
+// NewEventOutboxRepository creates a new EventOutboxRepository
+func NewEventOutboxRepository(db *DB) interfaces.EventOutboxRepository {
+	return &EventOutboxRepository{db: db}
+}
+
+const outboxColumns = `
+	payload, attempts, next_attempt_at, last_error, published_at, created_at
+`
+
+// insertOutboxEvent writes an event into the outbox as part of the caller's
+// transaction. Nil events are ignored.
+func insertOutboxEvent(ctx context.Context, tx *Tx, event *domain.Event) error {
+	if event == nil {
+		return nil
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO event_outbox (
+			id, event_type, organization_id, payload, next_attempt_at, created_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $5
+		)`,
+		event.ID,
+		event.Type,
+		event.OrganizationID,
+		string(payload),
+		event.OccurredAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to write event to outbox: %w", err)
+	}
+
+	return nil
+}
+
+// ClaimPending locks due events in insertion order and leases them to the caller
+func (r *EventOutboxRepository) ClaimPending(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*domain.OutboxEvent, error) {
+	query := `
+		WITH due AS (
+			SELECT id
+			FROM event_outbox
+			WHERE published_at IS NULL AND next_attempt_at <= $1
+			ORDER BY sequence
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		UPDATE event_outbox o
+		SET next_attempt_at = $2
+		FROM due
+		WHERE o.id = due.id
+		RETURNING o.sequence, ` + outboxColumns
+
+	rows, err := r.db.QueryContext(ctx, query, now, now.Add(lease), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim outbox events: %w", err)
+	}
+	defer rows.Close()
+
+	// RETURNING does not preserve the CTE order
+	type claimed struct {
+		sequence int64
+		event    *domain.OutboxEvent
+	}
+	var claims []claimed
+
+	for rows.Next() {
+		var sequence int64
+		event, err := scanOutboxEvent(rows, &sequence)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		claims = append(claims, claimed{sequence: sequence, event: event})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to claim outbox events: %w", err)
+	}
+
+	sort.Slice(claims, func(i, j int) bool {
+		return claims[i].sequence < claims[j].sequence
+	})
+
+	events := make([]*domain.OutboxEvent, len(claims))
+	for i, c := range claims {
+		events[i] = c.event
+	}
+
+	return events, nil
+}
+
+// MarkPublished records that the event has been relayed
+func (r *EventOutboxRepository) MarkPublished(ctx context.Context, id uuid.UUID, publishedAt time.Time) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE event_outbox SET
+			published_at = $2,
+			attempts = attempts + 1,
+			last_error = NULL
+		WHERE id = $1`,
+		id, publishedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to mark outbox event published: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rows == 0 {
+		return fmt.Errorf("outbox event not found")
+	}
+
+	return nil
+}
+
+// MarkFailed records a failed relay attempt and schedules the next one
+func (r *EventOutboxRepository) MarkFailed(ctx context.Context, id uuid.UUID, lastError string, nextAttemptAt time.Time) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE event_outbox SET
+			attempts = attempts + 1,
+			last_error = $2,
+			next_attempt_at = $3
+		WHERE id = $1`,
+		id, lastError, nextAttemptAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to mark outbox event failed: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rows == 0 {
+		return fmt.Errorf("outbox event not found")
+	}
+
+	return nil
+}
+
+// DeletePublished removes events published before the given time
+func (r *EventOutboxRepository) DeletePublished(ctx context.Context, before time.Time) (int, error) {
+	result, err := r.db.ExecContext(ctx,
+		`DELETE FROM event_outbox WHERE published_at IS NOT NULL AND published_at < $1`,
+		before,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete published outbox events: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return int(rows), nil
+}
+
+// scanOutboxEvent reads an outbox event from a row selected with the sequence
+// followed by outboxColumns
+func scanOutboxEvent(row rowScanner, sequence *int64) (*domain.OutboxEvent, error) {
+	event := &domain.OutboxEvent{}
+	var payload []byte
+	var lastError sql.NullString
+	var publishedAt sql.NullTime
+
+	err := row.Scan(
+		sequence,
+		&payload,
+		&event.Attempts,
+		&event.NextAttemptAt,
+		&lastError,
+		&publishedAt,
+		&event.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	event.Event = &domain.Event{}
+	if err := json.Unmarshal(payload, event.Event); err != nil {
+		return nil, fmt.Errorf("failed to decode event payload: %w", err)
+	}
+
+	if lastError.Valid {
+		event.LastError = &lastError.String
+	}
+	if publishedAt.Valid {
+		event.PublishedAt = &publishedAt.Time
+	}
+
+	return event, nil
+}
@@ -2,8 +2,8 @@ package database
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
@@ -26,36 +26,203 @@ func NewFindingRepository(db *DB) interfaces.FindingRepository {
 	}
 }
 
-// CreateBatch creates multiple findings in a single transaction
-func (r *FindingRepository) CreateBatch(ctx context.Context, findings []*domain.Finding) error {
-	if len(findings) == 0 {
+// findingCopyColumns are the columns written when findings are created;
+// only fields that are populated from proto
+var findingCopyColumns = []string{
+	"id", "scan_id", "scan_created_at", "scan_type", "tool_name",
+	"title", "description", "severity",
+	"file_path", "start_line", "code_snippet",
+	"cwe_id", "cve_id", "created_at",
+}
+
+// CreateBatch creates multiple findings of the target's scan, scan type and
+// attempt in a single transaction, together with a finding.critical outbox
+// event for every critical finding. A runner request, if given, is recorded
+// in the transaction; if it was applied before no finding is created and
+// ErrRequestReplayed is returned. Findings of a replaced attempt or a
+// finished sub-scan or scan are refused, see lockIngestTarget.
+func (r *FindingRepository) CreateBatch(ctx context.Context, request *domain.RunnerRequest, target *domain.FindingUpload, findings []*domain.Finding) error {
+	if len(findings) == 0 && request == nil {
 		return nil
 	}
 
 	r.logger.WithField("count", len(findings)).Debug("Creating batch of findings")
 
-	// Build bulk insert query - only insert fields that are populated from proto
-	query := `INSERT INTO findings (
-		id, scan_id, scan_type, tool_name,
-		title, description, severity,
-		file_path, start_line, code_snippet,
-		cwe_id, cve_id, created_at
-	) VALUES `
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// A replay returns the original response, even once the sub-scan finished
+	if err := claimRunnerRequest(ctx, tx, request); err != nil {
+		return err
+	}
+
+	if err := lockIngestTarget(ctx, tx, target); err != nil {
+		return err
+	}
+
+	if err := insertFindings(ctx, tx, findings); err != nil {
+		r.logger.WithError(err).Error("Failed to create findings batch")
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit findings: %w", err)
+	}
+
+	r.logger.WithField("count", len(findings)).Info("Successfully created findings batch")
+	return nil
+}
 
-	values := []interface{}{}
-	placeholders := []string{}
+// lockIngestTarget checks that findings may be created for the target. A
+// retry's dispatch deletes the findings of earlier attempts, so findings of
+// a replaced attempt (ErrStaleAttempt) or of a finished sub-scan
+// (ErrSubScanNotRunning) are refused; for scans without sub-scans those of a
+// finished or deleting scan (ErrScanFinished, ErrScanDeleting). The lock
+// keeps the retry or the status change waiting until the findings commit.
+func lockIngestTarget(ctx context.Context, tx *Tx, target *domain.FindingUpload) error {
+	if target.ScanType == "" {
+		var current domain.ScanStatus
+		err := tx.QueryRowContext(ctx,
+			`SELECT status FROM scans WHERE id = $1 AND created_at = $2 FOR SHARE`,
+			target.ScanID, target.ScanCreatedAt,
+		).Scan(&current)
+		if err == sql.ErrNoRows {
+			return domain.ErrScanNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get scan: %w", err)
+		}
+		if current == domain.ScanStatusDeleting {
+			return domain.ErrScanDeleting
+		}
+		if (&domain.Scan{Status: current}).IsTerminal() {
+			return domain.ErrScanFinished
+		}
+		return nil
+	}
+
+	var current domain.ScanStatus
+	var attempt int
+	err := tx.QueryRowContext(ctx,
+		`SELECT status, attempt FROM sub_scans WHERE scan_id = $1 AND scan_type = $2 FOR SHARE`,
+		target.ScanID, target.ScanType,
+	).Scan(&current, &attempt)
+	if err == sql.ErrNoRows {
+		return domain.ErrSubScanNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get sub-scan: %w", err)
+	}
+	if attempt != target.Attempt {
+		return domain.ErrStaleAttempt
+	}
+	if current != domain.ScanStatusRunning {
+		return domain.ErrSubScanNotRunning
+	}
+	return nil
+}
+
+// UploadBatch creates the findings of batch sequence of an upload in one
+// transaction with the upload's last sequence. A batch committed before
+// isn't created again and returns ErrBatchAlreadyUploaded, a batch that
+// doesn't follow the last one ErrBatchOutOfSequence. It returns the last
+// committed sequence of the upload.
+func (r *FindingRepository) UploadBatch(ctx context.Context, upload *domain.FindingUpload, sequence int64, findings []*domain.Finding) (int64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := lockIngestTarget(ctx, tx, upload); err != nil {
+		return 0, err
+	}
+
+	// Concurrent streams of one upload take turns on its row
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO finding_uploads (scan_id, scan_created_at, scan_type, attempt)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (scan_id, scan_type, attempt) DO NOTHING`,
+		upload.ScanID, upload.ScanCreatedAt, upload.ScanType, upload.Attempt,
+	); err != nil {
+		return 0, fmt.Errorf("failed to create finding upload: %w", err)
+	}
 
+	var lastSequence int64
+	err = tx.QueryRowContext(ctx, `
+		SELECT last_sequence FROM finding_uploads
+		WHERE scan_id = $1 AND scan_type = $2 AND attempt = $3
+		FOR UPDATE`,
+		upload.ScanID, upload.ScanType, upload.Attempt,
+	).Scan(&lastSequence)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get finding upload: %w", err)
+	}
+
+	if sequence <= lastSequence {
+		return lastSequence, domain.ErrBatchAlreadyUploaded
+	}
+	if sequence != lastSequence+1 {
+		return lastSequence, fmt.Errorf("%w: got %d, expected %d", domain.ErrBatchOutOfSequence, sequence, lastSequence+1)
+	}
+
+	if err := insertFindings(ctx, tx, findings); err != nil {
+		return lastSequence, err
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE finding_uploads SET
+			last_sequence = $4,
+			findings_count = findings_count + $5,
+			updated_at = NOW()
+		WHERE scan_id = $1 AND scan_type = $2 AND attempt = $3`,
+		upload.ScanID, upload.ScanType, upload.Attempt, sequence, len(findings),
+	); err != nil {
+		return lastSequence, fmt.Errorf("failed to update finding upload: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return lastSequence, fmt.Errorf("failed to commit findings: %w", err)
+	}
+
+	return sequence, nil
+}
+
+// LastUploadedSequence returns the last committed sequence of an upload, 0
+// if it has none
+func (r *FindingRepository) LastUploadedSequence(ctx context.Context, upload *domain.FindingUpload) (int64, error) {
+	var lastSequence int64
+	err := r.db.QueryRowContext(ctx, `
+		SELECT last_sequence FROM finding_uploads
+		WHERE scan_id = $1 AND scan_type = $2 AND attempt = $3`,
+		upload.ScanID, upload.ScanType, upload.Attempt,
+	).Scan(&lastSequence)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to get finding upload: %w", err)
+	}
+	return lastSequence, nil
+}
+
+// insertFindings copies findings into the findings table and announces
+// critical findings through the outbox in the same transaction
+func insertFindings(ctx context.Context, tx *Tx, findings []*domain.Finding) error {
+	if len(findings) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	rows := make([][]interface{}, len(findings))
 	for i, f := range findings {
-		offset := i * 13
-		placeholders = append(placeholders, fmt.Sprintf(
-			"($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d)",
-			offset+1, offset+2, offset+3, offset+4, offset+5, offset+6, offset+7,
-			offset+8, offset+9, offset+10, offset+11, offset+12, offset+13,
-		))
-
-		values = append(values,
+		rows[i] = []interface{}{
 			f.ID,
 			f.ScanID,
+			f.ScanCreatedAt,
 			f.ScanType,
 			f.ToolName,
 			f.Title,
@@ -66,19 +233,35 @@ func (r *FindingRepository) CreateBatch(ctx context.Context, findings []*domain.
 			f.CodeSnippet,
 			f.CWEID,
 			f.CVEID,
-			time.Now(),
-		)
+			now,
+		}
 	}
 
-	query += strings.Join(placeholders, ", ")
-
-	_, err := r.db.ExecContext(ctx, query, values...)
-	if err != nil {
-		r.logger.WithError(err).Error("Failed to create findings batch")
+	if err := tx.CopyIn(ctx, "findings", findingCopyColumns, rows); err != nil {
 		return fmt.Errorf("failed to create findings: %w", err)
 	}
 
-	r.logger.WithField("count", len(findings)).Info("Successfully created findings batch")
+	organizations := map[uuid.UUID]uuid.UUID{}
+	for _, f := range findings {
+		if f.Severity != domain.SeverityCritical {
+			continue
+		}
+
+		orgID, ok := organizations[f.ScanID]
+		if !ok {
+			err := tx.QueryRowContext(ctx, `SELECT organization_id FROM scans WHERE id = $1 AND created_at = $2`, f.ScanID, f.ScanCreatedAt).Scan(&orgID)
+			if err != nil {
+				return fmt.Errorf("failed to get scan organization: %w", err)
+			}
+			organizations[f.ScanID] = orgID
+		}
+
+		scan := &domain.Scan{ID: f.ScanID, OrganizationID: orgID}
+		if err := insertOutboxEvent(ctx, tx, domain.NewCriticalFindingEvent(scan, f)); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -198,3 +381,38 @@ func (r *FindingRepository) DeleteByScanID(ctx context.Context, scanID uuid.UUID
 	r.logger.WithField("deleted_count", rowsAffected).Info("Successfully deleted findings")
 	return nil
 }
+
+// ReconcileOrphans fills in scan_created_at for up to limit findings written
+// before the column existed, and deletes those whose scan is gone. Rows are
+// claimed with SKIP LOCKED so replicas can reconcile concurrently.
+func (r *FindingRepository) ReconcileOrphans(ctx context.Context, limit int) (int, int, error) {
+	query := `
+		WITH batch AS (
+			SELECT f.id, s.created_at AS scan_created_at
+			FROM findings f
+			LEFT JOIN scans s ON s.id = f.scan_id
+			WHERE f.scan_created_at IS NULL
+			LIMIT $1
+			FOR UPDATE OF f SKIP LOCKED
+		), backfilled AS (
+			UPDATE findings f
+			SET scan_created_at = b.scan_created_at
+			FROM batch b
+			WHERE f.id = b.id AND b.scan_created_at IS NOT NULL
+			RETURNING f.id
+		), deleted AS (
+			DELETE FROM findings f
+			USING batch b
+			WHERE f.id = b.id AND b.scan_created_at IS NULL
+			RETURNING f.id
+		)
+		SELECT (SELECT COUNT(*) FROM backfilled), (SELECT COUNT(*) FROM deleted)
+	`
+
+	var backfilled, deleted int
+	if err := r.db.QueryRowContext(ctx, query, limit).Scan(&backfilled, &deleted); err != nil {
+		return 0, 0, fmt.Errorf("failed to reconcile findings: %w", err)
+	}
+
+	return backfilled, deleted, nil
+}
@@ -0,0 +1,170 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// IntegrationRepository implements interfaces.IntegrationRepository using PostgreSQL
+type IntegrationRepository struct {
+	db *DB
+}
+
+// NewIntegrationRepository creates a new IntegrationRepository
+func NewIntegrationRepository(db *DB) interfaces.IntegrationRepository {
+	return &IntegrationRepository{db: db}
+}
+
+const integrationColumns = `
+	id, organization_id, project_id, provider, repository_url,
+	webhook_secret, scan_types, enabled, created_at, updated_at
+`
+
+// Create creates a new repository integration
+func (r *IntegrationRepository) Create(ctx context.Context, integration *domain.RepositoryIntegration) error {
+	query := `
+		INSERT INTO repository_integrations (
+			id, organization_id, project_id, provider, repository_url,
+			webhook_secret, scan_types, enabled, created_at, updated_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+		)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		integration.ID,
+		integration.OrganizationID,
+		integration.ProjectID,
+		integration.Provider,
+		integration.RepositoryURL,
+		integration.WebhookSecret,
+		pq.Array(integration.ScanTypes),
+		integration.Enabled,
+		integration.CreatedAt,
+		integration.UpdatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to create repository integration: %w", err)
+	}
+
+	return nil
+}
+
+// Get retrieves an integration by ID
+func (r *IntegrationRepository) Get(ctx context.Context, id uuid.UUID) (*domain.RepositoryIntegration, error) {
+	query := `SELECT ` + integrationColumns + ` FROM repository_integrations WHERE id = $1`
+
+	integration, err := scanIntegration(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("repository integration not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repository integration: %w", err)
+	}
+
+	return integration, nil
+}
+
+// GetByRepository retrieves the integration for a normalized repository URL
+func (r *IntegrationRepository) GetByRepository(ctx context.Context, provider domain.GitProvider, repositoryURL string) (*domain.RepositoryIntegration, error) {
+	query := `SELECT ` + integrationColumns + `
+		FROM repository_integrations
+		WHERE provider = $1 AND repository_url = $2`
+
+	integration, err := scanIntegration(r.db.QueryRowContext(ctx, query, provider, repositoryURL))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("repository integration not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repository integration: %w", err)
+	}
+
+	return integration, nil
+}
+
+// List retrieves integrations for an organization, optionally narrowed to a project
+func (r *IntegrationRepository) List(ctx context.Context, organizationID uuid.UUID, projectID *uuid.UUID) ([]*domain.RepositoryIntegration, error) {
+	query := `SELECT ` + integrationColumns + ` FROM repository_integrations WHERE organization_id = $1`
+	args := []interface{}{organizationID}
+
+	if projectID != nil {
+		query += " AND project_id = $2"
+		args = append(args, *projectID)
+	}
+
+	query += " ORDER BY created_at DESC"
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list repository integrations: %w", err)
+	}
+	defer rows.Close()
+
+	integrations := []*domain.RepositoryIntegration{}
+	for rows.Next() {
+		integration, err := scanIntegration(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		integrations = append(integrations, integration)
+	}
+
+	return integrations, rows.Err()
+}
+
+// Delete deletes an integration
+func (r *IntegrationRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM repository_integrations WHERE id = $1`
+
+	result, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete repository integration: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rows == 0 {
+		return fmt.Errorf("repository integration not found")
+	}
+
+	return nil
+}
+
+// scanIntegration reads an integration from a row selected with integrationColumns
+func scanIntegration(row rowScanner) (*domain.RepositoryIntegration, error) {
+	integration := &domain.RepositoryIntegration{}
+	var scanTypes pq.StringArray
+
+	err := row.Scan(
+		&integration.ID,
+		&integration.OrganizationID,
+		&integration.ProjectID,
+		&integration.Provider,
+		&integration.RepositoryURL,
+		&integration.WebhookSecret,
+		&scanTypes,
+		&integration.Enabled,
+		&integration.CreatedAt,
+		&integration.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	integration.ScanTypes = make([]domain.ScanType, len(scanTypes))
+	for i, st := range scanTypes {
+		integration.ScanTypes[i] = domain.ScanType(st)
+	}
+
+	return integration, nil
+}
@@ -0,0 +1,239 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// JobProfileRepository implements interfaces.JobProfileRepository using PostgreSQL
+type JobProfileRepository struct {
+	db *DB
+}
+
+// NewJobProfileRepository creates a new JobProfileRepository
+func NewJobProfileRepository(db *DB) interfaces.JobProfileRepository {
+	return &JobProfileRepository{db: db}
+}
+
+const jobProfileColumns = `
+	project_id, organization_id, settings, updated_by, created_at, updated_at
+`
+
+const jobLimitsColumns = `
+	organization_id, max_cpu, max_memory, max_ephemeral_storage,
+	max_deadline_seconds, max_backoff_limit, allowed_priority_classes,
+	updated_by, created_at, updated_at
+`
+
+// SetProfile creates or replaces the project's profile
+func (r *JobProfileRepository) SetProfile(ctx context.Context, profile *domain.JobProfile) error {
+	settings, err := json.Marshal(profile.Settings)
+	if err != nil {
+		return fmt.Errorf("failed to encode job settings: %w", err)
+	}
+
+	inOrganization, err := projectInOrganization(ctx, r.db, profile.ProjectID, profile.OrganizationID)
+	if err != nil {
+		return err
+	}
+	if !inOrganization {
+		return domain.ErrProjectNotFound
+	}
+
+	// A profile stays with its organization; one of another organization
+	// isn't replaced
+	query := `
+		INSERT INTO job_profiles (
+			project_id, organization_id, settings, updated_by, created_at, updated_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6
+		)
+		ON CONFLICT (project_id) DO UPDATE SET
+			settings = EXCLUDED.settings,
+			updated_by = EXCLUDED.updated_by
+		WHERE job_profiles.organization_id = EXCLUDED.organization_id
+		RETURNING created_at, updated_at
+	`
+
+	err = r.db.QueryRowContext(ctx, query,
+		profile.ProjectID,
+		profile.OrganizationID,
+		string(settings),
+		nullUUID(profile.UpdatedBy),
+		profile.CreatedAt,
+		profile.UpdatedAt,
+	).Scan(&profile.CreatedAt, &profile.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return domain.ErrProjectNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to set job profile: %w", err)
+	}
+
+	return nil
+}
+
+// GetProfile returns the project's profile
+func (r *JobProfileRepository) GetProfile(ctx context.Context, projectID uuid.UUID) (*domain.JobProfile, error) {
+	query := `SELECT ` + jobProfileColumns + ` FROM job_profiles WHERE project_id = $1`
+
+	profile, err := scanJobProfile(r.db.QueryRowContext(ctx, query, projectID))
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrJobProfileNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job profile: %w", err)
+	}
+
+	return profile, nil
+}
+
+// DeleteProfile deletes the project's profile
+func (r *JobProfileRepository) DeleteProfile(ctx context.Context, projectID uuid.UUID) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM job_profiles WHERE project_id = $1`, projectID)
+	if err != nil {
+		return fmt.Errorf("failed to delete job profile: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return domain.ErrJobProfileNotFound
+	}
+
+	return nil
+}
+
+// SetLimits creates or replaces the organization's limits
+func (r *JobProfileRepository) SetLimits(ctx context.Context, limits *domain.JobLimits) error {
+	query := `
+		INSERT INTO job_limits (
+			organization_id, max_cpu, max_memory, max_ephemeral_storage,
+			max_deadline_seconds, max_backoff_limit, allowed_priority_classes,
+			updated_by, created_at, updated_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+		)
+		ON CONFLICT (organization_id) DO UPDATE SET
+			max_cpu = EXCLUDED.max_cpu,
+			max_memory = EXCLUDED.max_memory,
+			max_ephemeral_storage = EXCLUDED.max_ephemeral_storage,
+			max_deadline_seconds = EXCLUDED.max_deadline_seconds,
+			max_backoff_limit = EXCLUDED.max_backoff_limit,
+			allowed_priority_classes = EXCLUDED.allowed_priority_classes,
+			updated_by = EXCLUDED.updated_by
+		RETURNING created_at, updated_at
+	`
+
+	allowed := limits.AllowedPriorityClasses
+	if allowed == nil {
+		allowed = []string{}
+	}
+
+	err := r.db.QueryRowContext(ctx, query,
+		limits.OrganizationID,
+		sql.NullString{String: limits.MaxCPU, Valid: limits.MaxCPU != ""},
+		sql.NullString{String: limits.MaxMemory, Valid: limits.MaxMemory != ""},
+		sql.NullString{String: limits.MaxEphemeralStorage, Valid: limits.MaxEphemeralStorage != ""},
+		limits.MaxDeadlineSeconds,
+		limits.MaxBackoffLimit,
+		pq.Array(allowed),
+		nullUUID(limits.UpdatedBy),
+		limits.CreatedAt,
+		limits.UpdatedAt,
+	).Scan(&limits.CreatedAt, &limits.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to set job limits: %w", err)
+	}
+
+	return nil
+}
+
+// GetLimits returns the organization's limits, or nil if it has none
+func (r *JobProfileRepository) GetLimits(ctx context.Context, organizationID uuid.UUID) (*domain.JobLimits, error) {
+	query := `SELECT ` + jobLimitsColumns + ` FROM job_limits WHERE organization_id = $1`
+
+	limits, err := scanJobLimits(r.db.QueryRowContext(ctx, query, organizationID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job limits: %w", err)
+	}
+
+	return limits, nil
+}
+
+// scanJobProfile reads a profile from a row selected with jobProfileColumns
+func scanJobProfile(row rowScanner) (*domain.JobProfile, error) {
+	profile := &domain.JobProfile{}
+	var settings []byte
+	var updatedBy uuid.NullUUID
+
+	err := row.Scan(
+		&profile.ProjectID,
+		&profile.OrganizationID,
+		&settings,
+		&updatedBy,
+		&profile.CreatedAt,
+		&profile.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(settings, &profile.Settings); err != nil {
+		return nil, fmt.Errorf("failed to decode job settings: %w", err)
+	}
+	profile.UpdatedBy = updatedBy.UUID
+
+	return profile, nil
+}
+
+// scanJobLimits reads limits from a row selected with jobLimitsColumns
+func scanJobLimits(row rowScanner) (*domain.JobLimits, error) {
+	limits := &domain.JobLimits{}
+	var maxCPU, maxMemory, maxEphemeralStorage sql.NullString
+	var maxBackoffLimit sql.NullInt32
+	var updatedBy uuid.NullUUID
+
+	err := row.Scan(
+		&limits.OrganizationID,
+		&maxCPU,
+		&maxMemory,
+		&maxEphemeralStorage,
+		&limits.MaxDeadlineSeconds,
+		&maxBackoffLimit,
+		pq.Array(&limits.AllowedPriorityClasses),
+		&updatedBy,
+		&limits.CreatedAt,
+		&limits.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	limits.MaxCPU = maxCPU.String
+	limits.MaxMemory = maxMemory.String
+	limits.MaxEphemeralStorage = maxEphemeralStorage.String
+	if maxBackoffLimit.Valid {
+		limits.MaxBackoffLimit = &maxBackoffLimit.Int32
+	}
+	limits.UpdatedBy = updatedBy.UUID
+
+	return limits, nil
+}
+
+// nullUUID returns a NULL for the nil UUID
+func nullUUID(id uuid.UUID) uuid.NullUUID {
+	return uuid.NullUUID{UUID: id, Valid: id != uuid.Nil}
+}
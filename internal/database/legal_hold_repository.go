@@ -0,0 +1,386 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+	"github.com/google/uuid"
+)
+
+// LegalHoldRepository implements interfaces.LegalHoldRepository using PostgreSQL
+type LegalHoldRepository struct {
+	db *DB
+}
+
+// NewLegalHoldRepository creates a new LegalHoldRepository
+func NewLegalHoldRepository(db *DB) interfaces.LegalHoldRepository {
+	return &LegalHoldRepository{db: db}
+}
+
+const legalHoldColumns = `
+	h.id, h.organization_id, h.project_id, h.scan_id,
+	h.reason, h.requested_by, h.placed_at,
+	h.released_at, h.released_by, h.release_reason
+`
+
+// heldScanCondition matches scans, aliased s, covered by an active legal hold
+const heldScanCondition = `EXISTS (
+	SELECT 1 FROM legal_holds h
+	WHERE h.released_at IS NULL
+		AND h.organization_id = s.organization_id
+		AND (h.project_id IS NULL OR h.project_id = s.project_id)
+		AND (h.scan_id IS NULL OR h.scan_id = s.id)
+)`
+
+// queryRower is implemented by both DB and Tx
+type queryRower interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// projectInOrganization reports whether the project belongs to the
+// organization. Projects are known from their row or, if they have none,
+// their scans.
+func projectInOrganization(ctx context.Context, q queryRower, projectID, organizationID uuid.UUID) (bool, error) {
+	var inOrganization bool
+	err := q.QueryRowContext(ctx, `
+		SELECT COUNT(*) = 1 AND bool_and(organization_id = $2)
+		FROM (
+			SELECT organization_id FROM projects WHERE id = $1
+			UNION
+			SELECT organization_id FROM scans WHERE project_id = $1
+		) o`,
+		projectID, organizationID,
+	).Scan(&inOrganization)
+	if err != nil {
+		return false, fmt.Errorf("failed to get project: %w", err)
+	}
+	return inOrganization, nil
+}
+
+// Place creates an active hold and records it in the audit log
+func (r *LegalHoldRepository) Place(ctx context.Context, hold *domain.LegalHold, source domain.AuditSource) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	switch {
+	case hold.ScanID != nil:
+		// A scan hold is scoped by the scan's own organization and project.
+		// Locking the scan orders the hold with a concurrent StartScanDeletion:
+		// either the deletion sees the hold, or the hold sees the scan deleting
+		// and the scan deleter refuses the deletion.
+		var organizationID, projectID uuid.UUID
+		err := tx.QueryRowContext(ctx,
+			`SELECT organization_id, project_id FROM scans WHERE id = $1 FOR UPDATE`,
+			*hold.ScanID,
+		).Scan(&organizationID, &projectID)
+		if err == sql.ErrNoRows || (err == nil && organizationID != hold.OrganizationID) {
+			return domain.ErrScanNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get scan: %w", err)
+		}
+		if hold.ProjectID != nil && *hold.ProjectID != projectID {
+			return domain.ErrScanNotFound
+		}
+		hold.ProjectID = &projectID
+
+	case hold.ProjectID != nil:
+		inOrganization, err := projectInOrganization(ctx, tx, *hold.ProjectID, hold.OrganizationID)
+		if err != nil {
+			return err
+		}
+		if !inOrganization {
+			return domain.ErrProjectNotFound
+		}
+
+	default:
+		var exists bool
+		if err := tx.QueryRowContext(ctx, `
+			SELECT EXISTS (SELECT 1 FROM organizations WHERE id = $1)
+				OR EXISTS (SELECT 1 FROM scans WHERE organization_id = $1)`,
+			hold.OrganizationID,
+		).Scan(&exists); err != nil {
+			return fmt.Errorf("failed to get organization: %w", err)
+		}
+		if !exists {
+			return domain.ErrOrganizationNotFound
+		}
+	}
+
+	query := `
+		INSERT INTO legal_holds (
+			id, organization_id, project_id, scan_id,
+			reason, requested_by, placed_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7
+		)
+	`
+
+	if _, err := tx.ExecContext(ctx, query,
+		hold.ID,
+		hold.OrganizationID,
+		hold.ProjectID,
+		hold.ScanID,
+		hold.Reason,
+		hold.RequestedBy,
+		hold.PlacedAt,
+	); err != nil {
+		return fmt.Errorf("failed to create legal hold: %w", err)
+	}
+
+	if err := insertAuditLog(ctx, tx,
+		hold.OrganizationID, hold.RequestedBy,
+		domain.AuditActionLegalHoldPlaced, "legal_hold", hold.ID,
+		legalHoldAuditMetadata(hold, hold.Reason), source, hold.PlacedAt,
+	); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// Release releases an active hold and records it in the audit log
+func (r *LegalHoldRepository) Release(ctx context.Context, id, releasedBy uuid.UUID, reason string, source domain.AuditSource) (*domain.LegalHold, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	hold, err := scanLegalHold(tx.QueryRowContext(ctx,
+		`SELECT `+legalHoldColumns+` FROM legal_holds h WHERE h.id = $1 FOR UPDATE`,
+		id,
+	))
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrLegalHoldNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get legal hold: %w", err)
+	}
+
+	if !hold.IsActive() {
+		return nil, domain.ErrLegalHoldReleased
+	}
+
+	now := time.Now()
+	hold.ReleasedAt = &now
+	hold.ReleasedBy = &releasedBy
+	if reason != "" {
+		hold.ReleaseReason = &reason
+	}
+
+	if _, err := tx.ExecContext(ctx,
+		`UPDATE legal_holds SET released_at = $2, released_by = $3, release_reason = $4 WHERE id = $1`,
+		hold.ID, hold.ReleasedAt, hold.ReleasedBy, hold.ReleaseReason,
+	); err != nil {
+		return nil, fmt.Errorf("failed to release legal hold: %w", err)
+	}
+
+	if err := insertAuditLog(ctx, tx,
+		hold.OrganizationID, releasedBy,
+		domain.AuditActionLegalHoldReleased, "legal_hold", hold.ID,
+		legalHoldAuditMetadata(hold, reason), source, now,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return hold, nil
+}
+
+// Get retrieves a hold by ID
+func (r *LegalHoldRepository) Get(ctx context.Context, id uuid.UUID) (*domain.LegalHold, error) {
+	query := `SELECT ` + legalHoldColumns + ` FROM legal_holds h WHERE h.id = $1`
+
+	hold, err := scanLegalHold(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrLegalHoldNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get legal hold: %w", err)
+	}
+
+	return hold, nil
+}
+
+// List retrieves holds with optional filters, newest first
+func (r *LegalHoldRepository) List(ctx context.Context, filter interfaces.LegalHoldFilter) ([]*domain.LegalHold, error) {
+	query := `SELECT ` + legalHoldColumns + ` FROM legal_holds h WHERE 1=1`
+
+	args := []interface{}{}
+	argPos := 1
+
+	if filter.OrganizationID != nil {
+		query += fmt.Sprintf(" AND h.organization_id = $%d", argPos)
+		args = append(args, *filter.OrganizationID)
+		argPos++
+	}
+
+	if filter.ProjectID != nil {
+		query += fmt.Sprintf(" AND h.project_id = $%d", argPos)
+		args = append(args, *filter.ProjectID)
+		argPos++
+	}
+
+	if filter.ScanID != nil {
+		query += fmt.Sprintf(" AND h.scan_id = $%d", argPos)
+		args = append(args, *filter.ScanID)
+		argPos++
+	}
+
+	if filter.ActiveOnly {
+		query += " AND h.released_at IS NULL"
+	}
+
+	query += " ORDER BY h.placed_at DESC"
+
+	if filter.Limit > 0 {
+		query += fmt.Sprintf(" LIMIT $%d", argPos)
+		args = append(args, filter.Limit)
+		argPos++
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list legal holds: %w", err)
+	}
+	defer rows.Close()
+
+	holds := []*domain.LegalHold{}
+	for rows.Next() {
+		hold, err := scanLegalHold(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		holds = append(holds, hold)
+	}
+
+	return holds, rows.Err()
+}
+
+// GetActiveForScan returns an active hold covering the scan, or nil if there is none
+func (r *LegalHoldRepository) GetActiveForScan(ctx context.Context, scanID uuid.UUID) (*domain.LegalHold, error) {
+	return activeScanHold(ctx, r.db, scanID)
+}
+
+// activeScanHold returns the oldest active hold covering the scan, or nil
+func activeScanHold(ctx context.Context, q queryRower, scanID uuid.UUID) (*domain.LegalHold, error) {
+	query := `
+		SELECT ` + legalHoldColumns + `
+		FROM legal_holds h
+		JOIN scans s ON s.organization_id = h.organization_id
+			AND (h.project_id IS NULL OR h.project_id = s.project_id)
+			AND (h.scan_id IS NULL OR h.scan_id = s.id)
+		WHERE s.id = $1 AND h.released_at IS NULL
+		ORDER BY h.placed_at
+		LIMIT 1
+	`
+
+	hold, err := scanLegalHold(q.QueryRowContext(ctx, query, scanID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to check legal holds: %w", err)
+	}
+
+	return hold, nil
+}
+
+// activeProjectHold returns the oldest active hold covering the project, its
+// organization or any of its scans, or nil
+func activeProjectHold(ctx context.Context, q queryRower, projectID uuid.UUID) (*domain.LegalHold, error) {
+	query := `
+		SELECT ` + legalHoldColumns + `
+		FROM legal_holds h
+		WHERE h.released_at IS NULL
+			AND (h.project_id = $1 OR (h.project_id IS NULL AND h.organization_id IN (
+				SELECT organization_id FROM projects WHERE id = $1
+				UNION
+				SELECT organization_id FROM scans WHERE project_id = $1
+			)))
+		ORDER BY h.placed_at
+		LIMIT 1
+	`
+
+	hold, err := scanLegalHold(q.QueryRowContext(ctx, query, projectID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to check legal holds: %w", err)
+	}
+
+	return hold, nil
+}
+
+// legalHoldAuditMetadata describes a hold change for the audit log
+func legalHoldAuditMetadata(hold *domain.LegalHold, reason string) map[string]interface{} {
+	metadata := map[string]interface{}{
+		"scope":  string(hold.Scope()),
+		"reason": reason,
+	}
+	if hold.ProjectID != nil {
+		metadata["project_id"] = hold.ProjectID.String()
+	}
+	if hold.ScanID != nil {
+		metadata["scan_id"] = hold.ScanID.String()
+	}
+	return metadata
+}
+
+// scanLegalHold reads a hold from a row selected with legalHoldColumns
+func scanLegalHold(row rowScanner) (*domain.LegalHold, error) {
+	hold := &domain.LegalHold{}
+	var projectID, scanID, releasedBy uuid.NullUUID
+	var releasedAt sql.NullTime
+	var releaseReason sql.NullString
+
+	err := row.Scan(
+		&hold.ID,
+		&hold.OrganizationID,
+		&projectID,
+		&scanID,
+		&hold.Reason,
+		&hold.RequestedBy,
+		&hold.PlacedAt,
+		&releasedAt,
+		&releasedBy,
+		&releaseReason,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if projectID.Valid {
+		hold.ProjectID = &projectID.UUID
+	}
+	if scanID.Valid {
+		hold.ScanID = &scanID.UUID
+	}
+	if releasedAt.Valid {
+		hold.ReleasedAt = &releasedAt.Time
+	}
+	if releasedBy.Valid {
+		hold.ReleasedBy = &releasedBy.UUID
+	}
+	if releaseReason.Valid {
+		hold.ReleaseReason = &releaseReason.String
+	}
+
+	return hold, nil
+}
@@ -0,0 +1,546 @@
+package database
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/migrations"
+	log "github.com/sirupsen/logrus"
+)
+
+// migrationLockID is the Postgres advisory lock held while migrating, so
+// replicas starting together apply each migration once
+const migrationLockID = 0x636c6f7564736361 // "cloudsca"
+
+// EmbeddedMigrations selects the migrations built into the binary
+const EmbeddedMigrations = "embedded"
+
+var migrationFilePattern = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+// Migration is a versioned schema change
+type Migration struct {
+	Version  int
+	Name     string
+	Up       string
+	Down     string // empty if the migration can't be reverted
+	Checksum string // SHA-256 of Up
+}
+
+// MigrationState is the state of a migration in the database
+type MigrationState string
+
+const (
+	MigrationPending  MigrationState = "pending"
+	MigrationApplied  MigrationState = "applied"
+	MigrationModified MigrationState = "modified" // applied, but the file changed since
+	MigrationMissing  MigrationState = "missing"  // applied, but unknown to this binary
+)
+
+// MigrationStatus describes a migration and whether it is applied
+type MigrationStatus struct {
+	Version   int
+	Name      string
+	State     MigrationState
+	AppliedAt *time.Time
+}
+
+// appliedMigration is a row of schema_migrations
+type appliedMigration struct {
+	version   int
+	name      string
+	checksum  string
+	appliedAt time.Time
+}
+
+// MigrationsSource returns the migration files selected by path: "embedded"
+// (or empty) for the migrations built into the binary, otherwise a directory,
+// optionally prefixed with file://
+func MigrationsSource(path string) (fs.FS, error) {
+	if path == "" || path == EmbeddedMigrations {
+		return migrations.FS, nil
+	}
+
+	dir := strings.TrimPrefix(path, "file://")
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open migrations directory: %w", err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("migrations path is not a directory: %s", dir)
+	}
+	return os.DirFS(dir), nil
+}
+
+// LoadMigrations reads the migrations in fsys, sorted by version
+func LoadMigrations(fsys fs.FS) ([]*Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations: %w", err)
+	}
+
+	byVersion := make(map[int]*Migration)
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".sql") {
+			continue
+		}
+
+		match := migrationFilePattern.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("invalid migration file name: %s", entry.Name())
+		}
+		version, _ := strconv.Atoi(match[1])
+
+		content, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %s: %w", entry.Name(), err)
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		} else if migration.Name != match[2] {
+			return nil, fmt.Errorf("migration %d has conflicting names: %s and %s", version, migration.Name, match[2])
+		}
+
+		if match[3] == "up" {
+			migration.Up = string(content)
+			sum := sha256.Sum256(content)
+			migration.Checksum = hex.EncodeToString(sum[:])
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	result := make([]*Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" {
+			return nil, fmt.Errorf("migration %d_%s has no up file", migration.Version, migration.Name)
+		}
+		result = append(result, migration)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Version < result[j].Version })
+
+	return result, nil
+}
+
+// Migrator applies and reverts migrations. Every migration runs in its own
+// transaction together with its schema_migrations row, while an advisory lock
+// keeps other replicas out.
+type Migrator struct {
+	db         *sql.DB
+	migrations []*Migration
+	logger     *log.Entry
+}
+
+// NewMigrator creates a migrator for the migrations in fsys
+func NewMigrator(db *sql.DB, fsys fs.FS) (*Migrator, error) {
+	loaded, err := LoadMigrations(fsys)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{
+		db:         db,
+		migrations: loaded,
+		logger:     log.WithField("component", "migrator"),
+	}, nil
+}
+
+// RunMigrations applies all pending migrations from migrationsPath
+func RunMigrations(ctx context.Context, db *sql.DB, migrationsPath string) error {
+	fsys, err := MigrationsSource(migrationsPath)
+	if err != nil {
+		return err
+	}
+
+	migrator, err := NewMigrator(db, fsys)
+	if err != nil {
+		return err
+	}
+
+	return migrator.Up(ctx)
+}
+
+// Latest returns the highest known migration version
+func (m *Migrator) Latest() int {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+// Status lists every known and every applied migration
+func (m *Migrator) Status(ctx context.Context) ([]MigrationStatus, error) {
+	var statuses []MigrationStatus
+
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		applied, err := m.applied(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for _, migration := range m.migrations {
+			status := MigrationStatus{Version: migration.Version, Name: migration.Name, State: MigrationPending}
+			if row, ok := applied[migration.Version]; ok {
+				status.State = MigrationApplied
+				if row.checksum != migration.Checksum {
+					status.State = MigrationModified
+				}
+				status.AppliedAt = &row.appliedAt
+				delete(applied, migration.Version)
+			}
+			statuses = append(statuses, status)
+		}
+
+		for _, row := range applied {
+			appliedAt := row.appliedAt
+			statuses = append(statuses, MigrationStatus{
+				Version:   row.version,
+				Name:      row.name,
+				State:     MigrationMissing,
+				AppliedAt: &appliedAt,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Version < statuses[j].Version })
+	return statuses, nil
+}
+
+// Up applies all pending migrations
+func (m *Migrator) Up(ctx context.Context) error {
+	return m.To(ctx, m.Latest())
+}
+
+// Down reverts the given number of most recently applied migrations
+func (m *Migrator) Down(ctx context.Context, steps int) error {
+	if steps < 1 {
+		return fmt.Errorf("steps must be at least 1")
+	}
+
+	return m.withLock(ctx, func(conn *sql.Conn) error {
+		applied, err := m.verify(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for i := len(m.migrations) - 1; i >= 0 && steps > 0; i-- {
+			migration := m.migrations[i]
+			if _, ok := applied[migration.Version]; !ok {
+				continue
+			}
+			if err := m.revert(ctx, conn, migration); err != nil {
+				return err
+			}
+			steps--
+		}
+		return nil
+	})
+}
+
+// To migrates up or down until version is the latest applied migration.
+// Version 0 reverts every migration.
+func (m *Migrator) To(ctx context.Context, version int) error {
+	if version < 0 {
+		return fmt.Errorf("invalid migration version: %d", version)
+	}
+	if version != 0 && m.find(version) == nil {
+		return fmt.Errorf("unknown migration version: %d", version)
+	}
+
+	return m.withLock(ctx, func(conn *sql.Conn) error {
+		applied, err := m.verify(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		// Revert newer migrations, newest first
+		for i := len(m.migrations) - 1; i >= 0; i-- {
+			migration := m.migrations[i]
+			if migration.Version <= version {
+				break
+			}
+			if _, ok := applied[migration.Version]; ok {
+				if err := m.revert(ctx, conn, migration); err != nil {
+					return err
+				}
+			}
+		}
+
+		// Apply pending migrations, oldest first
+		pending := 0
+		for _, migration := range m.migrations {
+			if migration.Version > version {
+				break
+			}
+			if _, ok := applied[migration.Version]; ok {
+				continue
+			}
+			if err := m.apply(ctx, conn, migration); err != nil {
+				return err
+			}
+			pending++
+		}
+
+		if pending == 0 {
+			m.logger.WithField("version", version).Info("Database schema is up to date")
+		}
+		return nil
+	})
+}
+
+// withLock runs fn on a dedicated connection holding the migration lock. The
+// lock is session scoped, so everything must run on that connection.
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *sql.Conn) error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get connection: %w", err)
+	}
+	defer conn.Close()
+
+	m.logger.Debug("Acquiring migration lock")
+	if _, err := conn.ExecContext(ctx, `SELECT pg_advisory_lock($1)`, int64(migrationLockID)); err != nil {
+		return fmt.Errorf("failed to acquire migration lock: %w", err)
+	}
+	defer func() {
+		// Use a fresh context so the lock is released even if ctx was cancelled
+		if _, err := conn.ExecContext(context.Background(), `SELECT pg_advisory_unlock($1)`, int64(migrationLockID)); err != nil {
+			m.logger.WithError(err).Warn("Failed to release migration lock")
+		}
+	}()
+
+	if _, err := conn.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			version BIGINT PRIMARY KEY,
+			name TEXT NOT NULL,
+			checksum TEXT NOT NULL,
+			applied_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+		)
+	`); err != nil {
+		return fmt.Errorf("failed to create schema_migrations table: %w", err)
+	}
+
+	if err := m.adoptLiquibase(ctx, conn); err != nil {
+		return err
+	}
+
+	return fn(conn)
+}
+
+// applied returns the applied migrations by version
+func (m *Migrator) applied(ctx context.Context, conn *sql.Conn) (map[int]appliedMigration, error) {
+	rows, err := conn.QueryContext(ctx, `SELECT version, name, checksum, applied_at FROM schema_migrations`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list applied migrations: %w", err)
+	}
+	defer rows.Close()
+
+	applied := make(map[int]appliedMigration)
+	for rows.Next() {
+		var row appliedMigration
+		if err := rows.Scan(&row.version, &row.name, &row.checksum, &row.appliedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		applied[row.version] = row
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list applied migrations: %w", err)
+	}
+
+	return applied, nil
+}
+
+// verify returns the applied migrations after checking that none of them was
+// modified. Applied migrations unknown to this binary come from a newer
+// release; they are tolerated so older replicas keep starting during a rollout.
+func (m *Migrator) verify(ctx context.Context, conn *sql.Conn) (map[int]appliedMigration, error) {
+	applied, err := m.applied(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+
+	for version, row := range applied {
+		migration := m.find(version)
+		if migration == nil {
+			m.logger.WithFields(log.Fields{
+				"version": version,
+				"name":    row.name,
+			}).Warn("Database has a migration unknown to this release")
+			continue
+		}
+		if row.checksum != migration.Checksum {
+			return nil, fmt.Errorf("checksum mismatch for migration %d_%s: applied migrations must not be modified", version, migration.Name)
+		}
+	}
+
+	return applied, nil
+}
+
+// apply runs a migration and records it
+func (m *Migrator) apply(ctx context.Context, conn *sql.Conn, migration *Migration) error {
+	logger := m.logger.WithFields(log.Fields{
+		"version": migration.Version,
+		"name":    migration.Name,
+	})
+	logger.Info("Applying migration")
+	start := time.Now()
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, migration.Up); err != nil {
+		return fmt.Errorf("failed to apply migration %d_%s: %w", migration.Version, migration.Name, err)
+	}
+
+	if _, err := tx.ExecContext(ctx,
+		`INSERT INTO schema_migrations (version, name, checksum) VALUES ($1, $2, $3)`,
+		migration.Version, migration.Name, migration.Checksum,
+	); err != nil {
+		return fmt.Errorf("failed to record migration %d: %w", migration.Version, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit migration %d: %w", migration.Version, err)
+	}
+
+	logger.WithField("duration", time.Since(start)).Info("Applied migration")
+	return nil
+}
+
+// revert runs a migration's down file and forgets it
+func (m *Migrator) revert(ctx context.Context, conn *sql.Conn, migration *Migration) error {
+	if migration.Down == "" {
+		return fmt.Errorf("migration %d_%s can't be reverted: no down file", migration.Version, migration.Name)
+	}
+
+	logger := m.logger.WithFields(log.Fields{
+		"version": migration.Version,
+		"name":    migration.Name,
+	})
+	logger.Info("Reverting migration")
+	start := time.Now()
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, migration.Down); err != nil {
+		return fmt.Errorf("failed to revert migration %d_%s: %w", migration.Version, migration.Name, err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM schema_migrations WHERE version = $1`, migration.Version); err != nil {
+		return fmt.Errorf("failed to unrecord migration %d: %w", migration.Version, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit migration %d: %w", migration.Version, err)
+	}
+
+	logger.WithField("duration", time.Since(start)).Info("Reverted migration")
+	return nil
+}
+
+func (m *Migrator) find(version int) *Migration {
+	for _, migration := range m.migrations {
+		if migration.Version == version {
+			return migration
+		}
+	}
+	return nil
+}
+
+// liquibaseChangesets maps the last Liquibase changeset of each migration, for
+// databases whose schema was applied with the former Liquibase changelog
+var liquibaseChangesets = []struct {
+	changeset string
+	version   int
+}{
+	{"9", 1},
+	{"10", 2},
+	{"11", 3},
+	{"12", 4},
+	{"13", 5},
+	{"14", 6},
+}
+
+// adoptLiquibase records the migrations equivalent to the applied Liquibase
+// changesets the first time the migrator runs against a Liquibase-managed database
+func (m *Migrator) adoptLiquibase(ctx context.Context, conn *sql.Conn) error {
+	var count int
+	if err := conn.QueryRowContext(ctx, `SELECT COUNT(*) FROM schema_migrations`).Scan(&count); err != nil {
+		return fmt.Errorf("failed to count applied migrations: %w", err)
+	}
+	if count > 0 {
+		return nil
+	}
+
+	var exists bool
+	if err := conn.QueryRowContext(ctx, `SELECT to_regclass('databasechangelog') IS NOT NULL`).Scan(&exists); err != nil {
+		return fmt.Errorf("failed to look for liquibase changelog: %w", err)
+	}
+	if !exists {
+		return nil
+	}
+
+	rows, err := conn.QueryContext(ctx, `SELECT id FROM databasechangelog WHERE author = 'cloudscan'`)
+	if err != nil {
+		return fmt.Errorf("failed to read liquibase changelog: %w", err)
+	}
+	changesets := make(map[string]bool)
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan row: %w", err)
+		}
+		changesets[id] = true
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to read liquibase changelog: %w", err)
+	}
+
+	for _, mapping := range liquibaseChangesets {
+		if !changesets[mapping.changeset] {
+			break
+		}
+		migration := m.find(mapping.version)
+		if migration == nil {
+			break
+		}
+
+		if _, err := conn.ExecContext(ctx,
+			`INSERT INTO schema_migrations (version, name, checksum) VALUES ($1, $2, $3)`,
+			migration.Version, migration.Name, migration.Checksum,
+		); err != nil {
+			return fmt.Errorf("failed to record migration %d: %w", migration.Version, err)
+		}
+		m.logger.WithFields(log.Fields{
+			"version":   migration.Version,
+			"name":      migration.Name,
+			"changeset": "cloudscan:" + mapping.changeset,
+		}).Info("Adopted migration applied by Liquibase")
+	}
+
+	return nil
+}
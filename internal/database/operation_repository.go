@@ -0,0 +1,608 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// OperationRepository implements interfaces.OperationRepository using PostgreSQL
+type OperationRepository struct {
+	db *DB
+}
+
+// NewOperationRepository creates a new OperationRepository
+func NewOperationRepository(db *DB) interfaces.OperationRepository {
+	return &OperationRepository{db: db}
+}
+
+const operationColumns = `
+	id, type, status, scan_id, project_id,
+	total_items, completed_items, failed_items, last_error,
+	created_at, updated_at, completed_at
+`
+
+const scanDeletionColumns = `
+	scan_id, scan_created_at, operation_id, status,
+	attempts, next_attempt_at, last_error, created_at,
+	previous_status
+`
+
+// Get retrieves an operation by ID
+func (r *OperationRepository) Get(ctx context.Context, id uuid.UUID) (*domain.Operation, error) {
+	query := `SELECT ` + operationColumns + ` FROM operations WHERE id = $1`
+
+	operation, err := scanOperation(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("operation not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get operation: %w", err)
+	}
+
+	return operation, nil
+}
+
+// StartScanDeletion marks the scan deleting and creates its operation
+func (r *OperationRepository) StartScanDeletion(ctx context.Context, scanID uuid.UUID) (*domain.Operation, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// The scan.deleting event carries a snapshot of the whole scan
+	scan, err := scanScan(tx.QueryRowContext(ctx,
+		`SELECT `+scanColumns+` FROM scans WHERE id = $1 FOR UPDATE`,
+		scanID,
+	))
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrScanNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scan: %w", err)
+	}
+
+	hold, err := activeScanHold(ctx, tx, scanID)
+	if err != nil {
+		return nil, err
+	}
+	if hold != nil {
+		return nil, hold.Err()
+	}
+
+	// Deleting a scan twice returns the running operation
+	if scan.Status == domain.ScanStatusDeleting {
+		deletion, err := scanScanDeletion(tx.QueryRowContext(ctx,
+			`SELECT `+scanDeletionColumns+` FROM scan_deletions WHERE scan_id = $1`,
+			scanID,
+		))
+		if err != nil && err != sql.ErrNoRows {
+			return nil, fmt.Errorf("failed to get scan deletion: %w", err)
+		}
+
+		if err == nil {
+			if deletion.Status == domain.ScanDeletionFailed {
+				if err := r.restartScanDeletion(ctx, tx, deletion); err != nil {
+					return nil, err
+				}
+			}
+
+			operation, err := scanOperation(tx.QueryRowContext(ctx,
+				`SELECT `+operationColumns+` FROM operations WHERE id = $1`,
+				deletion.OperationID,
+			))
+			if err != nil {
+				return nil, fmt.Errorf("failed to get operation: %w", err)
+			}
+
+			if err := tx.Commit(); err != nil {
+				return nil, fmt.Errorf("failed to commit transaction: %w", err)
+			}
+			return operation, nil
+		}
+	}
+
+	operation := &domain.Operation{
+		ID:         uuid.New(),
+		Type:       domain.OperationDeleteScan,
+		Status:     domain.OperationRunning,
+		ScanID:     &scanID,
+		ProjectID:  &scan.ProjectID,
+		TotalItems: 1,
+		CreatedAt:  time.Now(),
+	}
+	operation.UpdatedAt = operation.CreatedAt
+
+	if err := insertOperation(ctx, tx, operation); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx,
+		`UPDATE scans SET status = 'deleting', updated_at = $3 WHERE id = $1 AND created_at = $2`,
+		scanID, scan.CreatedAt, operation.CreatedAt,
+	); err != nil {
+		return nil, fmt.Errorf("failed to mark scan deleting: %w", err)
+	}
+
+	// Restored if a legal hold refuses the deletion. A scan left deleting
+	// without its deletion has no known previous status.
+	var previousStatus *domain.ScanStatus
+	if scan.Status != domain.ScanStatusDeleting {
+		previousStatus = &scan.Status
+	}
+
+	scan.Status = domain.ScanStatusDeleting
+	scan.UpdatedAt = operation.CreatedAt
+	if err := insertOutboxEvent(ctx, tx, domain.NewScanEvent(scan)); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO scan_deletions (scan_id, scan_created_at, operation_id, next_attempt_at, created_at, previous_status)
+		VALUES ($1, $2, $3, $4, $4, $5)
+		ON CONFLICT (scan_id) DO UPDATE SET
+			operation_id = EXCLUDED.operation_id,
+			status = 'pending',
+			attempts = 0,
+			next_attempt_at = EXCLUDED.next_attempt_at,
+			last_error = NULL,
+			previous_status = EXCLUDED.previous_status`,
+		scanID, scan.CreatedAt, operation.ID, operation.CreatedAt, previousStatus,
+	); err != nil {
+		return nil, fmt.Errorf("failed to queue scan deletion: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return operation, nil
+}
+
+// StartProjectDeletion marks every scan of the project deleting and creates the operation
+func (r *OperationRepository) StartProjectDeletion(ctx context.Context, projectID uuid.UUID) (*domain.Operation, error) {
+	operation := &domain.Operation{
+		ID:        uuid.New(),
+		Type:      domain.OperationDeleteProjectScans,
+		Status:    domain.OperationRunning,
+		ProjectID: &projectID,
+		CreatedAt: time.Now(),
+	}
+	operation.UpdatedAt = operation.CreatedAt
+
+	// startDeletion skips held scans, which also covers a hold placed meanwhile
+	hold, err := activeProjectHold(ctx, r.db, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if hold != nil {
+		return nil, hold.Err()
+	}
+
+	if err := r.startDeletion(ctx, operation, `project_id = $3 AND status <> 'deleting'`, projectID); err != nil {
+		return nil, err
+	}
+
+	return operation, nil
+}
+
+// StartRetentionDeletion marks the scans deleting and creates an apply_retention operation
+func (r *OperationRepository) StartRetentionDeletion(ctx context.Context, scanIDs []uuid.UUID) (*domain.Operation, error) {
+	operation := &domain.Operation{
+		ID:        uuid.New(),
+		Type:      domain.OperationApplyRetention,
+		Status:    domain.OperationRunning,
+		CreatedAt: time.Now(),
+	}
+	operation.UpdatedAt = operation.CreatedAt
+
+	// Scans that left a final state since they were selected are skipped
+	if err := r.startDeletion(ctx, operation,
+		`id = ANY($3) AND status IN ('completed', 'partial', 'failed', 'cancelled')`,
+		pq.Array(scanIDs),
+	); err != nil {
+		return nil, err
+	}
+
+	return operation, nil
+}
+
+// startDeletion creates the operation and queues a deletion for every scan
+// matching condition that no legal hold covers, in one transaction, with a
+// scan.deleting event for each. In condition, $1 is the operation ID, $2 the
+// creation time and $3 the given argument.
+func (r *OperationRepository) startDeletion(ctx context.Context, operation *domain.Operation, condition string, arg interface{}) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := insertOperation(ctx, tx, operation); err != nil {
+		return err
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+		WITH candidates AS (
+			SELECT id AS candidate_id, status AS previous_status
+			FROM scans s
+			WHERE `+condition+` AND NOT `+heldScanCondition+`
+			FOR UPDATE
+		), marked AS (
+			UPDATE scans s SET status = 'deleting', updated_at = $2
+			FROM candidates c
+			WHERE s.id = c.candidate_id
+			RETURNING c.previous_status, `+scanColumns+`
+		), queued AS (
+			INSERT INTO scan_deletions (scan_id, scan_created_at, operation_id, next_attempt_at, created_at, previous_status)
+			SELECT id, created_at, $1, $2, $2, previous_status FROM marked
+		)
+		SELECT `+scanColumns+` FROM marked`,
+		operation.ID, operation.CreatedAt, arg,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to queue scan deletions: %w", err)
+	}
+
+	var marked []*domain.Scan
+	for rows.Next() {
+		scan, err := scanScan(rows)
+		if err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan row: %w", err)
+		}
+		marked = append(marked, scan)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to queue scan deletions: %w", err)
+	}
+
+	for _, scan := range marked {
+		if err := insertOutboxEvent(ctx, tx, domain.NewScanEvent(scan)); err != nil {
+			return err
+		}
+	}
+	total := len(marked)
+
+	// An operation without scans is done right away
+	operation.TotalItems = int(total)
+	if total == 0 {
+		operation.Status = domain.OperationSucceeded
+		operation.CompletedAt = &operation.CreatedAt
+	}
+
+	if _, err := tx.ExecContext(ctx,
+		`UPDATE operations SET total_items = $2, status = $3, completed_at = $4 WHERE id = $1`,
+		operation.ID, operation.TotalItems, operation.Status, operation.CompletedAt,
+	); err != nil {
+		return fmt.Errorf("failed to update operation: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// ClaimDueScanDeletions locks pending deletions that are due, oldest first
+func (r *OperationRepository) ClaimDueScanDeletions(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*domain.ScanDeletion, error) {
+	query := `
+		WITH due AS (
+			SELECT scan_id AS due_scan_id
+			FROM scan_deletions
+			WHERE status = 'pending' AND next_attempt_at <= $1
+			ORDER BY next_attempt_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		UPDATE scan_deletions d
+		SET next_attempt_at = $2
+		FROM due
+		WHERE d.scan_id = due.due_scan_id
+		RETURNING ` + scanDeletionColumns
+
+	rows, err := r.db.QueryContext(ctx, query, now, now.Add(lease), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim scan deletions: %w", err)
+	}
+	defer rows.Close()
+
+	deletions := []*domain.ScanDeletion{}
+	for rows.Next() {
+		deletion, err := scanScanDeletion(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		deletions = append(deletions, deletion)
+	}
+
+	return deletions, rows.Err()
+}
+
+// CompleteScanDeletion deletes the scan rows and records the progress in one transaction
+func (r *OperationRepository) CompleteScanDeletion(ctx context.Context, deletion *domain.ScanDeletion) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// The scan may already be gone, e.g. removed by the cleaner
+	if _, err := deleteScan(ctx, tx, deletion.ScanID); err != nil {
+		return err
+	}
+
+	result, err := tx.ExecContext(ctx,
+		`DELETE FROM scan_deletions WHERE scan_id = $1 AND operation_id = $2`,
+		deletion.ScanID, deletion.OperationID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to delete scan deletion: %w", err)
+	}
+
+	// Only count the item if this call removed it, so a retried call is harmless
+	if rows, _ := result.RowsAffected(); rows > 0 {
+		if err := recordOperationProgress(ctx, tx, deletion.OperationID, 1, 0, nil); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// RetryScanDeletion records a failed attempt and schedules the next one
+func (r *OperationRepository) RetryScanDeletion(ctx context.Context, deletion *domain.ScanDeletion, lastError string, nextAttemptAt time.Time) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE scan_deletions SET
+			attempts = attempts + 1,
+			next_attempt_at = $3,
+			last_error = $4
+		WHERE scan_id = $1 AND operation_id = $2`,
+		deletion.ScanID, deletion.OperationID, nextAttemptAt, lastError,
+	); err != nil {
+		return fmt.Errorf("failed to update scan deletion: %w", err)
+	}
+
+	// Surface the error while the operation is still running
+	if err := recordOperationProgress(ctx, tx, deletion.OperationID, 0, 0, &lastError); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// FailScanDeletion gives up on the deletion and counts it as failed
+func (r *OperationRepository) FailScanDeletion(ctx context.Context, deletion *domain.ScanDeletion, lastError string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE scan_deletions SET
+			status = 'failed',
+			attempts = attempts + 1,
+			last_error = $3
+		WHERE scan_id = $1 AND operation_id = $2 AND status = 'pending'`,
+		deletion.ScanID, deletion.OperationID, lastError,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update scan deletion: %w", err)
+	}
+
+	if rows, _ := result.RowsAffected(); rows > 0 {
+		if err := recordOperationProgress(ctx, tx, deletion.OperationID, 0, 1, &lastError); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// RefuseScanDeletion drops a deletion that a legal hold refuses, counts it as
+// failed and restores the scan's status from before the deletion
+func (r *OperationRepository) RefuseScanDeletion(ctx context.Context, deletion *domain.ScanDeletion, lastError string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx,
+		`DELETE FROM scan_deletions WHERE scan_id = $1 AND operation_id = $2 AND status = 'pending'`,
+		deletion.ScanID, deletion.OperationID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to delete scan deletion: %w", err)
+	}
+
+	// Only act if this call removed it, so a retried call is harmless
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return nil
+	}
+
+	if err := recordOperationProgress(ctx, tx, deletion.OperationID, 0, 1, &lastError); err != nil {
+		return err
+	}
+
+	// Deletions queued before the previous status was recorded leave the
+	// scan failed, which any final state can be deleted from again
+	previousStatus := deletion.PreviousStatus
+	if previousStatus == "" {
+		previousStatus = domain.ScanStatusFailed
+	}
+
+	scan, err := scanScan(tx.QueryRowContext(ctx, `
+		UPDATE scans SET status = $3, updated_at = NOW()
+		WHERE id = $1 AND created_at = $2 AND status = 'deleting'
+		RETURNING `+scanColumns,
+		deletion.ScanID, deletion.ScanCreatedAt, previousStatus,
+	))
+	if err != nil && err != sql.ErrNoRows {
+		return fmt.Errorf("failed to restore scan status: %w", err)
+	}
+	if err == nil {
+		if err := insertOutboxEvent(ctx, tx, domain.NewScanEvent(scan)); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// restartScanDeletion queues a failed deletion again and reopens its operation
+func (r *OperationRepository) restartScanDeletion(ctx context.Context, tx *Tx, deletion *domain.ScanDeletion) error {
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE scan_deletions SET
+			status = 'pending',
+			attempts = 0,
+			next_attempt_at = NOW()
+		WHERE scan_id = $1`,
+		deletion.ScanID,
+	); err != nil {
+		return fmt.Errorf("failed to restart scan deletion: %w", err)
+	}
+
+	return recordOperationProgress(ctx, tx, deletion.OperationID, 0, -1, nil)
+}
+
+// insertOperation writes a new operation in the caller's transaction
+func insertOperation(ctx context.Context, tx *Tx, operation *domain.Operation) error {
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO operations (
+			id, type, status, scan_id, project_id, total_items, created_at, updated_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8
+		)`,
+		operation.ID,
+		operation.Type,
+		operation.Status,
+		operation.ScanID,
+		operation.ProjectID,
+		operation.TotalItems,
+		operation.CreatedAt,
+		operation.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create operation: %w", err)
+	}
+
+	return nil
+}
+
+// recordOperationProgress adds completed and failed items to the operation and
+// finishes it once every item is accounted for
+func recordOperationProgress(ctx context.Context, tx *Tx, id uuid.UUID, completed, failed int, lastError *string) error {
+	_, err := tx.ExecContext(ctx, `
+		UPDATE operations SET
+			completed_items = completed_items + $2,
+			failed_items = failed_items + $3,
+			last_error = COALESCE($4, last_error),
+			status = CASE
+				WHEN completed_items + $2 + failed_items + $3 < total_items THEN 'running'
+				WHEN failed_items + $3 > 0 THEN 'failed'
+				ELSE 'succeeded'
+			END,
+			completed_at = CASE
+				WHEN completed_items + $2 + failed_items + $3 < total_items THEN NULL
+				ELSE NOW()
+			END
+		WHERE id = $1`,
+		id, completed, failed, lastError,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update operation progress: %w", err)
+	}
+
+	return nil
+}
+
+// scanOperation reads an operation from a row selected with operationColumns
+func scanOperation(row rowScanner) (*domain.Operation, error) {
+	operation := &domain.Operation{}
+	var scanID, projectID uuid.NullUUID
+
+	err := row.Scan(
+		&operation.ID,
+		&operation.Type,
+		&operation.Status,
+		&scanID,
+		&projectID,
+		&operation.TotalItems,
+		&operation.CompletedItems,
+		&operation.FailedItems,
+		&operation.LastError,
+		&operation.CreatedAt,
+		&operation.UpdatedAt,
+		&operation.CompletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if scanID.Valid {
+		operation.ScanID = &scanID.UUID
+	}
+	if projectID.Valid {
+		operation.ProjectID = &projectID.UUID
+	}
+
+	return operation, nil
+}
+
+// scanScanDeletion reads a scan deletion from a row selected with scanDeletionColumns
+func scanScanDeletion(row rowScanner) (*domain.ScanDeletion, error) {
+	deletion := &domain.ScanDeletion{}
+	var previousStatus sql.NullString
+
+	err := row.Scan(
+		&deletion.ScanID,
+		&deletion.ScanCreatedAt,
+		&deletion.OperationID,
+		&deletion.Status,
+		&deletion.Attempts,
+		&deletion.NextAttemptAt,
+		&deletion.LastError,
+		&deletion.CreatedAt,
+		&previousStatus,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	deletion.PreviousStatus = domain.ScanStatus(previousStatus.String)
+
+	return deletion, nil
+}
@@ -0,0 +1,440 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+	"github.com/lib/pq"
+)
+
+// partitionLockID is the Postgres advisory lock held while partitions are
+// maintained, so a single replica creates and detaches them
+const partitionLockID = 0x7363616e70617274 // "scanpart"
+
+// partitionedTable is the table whose partitions are managed
+const partitionedTable = "scans"
+
+// partitionBoundPattern extracts the bounds from pg_get_expr(relpartbound)
+var partitionBoundPattern = regexp.MustCompile(`FOR VALUES FROM \('([^']+)'\) TO \('([^']+)'\)`)
+
+// partitionBoundLayouts are the formats Postgres prints timestamptz bounds in
+var partitionBoundLayouts = []string{
+	"2006-01-02 15:04:05-07",
+	"2006-01-02 15:04:05-07:00",
+	"2006-01-02 15:04:05.999999-07",
+	"2006-01-02 15:04:05.999999-07:00",
+}
+
+// PartitionRepository implements interfaces.PartitionRepository using PostgreSQL
+type PartitionRepository struct {
+	db *DB
+}
+
+// NewPartitionRepository creates a new PartitionRepository
+func NewPartitionRepository(db *DB) interfaces.PartitionRepository {
+	return &PartitionRepository{db: db}
+}
+
+// List returns the attached partitions ordered by lower bound
+func (r *PartitionRepository) List(ctx context.Context) ([]*domain.Partition, error) {
+	query := `
+		SELECT c.relname, pg_get_expr(c.relpartbound, c.oid), i.inhdetachpending
+		FROM pg_inherits i
+		JOIN pg_class c ON c.oid = i.inhrelid
+		WHERE i.inhparent = $1::regclass
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, partitionedTable)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list partitions: %w", err)
+	}
+	defer rows.Close()
+
+	var partitions []*domain.Partition
+	for rows.Next() {
+		partition := &domain.Partition{Table: partitionedTable}
+		var bound string
+		if err := rows.Scan(&partition.Name, &bound, &partition.DetachPending); err != nil {
+			return nil, fmt.Errorf("failed to scan partition: %w", err)
+		}
+
+		if bound != "DEFAULT" {
+			if partition.From, partition.To, err = parsePartitionBound(bound); err != nil {
+				return nil, fmt.Errorf("partition %s: %w", partition.Name, err)
+			}
+		}
+		partitions = append(partitions, partition)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating partitions: %w", err)
+	}
+
+	// The default partition has no bounds and sorts first
+	sort.Slice(partitions, func(i, j int) bool {
+		return partitions[i].From.Before(partitions[j].From)
+	})
+	return partitions, nil
+}
+
+// CreateMonth creates the partition for the month starting at month. Bounds
+// are dates, as in the initial migration, so they are interpreted in the
+// database's time zone just like the existing partitions.
+func (r *PartitionRepository) CreateMonth(ctx context.Context, month time.Time) error {
+	from := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+	name := fmt.Sprintf("%s_%s", partitionedTable, from.Format("2006_01"))
+
+	query := fmt.Sprintf(
+		`CREATE TABLE IF NOT EXISTS %s PARTITION OF %s FOR VALUES FROM (%s) TO (%s)`,
+		pq.QuoteIdentifier(name),
+		pq.QuoteIdentifier(partitionedTable),
+		pq.QuoteLiteral(from.Format("2006-01-02")),
+		pq.QuoteLiteral(to.Format("2006-01-02")),
+	)
+
+	if _, err := r.db.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to create partition %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// ArchiveFindings moves the findings and sub-scans of the partition's scans
+// into the schema, and deletes their finding uploads, runner requests and
+// sub-scan logs, in one transaction. It returns the number of findings moved.
+func (r *PartitionRepository) ArchiveFindings(ctx context.Context, name, schema string) (int, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`CREATE SCHEMA IF NOT EXISTS %s`, pq.QuoteIdentifier(schema))); err != nil {
+		return 0, fmt.Errorf("failed to create archive schema: %w", err)
+	}
+
+	archive := pq.QuoteIdentifier(schema) + "." + pq.QuoteIdentifier(name+"_findings")
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (LIKE findings)`, archive)); err != nil {
+		return 0, fmt.Errorf("failed to create findings archive of partition %s: %w", name, err)
+	}
+
+	result, err := tx.ExecContext(ctx, fmt.Sprintf(`
+		WITH moved AS (
+			DELETE FROM findings WHERE scan_id IN (SELECT id FROM %s) RETURNING *
+		)
+		INSERT INTO %s SELECT * FROM moved`,
+		pq.QuoteIdentifier(name), archive,
+	))
+	if err != nil {
+		return 0, fmt.Errorf("failed to archive findings of partition %s: %w", name, err)
+	}
+
+	subScans := pq.QuoteIdentifier(schema) + "." + pq.QuoteIdentifier(name+"_sub_scans")
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (LIKE sub_scans)`, subScans)); err != nil {
+		return 0, fmt.Errorf("failed to create sub-scans archive of partition %s: %w", name, err)
+	}
+
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`
+		WITH moved AS (
+			DELETE FROM sub_scans WHERE scan_id IN (SELECT id FROM %s) RETURNING *
+		)
+		INSERT INTO %s SELECT * FROM moved`,
+		pq.QuoteIdentifier(name), subScans,
+	)); err != nil {
+		return 0, fmt.Errorf("failed to archive sub-scans of partition %s: %w", name, err)
+	}
+
+	// Upload, runner request and log bookkeeping isn't archived
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(
+		`DELETE FROM finding_uploads WHERE scan_id IN (SELECT id FROM %s)`,
+		pq.QuoteIdentifier(name),
+	)); err != nil {
+		return 0, fmt.Errorf("failed to delete finding uploads of partition %s: %w", name, err)
+	}
+
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(
+		`DELETE FROM runner_requests WHERE scan_id IN (SELECT id FROM %s)`,
+		pq.QuoteIdentifier(name),
+	)); err != nil {
+		return 0, fmt.Errorf("failed to delete runner requests of partition %s: %w", name, err)
+	}
+
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(
+		`DELETE FROM sub_scan_logs WHERE scan_id IN (SELECT id FROM %s)`,
+		pq.QuoteIdentifier(name),
+	)); err != nil {
+		return 0, fmt.Errorf("failed to delete sub-scan logs of partition %s: %w", name, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	moved, _ := result.RowsAffected()
+	return int(moved), nil
+}
+
+// DeleteFindings deletes the findings, sub-scans, finding uploads, runner
+// requests and sub-scan logs of the partition's scans and returns the number
+// of findings deleted
+func (r *PartitionRepository) DeleteFindings(ctx context.Context, name string) (int, error) {
+	result, err := r.db.ExecContext(ctx, fmt.Sprintf(
+		`DELETE FROM findings WHERE scan_id IN (SELECT id FROM %s)`,
+		pq.QuoteIdentifier(name),
+	))
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete findings of partition %s: %w", name, err)
+	}
+
+	if _, err := r.db.ExecContext(ctx, fmt.Sprintf(
+		`DELETE FROM sub_scans WHERE scan_id IN (SELECT id FROM %s)`,
+		pq.QuoteIdentifier(name),
+	)); err != nil {
+		return 0, fmt.Errorf("failed to delete sub-scans of partition %s: %w", name, err)
+	}
+
+	if _, err := r.db.ExecContext(ctx, fmt.Sprintf(
+		`DELETE FROM finding_uploads WHERE scan_id IN (SELECT id FROM %s)`,
+		pq.QuoteIdentifier(name),
+	)); err != nil {
+		return 0, fmt.Errorf("failed to delete finding uploads of partition %s: %w", name, err)
+	}
+
+	if _, err := r.db.ExecContext(ctx, fmt.Sprintf(
+		`DELETE FROM runner_requests WHERE scan_id IN (SELECT id FROM %s)`,
+		pq.QuoteIdentifier(name),
+	)); err != nil {
+		return 0, fmt.Errorf("failed to delete runner requests of partition %s: %w", name, err)
+	}
+
+	if _, err := r.db.ExecContext(ctx, fmt.Sprintf(
+		`DELETE FROM sub_scan_logs WHERE scan_id IN (SELECT id FROM %s)`,
+		pq.QuoteIdentifier(name),
+	)); err != nil {
+		return 0, fmt.Errorf("failed to delete sub-scan logs of partition %s: %w", name, err)
+	}
+
+	deleted, _ := result.RowsAffected()
+	return int(deleted), nil
+}
+
+// HasHeldScans returns true if a legal hold covers any of the partition's scans
+func (r *PartitionRepository) HasHeldScans(ctx context.Context, name string) (bool, error) {
+	var held bool
+	err := r.db.QueryRowContext(ctx, fmt.Sprintf(
+		`SELECT EXISTS (SELECT 1 FROM %s s WHERE %s)`,
+		pq.QuoteIdentifier(name),
+		heldScanCondition,
+	)).Scan(&held)
+	if err != nil {
+		return false, fmt.Errorf("failed to check legal holds of partition %s: %w", name, err)
+	}
+
+	return held, nil
+}
+
+// ArtifactIDs returns the storage artifacts of the partition's scans: their
+// source archives, archived logs and archived sub-scan attempt logs
+func (r *PartitionRepository) ArtifactIDs(ctx context.Context, name string) ([]string, error) {
+	rows, err := r.db.QueryContext(ctx, fmt.Sprintf(`
+		SELECT source_archive_key FROM %[1]s WHERE source_archive_key <> ''
+		UNION ALL
+		SELECT logs_artifact_id FROM %[1]s WHERE logs_artifact_id <> ''
+		UNION ALL
+		SELECT artifact_id FROM sub_scan_logs WHERE scan_id IN (SELECT id FROM %[1]s)`,
+		pq.QuoteIdentifier(name),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list artifacts of partition %s: %w", name, err)
+	}
+	defer rows.Close()
+
+	artifactIDs := []string{}
+	for rows.Next() {
+		var artifactID string
+		if err := rows.Scan(&artifactID); err != nil {
+			return nil, fmt.Errorf("failed to scan artifact ID: %w", err)
+		}
+		artifactIDs = append(artifactIDs, artifactID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating artifacts of partition %s: %w", name, err)
+	}
+
+	return artifactIDs, nil
+}
+
+// Detach detaches the partition concurrently. This can't run inside a
+// transaction; if it is interrupted the partition is left pending and the
+// next call finalizes it. The partition is recorded in detached_partitions
+// first, so it is still known once it is no longer listed.
+func (r *PartitionRepository) Detach(ctx context.Context, partition *domain.Partition) error {
+	mode := "CONCURRENTLY"
+	if partition.DetachPending {
+		mode = "FINALIZE"
+	}
+
+	if _, err := r.db.ExecContext(ctx,
+		`INSERT INTO detached_partitions (name) VALUES ($1) ON CONFLICT (name) DO NOTHING`,
+		partition.Name,
+	); err != nil {
+		return fmt.Errorf("failed to record detached partition %s: %w", partition.Name, err)
+	}
+
+	query := fmt.Sprintf(
+		`ALTER TABLE %s DETACH PARTITION %s %s`,
+		pq.QuoteIdentifier(partitionedTable),
+		pq.QuoteIdentifier(partition.Name),
+		mode,
+	)
+
+	if _, err := r.db.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to detach partition %s: %w", partition.Name, err)
+	}
+
+	return nil
+}
+
+// ListDetached returns the partitions Detach detached that are neither
+// archived nor dropped yet. Records of tables that no longer exist, e.g.
+// removed by hand, are deleted.
+func (r *PartitionRepository) ListDetached(ctx context.Context) ([]string, error) {
+	if _, err := r.db.ExecContext(ctx,
+		`DELETE FROM detached_partitions WHERE to_regclass(quote_ident(name)) IS NULL`,
+	); err != nil {
+		return nil, fmt.Errorf("failed to delete stale detached partitions: %w", err)
+	}
+
+	// A partition whose detach failed is still attached and listed by List
+	query := `
+		SELECT d.name
+		FROM detached_partitions d
+		JOIN pg_class c ON c.oid = to_regclass(quote_ident(d.name))
+		WHERE NOT c.relispartition
+		ORDER BY d.name
+	`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list detached partitions: %w", err)
+	}
+	defer rows.Close()
+
+	var names []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, fmt.Errorf("failed to scan detached partition: %w", err)
+		}
+		names = append(names, name)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating detached partitions: %w", err)
+	}
+	return names, nil
+}
+
+// Archive moves a detached partition into the schema and deletes its record
+func (r *PartitionRepository) Archive(ctx context.Context, name, schema string) error {
+	if _, err := r.db.ExecContext(ctx, fmt.Sprintf(`CREATE SCHEMA IF NOT EXISTS %s`, pq.QuoteIdentifier(schema))); err != nil {
+		return fmt.Errorf("failed to create archive schema: %w", err)
+	}
+
+	query := fmt.Sprintf(`ALTER TABLE %s SET SCHEMA %s`, pq.QuoteIdentifier(name), pq.QuoteIdentifier(schema))
+	if err := r.finishDetached(ctx, name, query); err != nil {
+		return fmt.Errorf("failed to archive partition %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// Drop drops a detached partition and deletes its record
+func (r *PartitionRepository) Drop(ctx context.Context, name string) error {
+	if err := r.finishDetached(ctx, name, fmt.Sprintf(`DROP TABLE %s`, pq.QuoteIdentifier(name))); err != nil {
+		return fmt.Errorf("failed to drop partition %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// finishDetached runs the statement archiving or dropping a detached
+// partition and deletes its record in the same transaction
+func (r *PartitionRepository) finishDetached(ctx context.Context, name, statement string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, statement); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM detached_partitions WHERE name = $1`, name); err != nil {
+		return fmt.Errorf("failed to delete detached partition record: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+// TryLock takes the partition maintenance lock on a dedicated connection. The
+// lock is session scoped, so the connection is held until unlock is called.
+func (r *PartitionRepository) TryLock(ctx context.Context) (func(), bool, error) {
+	conn, err := r.db.Conn(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get connection: %w", err)
+	}
+
+	var locked bool
+	if err := conn.QueryRowContext(ctx, `SELECT pg_try_advisory_lock($1)`, int64(partitionLockID)).Scan(&locked); err != nil {
+		conn.Close()
+		return nil, false, fmt.Errorf("failed to acquire partition lock: %w", err)
+	}
+
+	if !locked {
+		conn.Close()
+		return nil, false, nil
+	}
+
+	unlock := func() {
+		releaseSessionLock(conn, "partition", `SELECT pg_advisory_unlock($1)`, int64(partitionLockID))
+	}
+
+	return unlock, true, nil
+}
+
+// parsePartitionBound parses a range bound such as
+// FOR VALUES FROM ('2025-01-01 00:00:00+00') TO ('2025-02-01 00:00:00+00')
+func parsePartitionBound(bound string) (time.Time, time.Time, error) {
+	match := partitionBoundPattern.FindStringSubmatch(bound)
+	if match == nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("unsupported partition bound: %s", bound)
+	}
+
+	from, err := parsePartitionTime(match[1])
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	to, err := parsePartitionTime(match[2])
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	return from, to, nil
+}
+
+func parsePartitionTime(value string) (time.Time, error) {
+	for _, layout := range partitionBoundLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid partition bound: %s", value)
+}
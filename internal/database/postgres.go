@@ -3,11 +3,16 @@ package database
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
 	"fmt"
+	"strings"
 	"time"
 
-	_ "github.com/lib/pq"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/tracing"
+	"github.com/lib/pq"
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // DB wraps a PostgreSQL connection pool
@@ -54,9 +59,11 @@ func (db *DB) Close() error {
 
 // ExecContext executes a query without returning rows
 func (db *DB) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	ctx, span := startSpan(ctx, "db.exec", query)
 	start := time.Now()
 	result, err := db.DB.ExecContext(ctx, query, args...)
 	duration := time.Since(start)
+	tracing.End(span, err)
 
 	log.WithFields(log.Fields{
 		"query":    query,
@@ -69,9 +76,11 @@ func (db *DB) ExecContext(ctx context.Context, query string, args ...interface{}
 
 // QueryContext executes a query that returns rows
 func (db *DB) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	ctx, span := startSpan(ctx, "db.query", query)
 	start := time.Now()
 	rows, err := db.DB.QueryContext(ctx, query, args...)
 	duration := time.Since(start)
+	tracing.End(span, err)
 
 	log.WithFields(log.Fields{
 		"query":    query,
@@ -84,9 +93,11 @@ func (db *DB) QueryContext(ctx context.Context, query string, args ...interface{
 
 // QueryRowContext executes a query that returns a single row
 func (db *DB) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	ctx, span := startSpan(ctx, "db.query_row", query)
 	start := time.Now()
 	row := db.DB.QueryRowContext(ctx, query, args...)
 	duration := time.Since(start)
+	tracing.End(span, row.Err())
 
 	log.WithFields(log.Fields{
 		"query":    query,
@@ -96,19 +107,94 @@ func (db *DB) QueryRowContext(ctx context.Context, query string, args ...interfa
 	return row
 }
 
-// BeginTx starts a new transaction
-func (db *DB) BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error) {
-	return db.DB.BeginTx(ctx, opts)
+// BeginTx starts a new transaction. Statements of the transaction are traced
+// like those of DB.
+func (db *DB) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error) {
+	tx, err := db.DB.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &Tx{tx}, nil
+}
+
+// releaseSessionLock releases an advisory lock taken on a dedicated
+// connection and closes the connection. If the unlock fails the connection
+// may still hold the lock, so it is discarded instead of returned to the pool.
+func releaseSessionLock(conn *sql.Conn, name, query string, args ...interface{}) {
+	// Use a fresh context so the lock is released even if the caller's was cancelled
+	if _, err := conn.ExecContext(context.Background(), query, args...); err != nil {
+		log.WithError(err).Warnf("Failed to release %s lock, discarding its connection", name)
+		conn.Raw(func(interface{}) error { return driver.ErrBadConn })
+	}
+	conn.Close()
+}
+
+// Tx wraps a transaction to trace its statements
+type Tx struct {
+	*sql.Tx
+}
+
+// ExecContext executes a query without returning rows
+func (tx *Tx) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	ctx, span := startSpan(ctx, "db.exec", query)
+	result, err := tx.Tx.ExecContext(ctx, query, args...)
+	tracing.End(span, err)
+	return result, err
+}
+
+// QueryContext executes a query that returns rows
+func (tx *Tx) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	ctx, span := startSpan(ctx, "db.query", query)
+	rows, err := tx.Tx.QueryContext(ctx, query, args...)
+	tracing.End(span, err)
+	return rows, err
 }
 
-// RunMigrations runs database migrations
-func RunMigrations(db *sql.DB, migrationsPath string) error {
-	log.WithField("path", migrationsPath).Info("Running database migrations")
+// QueryRowContext executes a query that returns a single row
+func (tx *Tx) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	ctx, span := startSpan(ctx, "db.query_row", query)
+	row := tx.Tx.QueryRowContext(ctx, query, args...)
+	tracing.End(span, row.Err())
+	return row
+}
+
+// CopyIn loads rows into a table with COPY, which is much faster than
+// inserting them with statements
+func (tx *Tx) CopyIn(ctx context.Context, table string, columns []string, rows [][]interface{}) (err error) {
+	ctx, span := startSpan(ctx, "db.copy", "COPY "+table)
+	defer func() { tracing.End(span, err) }()
+
+	stmt, err := tx.Tx.PrepareContext(ctx, pq.CopyIn(table, columns...))
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, row := range rows {
+		if _, err := stmt.ExecContext(ctx, row...); err != nil {
+			return err
+		}
+	}
 
-	// For now, migrations are in the migrations directory
-	// In production, use a migration tool like golang-migrate or goose
-	// For this implementation, migrations are applied via migration files
+	// The buffered rows are sent by the final, empty call
+	_, err = stmt.ExecContext(ctx)
+	return err
+}
 
-	log.Info("Database migrations completed (migrations handled externally)")
-	return nil
-}
\ No newline at end of file
+// startSpan starts a client span for a SQL statement
+func startSpan(ctx context.Context, name, query string) (context.Context, trace.Span) {
+	statement := strings.Join(strings.Fields(query), " ")
+	operation := statement
+	if i := strings.IndexByte(statement, ' '); i > 0 {
+		operation = statement[:i]
+	}
+
+	return tracing.Tracer.Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("db.system", "postgresql"),
+			attribute.String("db.operation", strings.ToUpper(operation)),
+			attribute.String("db.statement", statement),
+		),
+	)
+}
@@ -0,0 +1,290 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+	"github.com/google/uuid"
+)
+
+// RetentionRepository implements interfaces.RetentionRepository using PostgreSQL
+type RetentionRepository struct {
+	db *DB
+}
+
+// NewRetentionRepository creates a new RetentionRepository
+func NewRetentionRepository(db *DB) interfaces.RetentionRepository {
+	return &RetentionRepository{db: db}
+}
+
+const retentionPolicyColumns = `
+	id, organization_id, project_id,
+	keep_days, keep_last_per_branch, keep_latest_default_branch, default_branch,
+	created_by, created_at, updated_at
+`
+
+// Set creates or replaces the policy of the organization or project
+func (r *RetentionRepository) Set(ctx context.Context, policy *domain.RetentionPolicy) error {
+	// Each partial unique index is its own conflict target
+	conflict := `(organization_id) WHERE project_id IS NULL`
+	if policy.ProjectID != nil {
+		conflict = `(organization_id, project_id) WHERE project_id IS NOT NULL`
+	}
+
+	query := `
+		INSERT INTO retention_policies (
+			id, organization_id, project_id,
+			keep_days, keep_last_per_branch, keep_latest_default_branch, default_branch,
+			created_by, created_at, updated_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+		)
+		ON CONFLICT ` + conflict + ` DO UPDATE SET
+			keep_days = EXCLUDED.keep_days,
+			keep_last_per_branch = EXCLUDED.keep_last_per_branch,
+			keep_latest_default_branch = EXCLUDED.keep_latest_default_branch,
+			default_branch = EXCLUDED.default_branch
+		RETURNING id, created_at, updated_at
+	`
+
+	var createdBy uuid.NullUUID
+	if policy.CreatedBy != uuid.Nil {
+		createdBy = uuid.NullUUID{UUID: policy.CreatedBy, Valid: true}
+	}
+
+	err := r.db.QueryRowContext(ctx, query,
+		policy.ID,
+		policy.OrganizationID,
+		policy.ProjectID,
+		policy.KeepDays,
+		policy.KeepLastPerBranch,
+		policy.KeepLatestDefaultBranch,
+		sql.NullString{String: policy.DefaultBranch, Valid: policy.DefaultBranch != ""},
+		createdBy,
+		policy.CreatedAt,
+		policy.UpdatedAt,
+	).Scan(&policy.ID, &policy.CreatedAt, &policy.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to set retention policy: %w", err)
+	}
+
+	return nil
+}
+
+// Get retrieves a policy by ID
+func (r *RetentionRepository) Get(ctx context.Context, id uuid.UUID) (*domain.RetentionPolicy, error) {
+	query := `SELECT ` + retentionPolicyColumns + ` FROM retention_policies WHERE id = $1`
+
+	policy, err := scanRetentionPolicy(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("retention policy not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get retention policy: %w", err)
+	}
+
+	return policy, nil
+}
+
+// List retrieves policies with optional filters, organization policies first
+func (r *RetentionRepository) List(ctx context.Context, filter interfaces.RetentionPolicyFilter) ([]*domain.RetentionPolicy, error) {
+	query := `SELECT ` + retentionPolicyColumns + ` FROM retention_policies WHERE 1=1`
+
+	args := []interface{}{}
+	argPos := 1
+
+	if filter.OrganizationID != nil {
+		query += fmt.Sprintf(" AND organization_id = $%d", argPos)
+		args = append(args, *filter.OrganizationID)
+		argPos++
+	}
+
+	if filter.ProjectID != nil {
+		query += fmt.Sprintf(" AND project_id = $%d", argPos)
+		args = append(args, *filter.ProjectID)
+		argPos++
+	}
+
+	query += " ORDER BY organization_id, project_id NULLS FIRST"
+
+	if filter.Limit > 0 {
+		query += fmt.Sprintf(" LIMIT $%d", argPos)
+		args = append(args, filter.Limit)
+		argPos++
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list retention policies: %w", err)
+	}
+	defer rows.Close()
+
+	policies := []*domain.RetentionPolicy{}
+	for rows.Next() {
+		policy, err := scanRetentionPolicy(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		policies = append(policies, policy)
+	}
+
+	return policies, rows.Err()
+}
+
+// Delete deletes a policy
+func (r *RetentionRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM retention_policies WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete retention policy: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rows == 0 {
+		return fmt.Errorf("retention policy not found")
+	}
+
+	return nil
+}
+
+// ListExpiredScans returns the next page of scans in scope none of the rules
+// of their policy keeps. Scans being deleted are ignored,
+// so they don't count towards the newest scans of their branch. Held scans
+// still count, but are never returned.
+func (r *RetentionRepository) ListExpiredScans(ctx context.Context, query interfaces.ExpiredScansQuery) ([]*domain.Scan, error) {
+	args := []interface{}{
+		query.Default.KeepDays,
+		query.Default.KeepLastPerBranch,
+		query.Default.KeepLatestDefaultBranch,
+		query.Now,
+		query.AfterCreatedAt,
+		query.AfterID,
+		query.Limit,
+	}
+	argPos := len(args) + 1
+
+	scope := ""
+	if query.OrganizationID != nil {
+		scope += fmt.Sprintf(" AND s.organization_id = $%d", argPos)
+		args = append(args, *query.OrganizationID)
+		argPos++
+	}
+	if query.ProjectID != nil {
+		scope += fmt.Sprintf(" AND s.project_id = $%d", argPos)
+		args = append(args, *query.ProjectID)
+		argPos++
+	}
+
+	// Candidates are walked in cursor order and each is checked on its own,
+	// so a page only looks at the scans before its last one and, through
+	// idx_scans_project_branch, at the newer scans of their branches, instead
+	// of ranking every scan in scope again for every page. Ties on created_at
+	// are ranked by id. The project policy sorts before the organization
+	// policy.
+	sqlQuery := `
+		WITH expired AS (
+			SELECT s.id, s.created_at
+			FROM scans s
+			LEFT JOIN LATERAL (
+				SELECT keep_days, keep_last_per_branch, keep_latest_default_branch, default_branch
+				FROM retention_policies
+				WHERE organization_id = s.organization_id
+					AND (project_id = s.project_id OR project_id IS NULL)
+				ORDER BY project_id NULLS LAST
+				LIMIT 1
+			) rp ON true
+			LEFT JOIN projects p ON p.id = s.project_id
+			CROSS JOIN LATERAL (
+				SELECT
+					COALESCE(rp.keep_days, $1) AS keep_days,
+					COALESCE(rp.keep_last_per_branch, $2) AS keep_last_per_branch,
+					COALESCE(rp.keep_latest_default_branch, $3) AS keep_latest_default_branch,
+					COALESCE(s.branch = COALESCE(rp.default_branch, p.default_branch, 'main'), false) AS on_default_branch
+			) k
+			WHERE s.status IN ('completed', 'partial', 'failed', 'cancelled')` + scope + `
+				AND (s.created_at, s.id) > ($5::timestamptz, $6::uuid)
+				AND (k.keep_days = 0 OR s.created_at < $4::timestamptz - make_interval(days => k.keep_days))
+				AND NOT ` + heldScanCondition + `
+				AND (k.keep_last_per_branch = 0 OR (
+					SELECT COUNT(*) FROM (
+						SELECT 1 FROM scans n
+						WHERE n.project_id = s.project_id
+							AND (n.branch = s.branch OR (n.branch IS NULL AND s.branch IS NULL))
+							AND (n.created_at, n.id) > (s.created_at, s.id)
+							AND n.status <> 'deleting'
+						LIMIT k.keep_last_per_branch
+					) newer
+				) >= k.keep_last_per_branch)
+				AND NOT (k.keep_latest_default_branch AND s.status = 'completed' AND k.on_default_branch
+					AND NOT EXISTS (
+						SELECT 1 FROM scans n
+						WHERE n.project_id = s.project_id
+							AND n.branch = s.branch
+							AND (n.created_at, n.id) > (s.created_at, s.id)
+							AND n.status = 'completed'
+					))
+			ORDER BY s.created_at, s.id
+			LIMIT $7
+		)
+		SELECT ` + scanColumns + `
+		FROM scans
+		WHERE (id, created_at) IN (SELECT id, created_at FROM expired)
+		ORDER BY created_at, id
+	`
+
+	rows, err := r.db.QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list expired scans: %w", err)
+	}
+	defer rows.Close()
+
+	scans := []*domain.Scan{}
+	for rows.Next() {
+		scan, err := scanScan(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		scans = append(scans, scan)
+	}
+
+	return scans, rows.Err()
+}
+
+// scanRetentionPolicy reads a policy from a row selected with retentionPolicyColumns
+func scanRetentionPolicy(row rowScanner) (*domain.RetentionPolicy, error) {
+	policy := &domain.RetentionPolicy{}
+	var projectID, createdBy uuid.NullUUID
+	var defaultBranch sql.NullString
+
+	err := row.Scan(
+		&policy.ID,
+		&policy.OrganizationID,
+		&projectID,
+		&policy.KeepDays,
+		&policy.KeepLastPerBranch,
+		&policy.KeepLatestDefaultBranch,
+		&defaultBranch,
+		&createdBy,
+		&policy.CreatedAt,
+		&policy.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if projectID.Valid {
+		policy.ProjectID = &projectID.UUID
+	}
+	if createdBy.Valid {
+		policy.CreatedBy = createdBy.UUID
+	}
+	policy.DefaultBranch = defaultBranch.String
+
+	return policy, nil
+}
@@ -0,0 +1,94 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+	"github.com/google/uuid"
+)
+
+// RunnerRequestRepository implements interfaces.RunnerRequestRepository using PostgreSQL
+type RunnerRequestRepository struct {
+	db *DB
+}
+
+// NewRunnerRequestRepository creates a new RunnerRequestRepository
+func NewRunnerRequestRepository(db *DB) interfaces.RunnerRequestRepository {
+	return &RunnerRequestRepository{db: db}
+}
+
+// Get returns the runner request of a scan with the request ID
+func (r *RunnerRequestRepository) Get(ctx context.Context, scanID uuid.UUID, requestID string) (*domain.RunnerRequest, error) {
+	request := &domain.RunnerRequest{}
+	err := r.db.QueryRowContext(ctx, `
+		SELECT scan_id, scan_created_at, request_id, method, response, created_at
+		FROM runner_requests WHERE scan_id = $1 AND request_id = $2`,
+		scanID, requestID,
+	).Scan(
+		&request.ScanID,
+		&request.ScanCreatedAt,
+		&request.RequestID,
+		&request.Method,
+		&request.Response,
+		&request.CreatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrRunnerRequestNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get runner request: %w", err)
+	}
+	return request, nil
+}
+
+// SaveResponse records the response of a runner request that was applied
+// before its response was known
+func (r *RunnerRequestRepository) SaveResponse(ctx context.Context, request *domain.RunnerRequest) error {
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE runner_requests SET response = $3 WHERE scan_id = $1 AND request_id = $2`,
+		request.ScanID, request.RequestID, request.Response,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save runner request response: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return domain.ErrRunnerRequestNotFound
+	}
+	return nil
+}
+
+// claimRunnerRequest records a runner request in the transaction that applies
+// it. It returns ErrRequestReplayed if the request ID was recorded before, in
+// which case the transaction must not apply the request. A nil request is a
+// callback without request ID.
+func claimRunnerRequest(ctx context.Context, tx *Tx, request *domain.RunnerRequest) error {
+	if request == nil {
+		return nil
+	}
+
+	result, err := tx.ExecContext(ctx, `
+		INSERT INTO runner_requests (scan_id, scan_created_at, request_id, method, response, created_at)
+		VALUES ($1, $2, $3, $4, $5, NOW())
+		ON CONFLICT (scan_id, request_id) DO NOTHING`,
+		request.ScanID, request.ScanCreatedAt, request.RequestID, request.Method, request.Response,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record runner request: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return domain.ErrRequestReplayed
+	}
+	return nil
+}
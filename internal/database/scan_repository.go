@@ -3,6 +3,7 @@ package database
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -22,19 +23,45 @@ func NewScanRepository(db *DB) interfaces.ScanRepository {
 	return &ScanRepository{db: db}
 }
 
-// Create creates a new scan
+// Create creates a new scan and writes its scan.queued event to the outbox
+// in the same transaction
 func (r *ScanRepository) Create(ctx context.Context, scan *domain.Scan) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := insertScan(ctx, tx, scan); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit scan: %w", err)
+	}
+
+	return nil
+}
+
+// insertScan inserts the scan and writes its scan.queued event to the outbox
+// in the transaction
+func insertScan(ctx context.Context, tx *Tx, scan *domain.Scan) error {
 	query := `
 		INSERT INTO scans (
 			id, organization_id, project_id, user_id, status, scan_types,
 			repository_url, branch, commit_sha, source_archive_key,
-			job_name, job_namespace, created_at, updated_at
+			job_name, job_namespace, trace_parent, job_settings, created_at, updated_at
 		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16
 		)
 	`
 
-	_, err := r.db.ExecContext(ctx, query,
+	jobSettings, err := marshalJobSettings(scan.JobSettings)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, query,
 		scan.ID,
 		scan.OrganizationID,
 		scan.ProjectID,
@@ -47,6 +74,8 @@ func (r *ScanRepository) Create(ctx context.Context, scan *domain.Scan) error {
 		scan.SourceArchiveKey,
 		scan.JobName,
 		scan.JobNamespace,
+		sql.NullString{String: scan.TraceParent, Valid: scan.TraceParent != ""},
+		jobSettings,
 		scan.CreatedAt,
 		scan.UpdatedAt,
 	)
@@ -55,69 +84,65 @@ func (r *ScanRepository) Create(ctx context.Context, scan *domain.Scan) error {
 		return fmt.Errorf("failed to create scan: %w", err)
 	}
 
-	return nil
+	return insertOutboxEvent(ctx, tx, domain.NewScanEvent(scan))
 }
 
 // Get retrieves a scan by ID
 func (r *ScanRepository) Get(ctx context.Context, id uuid.UUID) (*domain.Scan, error) {
-	query := `
-		SELECT
-			id, organization_id, project_id, user_id, status, scan_types,
-			repository_url, branch, commit_sha, source_archive_key,
-			job_name, job_namespace,
-			findings_count, critical_count, high_count, medium_count, low_count,
-			started_at, completed_at, error_message,
-			created_at, updated_at
-		FROM scans
-		WHERE id = $1
-	`
-
-	scan := &domain.Scan{}
-	var scanTypes pq.StringArray
-
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
-		&scan.ID,
-		&scan.OrganizationID,
-		&scan.ProjectID,
-		&scan.UserID,
-		&scan.Status,
-		&scanTypes,
-		&scan.RepositoryURL,
-		&scan.Branch,
-		&scan.CommitSHA,
-		&scan.SourceArchiveKey,
-		&scan.JobName,
-		&scan.JobNamespace,
-		&scan.FindingsCount,
-		&scan.CriticalCount,
-		&scan.HighCount,
-		&scan.MediumCount,
-		&scan.LowCount,
-		&scan.StartedAt,
-		&scan.CompletedAt,
-		&scan.ErrorMessage,
-		&scan.CreatedAt,
-		&scan.UpdatedAt,
-	)
+	query := `SELECT ` + scanColumns + ` FROM scans WHERE id = $1`
 
+	scan, err := scanScan(r.db.QueryRowContext(ctx, query, id))
 	if err == sql.ErrNoRows {
-		return nil, fmt.Errorf("scan not found")
+		return nil, domain.ErrScanNotFound
 	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to get scan: %w", err)
 	}
 
-	// Convert string array to ScanType array
-	scan.ScanTypes = make([]domain.ScanType, len(scanTypes))
-	for i, st := range scanTypes {
-		scan.ScanTypes[i] = domain.ScanType(st)
-	}
-
 	return scan, nil
 }
 
-// Update updates an existing scan
+// Update updates an existing scan. If the status changed, the scan event is
+// written to the outbox in the same transaction.
 func (r *ScanRepository) Update(ctx context.Context, scan *domain.Scan) error {
+	return r.update(ctx, scan, nil)
+}
+
+// UpdateOnce updates a scan like Update unless the runner request was applied
+// before, in which case ErrRequestReplayed is returned
+func (r *ScanRepository) UpdateOnce(ctx context.Context, request *domain.RunnerRequest, scan *domain.Scan) error {
+	return r.update(ctx, scan, request)
+}
+
+// update updates a scan, recording the runner request, if any, in the same
+// transaction
+func (r *ScanRepository) update(ctx context.Context, scan *domain.Scan, request *domain.RunnerRequest) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Lock the row so concurrent updates can't both observe the old status
+	var previousStatus domain.ScanStatus
+	err = tx.QueryRowContext(ctx, `SELECT status FROM scans WHERE id = $1 FOR UPDATE`, scan.ID).Scan(&previousStatus)
+	if err == sql.ErrNoRows {
+		return fmt.Errorf("scan not found")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get scan: %w", err)
+	}
+	if previousStatus == domain.ScanStatusDeleting {
+		return domain.ErrScanDeleting
+	}
+
+	if request != nil {
+		request.ScanCreatedAt = scan.CreatedAt
+		if err := claimRunnerRequest(ctx, tx, request); err != nil {
+			return err
+		}
+	}
+
 	query := `
 		UPDATE scans SET
 			status = $2,
@@ -130,13 +155,21 @@ func (r *ScanRepository) Update(ctx context.Context, scan *domain.Scan) error {
 			started_at = $9,
 			completed_at = $10,
 			error_message = $11,
-			updated_at = $12
+			updated_at = $12,
+			job_namespace = $13,
+			failure_reason = $14,
+			progress = $15
 		WHERE id = $1
 	`
 
+	progress, err := marshalProgress(scan.Progress)
+	if err != nil {
+		return err
+	}
+
 	scan.UpdatedAt = time.Now()
 
-	result, err := r.db.ExecContext(ctx, query,
+	_, err = tx.ExecContext(ctx, query,
 		scan.ID,
 		scan.Status,
 		scan.JobName,
@@ -149,19 +182,23 @@ func (r *ScanRepository) Update(ctx context.Context, scan *domain.Scan) error {
 		scan.CompletedAt,
 		scan.ErrorMessage,
 		scan.UpdatedAt,
+		scan.JobNamespace,
+		nullFailureReason(scan.FailureReason),
+		progress,
 	)
 
 	if err != nil {
 		return fmt.Errorf("failed to update scan: %w", err)
 	}
 
-	rows, err := result.RowsAffected()
-	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w", err)
+	if scan.Status != previousStatus {
+		if err := insertOutboxEvent(ctx, tx, domain.NewScanEvent(scan)); err != nil {
+			return err
+		}
 	}
 
-	if rows == 0 {
-		return fmt.Errorf("scan not found")
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit scan update: %w", err)
 	}
 
 	return nil
@@ -169,17 +206,7 @@ func (r *ScanRepository) Update(ctx context.Context, scan *domain.Scan) error {
 
 // List retrieves scans with optional filters
 func (r *ScanRepository) List(ctx context.Context, filter interfaces.ScanFilter) ([]*domain.Scan, error) {
-	query := `
-		SELECT
-			id, organization_id, project_id, user_id, status, scan_types,
-			repository_url, branch, commit_sha, source_archive_key,
-			job_name, job_namespace,
-			findings_count, critical_count, high_count, medium_count, low_count,
-			started_at, completed_at, error_message,
-			created_at, updated_at
-		FROM scans
-		WHERE 1=1
-	`
+	query := `SELECT ` + scanColumns + ` FROM scans WHERE 1=1`
 
 	args := []interface{}{}
 	argPos := 1
@@ -208,6 +235,18 @@ func (r *ScanRepository) List(ctx context.Context, filter interfaces.ScanFilter)
 		argPos++
 	}
 
+	if filter.CommitSHA != nil {
+		query += fmt.Sprintf(" AND commit_sha = $%d", argPos)
+		args = append(args, *filter.CommitSHA)
+		argPos++
+	}
+
+	if filter.CreatedBefore != nil {
+		query += fmt.Sprintf(" AND created_at < $%d", argPos)
+		args = append(args, *filter.CreatedBefore)
+		argPos++
+	}
+
 	query += " ORDER BY created_at DESC"
 
 	if filter.Limit > 0 {
@@ -230,110 +269,308 @@ func (r *ScanRepository) List(ctx context.Context, filter interfaces.ScanFilter)
 
 	scans := []*domain.Scan{}
 	for rows.Next() {
-		scan := &domain.Scan{}
-		var scanTypes pq.StringArray
-
-		err := rows.Scan(
-			&scan.ID,
-			&scan.OrganizationID,
-			&scan.ProjectID,
-			&scan.UserID,
-			&scan.Status,
-			&scanTypes,
-			&scan.RepositoryURL,
-			&scan.Branch,
-			&scan.CommitSHA,
-			&scan.SourceArchiveKey,
-			&scan.JobName,
-			&scan.JobNamespace,
-			&scan.FindingsCount,
-			&scan.CriticalCount,
-			&scan.HighCount,
-			&scan.MediumCount,
-			&scan.LowCount,
-			&scan.StartedAt,
-			&scan.CompletedAt,
-			&scan.ErrorMessage,
-			&scan.CreatedAt,
-			&scan.UpdatedAt,
-		)
-
+		scan, err := scanScan(rows)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan row: %w", err)
 		}
 
-		// Convert string array to ScanType array
-		scan.ScanTypes = make([]domain.ScanType, len(scanTypes))
-		for i, st := range scanTypes {
-			scan.ScanTypes[i] = domain.ScanType(st)
-		}
-
 		scans = append(scans, scan)
 	}
 
 	return scans, nil
 }
 
-// Delete deletes a scan (hard delete - permanently removes from database)
+// Delete permanently deletes a scan and, through the foreign key cascade, its findings
 func (r *ScanRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	query := `DELETE FROM scans WHERE id = $1`
-
-	result, err := r.db.ExecContext(ctx, query, id)
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
-		return fmt.Errorf("failed to delete scan: %w", err)
+		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
+	defer tx.Rollback()
 
-	rows, err := result.RowsAffected()
+	rows, err := deleteScan(ctx, tx, id)
 	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w", err)
+		return err
 	}
 
 	if rows == 0 {
 		return fmt.Errorf("scan not found")
 	}
 
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
 	return nil
 }
 
-// UpdateStatus updates only the status of a scan
+// deleteScan deletes a scan in the caller's transaction and returns the number
+// of deleted rows. Findings and sub-scans cascade with the scan, except findings written before
+// they carried scan_created_at and not reconciled yet. A scan under legal hold
+// is never deleted.
+func deleteScan(ctx context.Context, tx *Tx, id uuid.UUID) (int64, error) {
+	hold, err := activeScanHold(ctx, tx, id)
+	if err != nil {
+		return 0, err
+	}
+	if hold != nil {
+		return 0, hold.Err()
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM findings WHERE scan_id = $1 AND scan_created_at IS NULL`, id); err != nil {
+		return 0, fmt.Errorf("failed to delete findings: %w", err)
+	}
+
+	// The scan.deleted event carries the scan as it was deleted
+	scan, err := scanScan(tx.QueryRowContext(ctx, `DELETE FROM scans WHERE id = $1 RETURNING `+scanColumns, id))
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete scan: %w", err)
+	}
+
+	if err := insertOutboxEvent(ctx, tx, domain.NewScanDeletedEvent(scan)); err != nil {
+		return 0, err
+	}
+
+	return 1, nil
+}
+
+// UpdateStatus updates only the status of a scan. If the status changed, the
+// scan event is written to the outbox in the same transaction.
 func (r *ScanRepository) UpdateStatus(ctx context.Context, id uuid.UUID, status domain.ScanStatus) error {
-	query := `UPDATE scans SET status = $2, updated_at = $3 WHERE id = $1`
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
 
-	result, err := r.db.ExecContext(ctx, query, id, status, time.Now())
+	// The event carries a snapshot of the whole scan
+	scan, err := scanScan(tx.QueryRowContext(ctx, `SELECT `+scanColumns+` FROM scans WHERE id = $1 FOR UPDATE`, id))
+	if err == sql.ErrNoRows {
+		return fmt.Errorf("scan not found")
+	}
 	if err != nil {
+		return fmt.Errorf("failed to get scan: %w", err)
+	}
+
+	previousStatus := scan.Status
+	if previousStatus == domain.ScanStatusDeleting {
+		return domain.ErrScanDeleting
+	}
+	scan.Status = status
+	scan.UpdatedAt = time.Now()
+
+	query := `UPDATE scans SET status = $2, updated_at = $3 WHERE id = $1`
+
+	if _, err := tx.ExecContext(ctx, query, id, status, scan.UpdatedAt); err != nil {
 		return fmt.Errorf("failed to update scan status: %w", err)
 	}
 
+	if status != previousStatus {
+		if err := insertOutboxEvent(ctx, tx, domain.NewScanEvent(scan)); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit scan status: %w", err)
+	}
+
+	return nil
+}
+
+// RecordProgress records a runner's progress report on a running scan. Only
+// the progress and updated_at columns are written, so a report racing the
+// scan's completion can't undo it. It returns the scan's status.
+func (r *ScanRepository) RecordProgress(ctx context.Context, id uuid.UUID, progress *domain.Progress) (domain.ScanStatus, error) {
+	data, err := marshalProgress(progress)
+	if err != nil {
+		return "", err
+	}
+
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE scans SET progress = $2, updated_at = $3 WHERE id = $1 AND status = $4`,
+		id, data, progress.UpdatedAt, domain.ScanStatusRunning,
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to record progress: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return "", fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows > 0 {
+		return domain.ScanStatusRunning, nil
+	}
+
+	var status domain.ScanStatus
+	err = r.db.QueryRowContext(ctx, `SELECT status FROM scans WHERE id = $1`, id).Scan(&status)
+	if err == sql.ErrNoRows {
+		return "", domain.ErrScanNotFound
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get scan: %w", err)
+	}
+	return status, nil
+}
+
+// GetByJobName retrieves a scan by Kubernetes job name
+func (r *ScanRepository) GetByJobName(ctx context.Context, jobName string) (*domain.Scan, error) {
+	query := `SELECT ` + scanColumns + ` FROM scans WHERE job_name = $1`
+
+	scan, err := scanScan(r.db.QueryRowContext(ctx, query, jobName))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("scan not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scan by job name: %w", err)
+	}
+
+	return scan, nil
+}
+
+// StatusSummary counts scans by status and finds the oldest queued scan
+func (r *ScanRepository) StatusSummary(ctx context.Context) (*interfaces.ScanStatusSummary, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT status, COUNT(*) FROM scans GROUP BY status`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count scans: %w", err)
+	}
+	defer rows.Close()
+
+	summary := &interfaces.ScanStatusSummary{Counts: map[domain.ScanStatus]int{}}
+	for rows.Next() {
+		var status domain.ScanStatus
+		var count int
+		if err := rows.Scan(&status, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		summary.Counts[status] = count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to count scans: %w", err)
+	}
+
+	var oldest sql.NullTime
+	err = r.db.QueryRowContext(ctx,
+		`SELECT MIN(created_at) FROM scans WHERE status = $1`, domain.ScanStatusQueued,
+	).Scan(&oldest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get oldest queued scan: %w", err)
+	}
+	if oldest.Valid {
+		summary.OldestQueuedAt = &oldest.Time
+	}
+
+	return summary, nil
+}
+
+// ClaimLogArchives locks finished scans whose logs aren't archived yet,
+// oldest first, and hides them from other replicas for lease
+func (r *ScanRepository) ClaimLogArchives(ctx context.Context, completedAfter, now time.Time, lease time.Duration, limit int) ([]*domain.Scan, error) {
+	query := `
+		WITH due AS (
+			SELECT id AS due_id, created_at AS due_created_at
+			FROM scans
+			WHERE logs_artifact_id IS NULL
+				AND (job_name IS NOT NULL OR EXISTS (
+					SELECT 1 FROM sub_scans ss WHERE ss.scan_id = scans.id AND ss.job_name IS NOT NULL
+				))
+				AND status IN ('completed', 'partial', 'failed', 'cancelled')
+				AND completed_at > $1
+				AND (logs_archive_claimed_until IS NULL OR logs_archive_claimed_until <= $2)
+			ORDER BY completed_at
+			LIMIT $4
+			FOR UPDATE SKIP LOCKED
+		)
+		UPDATE scans
+		SET logs_archive_claimed_until = $3
+		FROM due
+		WHERE id = due.due_id AND created_at = due.due_created_at
+		RETURNING ` + scanColumns
+
+	rows, err := r.db.QueryContext(ctx, query, completedAfter, now, now.Add(lease), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim log archives: %w", err)
+	}
+	defer rows.Close()
+
+	scans := []*domain.Scan{}
+	for rows.Next() {
+		scan, err := scanScan(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		scans = append(scans, scan)
+	}
+
+	return scans, rows.Err()
+}
+
+// SetLogsArtifact records the artifact holding the scan's archived logs. A
+// scan being deleted counts as not found, since its deletion may already have
+// looked for artifacts.
+func (r *ScanRepository) SetLogsArtifact(ctx context.Context, id uuid.UUID, artifactID string) error {
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE scans SET logs_artifact_id = $2, logs_archive_claimed_until = NULL WHERE id = $1 AND status <> 'deleting'`,
+		id, artifactID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to set logs artifact: %w", err)
+	}
+
 	rows, err := result.RowsAffected()
 	if err != nil {
 		return fmt.Errorf("failed to get rows affected: %w", err)
 	}
 
 	if rows == 0 {
-		return fmt.Errorf("scan not found")
+		return domain.ErrScanNotFound
 	}
 
 	return nil
 }
 
-// GetByJobName retrieves a scan by Kubernetes job name
-func (r *ScanRepository) GetByJobName(ctx context.Context, jobName string) (*domain.Scan, error) {
-	query := `
-		SELECT
-			id, organization_id, project_id, user_id, status, scan_types,
-			repository_url, branch, commit_sha, source_archive_key,
-			job_name, job_namespace,
-			findings_count, critical_count, high_count, medium_count, low_count,
-			started_at, completed_at, error_message,
-			created_at, updated_at
-		FROM scans
-		WHERE job_name = $1
-	`
+// LockCommit takes a session lock on the commit of the project on a dedicated
+// connection, waiting for other holders. The connection is held until unlock is
+// called, and discarded if the lock can't be released.
+func (r *ScanRepository) LockCommit(ctx context.Context, projectID uuid.UUID, commitSHA string) (func(), error) {
+	conn, err := r.db.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get connection: %w", err)
+	}
+
+	key := "scan-commit:" + projectID.String() + ":" + commitSHA
+	if _, err := conn.ExecContext(ctx, `SELECT pg_advisory_lock(hashtextextended($1, 0))`, key); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to acquire commit lock: %w", err)
+	}
+
+	unlock := func() {
+		releaseSessionLock(conn, "commit", `SELECT pg_advisory_unlock(hashtextextended($1, 0))`, key)
+	}
+
+	return unlock, nil
+}
 
+const scanColumns = `
+	id, organization_id, project_id, user_id, status, scan_types,
+	repository_url, branch, commit_sha, source_archive_key, logs_artifact_id,
+	job_name, job_namespace,
+	findings_count, critical_count, high_count, medium_count, low_count,
+	started_at, completed_at, error_message, failure_reason, trace_parent, job_settings,
+	progress, created_at, updated_at
+`
+
+// scanScan reads a scan from a row selected with scanColumns
+func scanScan(row rowScanner) (*domain.Scan, error) {
 	scan := &domain.Scan{}
 	var scanTypes pq.StringArray
+	var failureReason, traceParent sql.NullString
+	var jobSettings, progress []byte
 
-	err := r.db.QueryRowContext(ctx, query, jobName).Scan(
+	err := row.Scan(
 		&scan.ID,
 		&scan.OrganizationID,
 		&scan.ProjectID,
@@ -344,6 +581,7 @@ func (r *ScanRepository) GetByJobName(ctx context.Context, jobName string) (*dom
 		&scan.Branch,
 		&scan.CommitSHA,
 		&scan.SourceArchiveKey,
+		&scan.LogsArtifactID,
 		&scan.JobName,
 		&scan.JobNamespace,
 		&scan.FindingsCount,
@@ -354,22 +592,75 @@ func (r *ScanRepository) GetByJobName(ctx context.Context, jobName string) (*dom
 		&scan.StartedAt,
 		&scan.CompletedAt,
 		&scan.ErrorMessage,
+		&failureReason,
+		&traceParent,
+		&jobSettings,
+		&progress,
 		&scan.CreatedAt,
 		&scan.UpdatedAt,
 	)
+	if err != nil {
+		return nil, err
+	}
+	scan.FailureReason = domain.FailureReason(failureReason.String)
+	scan.TraceParent = traceParent.String
 
-	if err == sql.ErrNoRows {
-		return nil, fmt.Errorf("scan not found")
+	if jobSettings != nil {
+		scan.JobSettings = &domain.JobSettings{}
+		if err := json.Unmarshal(jobSettings, scan.JobSettings); err != nil {
+			return nil, fmt.Errorf("failed to decode job settings: %w", err)
+		}
 	}
-	if err != nil {
-		return nil, fmt.Errorf("failed to get scan by job name: %w", err)
+
+	if scan.Progress, err = unmarshalProgress(progress); err != nil {
+		return nil, err
 	}
 
-	// Convert string array to ScanType array
 	scan.ScanTypes = make([]domain.ScanType, len(scanTypes))
 	for i, st := range scanTypes {
 		scan.ScanTypes[i] = domain.ScanType(st)
 	}
 
 	return scan, nil
-}
\ No newline at end of file
+}
+
+// nullFailureReason stores an empty failure reason as NULL
+func nullFailureReason(reason domain.FailureReason) sql.NullString {
+	return sql.NullString{String: string(reason), Valid: reason != ""}
+}
+
+// marshalJobSettings encodes job settings for a JSONB column, NULL if unset
+func marshalJobSettings(settings *domain.JobSettings) (sql.NullString, error) {
+	if settings == nil || settings.IsEmpty() {
+		return sql.NullString{}, nil
+	}
+	data, err := json.Marshal(settings)
+	if err != nil {
+		return sql.NullString{}, fmt.Errorf("failed to encode job settings: %w", err)
+	}
+	return sql.NullString{String: string(data), Valid: true}, nil
+}
+
+// marshalProgress encodes runner progress for a JSONB column, NULL if unset
+func marshalProgress(progress *domain.Progress) (sql.NullString, error) {
+	if progress == nil {
+		return sql.NullString{}, nil
+	}
+	data, err := json.Marshal(progress)
+	if err != nil {
+		return sql.NullString{}, fmt.Errorf("failed to encode progress: %w", err)
+	}
+	return sql.NullString{String: string(data), Valid: true}, nil
+}
+
+// unmarshalProgress decodes runner progress read from a JSONB column
+func unmarshalProgress(data []byte) (*domain.Progress, error) {
+	if data == nil {
+		return nil, nil
+	}
+	progress := &domain.Progress{}
+	if err := json.Unmarshal(data, progress); err != nil {
+		return nil, fmt.Errorf("failed to decode progress: %w", err)
+	}
+	return progress, nil
+}
@@ -0,0 +1,150 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+	"github.com/lib/pq"
+)
+
+// ScannerRepository implements interfaces.ScannerRepository using PostgreSQL
+type ScannerRepository struct {
+	db *DB
+}
+
+// NewScannerRepository creates a new ScannerRepository
+func NewScannerRepository(db *DB) interfaces.ScannerRepository {
+	return &ScannerRepository{db: db}
+}
+
+const scannerColumns = `
+	scan_type, name, image, version, digest, args, env,
+	requests_cpu, requests_memory, limits_cpu, limits_memory,
+	timeout_seconds, enabled
+`
+
+// List returns the scanners ordered by scan type
+func (r *ScannerRepository) List(ctx context.Context) ([]interfaces.ScannerConfig, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT `+scannerColumns+` FROM scanners ORDER BY scan_type`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scanners: %w", err)
+	}
+	defer rows.Close()
+
+	scanners := []interfaces.ScannerConfig{}
+	for rows.Next() {
+		scanner, err := scanScanner(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		scanners = append(scanners, *scanner)
+	}
+
+	return scanners, rows.Err()
+}
+
+// Set creates or replaces the scanner of its scan type
+func (r *ScannerRepository) Set(ctx context.Context, scanner *interfaces.ScannerConfig) error {
+	env := []byte("{}")
+	if len(scanner.Env) > 0 {
+		var err error
+		if env, err = json.Marshal(scanner.Env); err != nil {
+			return fmt.Errorf("failed to encode env of %s scanner: %w", scanner.ScanType, err)
+		}
+	}
+	args := scanner.Args
+	if args == nil {
+		args = []string{}
+	}
+
+	var timeoutSeconds sql.NullInt64
+	if scanner.Timeout > 0 {
+		timeoutSeconds = sql.NullInt64{Int64: int64(scanner.Timeout / time.Second), Valid: true}
+	}
+
+	query := `
+		INSERT INTO scanners (` + scannerColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		ON CONFLICT (scan_type) DO UPDATE SET
+			name = EXCLUDED.name,
+			image = EXCLUDED.image,
+			version = EXCLUDED.version,
+			digest = EXCLUDED.digest,
+			args = EXCLUDED.args,
+			env = EXCLUDED.env,
+			requests_cpu = EXCLUDED.requests_cpu,
+			requests_memory = EXCLUDED.requests_memory,
+			limits_cpu = EXCLUDED.limits_cpu,
+			limits_memory = EXCLUDED.limits_memory,
+			timeout_seconds = EXCLUDED.timeout_seconds,
+			enabled = EXCLUDED.enabled,
+			updated_at = NOW()
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		scanner.ScanType,
+		scanner.Name,
+		scanner.Image,
+		scanner.Version,
+		sql.NullString{String: scanner.Digest, Valid: scanner.Digest != ""},
+		pq.Array(args),
+		string(env),
+		sql.NullString{String: scanner.Resources.Requests.CPU, Valid: scanner.Resources.Requests.CPU != ""},
+		sql.NullString{String: scanner.Resources.Requests.Memory, Valid: scanner.Resources.Requests.Memory != ""},
+		sql.NullString{String: scanner.Resources.Limits.CPU, Valid: scanner.Resources.Limits.CPU != ""},
+		sql.NullString{String: scanner.Resources.Limits.Memory, Valid: scanner.Resources.Limits.Memory != ""},
+		timeoutSeconds,
+		scanner.Enabled,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to set scanner: %w", err)
+	}
+
+	return nil
+}
+
+// scanScanner reads a scanner from a row selected with scannerColumns
+func scanScanner(row rowScanner) (*interfaces.ScannerConfig, error) {
+	scanner := &interfaces.ScannerConfig{}
+	var digest, requestsCPU, requestsMemory, limitsCPU, limitsMemory sql.NullString
+	var timeoutSeconds sql.NullInt64
+	var env []byte
+
+	err := row.Scan(
+		&scanner.ScanType,
+		&scanner.Name,
+		&scanner.Image,
+		&scanner.Version,
+		&digest,
+		pq.Array(&scanner.Args),
+		&env,
+		&requestsCPU,
+		&requestsMemory,
+		&limitsCPU,
+		&limitsMemory,
+		&timeoutSeconds,
+		&scanner.Enabled,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(env, &scanner.Env); err != nil {
+		return nil, fmt.Errorf("failed to decode env of %s scanner: %w", scanner.ScanType, err)
+	}
+
+	scanner.Digest = digest.String
+	scanner.Resources.Requests.CPU = requestsCPU.String
+	scanner.Resources.Requests.Memory = requestsMemory.String
+	scanner.Resources.Limits.CPU = limitsCPU.String
+	scanner.Resources.Limits.Memory = limitsMemory.String
+	if timeoutSeconds.Valid {
+		scanner.Timeout = time.Duration(timeoutSeconds.Int64) * time.Second
+	}
+
+	return scanner, nil
+}
@@ -0,0 +1,326 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// ScheduleRepository implements interfaces.ScheduleRepository using PostgreSQL
+type ScheduleRepository struct {
+	db *DB
+}
+
+// NewScheduleRepository creates a new ScheduleRepository
+func NewScheduleRepository(db *DB) interfaces.ScheduleRepository {
+	return &ScheduleRepository{db: db}
+}
+
+const scheduleColumns = `
+	id, organization_id, project_id, cron_expression, timezone, scan_types,
+	repository_url, branch, enabled,
+	next_run_at, last_run_at, last_scan_id,
+	created_by, created_at, updated_at
+`
+
+// Create creates a new schedule
+func (r *ScheduleRepository) Create(ctx context.Context, schedule *domain.ScanSchedule) error {
+	query := `
+		INSERT INTO scan_schedules (
+			id, organization_id, project_id, cron_expression, timezone, scan_types,
+			repository_url, branch, enabled, next_run_at,
+			created_by, created_at, updated_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
+		)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		schedule.ID,
+		schedule.OrganizationID,
+		schedule.ProjectID,
+		schedule.CronExpression,
+		schedule.Timezone,
+		pq.Array(schedule.ScanTypes),
+		schedule.RepositoryURL,
+		schedule.Branch,
+		schedule.Enabled,
+		schedule.NextRunAt,
+		schedule.CreatedBy,
+		schedule.CreatedAt,
+		schedule.UpdatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to create schedule: %w", err)
+	}
+
+	return nil
+}
+
+// Get retrieves a schedule by ID
+func (r *ScheduleRepository) Get(ctx context.Context, id uuid.UUID) (*domain.ScanSchedule, error) {
+	query := `SELECT ` + scheduleColumns + ` FROM scan_schedules WHERE id = $1`
+
+	schedule, err := scanSchedule(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("schedule not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schedule: %w", err)
+	}
+
+	return schedule, nil
+}
+
+// Update updates an existing schedule's definition and next run time
+func (r *ScheduleRepository) Update(ctx context.Context, schedule *domain.ScanSchedule) error {
+	query := `
+		UPDATE scan_schedules SET
+			cron_expression = $2,
+			timezone = $3,
+			scan_types = $4,
+			repository_url = $5,
+			branch = $6,
+			enabled = $7,
+			next_run_at = $8,
+			updated_at = $9
+		WHERE id = $1
+	`
+
+	schedule.UpdatedAt = time.Now()
+
+	result, err := r.db.ExecContext(ctx, query,
+		schedule.ID,
+		schedule.CronExpression,
+		schedule.Timezone,
+		pq.Array(schedule.ScanTypes),
+		schedule.RepositoryURL,
+		schedule.Branch,
+		schedule.Enabled,
+		schedule.NextRunAt,
+		schedule.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update schedule: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rows == 0 {
+		return fmt.Errorf("schedule not found")
+	}
+
+	return nil
+}
+
+// List retrieves schedules with optional filters
+func (r *ScheduleRepository) List(ctx context.Context, filter interfaces.ScheduleFilter) ([]*domain.ScanSchedule, error) {
+	query := `SELECT ` + scheduleColumns + ` FROM scan_schedules WHERE 1=1`
+
+	args := []interface{}{}
+	argPos := 1
+
+	if filter.OrganizationID != nil {
+		query += fmt.Sprintf(" AND organization_id = $%d", argPos)
+		args = append(args, *filter.OrganizationID)
+		argPos++
+	}
+
+	if filter.ProjectID != nil {
+		query += fmt.Sprintf(" AND project_id = $%d", argPos)
+		args = append(args, *filter.ProjectID)
+		argPos++
+	}
+
+	if filter.Enabled != nil {
+		query += fmt.Sprintf(" AND enabled = $%d", argPos)
+		args = append(args, *filter.Enabled)
+		argPos++
+	}
+
+	query += " ORDER BY created_at DESC"
+
+	if filter.Limit > 0 {
+		query += fmt.Sprintf(" LIMIT $%d", argPos)
+		args = append(args, filter.Limit)
+		argPos++
+	}
+
+	if filter.Offset > 0 {
+		query += fmt.Sprintf(" OFFSET $%d", argPos)
+		args = append(args, filter.Offset)
+		argPos++
+	}
+
+	return r.query(ctx, query, args...)
+}
+
+// Delete deletes a schedule
+func (r *ScheduleRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM scan_schedules WHERE id = $1`
+
+	result, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete schedule: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rows == 0 {
+		return fmt.Errorf("schedule not found")
+	}
+
+	return nil
+}
+
+// ListDue retrieves enabled schedules whose next run time is at or before now
+func (r *ScheduleRepository) ListDue(ctx context.Context, now time.Time, limit int) ([]*domain.ScanSchedule, error) {
+	query := `SELECT ` + scheduleColumns + `
+		FROM scan_schedules
+		WHERE enabled = true AND next_run_at IS NOT NULL AND next_run_at <= $1
+		ORDER BY next_run_at ASC
+		LIMIT $2`
+
+	return r.query(ctx, query, now, limit)
+}
+
+// ClaimRun atomically moves next_run_at from expected to next.
+// The compare-and-set makes sure only one orchestrator replica enqueues a given run.
+func (r *ScheduleRepository) ClaimRun(ctx context.Context, id uuid.UUID, expected, next time.Time) (bool, error) {
+	query := `
+		UPDATE scan_schedules SET next_run_at = $3, updated_at = $4
+		WHERE id = $1 AND next_run_at = $2 AND enabled = true
+	`
+
+	result, err := r.db.ExecContext(ctx, query, id, expected, next, time.Now())
+	if err != nil {
+		return false, fmt.Errorf("failed to claim schedule run: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rows == 1, nil
+}
+
+// EnqueueRun claims the run like ClaimRun, creates the scan and records it as
+// the schedule's last run in one transaction, so a run is never claimed
+// without its scan. It returns false, and creates nothing, if another
+// replica claimed the run.
+func (r *ScheduleRepository) EnqueueRun(ctx context.Context, id uuid.UUID, expected, next time.Time, scan *domain.Scan) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE scan_schedules
+		SET next_run_at = $3, last_run_at = $4, last_scan_id = $5, updated_at = $6
+		WHERE id = $1 AND next_run_at = $2 AND enabled = true`,
+		id, expected, next, scan.CreatedAt, scan.ID, time.Now(),
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to claim schedule run: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows != 1 {
+		return false, nil
+	}
+
+	if err := insertScan(ctx, tx, scan); err != nil {
+		return false, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit schedule run: %w", err)
+	}
+
+	return true, nil
+}
+
+// query runs a schedule SELECT and scans all rows
+func (r *ScheduleRepository) query(ctx context.Context, query string, args ...interface{}) ([]*domain.ScanSchedule, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list schedules: %w", err)
+	}
+	defer rows.Close()
+
+	schedules := []*domain.ScanSchedule{}
+	for rows.Next() {
+		schedule, err := scanSchedule(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		schedules = append(schedules, schedule)
+	}
+
+	return schedules, rows.Err()
+}
+
+// rowScanner is satisfied by both *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanSchedule reads a schedule from a row selected with scheduleColumns
+func scanSchedule(row rowScanner) (*domain.ScanSchedule, error) {
+	schedule := &domain.ScanSchedule{}
+	var scanTypes pq.StringArray
+	var lastScanID uuid.NullUUID
+	var createdBy uuid.NullUUID
+
+	err := row.Scan(
+		&schedule.ID,
+		&schedule.OrganizationID,
+		&schedule.ProjectID,
+		&schedule.CronExpression,
+		&schedule.Timezone,
+		&scanTypes,
+		&schedule.RepositoryURL,
+		&schedule.Branch,
+		&schedule.Enabled,
+		&schedule.NextRunAt,
+		&schedule.LastRunAt,
+		&lastScanID,
+		&createdBy,
+		&schedule.CreatedAt,
+		&schedule.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule.ScanTypes = make([]domain.ScanType, len(scanTypes))
+	for i, st := range scanTypes {
+		schedule.ScanTypes[i] = domain.ScanType(st)
+	}
+	if lastScanID.Valid {
+		schedule.LastScanID = &lastScanID.UUID
+	}
+	if createdBy.Valid {
+		schedule.CreatedBy = createdBy.UUID
+	}
+
+	return schedule, nil
+}
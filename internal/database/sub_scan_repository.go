@@ -0,0 +1,700 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+	"github.com/google/uuid"
+)
+
+// SubScanRepository implements interfaces.SubScanRepository using PostgreSQL
+type SubScanRepository struct {
+	db *DB
+}
+
+// NewSubScanRepository creates a new SubScanRepository
+func NewSubScanRepository(db *DB) interfaces.SubScanRepository {
+	return &SubScanRepository{db: db}
+}
+
+const subScanColumns = `
+	scan_id, scan_created_at, scan_type, scanner_name, scanner_image, status,
+	job_name, job_namespace,
+	findings_count, critical_count, high_count, medium_count, low_count,
+	error_message, started_at, completed_at,
+	attempt, failure_reason, retry_at, last_heartbeat_at, progress, created_at, updated_at
+`
+
+// Create records the sub-scans of a dispatched scan and aggregates them into the scan
+func (r *SubScanRepository) Create(ctx context.Context, scan *domain.Scan, subs []*domain.SubScan) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	previousStatus, err := lockScanStatus(ctx, tx, scan.ID)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO sub_scans (
+			scan_id, scan_created_at, scan_type, scanner_name, scanner_image, status,
+			job_name, job_namespace, error_message, started_at, completed_at,
+			attempt, failure_reason, created_at, updated_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15
+		)
+	`
+
+	for _, sub := range subs {
+		if _, err := tx.ExecContext(ctx, query,
+			scan.ID,
+			scan.CreatedAt,
+			sub.ScanType,
+			sub.ScannerName,
+			sub.ScannerImage,
+			sub.Status,
+			sub.JobName,
+			sub.JobNamespace,
+			sub.ErrorMessage,
+			sub.StartedAt,
+			sub.CompletedAt,
+			sub.Attempt,
+			nullFailureReason(sub.FailureReason),
+			sub.CreatedAt,
+			sub.UpdatedAt,
+		); err != nil {
+			return fmt.Errorf("failed to create %s sub-scan: %w", sub.ScanType, err)
+		}
+	}
+
+	// A scan cancelled while its jobs were created stays cancelled
+	scan.SubScans = subs
+	if !(&domain.Scan{Status: previousStatus}).IsTerminal() {
+		scan.Aggregate(subs)
+		if err := updateAggregatedScan(ctx, tx, scan, previousStatus); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// ListByScan returns the scan's sub-scans ordered by scan type
+func (r *SubScanRepository) ListByScan(ctx context.Context, scanID uuid.UUID) ([]*domain.SubScan, error) {
+	return listSubScans(ctx, r.db, scanID)
+}
+
+// Update updates a sub-scan and returns its parent scan as aggregated
+func (r *SubScanRepository) Update(ctx context.Context, sub *domain.SubScan) (*domain.Scan, error) {
+	return r.update(ctx, sub, nil)
+}
+
+// UpdateFromRunner applies the report of the runner of attempt to a sub-scan
+// like Update. The runner request, if any, is recorded in the same
+// transaction; ErrRequestReplayed is returned if it was applied before. A
+// report of a replaced attempt returns ErrStaleAttempt, one for a sub-scan
+// that finished or is queued for a retry ErrSubScanNotRunning.
+func (r *SubScanRepository) UpdateFromRunner(ctx context.Context, request *domain.RunnerRequest, attempt int, sub *domain.SubScan) (*domain.Scan, error) {
+	return r.update(ctx, sub, func(tx *Tx, scan *domain.Scan) error {
+		if request != nil {
+			request.ScanCreatedAt = scan.CreatedAt
+		}
+		if err := claimRunnerRequest(ctx, tx, request); err != nil {
+			return err
+		}
+		if sub.Attempt != attempt {
+			return domain.ErrStaleAttempt
+		}
+		return lockRunningSubScan(ctx, tx, sub)
+	})
+}
+
+// UpdateRunning updates a sub-scan like Update if it is still running the
+// attempt it was read with
+func (r *SubScanRepository) UpdateRunning(ctx context.Context, sub *domain.SubScan) (*domain.Scan, error) {
+	return r.update(ctx, sub, func(tx *Tx, scan *domain.Scan) error {
+		return lockRunningSubScan(ctx, tx, sub)
+	})
+}
+
+// lockRunningSubScan locks the stored sub-scan and checks that it still runs
+// sub's attempt. It may have been finished, failed or retried since it was read.
+func lockRunningSubScan(ctx context.Context, tx *Tx, sub *domain.SubScan) error {
+	var current domain.ScanStatus
+	var currentAttempt int
+	err := tx.QueryRowContext(ctx,
+		`SELECT status, attempt FROM sub_scans WHERE scan_id = $1 AND scan_type = $2 FOR UPDATE`,
+		sub.ScanID, sub.ScanType,
+	).Scan(&current, &currentAttempt)
+	if err == sql.ErrNoRows {
+		return domain.ErrSubScanNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get sub-scan: %w", err)
+	}
+	if currentAttempt != sub.Attempt {
+		return domain.ErrStaleAttempt
+	}
+	if current != domain.ScanStatusRunning {
+		return domain.ErrSubScanNotRunning
+	}
+	return nil
+}
+
+// RecordRetry records the dispatch of a sub-scan's retry like Update and
+// deletes the findings of its earlier attempts in the same transaction, so
+// findings reported again by the retry aren't stored twice
+func (r *SubScanRepository) RecordRetry(ctx context.Context, sub *domain.SubScan) (*domain.Scan, error) {
+	return r.update(ctx, sub, func(tx *Tx, scan *domain.Scan) error {
+		if _, err := tx.ExecContext(ctx,
+			`DELETE FROM findings WHERE scan_id = $1 AND scan_created_at = $2 AND scan_type = $3`,
+			scan.ID, scan.CreatedAt, sub.ScanType,
+		); err != nil {
+			return fmt.Errorf("failed to delete findings of earlier attempts: %w", err)
+		}
+		return nil
+	})
+}
+
+// update updates a sub-scan and aggregates its parent scan. before, if set,
+// runs in the transaction once the parent is locked; an error aborts the
+// update.
+func (r *SubScanRepository) update(ctx context.Context, sub *domain.SubScan, before func(tx *Tx, scan *domain.Scan) error) (*domain.Scan, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// The parent is locked first so concurrent sub-scan updates aggregate in turn
+	scan, err := scanScan(tx.QueryRowContext(ctx, `SELECT `+scanColumns+` FROM scans WHERE id = $1 FOR UPDATE`, sub.ScanID))
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrScanNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scan: %w", err)
+	}
+	if scan.Status == domain.ScanStatusDeleting {
+		return nil, domain.ErrScanDeleting
+	}
+
+	if before != nil {
+		if err := before(tx, scan); err != nil {
+			return nil, err
+		}
+	}
+
+	progress, err := marshalProgress(sub.Progress)
+	if err != nil {
+		return nil, err
+	}
+
+	sub.UpdatedAt = time.Now()
+
+	query := `
+		UPDATE sub_scans SET
+			status = $3,
+			job_name = $4,
+			job_namespace = $5,
+			findings_count = $6,
+			critical_count = $7,
+			high_count = $8,
+			medium_count = $9,
+			low_count = $10,
+			error_message = $11,
+			started_at = $12,
+			completed_at = $13,
+			attempt = $14,
+			failure_reason = $15,
+			retry_at = $16,
+			scanner_name = $17,
+			scanner_image = $18,
+			last_heartbeat_at = $19,
+			progress = $20,
+			updated_at = $21
+		WHERE scan_id = $1 AND scan_type = $2
+	`
+
+	result, err := tx.ExecContext(ctx, query,
+		sub.ScanID,
+		sub.ScanType,
+		sub.Status,
+		sub.JobName,
+		sub.JobNamespace,
+		sub.FindingsCount,
+		sub.CriticalCount,
+		sub.HighCount,
+		sub.MediumCount,
+		sub.LowCount,
+		sub.ErrorMessage,
+		sub.StartedAt,
+		sub.CompletedAt,
+		sub.Attempt,
+		nullFailureReason(sub.FailureReason),
+		sub.RetryAt,
+		sub.ScannerName,
+		sub.ScannerImage,
+		sub.LastHeartbeatAt,
+		progress,
+		sub.UpdatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update sub-scan: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return nil, domain.ErrSubScanNotFound
+	}
+
+	subs, err := listSubScans(ctx, tx, sub.ScanID)
+	if err != nil {
+		return nil, err
+	}
+	scan.SubScans = subs
+
+	// A cancelled or otherwise finished scan isn't reopened by late reports
+	if scan.IsTerminal() {
+		if err := tx.Commit(); err != nil {
+			return nil, fmt.Errorf("failed to commit transaction: %w", err)
+		}
+		return scan, nil
+	}
+
+	previousStatus := scan.Status
+	scan.Aggregate(subs)
+	if err := updateAggregatedScan(ctx, tx, scan, previousStatus); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return scan, nil
+}
+
+// ListRetriesDue returns the sub-scans queued for a retry that is due, of
+// scans still running, oldest first
+func (r *SubScanRepository) ListRetriesDue(ctx context.Context, now time.Time, limit int) ([]*domain.SubScan, error) {
+	query := `
+		SELECT ` + subScanColumns + ` FROM sub_scans
+		WHERE status = $1 AND retry_at <= $2
+		  AND scan_id IN (SELECT id FROM scans WHERE status = $3)
+		ORDER BY retry_at
+		LIMIT $4
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, domain.ScanStatusQueued, now, domain.ScanStatusRunning, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list due retries: %w", err)
+	}
+	defer rows.Close()
+
+	subs := []*domain.SubScan{}
+	for rows.Next() {
+		sub, err := scanSubScan(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		subs = append(subs, sub)
+	}
+
+	return subs, rows.Err()
+}
+
+// ClaimLogArchives locks finished sub-scans, and those queued for a retry,
+// whose current attempt's log isn't archived yet, oldest first, and hides
+// them from other replicas for lease
+func (r *SubScanRepository) ClaimLogArchives(ctx context.Context, completedAfter, now time.Time, lease time.Duration, limit int) ([]*domain.SubScan, error) {
+	query := `
+		WITH due AS (
+			SELECT scan_id AS due_scan_id, scan_type AS due_scan_type
+			FROM sub_scans ss
+			WHERE job_name IS NOT NULL
+				AND (status IN ('completed', 'failed', 'cancelled') AND completed_at > $1
+					OR status = 'queued' AND retry_at IS NOT NULL AND updated_at > $1)
+				AND (logs_archive_claimed_until IS NULL OR logs_archive_claimed_until <= $2)
+				AND NOT EXISTS (
+					SELECT 1 FROM sub_scan_logs l
+					WHERE l.scan_id = ss.scan_id AND l.scan_type = ss.scan_type AND l.attempt = ss.attempt
+				)
+			ORDER BY COALESCE(completed_at, updated_at)
+			LIMIT $4
+			FOR UPDATE SKIP LOCKED
+		)
+		UPDATE sub_scans
+		SET logs_archive_claimed_until = $3
+		FROM due
+		WHERE scan_id = due.due_scan_id AND scan_type = due.due_scan_type
+		RETURNING ` + subScanColumns
+
+	rows, err := r.db.QueryContext(ctx, query, completedAfter, now, now.Add(lease), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim sub-scan log archives: %w", err)
+	}
+	defer rows.Close()
+
+	subs := []*domain.SubScan{}
+	for rows.Next() {
+		sub, err := scanSubScan(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		subs = append(subs, sub)
+	}
+
+	return subs, rows.Err()
+}
+
+// AddLog records the archived log of an attempt. It returns false if the
+// scan is gone or being deleted, since its deletion may already have looked
+// for artifacts, or the attempt's log was recorded before.
+func (r *SubScanRepository) AddLog(ctx context.Context, entry *domain.SubScanLog) (bool, error) {
+	query := `
+		INSERT INTO sub_scan_logs (
+			scan_id, scan_created_at, scan_type, attempt, scanner_name, artifact_id, created_at
+		)
+		SELECT id, created_at, $2, $3, $4, $5, $6
+		FROM scans
+		WHERE id = $1 AND status <> 'deleting'
+		FOR SHARE
+		ON CONFLICT (scan_id, scan_type, attempt) DO NOTHING
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		entry.ScanID,
+		entry.ScanType,
+		entry.Attempt,
+		entry.ScannerName,
+		entry.ArtifactID,
+		entry.CreatedAt,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to add sub-scan log: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return rows > 0, nil
+}
+
+// ListLogs returns the archived logs of the scan's sub-scans ordered by scan
+// type and attempt
+func (r *SubScanRepository) ListLogs(ctx context.Context, scanID uuid.UUID) ([]*domain.SubScanLog, error) {
+	query := `
+		SELECT scan_id, scan_created_at, scan_type, attempt, scanner_name, artifact_id, created_at
+		FROM sub_scan_logs
+		WHERE scan_id = $1
+		ORDER BY scan_type, attempt
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, scanID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sub-scan logs: %w", err)
+	}
+	defer rows.Close()
+
+	logs := []*domain.SubScanLog{}
+	for rows.Next() {
+		entry := &domain.SubScanLog{}
+		if err := rows.Scan(
+			&entry.ScanID,
+			&entry.ScanCreatedAt,
+			&entry.ScanType,
+			&entry.Attempt,
+			&entry.ScannerName,
+			&entry.ArtifactID,
+			&entry.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		logs = append(logs, entry)
+	}
+
+	return logs, rows.Err()
+}
+
+// DeleteLogs deletes the records of the scan's archived sub-scan logs
+func (r *SubScanRepository) DeleteLogs(ctx context.Context, scanID uuid.UUID) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM sub_scan_logs WHERE scan_id = $1`, scanID); err != nil {
+		return fmt.Errorf("failed to delete sub-scan logs: %w", err)
+	}
+	return nil
+}
+
+// RecordHeartbeat records a runner callback for a running sub-scan. Other
+// sub-scans are left unchanged.
+func (r *SubScanRepository) RecordHeartbeat(ctx context.Context, scanID uuid.UUID, scanType domain.ScanType, at time.Time) error {
+	query := `
+		UPDATE sub_scans SET last_heartbeat_at = $3
+		WHERE scan_id = $1 AND scan_type = $2 AND status = $4
+		  AND (last_heartbeat_at IS NULL OR last_heartbeat_at < $3)
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, scanID, scanType, at, domain.ScanStatusRunning); err != nil {
+		return fmt.Errorf("failed to record heartbeat: %w", err)
+	}
+	return nil
+}
+
+// RecordProgress records a runner's progress report for a running sub-scan,
+// as a heartbeat too, and combines it into the scan's progress. Sub-scans
+// that aren't running are left unchanged. It returns the sub-scan, or
+// ErrStaleAttempt for a report of a replaced attempt.
+func (r *SubScanRepository) RecordProgress(ctx context.Context, scanID uuid.UUID, scanType domain.ScanType, attempt int, progress *domain.Progress) (*domain.SubScan, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	scan, err := scanScan(tx.QueryRowContext(ctx, `SELECT `+scanColumns+` FROM scans WHERE id = $1 FOR UPDATE`, scanID))
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrScanNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scan: %w", err)
+	}
+	if scan.Status == domain.ScanStatusDeleting {
+		return nil, domain.ErrScanDeleting
+	}
+
+	subs, err := listSubScans(ctx, tx, scanID)
+	if err != nil {
+		return nil, err
+	}
+	var sub *domain.SubScan
+	for _, candidate := range subs {
+		if candidate.ScanType == scanType {
+			sub = candidate
+			break
+		}
+	}
+	if sub == nil {
+		return nil, domain.ErrSubScanNotFound
+	}
+	if sub.Attempt != attempt {
+		return nil, domain.ErrStaleAttempt
+	}
+	if sub.Status != domain.ScanStatusRunning {
+		return sub, nil
+	}
+
+	data, err := marshalProgress(progress)
+	if err != nil {
+		return nil, err
+	}
+	result, err := tx.ExecContext(ctx,
+		`UPDATE sub_scans SET progress = $3, last_heartbeat_at = $4 WHERE scan_id = $1 AND scan_type = $2 AND attempt = $5`,
+		scanID, scanType, data, progress.UpdatedAt, attempt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record progress: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return nil, domain.ErrStaleAttempt
+	}
+	sub.Progress = progress
+	sub.LastHeartbeatAt = &progress.UpdatedAt
+
+	if !scan.IsTerminal() {
+		scan.Aggregate(subs)
+		if err := updateAggregatedScan(ctx, tx, scan, scan.Status); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return sub, nil
+}
+
+// lockScanStatus locks the scan and returns its status. A scan being deleted
+// can't be changed.
+func lockScanStatus(ctx context.Context, tx *Tx, id uuid.UUID) (domain.ScanStatus, error) {
+	var status domain.ScanStatus
+	err := tx.QueryRowContext(ctx, `SELECT status FROM scans WHERE id = $1 FOR UPDATE`, id).Scan(&status)
+	if err == sql.ErrNoRows {
+		return "", domain.ErrScanNotFound
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get scan: %w", err)
+	}
+	if status == domain.ScanStatusDeleting {
+		return "", domain.ErrScanDeleting
+	}
+	return status, nil
+}
+
+// updateAggregatedScan writes the aggregated status and results of the scan
+// and its event if the status changed
+func updateAggregatedScan(ctx context.Context, tx *Tx, scan *domain.Scan, previousStatus domain.ScanStatus) error {
+	query := `
+		UPDATE scans SET
+			status = $2,
+			findings_count = $3,
+			critical_count = $4,
+			high_count = $5,
+			medium_count = $6,
+			low_count = $7,
+			started_at = $8,
+			completed_at = $9,
+			error_message = $10,
+			job_namespace = $11,
+			failure_reason = $12,
+			progress = $13,
+			updated_at = $14
+		WHERE id = $1
+	`
+
+	progress, err := marshalProgress(scan.Progress)
+	if err != nil {
+		return err
+	}
+
+	scan.UpdatedAt = time.Now()
+
+	if _, err := tx.ExecContext(ctx, query,
+		scan.ID,
+		scan.Status,
+		scan.FindingsCount,
+		scan.CriticalCount,
+		scan.HighCount,
+		scan.MediumCount,
+		scan.LowCount,
+		scan.StartedAt,
+		scan.CompletedAt,
+		scan.ErrorMessage,
+		scan.JobNamespace,
+		nullFailureReason(scan.FailureReason),
+		progress,
+		scan.UpdatedAt,
+	); err != nil {
+		return fmt.Errorf("failed to update scan: %w", err)
+	}
+
+	if scan.Status != previousStatus {
+		if err := insertOutboxEvent(ctx, tx, domain.NewScanEvent(scan)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// queryer is implemented by both DB and Tx
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}
+
+// listSubScans returns the scan's sub-scans ordered by scan type
+func listSubScans(ctx context.Context, q queryer, scanID uuid.UUID) ([]*domain.SubScan, error) {
+	rows, err := q.QueryContext(ctx,
+		`SELECT `+subScanColumns+` FROM sub_scans WHERE scan_id = $1 ORDER BY scan_type`,
+		scanID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sub-scans: %w", err)
+	}
+	defer rows.Close()
+
+	subs := []*domain.SubScan{}
+	for rows.Next() {
+		sub, err := scanSubScan(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		subs = append(subs, sub)
+	}
+
+	return subs, rows.Err()
+}
+
+// scanSubScan reads a sub-scan from a row selected with subScanColumns
+func scanSubScan(row rowScanner) (*domain.SubScan, error) {
+	sub := &domain.SubScan{}
+	var jobName, jobNamespace, errorMessage, failureReason sql.NullString
+	var startedAt, completedAt, retryAt, lastHeartbeatAt sql.NullTime
+	var progress []byte
+
+	err := row.Scan(
+		&sub.ScanID,
+		&sub.ScanCreatedAt,
+		&sub.ScanType,
+		&sub.ScannerName,
+		&sub.ScannerImage,
+		&sub.Status,
+		&jobName,
+		&jobNamespace,
+		&sub.FindingsCount,
+		&sub.CriticalCount,
+		&sub.HighCount,
+		&sub.MediumCount,
+		&sub.LowCount,
+		&errorMessage,
+		&startedAt,
+		&completedAt,
+		&sub.Attempt,
+		&failureReason,
+		&retryAt,
+		&lastHeartbeatAt,
+		&progress,
+		&sub.CreatedAt,
+		&sub.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if jobName.Valid {
+		sub.JobName = &jobName.String
+	}
+	if jobNamespace.Valid {
+		sub.JobNamespace = &jobNamespace.String
+	}
+	if errorMessage.Valid {
+		sub.ErrorMessage = &errorMessage.String
+	}
+	if startedAt.Valid {
+		sub.StartedAt = &startedAt.Time
+	}
+	if completedAt.Valid {
+		sub.CompletedAt = &completedAt.Time
+	}
+	if retryAt.Valid {
+		sub.RetryAt = &retryAt.Time
+	}
+	if lastHeartbeatAt.Valid {
+		sub.LastHeartbeatAt = &lastHeartbeatAt.Time
+	}
+	sub.FailureReason = domain.FailureReason(failureReason.String)
+
+	if sub.Progress, err = unmarshalProgress(progress); err != nil {
+		return nil, err
+	}
+
+	return sub, nil
+}
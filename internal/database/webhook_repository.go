@@ -0,0 +1,521 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// WebhookRepository implements interfaces.WebhookRepository using PostgreSQL
+type WebhookRepository struct {
+	db *DB
+}
+
+// NewWebhookRepository creates a new WebhookRepository
+func NewWebhookRepository(db *DB) interfaces.WebhookRepository {
+	return &WebhookRepository{db: db}
+}
+
+const subscriptionColumns = `
+	id, organization_id, url, secret, event_types, enabled, created_at, updated_at
+`
+
+// deliveryColumns are qualified with the alias d so they can be used in joins
+const deliveryColumns = `
+	d.id, d.subscription_id, d.organization_id, d.event_id, d.event_type, d.payload,
+	d.status, d.attempts, d.next_attempt_at, d.last_status_code, d.last_error,
+	d.delivered_at, d.created_at, d.updated_at
+`
+
+// CreateSubscription creates a new webhook subscription
+func (r *WebhookRepository) CreateSubscription(ctx context.Context, subscription *domain.WebhookSubscription) error {
+	query := `
+		INSERT INTO webhook_subscriptions (
+			id, organization_id, url, secret, event_types, enabled, created_at, updated_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8
+		)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		subscription.ID,
+		subscription.OrganizationID,
+		subscription.URL,
+		subscription.Secret,
+		pq.Array(subscription.EventTypes),
+		subscription.Enabled,
+		subscription.CreatedAt,
+		subscription.UpdatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to create webhook subscription: %w", err)
+	}
+
+	return nil
+}
+
+// GetSubscription retrieves a subscription by ID
+func (r *WebhookRepository) GetSubscription(ctx context.Context, id uuid.UUID) (*domain.WebhookSubscription, error) {
+	query := `SELECT ` + subscriptionColumns + ` FROM webhook_subscriptions WHERE id = $1`
+
+	subscription, err := scanSubscription(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("webhook subscription not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook subscription: %w", err)
+	}
+
+	return subscription, nil
+}
+
+// UpdateSubscription updates a subscription's URL, secret, event filter and enabled flag
+func (r *WebhookRepository) UpdateSubscription(ctx context.Context, subscription *domain.WebhookSubscription) error {
+	query := `
+		UPDATE webhook_subscriptions SET
+			url = $2,
+			secret = $3,
+			event_types = $4,
+			enabled = $5,
+			updated_at = NOW()
+		WHERE id = $1
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		subscription.ID,
+		subscription.URL,
+		subscription.Secret,
+		pq.Array(subscription.EventTypes),
+		subscription.Enabled,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update webhook subscription: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rows == 0 {
+		return fmt.Errorf("webhook subscription not found")
+	}
+
+	return nil
+}
+
+// ListSubscriptions retrieves the subscriptions of an organization
+func (r *WebhookRepository) ListSubscriptions(ctx context.Context, organizationID uuid.UUID) ([]*domain.WebhookSubscription, error) {
+	query := `SELECT ` + subscriptionColumns + `
+		FROM webhook_subscriptions
+		WHERE organization_id = $1
+		ORDER BY created_at DESC`
+
+	rows, err := r.db.QueryContext(ctx, query, organizationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook subscriptions: %w", err)
+	}
+	defer rows.Close()
+
+	subscriptions := []*domain.WebhookSubscription{}
+	for rows.Next() {
+		subscription, err := scanSubscription(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		subscriptions = append(subscriptions, subscription)
+	}
+
+	return subscriptions, rows.Err()
+}
+
+// DeleteSubscription deletes a subscription; its deliveries are removed by cascade
+func (r *WebhookRepository) DeleteSubscription(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM webhook_subscriptions WHERE id = $1`
+
+	result, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook subscription: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rows == 0 {
+		return fmt.Errorf("webhook subscription not found")
+	}
+
+	return nil
+}
+
+// EnqueueDeliveries inserts deliveries into the outbox in a single transaction.
+// Deliveries of an event already enqueued for the subscription are skipped.
+func (r *WebhookRepository) EnqueueDeliveries(ctx context.Context, deliveries []*domain.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO webhook_deliveries (
+			id, subscription_id, organization_id, event_id, event_type, payload,
+			status, attempts, next_attempt_at, created_at, updated_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+		)
+		ON CONFLICT (subscription_id, event_id) DO NOTHING
+	`
+
+	for _, d := range deliveries {
+		_, err := tx.ExecContext(ctx, query,
+			d.ID,
+			d.SubscriptionID,
+			d.OrganizationID,
+			d.EventID,
+			d.EventType,
+			string(d.Payload),
+			d.Status,
+			d.Attempts,
+			d.NextAttemptAt,
+			d.CreatedAt,
+			d.UpdatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to enqueue webhook delivery: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit webhook deliveries: %w", err)
+	}
+
+	return nil
+}
+
+// ClaimDueDeliveries locks due deliveries of enabled subscriptions and leases them
+func (r *WebhookRepository) ClaimDueDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*domain.WebhookDelivery, error) {
+	query := `
+		WITH due AS (
+			SELECT d.id
+			FROM webhook_deliveries d
+			JOIN webhook_subscriptions s ON s.id = d.subscription_id
+			WHERE d.status = 'pending' AND d.next_attempt_at <= $1 AND s.enabled = true
+			ORDER BY d.next_attempt_at
+			LIMIT $3
+			FOR UPDATE OF d SKIP LOCKED
+		)
+		UPDATE webhook_deliveries d
+		SET next_attempt_at = $2
+		FROM due, webhook_subscriptions s
+		WHERE d.id = due.id AND s.id = d.subscription_id
+		RETURNING ` + deliveryColumns + `, s.url, s.secret`
+
+	rows, err := r.db.QueryContext(ctx, query, now, now.Add(lease), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	deliveries := []*domain.WebhookDelivery{}
+	for rows.Next() {
+		delivery, err := scanDelivery(rows, true)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, rows.Err()
+}
+
+// RecordAttempt stores the attempt in the delivery log and updates the delivery
+// state in a single transaction
+func (r *WebhookRepository) RecordAttempt(ctx context.Context, delivery *domain.WebhookDelivery, attempt *domain.WebhookDeliveryAttempt) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO webhook_delivery_attempts (
+			id, delivery_id, attempt, status_code, error, duration_ms, attempted_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7
+		)`,
+		attempt.ID,
+		attempt.DeliveryID,
+		attempt.Attempt,
+		attempt.StatusCode,
+		attempt.Error,
+		attempt.DurationMs,
+		attempt.AttemptedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record webhook delivery attempt: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE webhook_deliveries SET
+			status = $2,
+			attempts = $3,
+			next_attempt_at = $4,
+			last_status_code = $5,
+			last_error = $6,
+			delivered_at = $7,
+			updated_at = NOW()
+		WHERE id = $1`,
+		delivery.ID,
+		delivery.Status,
+		delivery.Attempts,
+		delivery.NextAttemptAt,
+		delivery.LastStatusCode,
+		delivery.LastError,
+		delivery.DeliveredAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update webhook delivery: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit webhook delivery attempt: %w", err)
+	}
+
+	return nil
+}
+
+// GetDelivery retrieves a delivery by ID
+func (r *WebhookRepository) GetDelivery(ctx context.Context, id uuid.UUID) (*domain.WebhookDelivery, error) {
+	query := `SELECT ` + deliveryColumns + ` FROM webhook_deliveries d WHERE d.id = $1`
+
+	delivery, err := scanDelivery(r.db.QueryRowContext(ctx, query, id), false)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("webhook delivery not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook delivery: %w", err)
+	}
+
+	return delivery, nil
+}
+
+// ListDeliveries retrieves deliveries with optional filters, newest first
+func (r *WebhookRepository) ListDeliveries(ctx context.Context, filter interfaces.WebhookDeliveryFilter) ([]*domain.WebhookDelivery, error) {
+	query := `SELECT ` + deliveryColumns + ` FROM webhook_deliveries d WHERE 1=1`
+
+	args := []interface{}{}
+	argPos := 1
+
+	if filter.OrganizationID != nil {
+		query += fmt.Sprintf(" AND d.organization_id = $%d", argPos)
+		args = append(args, *filter.OrganizationID)
+		argPos++
+	}
+
+	if filter.SubscriptionID != nil {
+		query += fmt.Sprintf(" AND d.subscription_id = $%d", argPos)
+		args = append(args, *filter.SubscriptionID)
+		argPos++
+	}
+
+	if filter.Status != nil {
+		query += fmt.Sprintf(" AND d.status = $%d", argPos)
+		args = append(args, *filter.Status)
+		argPos++
+	}
+
+	query += " ORDER BY d.created_at DESC"
+
+	if filter.Limit > 0 {
+		query += fmt.Sprintf(" LIMIT $%d", argPos)
+		args = append(args, filter.Limit)
+		argPos++
+	}
+
+	if filter.Offset > 0 {
+		query += fmt.Sprintf(" OFFSET $%d", argPos)
+		args = append(args, filter.Offset)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	deliveries := []*domain.WebhookDelivery{}
+	for rows.Next() {
+		delivery, err := scanDelivery(rows, false)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, rows.Err()
+}
+
+// ListAttempts retrieves the delivery log of a delivery, oldest attempt first
+func (r *WebhookRepository) ListAttempts(ctx context.Context, deliveryID uuid.UUID) ([]*domain.WebhookDeliveryAttempt, error) {
+	query := `
+		SELECT id, delivery_id, attempt, status_code, error, duration_ms, attempted_at
+		FROM webhook_delivery_attempts
+		WHERE delivery_id = $1
+		ORDER BY attempted_at
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, deliveryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook delivery attempts: %w", err)
+	}
+	defer rows.Close()
+
+	attempts := []*domain.WebhookDeliveryAttempt{}
+	for rows.Next() {
+		attempt := &domain.WebhookDeliveryAttempt{}
+		var statusCode sql.NullInt64
+		var errMsg sql.NullString
+
+		err := rows.Scan(
+			&attempt.ID,
+			&attempt.DeliveryID,
+			&attempt.Attempt,
+			&statusCode,
+			&errMsg,
+			&attempt.DurationMs,
+			&attempt.AttemptedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		if statusCode.Valid {
+			code := int(statusCode.Int64)
+			attempt.StatusCode = &code
+		}
+		if errMsg.Valid {
+			attempt.Error = &errMsg.String
+		}
+
+		attempts = append(attempts, attempt)
+	}
+
+	return attempts, rows.Err()
+}
+
+// ResetDelivery makes a delivery pending again for immediate redelivery.
+// Previous attempts stay in the delivery log.
+func (r *WebhookRepository) ResetDelivery(ctx context.Context, id uuid.UUID) error {
+	query := `
+		UPDATE webhook_deliveries SET
+			status = 'pending',
+			attempts = 0,
+			next_attempt_at = NOW(),
+			delivered_at = NULL,
+			updated_at = NOW()
+		WHERE id = $1
+	`
+
+	result, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to reset webhook delivery: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rows == 0 {
+		return fmt.Errorf("webhook delivery not found")
+	}
+
+	return nil
+}
+
+// scanSubscription reads a subscription from a row selected with subscriptionColumns
+func scanSubscription(row rowScanner) (*domain.WebhookSubscription, error) {
+	subscription := &domain.WebhookSubscription{}
+	var eventTypes pq.StringArray
+
+	err := row.Scan(
+		&subscription.ID,
+		&subscription.OrganizationID,
+		&subscription.URL,
+		&subscription.Secret,
+		&eventTypes,
+		&subscription.Enabled,
+		&subscription.CreatedAt,
+		&subscription.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription.EventTypes = make([]domain.EventType, len(eventTypes))
+	for i, t := range eventTypes {
+		subscription.EventTypes[i] = domain.EventType(t)
+	}
+
+	return subscription, nil
+}
+
+// scanDelivery reads a delivery from a row selected with deliveryColumns,
+// followed by the subscription URL and secret if withEndpoint is set
+func scanDelivery(row rowScanner, withEndpoint bool) (*domain.WebhookDelivery, error) {
+	delivery := &domain.WebhookDelivery{}
+	var lastStatusCode sql.NullInt64
+	var lastError sql.NullString
+	var deliveredAt sql.NullTime
+
+	dest := []interface{}{
+		&delivery.ID,
+		&delivery.SubscriptionID,
+		&delivery.OrganizationID,
+		&delivery.EventID,
+		&delivery.EventType,
+		&delivery.Payload,
+		&delivery.Status,
+		&delivery.Attempts,
+		&delivery.NextAttemptAt,
+		&lastStatusCode,
+		&lastError,
+		&deliveredAt,
+		&delivery.CreatedAt,
+		&delivery.UpdatedAt,
+	}
+	if withEndpoint {
+		dest = append(dest, &delivery.URL, &delivery.Secret)
+	}
+
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+
+	if lastStatusCode.Valid {
+		code := int(lastStatusCode.Int64)
+		delivery.LastStatusCode = &code
+	}
+	if lastError.Valid {
+		delivery.LastError = &lastError.String
+	}
+	if deliveredAt.Valid {
+		delivery.DeliveredAt = &deliveredAt.Time
+	}
+
+	return delivery, nil
+}
@@ -0,0 +1,13 @@
+package domain
+
+// Audit log actions
+const (
+	AuditActionLegalHoldPlaced   = "legal_hold.placed"
+	AuditActionLegalHoldReleased = "legal_hold.released"
+)
+
+// AuditSource identifies where an audited request came from
+type AuditSource struct {
+	IPAddress string // Empty if unknown
+	UserAgent string
+}
@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CommitStatusDeliveryStatus represents the state of a commit status delivery
+type CommitStatusDeliveryStatus string
+
+const (
+	CommitStatusDeliveryPending   CommitStatusDeliveryStatus = "pending"   // Waiting for the next attempt
+	CommitStatusDeliverySucceeded CommitStatusDeliveryStatus = "succeeded" // The provider accepted the status
+	CommitStatusDeliveryFailed    CommitStatusDeliveryStatus = "failed"    // Gave up, or the error can't be retried
+)
+
+// CommitStatusDelivery is the outcome of a finished scan waiting to be reported
+// by one commit status client
+type CommitStatusDelivery struct {
+	ID            uuid.UUID                  `json:"id" db:"id"`
+	EventID       uuid.UUID                  `json:"event_id" db:"event_id"`
+	ScanID        uuid.UUID                  `json:"scan_id" db:"scan_id"`
+	Client        string                     `json:"client" db:"client"` // Name of the commit status client
+	Scan          *Scan                      `json:"scan" db:"scan"`     // Snapshot from the event
+	Status        CommitStatusDeliveryStatus `json:"status" db:"status"`
+	Attempts      int                        `json:"attempts" db:"attempts"`
+	NextAttemptAt time.Time                  `json:"next_attempt_at" db:"next_attempt_at"`
+	LastError     *string                    `json:"last_error,omitempty" db:"last_error"`
+	DeliveredAt   *time.Time                 `json:"delivered_at,omitempty" db:"delivered_at"`
+	CreatedAt     time.Time                  `json:"created_at" db:"created_at"`
+	UpdatedAt     time.Time                  `json:"updated_at" db:"updated_at"`
+}
@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrCredentialNotFound is returned when a project has no repository credential
+var ErrCredentialNotFound = errors.New("repository credential not found")
+
+// CredentialType identifies how a runner authenticates to the git host
+type CredentialType string
+
+const (
+	CredentialTypeDeployKey  CredentialType = "deploy_key"  // SSH private key
+	CredentialTypeHTTPSToken CredentialType = "https_token" // Personal or project access token
+	CredentialTypeGitHubApp  CredentialType = "github_app"  // GitHub App installation
+)
+
+// Audit log actions of repository credentials
+const (
+	AuditActionCredentialSet     = "repository_credential.set"
+	AuditActionCredentialDeleted = "repository_credential.deleted"
+)
+
+// RepositoryCredential is the credential a project's git scans clone with.
+// Its secret, the deploy key, the token or the GitHub App's private key, is
+// only held sealed; see SealedSecret.
+type RepositoryCredential struct {
+	OrganizationID uuid.UUID      `json:"organization_id" db:"organization_id"`
+	ProjectID      uuid.UUID      `json:"project_id" db:"project_id"`
+	Type           CredentialType `json:"type" db:"type"`
+
+	// HTTPS token
+	Username string `json:"username,omitempty" db:"username"` // Defaults to x-access-token
+
+	// Deploy key
+	KnownHosts  string `json:"known_hosts,omitempty" db:"known_hosts"` // Empty accepts the host key on first use
+	Fingerprint string `json:"fingerprint,omitempty" db:"fingerprint"` // SHA256 fingerprint of the public key
+
+	// GitHub App
+	GitHubAppID          int64 `json:"github_app_id,omitempty" db:"github_app_id"`
+	GitHubInstallationID int64 `json:"github_installation_id,omitempty" db:"github_installation_id"`
+
+	Secret SealedSecret `json:"-" db:"-"`
+
+	// Audit
+	UpdatedBy uuid.UUID `json:"updated_by" db:"updated_by"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// SealedSecret is a secret encrypted with a random data key, which is itself
+// wrapped by the key manager's key KeyID (envelope encryption)
+type SealedSecret struct {
+	KeyID      string `db:"key_id"`
+	WrappedKey []byte `db:"wrapped_key"`
+	Ciphertext []byte `db:"ciphertext"`
+}
+
+// Validate checks that the credential's fields fit its type
+func (c *RepositoryCredential) Validate() error {
+	switch c.Type {
+	case CredentialTypeDeployKey:
+		if c.Username != "" || c.GitHubAppID != 0 || c.GitHubInstallationID != 0 {
+			return fmt.Errorf("deploy keys only take a secret and known_hosts")
+		}
+	case CredentialTypeHTTPSToken:
+		if c.KnownHosts != "" || c.GitHubAppID != 0 || c.GitHubInstallationID != 0 {
+			return fmt.Errorf("https tokens only take a secret and a username")
+		}
+	case CredentialTypeGitHubApp:
+		if c.GitHubAppID <= 0 || c.GitHubInstallationID <= 0 {
+			return fmt.Errorf("github apps need a github_app_id and a github_installation_id")
+		}
+		if c.Username != "" || c.KnownHosts != "" {
+			return fmt.Errorf("github apps only take a secret, an app ID and an installation ID")
+		}
+	default:
+		return fmt.Errorf("invalid credential type %q", c.Type)
+	}
+	return nil
+}
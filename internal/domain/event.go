@@ -0,0 +1,127 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventType identifies a scan lifecycle or finding event
+type EventType string
+
+const (
+	EventScanQueued      EventType = "scan.queued"
+	EventScanStarted     EventType = "scan.started"
+	EventScanCompleted   EventType = "scan.completed"
+	EventScanFailed      EventType = "scan.failed"
+	EventScanCancelled   EventType = "scan.cancelled"
+	EventScanDeleting    EventType = "scan.deleting"
+	EventScanDeleted     EventType = "scan.deleted"
+	EventFindingCritical EventType = "finding.critical"
+)
+
+// EventTypes lists every event type that can be subscribed to
+var EventTypes = []EventType{
+	EventScanQueued,
+	EventScanStarted,
+	EventScanCompleted,
+	EventScanFailed,
+	EventScanCancelled,
+	EventScanDeleting,
+	EventScanDeleted,
+	EventFindingCritical,
+}
+
+// IsValid returns true for known event types
+func (t EventType) IsValid() bool {
+	for _, known := range EventTypes {
+		if t == known {
+			return true
+		}
+	}
+	return false
+}
+
+// Event is emitted when a scan changes status or a critical finding is reported.
+// It is the JSON document delivered to webhook subscribers.
+type Event struct {
+	ID             uuid.UUID `json:"id"`
+	Type           EventType `json:"type"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+	OccurredAt     time.Time `json:"occurred_at"`
+	Scan           *Scan     `json:"scan,omitempty"`
+	Finding        *Finding  `json:"finding,omitempty"`
+}
+
+// NewScanEvent creates the event for the scan's current status. It returns nil
+// for statuses without an event.
+func NewScanEvent(scan *Scan) *Event {
+	var eventType EventType
+	switch scan.Status {
+	case ScanStatusQueued:
+		eventType = EventScanQueued
+	case ScanStatusRunning:
+		eventType = EventScanStarted
+	case ScanStatusCompleted, ScanStatusPartial:
+		// A partial scan completed with results; its status tells them apart
+		eventType = EventScanCompleted
+	case ScanStatusFailed:
+		eventType = EventScanFailed
+	case ScanStatusCancelled:
+		eventType = EventScanCancelled
+	case ScanStatusDeleting:
+		eventType = EventScanDeleting
+	default:
+		return nil
+	}
+
+	// Snapshot the scan so later changes by the caller don't leak into the event
+	snapshot := *scan
+
+	return &Event{
+		ID:             uuid.New(),
+		Type:           eventType,
+		OrganizationID: scan.OrganizationID,
+		OccurredAt:     time.Now().UTC(),
+		Scan:           &snapshot,
+	}
+}
+
+// NewScanDeletedEvent creates the scan.deleted event of a scan whose row was
+// deleted, with the scan as it was last stored
+func NewScanDeletedEvent(scan *Scan) *Event {
+	snapshot := *scan
+
+	return &Event{
+		ID:             uuid.New(),
+		Type:           EventScanDeleted,
+		OrganizationID: scan.OrganizationID,
+		OccurredAt:     time.Now().UTC(),
+		Scan:           &snapshot,
+	}
+}
+
+// NewCriticalFindingEvent creates a finding.critical event for a finding of the scan
+func NewCriticalFindingEvent(scan *Scan, finding *Finding) *Event {
+	snapshot := *finding
+	snapshot.RawOutput = "" // Raw scanner output can be large and is available through the API
+
+	return &Event{
+		ID:             uuid.New(),
+		Type:           EventFindingCritical,
+		OrganizationID: scan.OrganizationID,
+		OccurredAt:     time.Now().UTC(),
+		Finding:        &snapshot,
+	}
+}
+
+// OutboxEvent is an event stored in the transactional outbox. It is written in
+// the same transaction as the change it describes and published by the event relay.
+type OutboxEvent struct {
+	Event         *Event     `json:"event"`
+	Attempts      int        `json:"attempts"`
+	NextAttemptAt time.Time  `json:"next_attempt_at"`
+	LastError     *string    `json:"last_error,omitempty"`
+	PublishedAt   *time.Time `json:"published_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
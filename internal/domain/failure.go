@@ -0,0 +1,112 @@
+package domain
+
+import "time"
+
+// FailureReason is the classified cause of a failed scan or sub-scan
+type FailureReason string
+
+const (
+	FailureReasonOOMKilled        FailureReason = "oom_killed"        // Runner exceeded its memory limit
+	FailureReasonDeadlineExceeded FailureReason = "deadline_exceeded" // Job ran past its deadline
+	FailureReasonImagePull        FailureReason = "image_pull"        // Scanner image couldn't be pulled
+	FailureReasonEvicted          FailureReason = "evicted"           // Pod was evicted from its node
+	FailureReasonStorageDownload  FailureReason = "storage_download"  // Source archive couldn't be downloaded
+	FailureReasonClone            FailureReason = "clone"             // Repository couldn't be cloned
+	FailureReasonScanner          FailureReason = "scanner_error"     // Scanner failed on the source
+	FailureReasonResultsUpload    FailureReason = "results_upload"    // Findings couldn't be reported
+	FailureReasonRunner           FailureReason = "runner_error"      // Runner exited with another error
+	FailureReasonDispatch         FailureReason = "dispatch_failed"   // Job couldn't be created
+	FailureReasonJobLost          FailureReason = "job_lost"          // Job was deleted while the scan ran
+	FailureReasonUnschedulable    FailureReason = "unschedulable"     // Pod couldn't be scheduled or created in time
+	FailureReasonPodPending       FailureReason = "pod_pending"       // Pod stayed pending for another reason, e.g. a missing volume
+	FailureReasonHeartbeatTimeout FailureReason = "heartbeat_timeout" // Runner stopped calling back
+	FailureReasonUnknown          FailureReason = "unknown"
+)
+
+// failureReasons lists the reasons a runner may report
+var failureReasons = map[FailureReason]bool{
+	FailureReasonOOMKilled:        true,
+	FailureReasonDeadlineExceeded: true,
+	FailureReasonImagePull:        true,
+	FailureReasonEvicted:          true,
+	FailureReasonStorageDownload:  true,
+	FailureReasonClone:            true,
+	FailureReasonScanner:          true,
+	FailureReasonResultsUpload:    true,
+	FailureReasonRunner:           true,
+	FailureReasonDispatch:         true,
+	FailureReasonJobLost:          true,
+	FailureReasonUnschedulable:    true,
+	FailureReasonPodPending:       true,
+	FailureReasonHeartbeatTimeout: true,
+	FailureReasonUnknown:          true,
+}
+
+// IsValid returns true if the reason is known
+func (r FailureReason) IsValid() bool {
+	return failureReasons[r]
+}
+
+// IsTransient returns true if the failure depends on the node or on other
+// services rather than on the scan, so running the job again may succeed.
+// Other failures, e.g. running out of memory or a scanner error, would recur.
+func (r FailureReason) IsTransient() bool {
+	switch r {
+	case FailureReasonEvicted, FailureReasonStorageDownload, FailureReasonResultsUpload,
+		FailureReasonJobLost, FailureReasonHeartbeatTimeout:
+		return true
+	}
+	return false
+}
+
+// RetryPolicy bounds the automatic retries of sub-scans that failed transiently
+type RetryPolicy struct {
+	MaxAttempts    int           // Attempts per sub-scan, including the first; 1 disables retries
+	InitialBackoff time.Duration // Delay before the first retry, doubled after each attempt
+	MaxBackoff     time.Duration // Upper bound for the retry delay
+}
+
+// Backoff returns the delay before retrying after the given attempt
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	delay := p.InitialBackoff
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if p.MaxBackoff > 0 && delay >= p.MaxBackoff {
+			return p.MaxBackoff
+		}
+	}
+	return delay
+}
+
+// StuckScanPolicy bounds how long a job may make no progress before the
+// sweeper fails it. A zero timeout disables its check.
+type StuckScanPolicy struct {
+	PendingTimeout   time.Duration // Pod not created, scheduled or started
+	ImagePullTimeout time.Duration // Runner image failing to pull
+	HeartbeatTimeout time.Duration // Running runner without callbacks
+}
+
+// Fail records a failure of the sub-scan's job. A transient failure with
+// attempts left queues the sub-scan for a retry after the policy's backoff;
+// its job is replaced when the dispatcher retries it. Any other failure is
+// final. Fail returns true if a retry was queued.
+func (s *SubScan) Fail(reason FailureReason, message string, policy RetryPolicy, now time.Time) bool {
+	if !reason.IsValid() {
+		reason = FailureReasonUnknown
+	}
+	s.FailureReason = reason
+	s.ErrorMessage = &message
+
+	if reason.IsTransient() && s.Attempt < policy.MaxAttempts {
+		retryAt := now.Add(policy.Backoff(s.Attempt))
+		s.Status = ScanStatusQueued
+		s.RetryAt = &retryAt
+		s.CompletedAt = nil
+		return true
+	}
+
+	s.Status = ScanStatusFailed
+	s.RetryAt = nil
+	s.CompletedAt = &now
+	return false
+}
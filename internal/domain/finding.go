@@ -22,6 +22,9 @@ type Finding struct {
 	ID     uuid.UUID `json:"id" db:"id"`
 	ScanID uuid.UUID `json:"scan_id" db:"scan_id"`
 
+	// created_at of the scan; together with ScanID it references the partitioned scans table
+	ScanCreatedAt time.Time `json:"-" db:"scan_created_at"`
+
 	// Scanner information
 	ScanType    ScanType `json:"scan_type" db:"scan_type"` // Which scanner found this
 	ToolName    string   `json:"tool_name" db:"tool_name"` // e.g., "semgrep", "trivy"
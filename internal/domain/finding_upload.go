@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrBatchAlreadyUploaded is returned when a batch of an upload was committed before
+var ErrBatchAlreadyUploaded = errors.New("batch already uploaded")
+
+// ErrBatchOutOfSequence is returned when a batch doesn't follow the last committed batch
+var ErrBatchOutOfSequence = errors.New("batch out of sequence")
+
+// FindingUpload is a runner's streamed upload of findings. Its batches are
+// numbered from 1 and committed one at a time, so an interrupted upload
+// resumes after LastSequence.
+type FindingUpload struct {
+	ScanID        uuid.UUID `json:"scan_id" db:"scan_id"`
+	ScanCreatedAt time.Time `json:"-" db:"scan_created_at"`
+	ScanType      ScanType  `json:"scan_type" db:"scan_type"` // Empty for scans without sub-scans
+	Attempt       int       `json:"attempt" db:"attempt"`     // Each retry uploads anew
+	LastSequence  int64     `json:"last_sequence" db:"last_sequence"`
+	FindingsCount int       `json:"findings_count" db:"findings_count"`
+	CreatedAt     time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at" db:"updated_at"`
+}
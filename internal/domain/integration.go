@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GitProvider identifies the Git hosting provider of a repository
+type GitProvider string
+
+const (
+	GitProviderGitHub    GitProvider = "github"
+	GitProviderGitLab    GitProvider = "gitlab"
+	GitProviderBitbucket GitProvider = "bitbucket"
+)
+
+// RepositoryIntegration links a Git repository to a project so that
+// push and pull request webhooks from the provider can trigger scans
+type RepositoryIntegration struct {
+	ID             uuid.UUID   `json:"id" db:"id"`
+	OrganizationID uuid.UUID   `json:"organization_id" db:"organization_id"`
+	ProjectID      uuid.UUID   `json:"project_id" db:"project_id"`
+	Provider       GitProvider `json:"provider" db:"provider"`
+	RepositoryURL  string      `json:"repository_url" db:"repository_url"` // Normalized, see NormalizeRepositoryURL
+	WebhookSecret  string      `json:"-" db:"webhook_secret"`              // HMAC key (GitHub, Bitbucket) or token (GitLab)
+	ScanTypes      []ScanType  `json:"scan_types" db:"scan_types"`         // Scan types to run for webhook-triggered scans
+	Enabled        bool        `json:"enabled" db:"enabled"`
+	CreatedAt      time.Time   `json:"created_at" db:"created_at"`
+	UpdatedAt      time.Time   `json:"updated_at" db:"updated_at"`
+}
+
+// NormalizeRepositoryURL reduces the different URL forms a provider uses for the
+// same repository (https clone URL, web URL, scp-style SSH URL) to "host/owner/repo"
+// so webhook payloads can be matched against registered integrations.
+func NormalizeRepositoryURL(raw string) string {
+	s := strings.TrimSpace(raw)
+
+	// scp-style SSH: git@github.com:owner/repo.git
+	if !strings.Contains(s, "://") {
+		if at := strings.Index(s, "@"); at >= 0 {
+			s = s[at+1:]
+		}
+		s = strings.Replace(s, ":", "/", 1)
+	} else if u, err := url.Parse(s); err == nil {
+		s = u.Hostname() + u.Path
+	}
+
+	s = strings.TrimSuffix(s, "/")
+	s = strings.TrimSuffix(s, ".git")
+	return strings.ToLower(s)
+}
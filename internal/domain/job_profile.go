@@ -0,0 +1,290 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// ErrJobProfileNotFound is returned when a project has no job profile
+var ErrJobProfileNotFound = errors.New("job profile not found")
+
+// ErrJobLimitsExceeded is returned when job settings exceed the limits of
+// their organization
+var ErrJobLimitsExceeded = errors.New("job settings exceed the organization's limits")
+
+// JobSettings customizes the Kubernetes jobs of a scan. Unset fields keep the
+// value of the broader settings: a scan's settings override its project's
+// profile, which overrides the scanner and the service-wide job config.
+type JobSettings struct {
+	RequestsCPU    string `json:"requests_cpu,omitempty"`
+	RequestsMemory string `json:"requests_memory,omitempty"`
+	LimitsCPU      string `json:"limits_cpu,omitempty"`
+	LimitsMemory   string `json:"limits_memory,omitempty"`
+
+	// EphemeralStorage sizes the emptyDir holding the checkout and is
+	// requested and limited as the container's ephemeral-storage
+	EphemeralStorage string `json:"ephemeral_storage,omitempty"`
+
+	ActiveDeadlineSeconds *int64 `json:"active_deadline_seconds,omitempty"`
+	BackoffLimit          *int32 `json:"backoff_limit,omitempty"`
+
+	// Scheduling
+	NodeSelector      map[string]string `json:"node_selector,omitempty"`
+	Tolerations       []Toleration      `json:"tolerations,omitempty"`
+	PriorityClassName string            `json:"priority_class_name,omitempty"`
+}
+
+// Toleration lets the jobs be scheduled onto nodes with a matching taint
+type Toleration struct {
+	Key               string `json:"key,omitempty"`
+	Operator          string `json:"operator,omitempty"` // Equal (default) or Exists
+	Value             string `json:"value,omitempty"`
+	Effect            string `json:"effect,omitempty"` // Empty matches all effects
+	TolerationSeconds *int64 `json:"toleration_seconds,omitempty"`
+}
+
+// IsEmpty returns true if no setting is set
+func (s *JobSettings) IsEmpty() bool {
+	return s.RequestsCPU == "" && s.RequestsMemory == "" &&
+		s.LimitsCPU == "" && s.LimitsMemory == "" &&
+		s.EphemeralStorage == "" &&
+		s.ActiveDeadlineSeconds == nil && s.BackoffLimit == nil &&
+		len(s.NodeSelector) == 0 && len(s.Tolerations) == 0 &&
+		s.PriorityClassName == ""
+}
+
+// Merge returns the settings with the fields set in override replacing
+// theirs. A node selector or tolerations replace the whole list.
+func (s JobSettings) Merge(override *JobSettings) JobSettings {
+	if override == nil {
+		return s
+	}
+	if override.RequestsCPU != "" {
+		s.RequestsCPU = override.RequestsCPU
+	}
+	if override.RequestsMemory != "" {
+		s.RequestsMemory = override.RequestsMemory
+	}
+	if override.LimitsCPU != "" {
+		s.LimitsCPU = override.LimitsCPU
+	}
+	if override.LimitsMemory != "" {
+		s.LimitsMemory = override.LimitsMemory
+	}
+	if override.EphemeralStorage != "" {
+		s.EphemeralStorage = override.EphemeralStorage
+	}
+	if override.ActiveDeadlineSeconds != nil {
+		s.ActiveDeadlineSeconds = override.ActiveDeadlineSeconds
+	}
+	if override.BackoffLimit != nil {
+		s.BackoffLimit = override.BackoffLimit
+	}
+	if len(override.NodeSelector) > 0 {
+		s.NodeSelector = override.NodeSelector
+	}
+	if len(override.Tolerations) > 0 {
+		s.Tolerations = override.Tolerations
+	}
+	if override.PriorityClassName != "" {
+		s.PriorityClassName = override.PriorityClassName
+	}
+	return s
+}
+
+// Validate checks that the settings are well-formed
+func (s *JobSettings) Validate() error {
+	quantities := []struct{ name, value string }{
+		{"requests_cpu", s.RequestsCPU},
+		{"requests_memory", s.RequestsMemory},
+		{"limits_cpu", s.LimitsCPU},
+		{"limits_memory", s.LimitsMemory},
+		{"ephemeral_storage", s.EphemeralStorage},
+	}
+	for _, q := range quantities {
+		if q.value == "" {
+			continue
+		}
+		quantity, err := resource.ParseQuantity(q.value)
+		if err != nil {
+			return fmt.Errorf("invalid %s %q: %v", q.name, q.value, err)
+		}
+		if quantity.Sign() <= 0 {
+			return fmt.Errorf("%s must be positive", q.name)
+		}
+	}
+	if err := checkRequestWithinLimit("cpu", s.RequestsCPU, s.LimitsCPU); err != nil {
+		return err
+	}
+	if err := checkRequestWithinLimit("memory", s.RequestsMemory, s.LimitsMemory); err != nil {
+		return err
+	}
+
+	if s.ActiveDeadlineSeconds != nil && *s.ActiveDeadlineSeconds < 1 {
+		return fmt.Errorf("active_deadline_seconds must be at least 1")
+	}
+	if s.BackoffLimit != nil && *s.BackoffLimit < 0 {
+		return fmt.Errorf("backoff_limit must not be negative")
+	}
+
+	for key := range s.NodeSelector {
+		if key == "" {
+			return fmt.Errorf("node_selector keys must not be empty")
+		}
+	}
+	for _, t := range s.Tolerations {
+		switch t.Operator {
+		case "", "Equal":
+			if t.Key == "" {
+				return fmt.Errorf("tolerations with the Equal operator need a key")
+			}
+		case "Exists":
+			if t.Value != "" {
+				return fmt.Errorf("tolerations with the Exists operator must not have a value")
+			}
+		default:
+			return fmt.Errorf("invalid toleration operator %q", t.Operator)
+		}
+		switch t.Effect {
+		case "", "NoSchedule", "PreferNoSchedule", "NoExecute":
+		default:
+			return fmt.Errorf("invalid toleration effect %q", t.Effect)
+		}
+		if t.TolerationSeconds != nil && t.Effect != "NoExecute" {
+			return fmt.Errorf("toleration_seconds requires the NoExecute effect")
+		}
+	}
+
+	return nil
+}
+
+// checkRequestWithinLimit checks that a request doesn't exceed its limit
+func checkRequestWithinLimit(name, request, limit string) error {
+	if request == "" || limit == "" {
+		return nil
+	}
+	above, err := quantityAbove(request, limit)
+	if err != nil {
+		return err
+	}
+	if above {
+		return fmt.Errorf("%s request %s exceeds its limit %s", name, request, limit)
+	}
+	return nil
+}
+
+// quantityAbove reports whether the quantity value is greater than max
+func quantityAbove(value, max string) (bool, error) {
+	v, err := resource.ParseQuantity(value)
+	if err != nil {
+		return false, fmt.Errorf("invalid quantity %q: %v", value, err)
+	}
+	m, err := resource.ParseQuantity(max)
+	if err != nil {
+		return false, fmt.Errorf("invalid quantity %q: %v", max, err)
+	}
+	return v.Cmp(m) > 0, nil
+}
+
+// JobProfile holds the job settings of a project's scans
+type JobProfile struct {
+	OrganizationID uuid.UUID   `json:"organization_id" db:"organization_id"`
+	ProjectID      uuid.UUID   `json:"project_id" db:"project_id"`
+	Settings       JobSettings `json:"settings" db:"settings"`
+
+	// Audit
+	UpdatedBy uuid.UUID `json:"updated_by" db:"updated_by"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// JobLimits caps the job settings of an organization's projects and scans.
+// Empty maximums are unlimited; only the listed priority classes may be used.
+type JobLimits struct {
+	OrganizationID         uuid.UUID `json:"organization_id" db:"organization_id"`
+	MaxCPU                 string    `json:"max_cpu,omitempty" db:"max_cpu"`
+	MaxMemory              string    `json:"max_memory,omitempty" db:"max_memory"`
+	MaxEphemeralStorage    string    `json:"max_ephemeral_storage,omitempty" db:"max_ephemeral_storage"`
+	MaxDeadlineSeconds     int64     `json:"max_deadline_seconds,omitempty" db:"max_deadline_seconds"` // 0 is unlimited
+	MaxBackoffLimit        *int32    `json:"max_backoff_limit,omitempty" db:"max_backoff_limit"`
+	AllowedPriorityClasses []string  `json:"allowed_priority_classes,omitempty" db:"allowed_priority_classes"`
+
+	// Audit; zero for the service-wide default limits
+	UpdatedBy uuid.UUID `json:"updated_by" db:"updated_by"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// Validate checks that the maximums are well-formed
+func (l *JobLimits) Validate() error {
+	quantities := []struct{ name, value string }{
+		{"max_cpu", l.MaxCPU},
+		{"max_memory", l.MaxMemory},
+		{"max_ephemeral_storage", l.MaxEphemeralStorage},
+	}
+	for _, q := range quantities {
+		if q.value == "" {
+			continue
+		}
+		if _, err := resource.ParseQuantity(q.value); err != nil {
+			return fmt.Errorf("invalid %s %q: %v", q.name, q.value, err)
+		}
+	}
+	if l.MaxDeadlineSeconds < 0 {
+		return fmt.Errorf("max_deadline_seconds must not be negative")
+	}
+	if l.MaxBackoffLimit != nil && *l.MaxBackoffLimit < 0 {
+		return fmt.Errorf("max_backoff_limit must not be negative")
+	}
+	return nil
+}
+
+// Check returns ErrJobLimitsExceeded if the validated settings exceed the
+// limits. Requests and limits are both checked against the maximum.
+func (l *JobLimits) Check(s *JobSettings) error {
+	maximums := []struct{ name, value, max string }{
+		{"requests_cpu", s.RequestsCPU, l.MaxCPU},
+		{"limits_cpu", s.LimitsCPU, l.MaxCPU},
+		{"requests_memory", s.RequestsMemory, l.MaxMemory},
+		{"limits_memory", s.LimitsMemory, l.MaxMemory},
+		{"ephemeral_storage", s.EphemeralStorage, l.MaxEphemeralStorage},
+	}
+	for _, m := range maximums {
+		if m.value == "" || m.max == "" {
+			continue
+		}
+		above, err := quantityAbove(m.value, m.max)
+		if err != nil {
+			return err
+		}
+		if above {
+			return fmt.Errorf("%w: %s %s is above %s", ErrJobLimitsExceeded, m.name, m.value, m.max)
+		}
+	}
+
+	if l.MaxDeadlineSeconds > 0 && s.ActiveDeadlineSeconds != nil && *s.ActiveDeadlineSeconds > l.MaxDeadlineSeconds {
+		return fmt.Errorf("%w: active_deadline_seconds %d is above %d", ErrJobLimitsExceeded, *s.ActiveDeadlineSeconds, l.MaxDeadlineSeconds)
+	}
+	if l.MaxBackoffLimit != nil && s.BackoffLimit != nil && *s.BackoffLimit > *l.MaxBackoffLimit {
+		return fmt.Errorf("%w: backoff_limit %d is above %d", ErrJobLimitsExceeded, *s.BackoffLimit, *l.MaxBackoffLimit)
+	}
+
+	if s.PriorityClassName != "" {
+		allowed := false
+		for _, class := range l.AllowedPriorityClasses {
+			if class == s.PriorityClassName {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return fmt.Errorf("%w: priority class %q is not allowed", ErrJobLimitsExceeded, s.PriorityClassName)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrLegalHold is returned when data covered by an active legal hold would be deleted
+	ErrLegalHold = errors.New("data is under legal hold")
+
+	// ErrLegalHoldNotFound is returned when a legal hold does not exist
+	ErrLegalHoldNotFound = errors.New("legal hold not found")
+
+	// ErrLegalHoldReleased is returned when releasing a hold that was already released
+	ErrLegalHoldReleased = errors.New("legal hold already released")
+)
+
+// LegalHoldScope is what a legal hold covers
+type LegalHoldScope string
+
+const (
+	LegalHoldScopeOrganization LegalHoldScope = "organization" // Every scan of the organization
+	LegalHoldScopeProject      LegalHoldScope = "project"      // Every scan of the project
+	LegalHoldScopeScan         LegalHoldScope = "scan"         // A single scan
+)
+
+// LegalHold freezes scans as evidence. While it is active nothing may delete
+// the scans it covers, their findings or their artifacts.
+type LegalHold struct {
+	ID             uuid.UUID  `json:"id" db:"id"`
+	OrganizationID uuid.UUID  `json:"organization_id" db:"organization_id"`
+	ProjectID      *uuid.UUID `json:"project_id,omitempty" db:"project_id"` // Set for project and scan holds
+	ScanID         *uuid.UUID `json:"scan_id,omitempty" db:"scan_id"`       // Set for scan holds
+
+	// Hold
+	Reason      string    `json:"reason" db:"reason"`
+	RequestedBy uuid.UUID `json:"requested_by" db:"requested_by"`
+	PlacedAt    time.Time `json:"placed_at" db:"placed_at"`
+
+	// Release
+	ReleasedAt    *time.Time `json:"released_at,omitempty" db:"released_at"`
+	ReleasedBy    *uuid.UUID `json:"released_by,omitempty" db:"released_by"`
+	ReleaseReason *string    `json:"release_reason,omitempty" db:"release_reason"`
+}
+
+// Scope returns what the hold covers
+func (h *LegalHold) Scope() LegalHoldScope {
+	switch {
+	case h.ScanID != nil:
+		return LegalHoldScopeScan
+	case h.ProjectID != nil:
+		return LegalHoldScopeProject
+	default:
+		return LegalHoldScopeOrganization
+	}
+}
+
+// IsActive returns true until the hold is released
+func (h *LegalHold) IsActive() bool {
+	return h.ReleasedAt == nil
+}
+
+// Err returns an error wrapping ErrLegalHold that names the hold
+func (h *LegalHold) Err() error {
+	return fmt.Errorf("%w (%s hold %s: %s)", ErrLegalHold, h.Scope(), h.ID, h.Reason)
+}
@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OperationType identifies what a long-running operation does
+type OperationType string
+
+const (
+	OperationDeleteScan         OperationType = "delete_scan"
+	OperationDeleteProjectScans OperationType = "delete_project_scans"
+	OperationApplyRetention     OperationType = "apply_retention" // Scans expired by retention policies
+)
+
+// OperationStatus represents the state of a long-running operation
+type OperationStatus string
+
+const (
+	OperationRunning   OperationStatus = "running"   // Items are still being processed
+	OperationSucceeded OperationStatus = "succeeded" // Every item was processed
+	OperationFailed    OperationStatus = "failed"    // At least one item gave up after the maximum number of attempts
+)
+
+// Operation tracks work that continues in the background after the RPC that
+// started it returned, such as deleting scans
+type Operation struct {
+	ID             uuid.UUID       `json:"id" db:"id"`
+	Type           OperationType   `json:"type" db:"type"`
+	Status         OperationStatus `json:"status" db:"status"`
+	ScanID         *uuid.UUID      `json:"scan_id,omitempty" db:"scan_id"`
+	ProjectID      *uuid.UUID      `json:"project_id,omitempty" db:"project_id"`
+	TotalItems     int             `json:"total_items" db:"total_items"`
+	CompletedItems int             `json:"completed_items" db:"completed_items"`
+	FailedItems    int             `json:"failed_items" db:"failed_items"`
+	LastError      *string         `json:"last_error,omitempty" db:"last_error"`
+	CreatedAt      time.Time       `json:"created_at" db:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at" db:"updated_at"`
+	CompletedAt    *time.Time      `json:"completed_at,omitempty" db:"completed_at"`
+}
+
+// IsDone returns true once every item of the operation was processed
+func (o *Operation) IsDone() bool {
+	return o.Status != OperationRunning
+}
+
+// ScanDeletionStatus represents the state of a pending scan deletion
+type ScanDeletionStatus string
+
+const (
+	ScanDeletionPending ScanDeletionStatus = "pending" // Waiting for the next attempt
+	ScanDeletionFailed  ScanDeletionStatus = "failed"  // Gave up after the maximum number of attempts
+)
+
+// ScanDeletion is a scan marked deleting whose Kubernetes job, artifacts and
+// rows are removed by the scan deleter
+type ScanDeletion struct {
+	ScanID        uuid.UUID          `json:"scan_id" db:"scan_id"`
+	ScanCreatedAt time.Time          `json:"scan_created_at" db:"scan_created_at"`
+	OperationID   uuid.UUID          `json:"operation_id" db:"operation_id"`
+	Status        ScanDeletionStatus `json:"status" db:"status"`
+	Attempts      int                `json:"attempts" db:"attempts"`
+	NextAttemptAt time.Time          `json:"next_attempt_at" db:"next_attempt_at"`
+	LastError     *string            `json:"last_error,omitempty" db:"last_error"`
+	CreatedAt     time.Time          `json:"created_at" db:"created_at"`
+
+	// PreviousStatus is the scan's status before it was marked deleting,
+	// empty if unknown
+	PreviousStatus ScanStatus `json:"previous_status,omitempty" db:"previous_status"`
+}
@@ -0,0 +1,22 @@
+package domain
+
+import "time"
+
+// Partition is a range partition of a partitioned table
+type Partition struct {
+	Name          string
+	Table         string
+	From          time.Time // Inclusive lower bound; zero for the default partition
+	To            time.Time // Exclusive upper bound; zero for the default partition
+	DetachPending bool      // A concurrent detach was interrupted and must be finalized
+}
+
+// IsDefault returns true for the default partition, which has no bounds
+func (p *Partition) IsDefault() bool {
+	return p.From.IsZero() && p.To.IsZero()
+}
+
+// Covers returns true if t falls within the partition's bounds
+func (p *Partition) Covers(t time.Time) bool {
+	return !p.IsDefault() && !t.Before(p.From) && t.Before(p.To)
+}
@@ -0,0 +1,55 @@
+package domain
+
+import "time"
+
+// ProgressPhase is the step a runner is at
+type ProgressPhase string
+
+const (
+	ProgressPhaseDownloading ProgressPhase = "downloading" // Downloading the source archive
+	ProgressPhaseCloning     ProgressPhase = "cloning"     // Cloning the repository
+	ProgressPhaseScanning    ProgressPhase = "scanning"    // Running the scanner
+	ProgressPhaseUploading   ProgressPhase = "uploading"   // Reporting findings and artifacts
+)
+
+// Progress is what a runner last reported doing. Each report is also a
+// heartbeat of the runner.
+type Progress struct {
+	Phase     ProgressPhase `json:"phase"`
+	Scanner   string        `json:"scanner,omitempty"` // Scanner currently running
+	Percent   int           `json:"percent"`           // 0 to 100
+	Message   string        `json:"message,omitempty"`
+	UpdatedAt time.Time     `json:"updated_at"`
+}
+
+// aggregateProgress returns the progress of a scan from its sub-scans: the
+// mean of their percentages, counting finished sub-scans as done, with the
+// phase, scanner and message of the latest report of a running sub-scan, or
+// else of the scan's previous progress. It returns nil until a runner
+// reported progress.
+func aggregateProgress(previous *Progress, subs []*SubScan) *Progress {
+	var latest *Progress
+	percent := 0
+	for _, sub := range subs {
+		switch {
+		case sub.IsTerminal():
+			percent += 100
+		case sub.Progress != nil && sub.Status == ScanStatusRunning:
+			percent += sub.Progress.Percent
+			if latest == nil || sub.Progress.UpdatedAt.After(latest.UpdatedAt) {
+				latest = sub.Progress
+			}
+		}
+	}
+
+	if latest == nil {
+		latest = previous
+	}
+	if latest == nil {
+		return nil
+	}
+
+	progress := *latest
+	progress.Percent = percent / len(subs)
+	return &progress
+}
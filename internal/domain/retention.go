@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RetentionPolicy decides which finished scans of an organization or project
+// the cleaner deletes. A scan is deleted only if none of the rules keeps it.
+// A project policy replaces the organization policy, which replaces the
+// service-wide default.
+type RetentionPolicy struct {
+	ID             uuid.UUID  `json:"id" db:"id"`
+	OrganizationID uuid.UUID  `json:"organization_id" db:"organization_id"`
+	ProjectID      *uuid.UUID `json:"project_id,omitempty" db:"project_id"` // nil for the organization-wide policy
+
+	// Rules
+	KeepDays                int    `json:"keep_days" db:"keep_days"`                                   // Keep scans younger than this many days; 0 disables
+	KeepLastPerBranch       int    `json:"keep_last_per_branch" db:"keep_last_per_branch"`             // Keep the newest N scans of each branch; 0 disables
+	KeepLatestDefaultBranch bool   `json:"keep_latest_default_branch" db:"keep_latest_default_branch"` // Keep the latest completed scan of the default branch
+	DefaultBranch           string `json:"default_branch,omitempty" db:"default_branch"`               // Project policies only; empty uses the project's default branch
+
+	// Audit
+	CreatedBy uuid.UUID `json:"created_by" db:"created_by"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// Validate checks that the rules are consistent and keep at least some scans
+func (p *RetentionPolicy) Validate() error {
+	if p.KeepDays < 0 {
+		return fmt.Errorf("keep_days must not be negative")
+	}
+	if p.KeepLastPerBranch < 0 {
+		return fmt.Errorf("keep_last_per_branch must not be negative")
+	}
+	if p.KeepDays == 0 && p.KeepLastPerBranch == 0 {
+		return fmt.Errorf("keep_days or keep_last_per_branch must be set")
+	}
+	if p.DefaultBranch != "" && p.ProjectID == nil {
+		return fmt.Errorf("default_branch can only be set on project policies")
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrRequestReplayed is returned when a runner request with the same request
+// ID was applied before; its change isn't applied again
+var ErrRequestReplayed = errors.New("request already applied")
+
+// ErrRunnerRequestNotFound is returned when no runner request has a request ID
+var ErrRunnerRequestNotFound = errors.New("runner request not found")
+
+// RunnerRequest records a runner callback made with a request ID, so a
+// replay, e.g. after a timeout, returns the original response instead of
+// applying the callback twice. Request IDs are unique per scan.
+type RunnerRequest struct {
+	ScanID        uuid.UUID `json:"scan_id" db:"scan_id"`
+	ScanCreatedAt time.Time `json:"-" db:"scan_created_at"`
+	RequestID     string    `json:"request_id" db:"request_id"`
+	Method        string    `json:"method" db:"method"` // RPC the request ID was used with
+	Response      []byte    `json:"-" db:"response"`    // Encoded response, nil until recorded
+	CreatedAt     time.Time `json:"created_at" db:"created_at"`
+}
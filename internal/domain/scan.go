@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
@@ -13,10 +14,27 @@ const (
 	ScanStatusQueued    ScanStatus = "queued"
 	ScanStatusRunning   ScanStatus = "running"
 	ScanStatusCompleted ScanStatus = "completed"
+	ScanStatusPartial   ScanStatus = "partial" // Some scan types completed, others failed or were cancelled
 	ScanStatusFailed    ScanStatus = "failed"
 	ScanStatusCancelled ScanStatus = "cancelled"
+	ScanStatusDeleting  ScanStatus = "deleting" // Removal of the job, artifacts and rows is in progress
 )
 
+// ErrScanDeleting is returned when a scan that is being deleted is modified
+var ErrScanDeleting = errors.New("scan is being deleted")
+
+// ErrScanNotFound is returned when a scan does not exist
+var ErrScanNotFound = errors.New("scan not found")
+
+// ErrScanFinished is returned for a runner call on a scan that reached a final state
+var ErrScanFinished = errors.New("scan is finished")
+
+// ErrOrganizationNotFound is returned when an organization has neither a row nor scans
+var ErrOrganizationNotFound = errors.New("organization not found")
+
+// ErrProjectNotFound is returned when a project has neither a row nor scans in the organization
+var ErrProjectNotFound = errors.New("project not found")
+
 // ScanType represents the type of security scan
 type ScanType string
 
@@ -43,8 +61,10 @@ type Scan struct {
 
 	// Artifact storage
 	SourceArchiveKey *string `json:"source_archive_key,omitempty" db:"source_archive_key"` // S3/MinIO key
+	LogsArtifactID   *string `json:"logs_artifact_id,omitempty" db:"logs_artifact_id"`     // Runner logs, set once the job finished
 
-	// Kubernetes job information
+	// Kubernetes job information. Scans dispatched with one job per scan type
+	// have no job of their own; their jobs are on the sub-scans.
 	JobName      *string `json:"job_name,omitempty" db:"job_name"`
 	JobNamespace *string `json:"job_namespace,omitempty" db:"job_namespace"`
 
@@ -58,6 +78,23 @@ type Scan struct {
 	CompletedAt    *time.Time `json:"completed_at,omitempty" db:"completed_at"`
 	ErrorMessage   *string    `json:"error_message,omitempty" db:"error_message"`
 
+	// FailureReason classifies why a failed or partial scan failed
+	FailureReason FailureReason `json:"failure_reason,omitempty" db:"failure_reason"`
+
+	// Progress is the runner's last progress report, combined over the
+	// sub-scans of scans with a job per scan type
+	Progress *Progress `json:"progress,omitempty" db:"progress"`
+
+	// JobSettings override the job profile of the scan's project
+	JobSettings *JobSettings `json:"job_settings,omitempty" db:"job_settings"`
+
+	// SubScans are the per-scan-type jobs. Only loaded where noted.
+	SubScans []*SubScan `json:"sub_scans,omitempty" db:"-"`
+
+	// TraceParent is the W3C trace context of the request that created the
+	// scan, so dispatch, runner callbacks and completion join its trace
+	TraceParent string `json:"-" db:"trace_parent"`
+
 	// Audit
 	CreatedAt time.Time `json:"created_at" db:"created_at"`
 	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
@@ -66,6 +103,7 @@ type Scan struct {
 // IsTerminal returns true if the scan is in a terminal state
 func (s *Scan) IsTerminal() bool {
 	return s.Status == ScanStatusCompleted ||
+		s.Status == ScanStatusPartial ||
 		s.Status == ScanStatusFailed ||
 		s.Status == ScanStatusCancelled
 }
@@ -84,6 +122,16 @@ func (s *Scan) Duration() time.Duration {
 	return endTime.Sub(*s.StartedAt)
 }
 
+// JobNamespace returns the namespace of a scan's or sub-scan's job. Scans
+// recorded before the namespace was stored have none; their jobs run in the
+// default namespace.
+func JobNamespace(namespace *string, defaultNamespace string) string {
+	if namespace != nil && *namespace != "" {
+		return *namespace
+	}
+	return defaultNamespace
+}
+
 // Project represents a code project to be scanned
 type Project struct {
 	ID             uuid.UUID `json:"id" db:"id"`
@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/robfig/cron/v3"
+)
+
+// cronParser accepts standard 5-field cron expressions and descriptors such as @daily
+var cronParser = cron.NewParser(
+	cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor,
+)
+
+// ScanSchedule represents a recurring scan of a project branch
+type ScanSchedule struct {
+	ID             uuid.UUID  `json:"id" db:"id"`
+	OrganizationID uuid.UUID  `json:"organization_id" db:"organization_id"`
+	ProjectID      uuid.UUID  `json:"project_id" db:"project_id"`
+	CronExpression string     `json:"cron_expression" db:"cron_expression"` // e.g., "0 2 * * *"
+	Timezone       string     `json:"timezone" db:"timezone"`               // IANA name, e.g., "Europe/Berlin"
+	ScanTypes      []ScanType `json:"scan_types" db:"scan_types"`
+	RepositoryURL  string     `json:"repository_url" db:"repository_url"`
+	Branch         string     `json:"branch" db:"branch"`
+	Enabled        bool       `json:"enabled" db:"enabled"`
+
+	// Run tracking
+	NextRunAt  *time.Time `json:"next_run_at,omitempty" db:"next_run_at"`
+	LastRunAt  *time.Time `json:"last_run_at,omitempty" db:"last_run_at"`
+	LastScanID *uuid.UUID `json:"last_scan_id,omitempty" db:"last_scan_id"`
+
+	// Audit
+	CreatedBy uuid.UUID `json:"created_by" db:"created_by"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// Validate checks that the cron expression and timezone can be evaluated
+func (s *ScanSchedule) Validate() error {
+	if _, err := cronParser.Parse(s.CronExpression); err != nil {
+		return fmt.Errorf("invalid cron expression %q: %w", s.CronExpression, err)
+	}
+	if _, err := time.LoadLocation(s.Timezone); err != nil {
+		return fmt.Errorf("invalid timezone %q: %w", s.Timezone, err)
+	}
+	return nil
+}
+
+// NextRunAfter returns the first activation time strictly after t,
+// evaluated in the schedule's timezone
+func (s *ScanSchedule) NextRunAfter(t time.Time) (time.Time, error) {
+	schedule, err := cronParser.Parse(s.CronExpression)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid cron expression %q: %w", s.CronExpression, err)
+	}
+
+	loc, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid timezone %q: %w", s.Timezone, err)
+	}
+
+	next := schedule.Next(t.In(loc))
+	if next.IsZero() {
+		return time.Time{}, fmt.Errorf("cron expression %q never fires", s.CronExpression)
+	}
+
+	return next.UTC(), nil
+}
@@ -0,0 +1,173 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrScannerUnavailable is returned when no enabled scanner runs a scan type
+var ErrScannerUnavailable = errors.New("no enabled scanner for scan type")
+
+// ErrInvalidScanner is returned for a scanner that can't be run
+var ErrInvalidScanner = errors.New("invalid scanner")
+
+// ErrScannersReadOnly is returned when changing scanners that come from the
+// configuration
+var ErrScannersReadOnly = errors.New("scanners are read-only")
+
+// ErrSubScanNotFound is returned when a scan has no sub-scan of a scan type
+var ErrSubScanNotFound = errors.New("sub-scan not found")
+
+// ErrStaleAttempt is returned for a runner callback of an attempt that was
+// replaced by a retry
+var ErrStaleAttempt = errors.New("attempt is not the sub-scan's current attempt")
+
+// ErrSubScanNotRunning is returned for a runner callback on a sub-scan that
+// finished or is queued for a retry
+var ErrSubScanNotRunning = errors.New("sub-scan is not running")
+
+// SubScan is the part of a scan that runs one scan type in its own job. The
+// parent scan's status and counts are aggregated from its sub-scans.
+type SubScan struct {
+	ScanID        uuid.UUID  `json:"scan_id" db:"scan_id"`
+	ScanCreatedAt time.Time  `json:"-" db:"scan_created_at"` // created_at of the scan
+	ScanType      ScanType   `json:"scan_type" db:"scan_type"`
+	ScannerName   string     `json:"scanner_name" db:"scanner_name"`
+	ScannerImage  string     `json:"scanner_image" db:"scanner_image"`
+	Status        ScanStatus `json:"status" db:"status"` // Never partial or deleting
+
+	// Kubernetes job information, nil if the job couldn't be created
+	JobName      *string `json:"job_name,omitempty" db:"job_name"`
+	JobNamespace *string `json:"job_namespace,omitempty" db:"job_namespace"`
+
+	// Results
+	FindingsCount int        `json:"findings_count" db:"findings_count"`
+	CriticalCount int        `json:"critical_count" db:"critical_count"`
+	HighCount     int        `json:"high_count" db:"high_count"`
+	MediumCount   int        `json:"medium_count" db:"medium_count"`
+	LowCount      int        `json:"low_count" db:"low_count"`
+	StartedAt     *time.Time `json:"started_at,omitempty" db:"started_at"`
+	CompletedAt   *time.Time `json:"completed_at,omitempty" db:"completed_at"`
+	ErrorMessage  *string    `json:"error_message,omitempty" db:"error_message"`
+
+	// Retries. A sub-scan queued for a retry keeps its failed job until the
+	// dispatcher replaces it.
+	Attempt       int           `json:"attempt" db:"attempt"` // Starts at 1
+	FailureReason FailureReason `json:"failure_reason,omitempty" db:"failure_reason"`
+	RetryAt       *time.Time    `json:"retry_at,omitempty" db:"retry_at"` // Set while queued for a retry
+
+	// LastHeartbeatAt is the time of the runner's last callback for the
+	// current attempt
+	LastHeartbeatAt *time.Time `json:"last_heartbeat_at,omitempty" db:"last_heartbeat_at"`
+
+	// Progress is the runner's last progress report for the current attempt
+	Progress *Progress `json:"progress,omitempty" db:"progress"`
+
+	// Audit
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// IsTerminal returns true if the sub-scan is in a terminal state
+func (s *SubScan) IsTerminal() bool {
+	return s.Status == ScanStatusCompleted ||
+		s.Status == ScanStatusFailed ||
+		s.Status == ScanStatusCancelled
+}
+
+// SubScanLog is the archived runner log of one attempt of a sub-scan
+type SubScanLog struct {
+	ScanID        uuid.UUID `json:"scan_id" db:"scan_id"`
+	ScanCreatedAt time.Time `json:"-" db:"scan_created_at"` // created_at of the scan
+	ScanType      ScanType  `json:"scan_type" db:"scan_type"`
+	Attempt       int       `json:"attempt" db:"attempt"`
+	ScannerName   string    `json:"scanner_name" db:"scanner_name"` // Scanner that ran the attempt
+	ArtifactID    string    `json:"artifact_id" db:"artifact_id"`
+	CreatedAt     time.Time `json:"created_at" db:"created_at"`
+}
+
+// Aggregate sets the scan's status, counts and error message from its
+// sub-scans. The scan stays running until every sub-scan finished, including
+// queued retries; it is then completed if all of them completed, partial if
+// only some did, cancelled if all were cancelled and failed otherwise. Its
+// failure reason is that of its first failed sub-scan, and its progress
+// combines theirs.
+func (s *Scan) Aggregate(subs []*SubScan) {
+	if len(subs) == 0 {
+		return
+	}
+
+	s.FindingsCount, s.CriticalCount, s.HighCount, s.MediumCount, s.LowCount = 0, 0, 0, 0, 0
+
+	var completed, cancelled, finished int
+	var errs []string
+	var failureReason FailureReason
+	var completedAt time.Time
+	for _, sub := range subs {
+		s.FindingsCount += sub.FindingsCount
+		s.CriticalCount += sub.CriticalCount
+		s.HighCount += sub.HighCount
+		s.MediumCount += sub.MediumCount
+		s.LowCount += sub.LowCount
+
+		if sub.StartedAt != nil && (s.StartedAt == nil || sub.StartedAt.Before(*s.StartedAt)) {
+			startedAt := *sub.StartedAt
+			s.StartedAt = &startedAt
+		}
+
+		if !sub.IsTerminal() {
+			continue
+		}
+		finished++
+		if sub.CompletedAt != nil && sub.CompletedAt.After(completedAt) {
+			completedAt = *sub.CompletedAt
+		}
+
+		switch sub.Status {
+		case ScanStatusCompleted:
+			completed++
+		case ScanStatusCancelled:
+			cancelled++
+		}
+		if sub.Status != ScanStatusCompleted && sub.ErrorMessage != nil && *sub.ErrorMessage != "" {
+			errs = append(errs, fmt.Sprintf("%s: %s", sub.ScanType, *sub.ErrorMessage))
+		}
+		if sub.Status == ScanStatusFailed && failureReason == "" {
+			failureReason = sub.FailureReason
+		}
+	}
+
+	s.Progress = aggregateProgress(s.Progress, subs)
+
+	if finished < len(subs) {
+		s.Status = ScanStatusRunning
+		return
+	}
+
+	switch {
+	case completed == len(subs):
+		s.Status = ScanStatusCompleted
+	case completed > 0:
+		s.Status = ScanStatusPartial
+	case cancelled == len(subs):
+		s.Status = ScanStatusCancelled
+	default:
+		s.Status = ScanStatusFailed
+	}
+
+	if completedAt.IsZero() {
+		completedAt = time.Now()
+	}
+	s.CompletedAt = &completedAt
+
+	s.FailureReason = failureReason
+	s.ErrorMessage = nil
+	if len(errs) > 0 {
+		message := strings.Join(errs, "; ")
+		s.ErrorMessage = &message
+	}
+}
@@ -0,0 +1,79 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WebhookSubscription delivers events of an organization to an HTTP endpoint
+type WebhookSubscription struct {
+	ID             uuid.UUID   `json:"id" db:"id"`
+	OrganizationID uuid.UUID   `json:"organization_id" db:"organization_id"`
+	URL            string      `json:"url" db:"url"`
+	Secret         string      `json:"-" db:"secret"`                // HMAC-SHA256 signing key
+	EventTypes     []EventType `json:"event_types" db:"event_types"` // Empty means all events
+	Enabled        bool        `json:"enabled" db:"enabled"`
+	CreatedAt      time.Time   `json:"created_at" db:"created_at"`
+	UpdatedAt      time.Time   `json:"updated_at" db:"updated_at"`
+}
+
+// Matches returns true if the subscription wants events of the given type
+func (s *WebhookSubscription) Matches(eventType EventType) bool {
+	if len(s.EventTypes) == 0 {
+		return true
+	}
+	for _, t := range s.EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookDeliveryStatus represents the state of a webhook delivery
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"   // Waiting for the next attempt
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "succeeded" // Endpoint answered with 2xx
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "failed"    // Gave up after the maximum number of attempts
+)
+
+// WebhookDelivery is an event queued for a subscription (the outbox entry)
+type WebhookDelivery struct {
+	ID             uuid.UUID             `json:"id" db:"id"`
+	SubscriptionID uuid.UUID             `json:"subscription_id" db:"subscription_id"`
+	OrganizationID uuid.UUID             `json:"organization_id" db:"organization_id"`
+	EventID        uuid.UUID             `json:"event_id" db:"event_id"`
+	EventType      EventType             `json:"event_type" db:"event_type"`
+	Payload        []byte                `json:"payload" db:"payload"` // JSON-encoded Event, signed as-is
+	Status         WebhookDeliveryStatus `json:"status" db:"status"`
+	Attempts       int                   `json:"attempts" db:"attempts"`
+	NextAttemptAt  time.Time             `json:"next_attempt_at" db:"next_attempt_at"`
+	LastStatusCode *int                  `json:"last_status_code,omitempty" db:"last_status_code"`
+	LastError      *string               `json:"last_error,omitempty" db:"last_error"`
+	DeliveredAt    *time.Time            `json:"delivered_at,omitempty" db:"delivered_at"`
+	CreatedAt      time.Time             `json:"created_at" db:"created_at"`
+	UpdatedAt      time.Time             `json:"updated_at" db:"updated_at"`
+
+	// Endpoint of the subscription, loaded when the delivery is claimed for sending
+	URL    string `json:"-" db:"-"`
+	Secret string `json:"-" db:"-"`
+}
+
+// WebhookDeliveryAttempt records a single HTTP request of a delivery
+type WebhookDeliveryAttempt struct {
+	ID          uuid.UUID `json:"id" db:"id"`
+	DeliveryID  uuid.UUID `json:"delivery_id" db:"delivery_id"`
+	Attempt     int       `json:"attempt" db:"attempt"`
+	StatusCode  *int      `json:"status_code,omitempty" db:"status_code"`
+	Error       *string   `json:"error,omitempty" db:"error"`
+	DurationMs  int64     `json:"duration_ms" db:"duration_ms"`
+	AttemptedAt time.Time `json:"attempted_at" db:"attempted_at"`
+}
+
+// Succeeded returns true if the endpoint accepted the request
+func (a *WebhookDeliveryAttempt) Succeeded() bool {
+	return a.StatusCode != nil && *a.StatusCode >= 200 && *a.StatusCode < 300
+}
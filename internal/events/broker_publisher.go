@@ -0,0 +1,155 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	pb "github.com/cloud-scan/cloudscan-orchestrator/generated/proto"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// SchemaVersion is the version of the cloudscan.events protobuf package
+	SchemaVersion = 1
+
+	// DefaultSubjectPrefix is the subject prefix for events of SchemaVersion
+	DefaultSubjectPrefix = "cloudscan.events.v1"
+
+	// ContentType identifies protobuf encoded EventEnvelope messages
+	ContentType = "application/x-protobuf; messageType=cloudscan.events.v1.EventEnvelope"
+)
+
+// Message headers set on every published event
+const (
+	HeaderContentType   = "Content-Type"
+	HeaderEventID       = "CloudScan-Event-Id"
+	HeaderEventType     = "CloudScan-Event-Type"
+	HeaderSchemaVersion = "CloudScan-Schema-Version"
+)
+
+// BrokerPublisher encodes events as versioned protobuf messages and publishes
+// them to a message broker on the subject "<prefix>.<event type>", e.g.
+// "cloudscan.events.v1.scan.completed"
+type BrokerPublisher struct {
+	broker        interfaces.MessageBroker
+	subjectPrefix string
+}
+
+// NewBrokerPublisher creates a publisher for the broker. An empty prefix uses
+// DefaultSubjectPrefix.
+func NewBrokerPublisher(broker interfaces.MessageBroker, subjectPrefix string) *BrokerPublisher {
+	if subjectPrefix == "" {
+		subjectPrefix = DefaultSubjectPrefix
+	}
+	return &BrokerPublisher{
+		broker:        broker,
+		subjectPrefix: strings.TrimSuffix(subjectPrefix, "."),
+	}
+}
+
+// Publish encodes the event and sends it to the broker
+func (p *BrokerPublisher) Publish(ctx context.Context, event *domain.Event) error {
+	data, err := proto.Marshal(EncodeEvent(event))
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	headers := map[string]string{
+		HeaderContentType:   ContentType,
+		HeaderEventID:       event.ID.String(),
+		HeaderEventType:     string(event.Type),
+		HeaderSchemaVersion: fmt.Sprintf("%d", SchemaVersion),
+	}
+
+	subject := p.subjectPrefix + "." + string(event.Type)
+	if err := p.broker.Publish(ctx, subject, data, headers); err != nil {
+		return fmt.Errorf("failed to publish event to %s: %w", subject, err)
+	}
+
+	return nil
+}
+
+// EncodeEvent converts an event to its protobuf envelope
+func EncodeEvent(event *domain.Event) *pb.EventEnvelope {
+	envelope := &pb.EventEnvelope{
+		Id:             event.ID.String(),
+		Type:           string(event.Type),
+		SchemaVersion:  SchemaVersion,
+		OrganizationId: event.OrganizationID.String(),
+		OccurredAt:     timestamppb.New(event.OccurredAt),
+	}
+
+	switch {
+	case event.Scan != nil:
+		envelope.Payload = &pb.EventEnvelope_Scan{Scan: encodeScan(event.Scan)}
+	case event.Finding != nil:
+		envelope.Payload = &pb.EventEnvelope_Finding{Finding: encodeFinding(event.Finding)}
+	}
+
+	return envelope
+}
+
+func encodeScan(scan *domain.Scan) *pb.ScanEvent {
+	scanEvent := &pb.ScanEvent{
+		ScanId:        scan.ID.String(),
+		ProjectId:     scan.ProjectID.String(),
+		UserId:        scan.UserID.String(),
+		Status:        pb.ScanStatus(pb.ScanStatus_value[strings.ToUpper(string(scan.Status))]),
+		FindingsCount: int32(scan.FindingsCount),
+		FindingsBySeverity: map[string]int32{
+			string(domain.SeverityCritical): int32(scan.CriticalCount),
+			string(domain.SeverityHigh):     int32(scan.HighCount),
+			string(domain.SeverityMedium):   int32(scan.MediumCount),
+			string(domain.SeverityLow):      int32(scan.LowCount),
+		},
+		CreatedAt: timestamppb.New(scan.CreatedAt),
+	}
+
+	for _, scanType := range scan.ScanTypes {
+		scanEvent.ScanTypes = append(scanEvent.ScanTypes, encodeScanType(scanType))
+	}
+	if scan.RepositoryURL != nil {
+		scanEvent.RepositoryUrl = *scan.RepositoryURL
+	}
+	if scan.Branch != nil {
+		scanEvent.Branch = *scan.Branch
+	}
+	if scan.CommitSHA != nil {
+		scanEvent.CommitSha = *scan.CommitSHA
+	}
+	if scan.ErrorMessage != nil {
+		scanEvent.ErrorMessage = *scan.ErrorMessage
+	}
+	if scan.StartedAt != nil {
+		scanEvent.StartedAt = timestamppb.New(*scan.StartedAt)
+	}
+	if scan.CompletedAt != nil {
+		scanEvent.CompletedAt = timestamppb.New(*scan.CompletedAt)
+	}
+
+	return scanEvent
+}
+
+func encodeFinding(finding *domain.Finding) *pb.FindingEvent {
+	return &pb.FindingEvent{
+		FindingId: finding.ID.String(),
+		ScanId:    finding.ScanID.String(),
+		ScanType:  encodeScanType(finding.ScanType),
+		Severity:  pb.Severity(pb.Severity_value[strings.ToUpper(string(finding.Severity))]),
+		ToolName:  finding.ToolName,
+		Title:     finding.Title,
+		FilePath:  finding.FilePath,
+		StartLine: int32(finding.StartLine),
+		CweId:     finding.CWEID,
+		CveId:     finding.CVEID,
+	}
+}
+
+// encodeScanType maps scan types by name; unknown types are unspecified
+func encodeScanType(scanType domain.ScanType) pb.ScanType {
+	return pb.ScanType(pb.ScanType_value[strings.ToUpper(string(scanType))])
+}
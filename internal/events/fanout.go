@@ -0,0 +1,38 @@
+package events
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+)
+
+// Fanout publishes every event to a list of publishers
+type Fanout struct {
+	publishers []interfaces.EventPublisher
+}
+
+// NewFanout creates a publisher that forwards events to all given publishers
+func NewFanout(publishers ...interfaces.EventPublisher) *Fanout {
+	return &Fanout{
+		publishers: publishers,
+	}
+}
+
+// Publish forwards the event to every publisher. A failing publisher does not
+// keep the event from the others; all errors are returned joined.
+func (f *Fanout) Publish(ctx context.Context, event *domain.Event) error {
+	if event == nil {
+		return nil
+	}
+
+	var errs []error
+	for _, publisher := range f.publishers {
+		if err := publisher.Publish(ctx, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,79 @@
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	log "github.com/sirupsen/logrus"
+)
+
+// KafkaBroker publishes events to Kafka. Writes wait for all in-sync replicas,
+// so a returned nil means the message is stored. Each subject is a topic of
+// its own, e.g. "cloudscan.events.v1.scan.completed", unless a topic is set
+// for all events. The event ID is the message key.
+type KafkaBroker struct {
+	writer  *kafka.Writer
+	topic   string
+	timeout time.Duration
+	logger  *log.Entry
+}
+
+// NewKafkaBroker creates a broker writing to the Kafka brokers. An empty topic
+// publishes every subject on the topic of its name. Connections are opened on
+// the first publish.
+func NewKafkaBroker(brokers []string, topic string, timeout time.Duration) *KafkaBroker {
+	logger := log.WithField("component", "kafka-broker")
+
+	writer := &kafka.Writer{
+		Addr:         kafka.TCP(brokers...),
+		Balancer:     &kafka.Hash{},
+		RequiredAcks: kafka.RequireAll,
+		// Messages are written one at a time; don't wait for a batch to fill
+		BatchTimeout: time.Millisecond,
+		WriteTimeout: timeout,
+		ReadTimeout:  timeout,
+		ErrorLogger: kafka.LoggerFunc(func(msg string, args ...interface{}) {
+			logger.Warnf(msg, args...)
+		}),
+	}
+
+	logger.WithField("brokers", brokers).Info("Created Kafka writer")
+
+	return &KafkaBroker{
+		writer:  writer,
+		topic:   topic,
+		timeout: timeout,
+		logger:  logger,
+	}
+}
+
+// Publish sends the message and waits until the brokers have stored it
+func (b *KafkaBroker) Publish(ctx context.Context, subject string, data []byte, headers map[string]string) error {
+	msg := kafka.Message{
+		Topic: subject,
+		Value: data,
+	}
+	if b.topic != "" {
+		msg.Topic = b.topic
+	}
+	if id, ok := headers[HeaderEventID]; ok {
+		msg.Key = []byte(id)
+	}
+	for k, v := range headers {
+		msg.Headers = append(msg.Headers, kafka.Header{Key: k, Value: []byte(v)})
+	}
+
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, b.timeout)
+		defer cancel()
+	}
+
+	return b.writer.WriteMessages(ctx, msg)
+}
+
+// Close flushes pending messages and closes the connections
+func (b *KafkaBroker) Close() error {
+	return b.writer.Close()
+}
@@ -0,0 +1,78 @@
+package events
+
+import (
+	"context"
+	"sync"
+)
+
+// Message is a message received by the MemoryBroker
+type Message struct {
+	Subject string
+	Data    []byte
+	Headers map[string]string
+}
+
+// MemoryBroker is an in-process interfaces.MessageBroker that records every
+// published message. It is meant for tests and local development.
+type MemoryBroker struct {
+	mu       sync.Mutex
+	messages []Message
+	err      error
+}
+
+// NewMemoryBroker creates an empty in-memory broker
+func NewMemoryBroker() *MemoryBroker {
+	return &MemoryBroker{}
+}
+
+// Publish records the message, or returns the error set with FailWith
+func (b *MemoryBroker) Publish(ctx context.Context, subject string, data []byte, headers map[string]string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.err != nil {
+		return b.err
+	}
+
+	copied := make(map[string]string, len(headers))
+	for k, v := range headers {
+		copied[k] = v
+	}
+
+	b.messages = append(b.messages, Message{
+		Subject: subject,
+		Data:    append([]byte(nil), data...),
+		Headers: copied,
+	})
+
+	return nil
+}
+
+// Messages returns the recorded messages in publish order
+func (b *MemoryBroker) Messages() []Message {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return append([]Message(nil), b.messages...)
+}
+
+// FailWith makes subsequent publishes fail with err until it is reset with nil
+func (b *MemoryBroker) FailWith(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.err = err
+}
+
+// Reset discards the recorded messages
+func (b *MemoryBroker) Reset() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.messages = nil
+}
+
+// Close is a no-op
+func (b *MemoryBroker) Close() error {
+	return nil
+}
@@ -0,0 +1,80 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	log "github.com/sirupsen/logrus"
+)
+
+// NATSBroker publishes events to NATS. Publishes are confirmed with a flush,
+// so a returned nil means the server has received the message. Subjects can be
+// captured by a JetStream stream for durable consumption; the event ID is set
+// as Nats-Msg-Id so JetStream drops messages redelivered by the relay.
+type NATSBroker struct {
+	conn    *nats.Conn
+	timeout time.Duration
+	logger  *log.Entry
+}
+
+// NewNATSBroker connects to the NATS server(s) at url (comma separated)
+func NewNATSBroker(url string, timeout time.Duration) (*NATSBroker, error) {
+	logger := log.WithField("component", "nats-broker")
+
+	conn, err := nats.Connect(url,
+		nats.Name("cloudscan-orchestrator"),
+		nats.Timeout(timeout),
+		nats.MaxReconnects(-1),
+		nats.DisconnectErrHandler(func(_ *nats.Conn, err error) {
+			if err != nil {
+				logger.WithError(err).Warn("Disconnected from NATS")
+			}
+		}),
+		nats.ReconnectHandler(func(c *nats.Conn) {
+			logger.WithField("url", c.ConnectedUrlRedacted()).Info("Reconnected to NATS")
+		}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to NATS: %w", err)
+	}
+
+	logger.WithField("url", conn.ConnectedUrlRedacted()).Info("Connected to NATS")
+
+	return &NATSBroker{
+		conn:    conn,
+		timeout: timeout,
+		logger:  logger,
+	}, nil
+}
+
+// Publish sends the message and waits until the server has received it
+func (b *NATSBroker) Publish(ctx context.Context, subject string, data []byte, headers map[string]string) error {
+	msg := nats.NewMsg(subject)
+	msg.Data = data
+	for k, v := range headers {
+		msg.Header.Set(k, v)
+	}
+	if id, ok := headers[HeaderEventID]; ok {
+		msg.Header.Set(nats.MsgIdHdr, id)
+	}
+
+	if err := b.conn.PublishMsg(msg); err != nil {
+		return err
+	}
+
+	// Flush requires a deadline
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, b.timeout)
+		defer cancel()
+	}
+
+	return b.conn.FlushWithContext(ctx)
+}
+
+// Close drains pending messages and closes the connection
+func (b *NATSBroker) Close() error {
+	return b.conn.Drain()
+}
@@ -0,0 +1,214 @@
+package gitwebhooks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	pb "github.com/cloud-scan/cloudscan-orchestrator/generated/proto"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+	grpcserver "github.com/cloud-scan/cloudscan-orchestrator/internal/grpc"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/tracing"
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// maxPayloadSize bounds webhook bodies; provider payloads are far smaller
+const maxPayloadSize = 25 << 20
+
+// ScanCreator creates scans. It is satisfied by the gRPC ScanServiceServer so
+// webhook-triggered scans take exactly the same path as API-triggered ones.
+type ScanCreator interface {
+	CreateScan(ctx context.Context, req *pb.CreateScanRequest) (*pb.CreateScanResponse, error)
+}
+
+// Handler receives push and pull request webhooks from Git providers and
+// creates scans for the projects the repositories are integrated with
+type Handler struct {
+	integrationRepo interfaces.IntegrationRepository
+	scanRepo        interfaces.ScanRepository
+	scanCreator     ScanCreator
+	logger          *log.Entry
+}
+
+// NewHandler creates a new webhook handler
+func NewHandler(
+	integrationRepo interfaces.IntegrationRepository,
+	scanRepo interfaces.ScanRepository,
+	scanCreator ScanCreator,
+) *Handler {
+	return &Handler{
+		integrationRepo: integrationRepo,
+		scanRepo:        scanRepo,
+		scanCreator:     scanCreator,
+		logger:          log.WithField("component", "git-webhooks"),
+	}
+}
+
+// Register adds the webhook endpoints to the mux
+func (h *Handler) Register(mux *http.ServeMux) {
+	mux.Handle("POST /webhooks/github", h.providerHandler(domain.GitProviderGitHub))
+	mux.Handle("POST /webhooks/gitlab", h.providerHandler(domain.GitProviderGitLab))
+	mux.Handle("POST /webhooks/bitbucket", h.providerHandler(domain.GitProviderBitbucket))
+}
+
+func (h *Handler) providerHandler(name domain.GitProvider) http.Handler {
+	p := providers[name]
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Root the trace of webhook-triggered scans here
+		ctx, span := tracing.Tracer.Start(r.Context(), "gitwebhooks."+string(name),
+			trace.WithSpanKind(trace.SpanKindServer),
+		)
+		defer span.End()
+
+		h.handle(w, r.WithContext(ctx), name, p)
+	})
+}
+
+func (h *Handler) handle(w http.ResponseWriter, r *http.Request, name domain.GitProvider, p provider) {
+	logger := h.logger.WithField("provider", name)
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxPayloadSize))
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, "error", "failed to read payload")
+		return
+	}
+
+	rawURL, err := p.repositoryURL(r, body)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, "error", err.Error())
+		return
+	}
+	if rawURL == "" {
+		writeJSON(w, http.StatusBadRequest, "error", "payload has no repository URL")
+		return
+	}
+
+	repositoryURL := domain.NormalizeRepositoryURL(rawURL)
+	logger = logger.WithField("repository", repositoryURL)
+
+	// Unknown repositories get the same answer as bad signatures, so callers
+	// can't probe which repositories are integrated
+	integration, err := h.integrationRepo.GetByRepository(r.Context(), name, repositoryURL)
+	if err != nil || !integration.Enabled {
+		logger.Debug("No enabled integration for repository")
+		writeJSON(w, http.StatusUnauthorized, "error", "invalid signature")
+		return
+	}
+
+	// Authenticate before looking at the event: the payload is untrusted until now
+	if !p.verify(r, body, integration.WebhookSecret) {
+		logger.Warn("Rejected webhook with invalid signature")
+		writeJSON(w, http.StatusUnauthorized, "error", "invalid signature")
+		return
+	}
+
+	event, err := p.parse(r, body)
+	if err != nil {
+		var ign *errIgnored
+		if errors.As(err, &ign) {
+			logger.WithField("reason", ign.reason).Debug("Ignoring webhook event")
+			writeJSON(w, http.StatusOK, "ignored", ign.reason)
+			return
+		}
+		writeJSON(w, http.StatusBadRequest, "error", err.Error())
+		return
+	}
+	if event.Branch == "" || event.CommitSHA == "" {
+		writeJSON(w, http.StatusBadRequest, "error", "payload has no branch or commit")
+		return
+	}
+
+	logger = logger.WithFields(log.Fields{
+		"event":      event.Kind,
+		"branch":     event.Branch,
+		"commit_sha": event.CommitSHA,
+		"project_id": integration.ProjectID.String(),
+	})
+
+	// Providers redeliver and send push and pull request events for the same
+	// commit; the lock keeps concurrent deliveries from each creating a scan
+	unlock, err := h.scanRepo.LockCommit(r.Context(), integration.ProjectID, event.CommitSHA)
+	if err != nil {
+		logger.WithError(err).Error("Failed to lock commit")
+		writeJSON(w, http.StatusInternalServerError, "error", "failed to check for existing scans")
+		return
+	}
+	defer unlock()
+
+	existing, err := h.findExistingScan(r.Context(), integration, event.CommitSHA)
+	if err != nil {
+		logger.WithError(err).Error("Failed to check for existing scans")
+		writeJSON(w, http.StatusInternalServerError, "error", "failed to check for existing scans")
+		return
+	}
+	if existing != nil {
+		logger.WithField("scan_id", existing.ID.String()).Info("Commit already scanned, skipping")
+		writeJSON(w, http.StatusOK, "duplicate", existing.ID.String())
+		return
+	}
+
+	resp, err := h.scanCreator.CreateScan(r.Context(), &pb.CreateScanRequest{
+		OrganizationId: integration.OrganizationID.String(),
+		ProjectId:      integration.ProjectID.String(),
+		ScanTypes:      convertScanTypesToProto(integration.ScanTypes),
+		GitUrl:         event.RepositoryURL,
+		GitBranch:      event.Branch,
+		GitCommit:      event.CommitSHA,
+	})
+	if err != nil {
+		logger.WithError(err).Error("Failed to create scan from webhook")
+		writeJSON(w, http.StatusInternalServerError, "error", "failed to create scan")
+		return
+	}
+
+	logger.WithField("scan_id", resp.Scan.Id).Info("Scan created from webhook")
+	writeJSON(w, http.StatusCreated, "created", resp.Scan.Id)
+}
+
+// findExistingScan returns a scan of the same commit in the project that is
+// still active or succeeded. Failed, partial and cancelled scans may be
+// retried by pushing the commit again.
+func (h *Handler) findExistingScan(ctx context.Context, integration *domain.RepositoryIntegration, commitSHA string) (*domain.Scan, error) {
+	scans, err := h.scanRepo.List(ctx, interfaces.ScanFilter{
+		ProjectID: &integration.ProjectID,
+		CommitSHA: &commitSHA,
+		Limit:     10,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, scan := range scans {
+		if scan.Status != domain.ScanStatusFailed && scan.Status != domain.ScanStatusPartial &&
+			scan.Status != domain.ScanStatusCancelled && scan.Status != domain.ScanStatusDeleting {
+			return scan, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// convertScanTypesToProto maps domain scan types to proto enum values, like
+// the gRPC API does; unknown scan types are skipped
+func convertScanTypesToProto(scanTypes []domain.ScanType) []pb.ScanType {
+	protoTypes := make([]pb.ScanType, 0, len(scanTypes))
+	for _, st := range scanTypes {
+		if v := grpcserver.ConvertScanTypeToProto(st); v != pb.ScanType_SCAN_TYPE_UNSPECIFIED {
+			protoTypes = append(protoTypes, v)
+		}
+	}
+	return protoTypes
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, result, detail string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(map[string]string{
+		"status": result,
+		"detail": detail,
+	})
+}
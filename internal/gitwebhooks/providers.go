@@ -0,0 +1,364 @@
+package gitwebhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+)
+
+// pushEvent is the provider-independent description of a webhook that may trigger a scan
+type pushEvent struct {
+	Kind          string // "push" or "pull_request"
+	RepositoryURL string // Clone URL reported by the provider
+	Branch        string
+	CommitSHA     string
+}
+
+// errIgnored marks webhook events that are valid but never trigger a scan
+// (pings, tag pushes, branch deletions, closed pull requests, ...)
+type errIgnored struct {
+	reason string
+}
+
+func (e *errIgnored) Error() string {
+	return e.reason
+}
+
+func ignored(format string, args ...interface{}) error {
+	return &errIgnored{reason: fmt.Sprintf(format, args...)}
+}
+
+// zeroSHA is sent as the "after" commit when a branch is deleted
+const zeroSHA = "0000000000000000000000000000000000000000"
+
+// provider parses and authenticates webhooks of a single Git provider
+type provider interface {
+	// repositoryURL extracts the repository URL used to look up the integration
+	repositoryURL(r *http.Request, body []byte) (string, error)
+
+	// verify authenticates the request with the integration's secret
+	verify(r *http.Request, body []byte, secret string) bool
+
+	// parse converts the payload into a push event
+	parse(r *http.Request, body []byte) (*pushEvent, error)
+}
+
+// verifyHMACSHA256 checks a "sha256=<hex>" signature header over the raw body
+func verifyHMACSHA256(header string, body []byte, secret string) bool {
+	signature, ok := strings.CutPrefix(header, "sha256=")
+	if !ok || secret == "" {
+		return false
+	}
+
+	expected, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
+
+// =============================================================================
+// GitHub
+// =============================================================================
+
+type githubProvider struct{}
+
+type githubRepository struct {
+	CloneURL string `json:"clone_url"`
+	HTMLURL  string `json:"html_url"`
+}
+
+type githubPushPayload struct {
+	Ref        string           `json:"ref"`
+	After      string           `json:"after"`
+	Deleted    bool             `json:"deleted"`
+	Repository githubRepository `json:"repository"`
+}
+
+type githubPullRequestPayload struct {
+	Action      string `json:"action"`
+	PullRequest struct {
+		Head struct {
+			Ref string `json:"ref"`
+			SHA string `json:"sha"`
+		} `json:"head"`
+	} `json:"pull_request"`
+	Repository githubRepository `json:"repository"`
+}
+
+func (githubProvider) repositoryURL(r *http.Request, body []byte) (string, error) {
+	var payload struct {
+		Repository githubRepository `json:"repository"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return "", fmt.Errorf("invalid GitHub payload: %w", err)
+	}
+	return firstNonEmpty(payload.Repository.CloneURL, payload.Repository.HTMLURL), nil
+}
+
+func (githubProvider) verify(r *http.Request, body []byte, secret string) bool {
+	return verifyHMACSHA256(r.Header.Get("X-Hub-Signature-256"), body, secret)
+}
+
+func (githubProvider) parse(r *http.Request, body []byte) (*pushEvent, error) {
+	switch event := r.Header.Get("X-GitHub-Event"); event {
+	case "push":
+		var payload githubPushPayload
+		if err := json.Unmarshal(body, &payload); err != nil {
+			return nil, fmt.Errorf("invalid GitHub push payload: %w", err)
+		}
+		branch, ok := strings.CutPrefix(payload.Ref, "refs/heads/")
+		if !ok {
+			return nil, ignored("push to non-branch ref %s", payload.Ref)
+		}
+		if payload.Deleted || payload.After == zeroSHA {
+			return nil, ignored("branch %s deleted", branch)
+		}
+		return &pushEvent{
+			Kind:          "push",
+			RepositoryURL: firstNonEmpty(payload.Repository.CloneURL, payload.Repository.HTMLURL),
+			Branch:        branch,
+			CommitSHA:     payload.After,
+		}, nil
+
+	case "pull_request":
+		var payload githubPullRequestPayload
+		if err := json.Unmarshal(body, &payload); err != nil {
+			return nil, fmt.Errorf("invalid GitHub pull_request payload: %w", err)
+		}
+		switch payload.Action {
+		case "opened", "synchronize", "reopened":
+		default:
+			return nil, ignored("pull request action %s", payload.Action)
+		}
+		return &pushEvent{
+			Kind:          "pull_request",
+			RepositoryURL: firstNonEmpty(payload.Repository.CloneURL, payload.Repository.HTMLURL),
+			Branch:        payload.PullRequest.Head.Ref,
+			CommitSHA:     payload.PullRequest.Head.SHA,
+		}, nil
+
+	default:
+		return nil, ignored("GitHub event %s", event)
+	}
+}
+
+// =============================================================================
+// GitLab
+// =============================================================================
+
+// gitlabProvider authenticates with the X-Gitlab-Token header: GitLab sends the
+// configured secret token verbatim instead of signing the payload.
+type gitlabProvider struct{}
+
+type gitlabProject struct {
+	GitHTTPURL string `json:"git_http_url"`
+	WebURL     string `json:"web_url"`
+}
+
+type gitlabPushPayload struct {
+	ObjectKind  string        `json:"object_kind"`
+	Ref         string        `json:"ref"`
+	After       string        `json:"after"`
+	CheckoutSHA string        `json:"checkout_sha"`
+	Project     gitlabProject `json:"project"`
+}
+
+type gitlabMergeRequestPayload struct {
+	ObjectKind       string        `json:"object_kind"`
+	Project          gitlabProject `json:"project"`
+	ObjectAttributes struct {
+		Action       string `json:"action"`
+		SourceBranch string `json:"source_branch"`
+		LastCommit   struct {
+			ID string `json:"id"`
+		} `json:"last_commit"`
+	} `json:"object_attributes"`
+}
+
+func (gitlabProvider) repositoryURL(r *http.Request, body []byte) (string, error) {
+	var payload struct {
+		Project gitlabProject `json:"project"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return "", fmt.Errorf("invalid GitLab payload: %w", err)
+	}
+	return firstNonEmpty(payload.Project.GitHTTPURL, payload.Project.WebURL), nil
+}
+
+func (gitlabProvider) verify(r *http.Request, body []byte, secret string) bool {
+	token := r.Header.Get("X-Gitlab-Token")
+	if token == "" || secret == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(token), []byte(secret)) == 1
+}
+
+func (gitlabProvider) parse(r *http.Request, body []byte) (*pushEvent, error) {
+	switch event := r.Header.Get("X-Gitlab-Event"); event {
+	case "Push Hook":
+		var payload gitlabPushPayload
+		if err := json.Unmarshal(body, &payload); err != nil {
+			return nil, fmt.Errorf("invalid GitLab push payload: %w", err)
+		}
+		branch, ok := strings.CutPrefix(payload.Ref, "refs/heads/")
+		if !ok {
+			return nil, ignored("push to non-branch ref %s", payload.Ref)
+		}
+		commit := firstNonEmpty(payload.CheckoutSHA, payload.After)
+		if commit == "" || commit == zeroSHA {
+			return nil, ignored("branch %s deleted", branch)
+		}
+		return &pushEvent{
+			Kind:          "push",
+			RepositoryURL: firstNonEmpty(payload.Project.GitHTTPURL, payload.Project.WebURL),
+			Branch:        branch,
+			CommitSHA:     commit,
+		}, nil
+
+	case "Merge Request Hook":
+		var payload gitlabMergeRequestPayload
+		if err := json.Unmarshal(body, &payload); err != nil {
+			return nil, fmt.Errorf("invalid GitLab merge request payload: %w", err)
+		}
+		switch payload.ObjectAttributes.Action {
+		case "open", "reopen", "update":
+		default:
+			return nil, ignored("merge request action %s", payload.ObjectAttributes.Action)
+		}
+		return &pushEvent{
+			Kind:          "pull_request",
+			RepositoryURL: firstNonEmpty(payload.Project.GitHTTPURL, payload.Project.WebURL),
+			Branch:        payload.ObjectAttributes.SourceBranch,
+			CommitSHA:     payload.ObjectAttributes.LastCommit.ID,
+		}, nil
+
+	default:
+		return nil, ignored("GitLab event %s", event)
+	}
+}
+
+// =============================================================================
+// Bitbucket Cloud
+// =============================================================================
+
+type bitbucketProvider struct{}
+
+type bitbucketRepository struct {
+	Links struct {
+		HTML struct {
+			Href string `json:"href"`
+		} `json:"html"`
+	} `json:"links"`
+}
+
+type bitbucketPushPayload struct {
+	Repository bitbucketRepository `json:"repository"`
+	Push       struct {
+		Changes []struct {
+			New *struct {
+				Type   string `json:"type"`
+				Name   string `json:"name"`
+				Target struct {
+					Hash string `json:"hash"`
+				} `json:"target"`
+			} `json:"new"`
+		} `json:"changes"`
+	} `json:"push"`
+}
+
+type bitbucketPullRequestPayload struct {
+	Repository  bitbucketRepository `json:"repository"`
+	PullRequest struct {
+		State  string `json:"state"`
+		Source struct {
+			Branch struct {
+				Name string `json:"name"`
+			} `json:"branch"`
+			Commit struct {
+				Hash string `json:"hash"`
+			} `json:"commit"`
+		} `json:"source"`
+	} `json:"pullrequest"`
+}
+
+func (bitbucketProvider) repositoryURL(r *http.Request, body []byte) (string, error) {
+	var payload struct {
+		Repository bitbucketRepository `json:"repository"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return "", fmt.Errorf("invalid Bitbucket payload: %w", err)
+	}
+	return payload.Repository.Links.HTML.Href, nil
+}
+
+func (bitbucketProvider) verify(r *http.Request, body []byte, secret string) bool {
+	return verifyHMACSHA256(r.Header.Get("X-Hub-Signature"), body, secret)
+}
+
+func (bitbucketProvider) parse(r *http.Request, body []byte) (*pushEvent, error) {
+	switch event := r.Header.Get("X-Event-Key"); event {
+	case "repo:push":
+		var payload bitbucketPushPayload
+		if err := json.Unmarshal(body, &payload); err != nil {
+			return nil, fmt.Errorf("invalid Bitbucket push payload: %w", err)
+		}
+		// A push may update several refs; scan the first updated branch
+		for _, change := range payload.Push.Changes {
+			if change.New == nil || change.New.Type != "branch" {
+				continue
+			}
+			return &pushEvent{
+				Kind:          "push",
+				RepositoryURL: payload.Repository.Links.HTML.Href,
+				Branch:        change.New.Name,
+				CommitSHA:     change.New.Target.Hash,
+			}, nil
+		}
+		return nil, ignored("push without updated branches")
+
+	case "pullrequest:created", "pullrequest:updated":
+		var payload bitbucketPullRequestPayload
+		if err := json.Unmarshal(body, &payload); err != nil {
+			return nil, fmt.Errorf("invalid Bitbucket pull request payload: %w", err)
+		}
+		if payload.PullRequest.State != "" && payload.PullRequest.State != "OPEN" {
+			return nil, ignored("pull request state %s", payload.PullRequest.State)
+		}
+		return &pushEvent{
+			Kind:          "pull_request",
+			RepositoryURL: payload.Repository.Links.HTML.Href,
+			Branch:        payload.PullRequest.Source.Branch.Name,
+			CommitSHA:     payload.PullRequest.Source.Commit.Hash,
+		}, nil
+
+	default:
+		return nil, ignored("Bitbucket event %s", event)
+	}
+}
+
+// providers maps each supported provider to its webhook implementation
+var providers = map[domain.GitProvider]provider{
+	domain.GitProviderGitHub:    githubProvider{},
+	domain.GitProviderGitLab:    gitlabProvider{},
+	domain.GitProviderBitbucket: bitbucketProvider{},
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,159 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	pb "github.com/cloud-scan/cloudscan-orchestrator/generated/proto"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/credentials"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CredentialServiceServer implements the gRPC CredentialService interface
+type CredentialServiceServer struct {
+	pb.UnimplementedCredentialServiceServer
+	credentialRepo interfaces.CredentialRepository
+	credentials    *credentials.Provider
+	logger         *log.Entry
+}
+
+// NewCredentialServiceServer creates a new credential service server
+func NewCredentialServiceServer(credentialRepo interfaces.CredentialRepository, credentials *credentials.Provider) *CredentialServiceServer {
+	return &CredentialServiceServer{
+		credentialRepo: credentialRepo,
+		credentials:    credentials,
+		logger:         log.WithField("component", "grpc-credential-service"),
+	}
+}
+
+// SetRepositoryCredential creates or replaces the credential of a project
+func (s *CredentialServiceServer) SetRepositoryCredential(ctx context.Context, req *pb.SetRepositoryCredentialRequest) (*pb.RepositoryCredential, error) {
+	logger := s.logger.WithFields(log.Fields{
+		"org_id":     req.OrganizationId,
+		"project_id": req.ProjectId,
+		"type":       req.Type,
+		"user_id":    req.UserId,
+	})
+	logger.Info("Setting repository credential")
+
+	orgID, err := uuid.Parse(req.OrganizationId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid organization_id: %v", err)
+	}
+
+	projectID, err := uuid.Parse(req.ProjectId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid project_id: %v", err)
+	}
+
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user_id: %v", err)
+	}
+
+	now := time.Now()
+	credential := &domain.RepositoryCredential{
+		OrganizationID:       orgID,
+		ProjectID:            projectID,
+		Type:                 domain.CredentialType(req.Type),
+		Username:             req.Username,
+		KnownHosts:           req.KnownHosts,
+		GitHubAppID:          req.GithubAppId,
+		GitHubInstallationID: req.GithubInstallationId,
+		UpdatedBy:            userID,
+		CreatedAt:            now,
+		UpdatedAt:            now,
+	}
+
+	err = s.credentials.Set(ctx, credential, []byte(req.Secret), auditSource(ctx))
+	if errors.Is(err, credentials.ErrUnavailable) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if errors.Is(err, credentials.ErrInvalidCredential) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		logger.WithError(err).Error("Failed to save repository credential")
+		return nil, status.Errorf(codes.Internal, "failed to set repository credential: %v", err)
+	}
+
+	logger.Info("Repository credential set successfully")
+	return convertCredentialToProto(credential), nil
+}
+
+// GetRepositoryCredential retrieves the credential of a project, without its secret
+func (s *CredentialServiceServer) GetRepositoryCredential(ctx context.Context, req *pb.GetRepositoryCredentialRequest) (*pb.RepositoryCredential, error) {
+	projectID, err := uuid.Parse(req.ProjectId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid project_id: %v", err)
+	}
+
+	credential, err := s.credentialRepo.Get(ctx, projectID)
+	if err != nil {
+		s.logger.WithError(err).WithField("project_id", req.ProjectId).Error("Failed to get repository credential")
+		return nil, status.Errorf(codes.NotFound, "repository credential not found: %v", err)
+	}
+
+	return convertCredentialToProto(credential), nil
+}
+
+// DeleteRepositoryCredential deletes the credential of a project
+func (s *CredentialServiceServer) DeleteRepositoryCredential(ctx context.Context, req *pb.DeleteRepositoryCredentialRequest) (*emptypb.Empty, error) {
+	projectID, err := uuid.Parse(req.ProjectId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid project_id: %v", err)
+	}
+
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user_id: %v", err)
+	}
+
+	err = s.credentialRepo.Delete(ctx, projectID, userID, auditSource(ctx))
+	if errors.Is(err, domain.ErrCredentialNotFound) {
+		return nil, status.Errorf(codes.NotFound, "%v", err)
+	}
+	if err != nil {
+		s.logger.WithError(err).WithField("project_id", req.ProjectId).Error("Failed to delete repository credential")
+		return nil, status.Errorf(codes.Internal, "failed to delete repository credential: %v", err)
+	}
+
+	s.logger.WithField("project_id", req.ProjectId).Info("Repository credential deleted successfully")
+	return &emptypb.Empty{}, nil
+}
+
+func convertCredentialToProto(credential *domain.RepositoryCredential) *pb.RepositoryCredential {
+	protoCredential := &pb.RepositoryCredential{
+		OrganizationId:       credential.OrganizationID.String(),
+		ProjectId:            credential.ProjectID.String(),
+		Type:                 string(credential.Type),
+		Username:             credential.Username,
+		KnownHosts:           credential.KnownHosts,
+		Fingerprint:          credential.Fingerprint,
+		GithubAppId:          credential.GitHubAppID,
+		GithubInstallationId: credential.GitHubInstallationID,
+		KeyId:                credential.Secret.KeyID,
+		CreatedAt:            timestamppb.New(credential.CreatedAt),
+		UpdatedAt:            timestamppb.New(credential.UpdatedAt),
+	}
+
+	if credential.UpdatedBy != uuid.Nil {
+		protoCredential.UpdatedBy = credential.UpdatedBy.String()
+	}
+
+	return protoCredential
+}
@@ -0,0 +1,211 @@
+package grpc
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	pb "github.com/cloud-scan/cloudscan-orchestrator/generated/proto"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// IntegrationServiceServer implements the gRPC IntegrationService interface
+type IntegrationServiceServer struct {
+	pb.UnimplementedIntegrationServiceServer
+	integrationRepo interfaces.IntegrationRepository
+	logger          *log.Entry
+}
+
+// NewIntegrationServiceServer creates a new integration service server
+func NewIntegrationServiceServer(integrationRepo interfaces.IntegrationRepository) *IntegrationServiceServer {
+	return &IntegrationServiceServer{
+		integrationRepo: integrationRepo,
+		logger:          log.WithField("component", "grpc-integration-service"),
+	}
+}
+
+// CreateRepositoryIntegration registers a repository so its webhooks create scans.
+// The webhook secret is only returned here; it must be configured on the provider.
+func (s *IntegrationServiceServer) CreateRepositoryIntegration(ctx context.Context, req *pb.CreateRepositoryIntegrationRequest) (*pb.RepositoryIntegration, error) {
+	logger := s.logger.WithFields(log.Fields{
+		"org_id":     req.OrganizationId,
+		"project_id": req.ProjectId,
+		"repository": req.RepositoryUrl,
+	})
+	logger.Info("Creating repository integration")
+
+	if req.OrganizationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
+	}
+	if req.ProjectId == "" {
+		return nil, status.Error(codes.InvalidArgument, "project_id is required")
+	}
+	if req.RepositoryUrl == "" {
+		return nil, status.Error(codes.InvalidArgument, "repository_url is required")
+	}
+	if len(req.ScanTypes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one scan_type is required")
+	}
+
+	provider := convertGitProviderFromProto(req.Provider)
+	if provider == "" {
+		return nil, status.Error(codes.InvalidArgument, "provider is required")
+	}
+
+	orgID, err := uuid.Parse(req.OrganizationId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid organization_id: %v", err)
+	}
+	projectID, err := uuid.Parse(req.ProjectId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid project_id: %v", err)
+	}
+
+	secret := req.WebhookSecret
+	if secret == "" {
+		secret, err = generateWebhookSecret()
+		if err != nil {
+			logger.WithError(err).Error("Failed to generate webhook secret")
+			return nil, status.Errorf(codes.Internal, "failed to generate webhook secret: %v", err)
+		}
+	}
+
+	now := time.Now()
+	integration := &domain.RepositoryIntegration{
+		ID:             uuid.New(),
+		OrganizationID: orgID,
+		ProjectID:      projectID,
+		Provider:       provider,
+		RepositoryURL:  domain.NormalizeRepositoryURL(req.RepositoryUrl),
+		WebhookSecret:  secret,
+		ScanTypes:      convertScanTypesFromProto(req.ScanTypes),
+		Enabled:        true,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+
+	if err := s.integrationRepo.Create(ctx, integration); err != nil {
+		logger.WithError(err).Error("Failed to save repository integration to database")
+		return nil, status.Errorf(codes.Internal, "failed to create repository integration: %v", err)
+	}
+
+	logger.WithField("integration_id", integration.ID.String()).Info("Repository integration created successfully")
+
+	protoIntegration := convertIntegrationToProto(integration)
+	protoIntegration.WebhookSecret = integration.WebhookSecret
+	return protoIntegration, nil
+}
+
+// ListRepositoryIntegrations lists integrations of an organization or project
+func (s *IntegrationServiceServer) ListRepositoryIntegrations(ctx context.Context, req *pb.ListRepositoryIntegrationsRequest) (*pb.ListRepositoryIntegrationsResponse, error) {
+	orgID, err := uuid.Parse(req.OrganizationId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid organization_id: %v", err)
+	}
+
+	var projectID *uuid.UUID
+	if req.ProjectId != "" {
+		id, err := uuid.Parse(req.ProjectId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid project_id: %v", err)
+		}
+		projectID = &id
+	}
+
+	integrations, err := s.integrationRepo.List(ctx, orgID, projectID)
+	if err != nil {
+		s.logger.WithError(err).Error("Failed to list repository integrations")
+		return nil, status.Errorf(codes.Internal, "failed to list repository integrations: %v", err)
+	}
+
+	protoIntegrations := make([]*pb.RepositoryIntegration, len(integrations))
+	for i, integration := range integrations {
+		protoIntegrations[i] = convertIntegrationToProto(integration)
+	}
+
+	return &pb.ListRepositoryIntegrationsResponse{
+		Integrations: protoIntegrations,
+	}, nil
+}
+
+// DeleteRepositoryIntegration removes an integration; its webhooks are rejected afterwards
+func (s *IntegrationServiceServer) DeleteRepositoryIntegration(ctx context.Context, req *pb.DeleteRepositoryIntegrationRequest) (*emptypb.Empty, error) {
+	logger := s.logger.WithField("integration_id", req.Id)
+	logger.Info("Deleting repository integration")
+
+	integrationID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid integration_id: %v", err)
+	}
+
+	if err := s.integrationRepo.Delete(ctx, integrationID); err != nil {
+		logger.WithError(err).Error("Failed to delete repository integration")
+		return nil, status.Errorf(codes.NotFound, "repository integration not found: %v", err)
+	}
+
+	logger.Info("Repository integration deleted successfully")
+	return &emptypb.Empty{}, nil
+}
+
+// generateWebhookSecret returns a random 256-bit hex-encoded secret
+func generateWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func convertIntegrationToProto(integration *domain.RepositoryIntegration) *pb.RepositoryIntegration {
+	protoIntegration := &pb.RepositoryIntegration{
+		Id:             integration.ID.String(),
+		OrganizationId: integration.OrganizationID.String(),
+		ProjectId:      integration.ProjectID.String(),
+		Provider:       convertGitProviderToProto(integration.Provider),
+		RepositoryUrl:  integration.RepositoryURL,
+		Enabled:        integration.Enabled,
+		CreatedAt:      timestamppb.New(integration.CreatedAt),
+		UpdatedAt:      timestamppb.New(integration.UpdatedAt),
+	}
+
+	protoIntegration.ScanTypes = make([]pb.ScanType, len(integration.ScanTypes))
+	for i, st := range integration.ScanTypes {
+		protoIntegration.ScanTypes[i] = ConvertScanTypeToProto(st)
+	}
+
+	return protoIntegration
+}
+
+func convertGitProviderToProto(provider domain.GitProvider) pb.GitProvider {
+	switch provider {
+	case domain.GitProviderGitHub:
+		return pb.GitProvider_GITHUB
+	case domain.GitProviderGitLab:
+		return pb.GitProvider_GITLAB
+	case domain.GitProviderBitbucket:
+		return pb.GitProvider_BITBUCKET
+	default:
+		return pb.GitProvider_GIT_PROVIDER_UNSPECIFIED
+	}
+}
+
+func convertGitProviderFromProto(provider pb.GitProvider) domain.GitProvider {
+	switch provider {
+	case pb.GitProvider_GITHUB:
+		return domain.GitProviderGitHub
+	case pb.GitProvider_GITLAB:
+		return domain.GitProviderGitLab
+	case pb.GitProvider_BITBUCKET:
+		return domain.GitProviderBitbucket
+	default:
+		return ""
+	}
+}
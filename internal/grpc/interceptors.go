@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/metrics"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -71,3 +72,34 @@ func errorHandlingInterceptor() grpc.UnaryServerInterceptor {
 		return handler(ctx, req)
 	}
 }
+
+// metricsInterceptor records request counts and latency by method and status code
+func metricsInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		metrics.ObserveGRPCRequest(info.FullMethod, start, err)
+		return resp, err
+	}
+}
+
+// metricsStreamInterceptor records streaming calls like metricsInterceptor;
+// the latency covers the whole stream
+func metricsStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		metrics.ObserveGRPCRequest(info.FullMethod, start, err)
+		return err
+	}
+}
@@ -0,0 +1,286 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	pb "github.com/cloud-scan/cloudscan-orchestrator/generated/proto"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/jobprofiles"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// JobProfileServiceServer implements the gRPC JobProfileService interface
+type JobProfileServiceServer struct {
+	pb.UnimplementedJobProfileServiceServer
+	jobProfileRepo interfaces.JobProfileRepository
+	jobProfiles    *jobprofiles.Resolver
+	logger         *log.Entry
+}
+
+// NewJobProfileServiceServer creates a new job profile service server
+func NewJobProfileServiceServer(jobProfileRepo interfaces.JobProfileRepository, jobProfiles *jobprofiles.Resolver) *JobProfileServiceServer {
+	return &JobProfileServiceServer{
+		jobProfileRepo: jobProfileRepo,
+		jobProfiles:    jobProfiles,
+		logger:         log.WithField("component", "grpc-job-profile-service"),
+	}
+}
+
+// SetJobProfile creates or replaces the job profile of a project
+func (s *JobProfileServiceServer) SetJobProfile(ctx context.Context, req *pb.SetJobProfileRequest) (*pb.JobProfile, error) {
+	logger := s.logger.WithFields(log.Fields{
+		"org_id":     req.OrganizationId,
+		"project_id": req.ProjectId,
+	})
+	logger.Info("Setting job profile")
+
+	orgID, err := uuid.Parse(req.OrganizationId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid organization_id: %v", err)
+	}
+
+	projectID, err := uuid.Parse(req.ProjectId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid project_id: %v", err)
+	}
+
+	var userID uuid.UUID
+	if req.UserId != "" {
+		userID, err = uuid.Parse(req.UserId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid user_id: %v", err)
+		}
+	}
+
+	now := time.Now()
+	profile := &domain.JobProfile{
+		OrganizationID: orgID,
+		ProjectID:      projectID,
+		UpdatedBy:      userID,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	if req.Settings != nil {
+		profile.Settings = *convertJobSettingsFromProto(req.Settings)
+	}
+
+	err = s.jobProfiles.Check(ctx, orgID, &profile.Settings)
+	if errors.Is(err, jobprofiles.ErrInvalidSettings) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		logger.WithError(err).Error("Failed to check job profile")
+		return nil, status.Errorf(codes.Internal, "failed to check job profile: %v", err)
+	}
+
+	err = s.jobProfileRepo.SetProfile(ctx, profile)
+	if errors.Is(err, domain.ErrProjectNotFound) {
+		return nil, status.Errorf(codes.NotFound, "%v", err)
+	}
+	if err != nil {
+		logger.WithError(err).Error("Failed to save job profile")
+		return nil, status.Errorf(codes.Internal, "failed to set job profile: %v", err)
+	}
+
+	logger.Info("Job profile set successfully")
+	return convertJobProfileToProto(profile), nil
+}
+
+// GetJobProfile retrieves the job profile of a project
+func (s *JobProfileServiceServer) GetJobProfile(ctx context.Context, req *pb.GetJobProfileRequest) (*pb.JobProfile, error) {
+	projectID, err := uuid.Parse(req.ProjectId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid project_id: %v", err)
+	}
+
+	profile, err := s.jobProfileRepo.GetProfile(ctx, projectID)
+	if errors.Is(err, domain.ErrJobProfileNotFound) {
+		return nil, status.Errorf(codes.NotFound, "%v", err)
+	}
+	if err != nil {
+		s.logger.WithError(err).WithField("project_id", req.ProjectId).Error("Failed to get job profile")
+		return nil, status.Errorf(codes.Internal, "failed to get job profile: %v", err)
+	}
+
+	return convertJobProfileToProto(profile), nil
+}
+
+// DeleteJobProfile deletes the job profile of a project
+func (s *JobProfileServiceServer) DeleteJobProfile(ctx context.Context, req *pb.DeleteJobProfileRequest) (*emptypb.Empty, error) {
+	projectID, err := uuid.Parse(req.ProjectId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid project_id: %v", err)
+	}
+
+	err = s.jobProfileRepo.DeleteProfile(ctx, projectID)
+	if errors.Is(err, domain.ErrJobProfileNotFound) {
+		return nil, status.Errorf(codes.NotFound, "%v", err)
+	}
+	if err != nil {
+		s.logger.WithError(err).WithField("project_id", req.ProjectId).Error("Failed to delete job profile")
+		return nil, status.Errorf(codes.Internal, "failed to delete job profile: %v", err)
+	}
+
+	s.logger.WithField("project_id", req.ProjectId).Info("Job profile deleted successfully")
+	return &emptypb.Empty{}, nil
+}
+
+// SetJobLimits creates or replaces the job limits of an organization
+func (s *JobProfileServiceServer) SetJobLimits(ctx context.Context, req *pb.SetJobLimitsRequest) (*pb.JobLimits, error) {
+	logger := s.logger.WithField("org_id", req.OrganizationId)
+	logger.Info("Setting job limits")
+
+	orgID, err := uuid.Parse(req.OrganizationId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid organization_id: %v", err)
+	}
+
+	var userID uuid.UUID
+	if req.UserId != "" {
+		userID, err = uuid.Parse(req.UserId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid user_id: %v", err)
+		}
+	}
+
+	now := time.Now()
+	limits := &domain.JobLimits{
+		OrganizationID:         orgID,
+		MaxCPU:                 req.MaxCpu,
+		MaxMemory:              req.MaxMemory,
+		MaxEphemeralStorage:    req.MaxEphemeralStorage,
+		MaxDeadlineSeconds:     req.MaxDeadlineSeconds,
+		MaxBackoffLimit:        req.MaxBackoffLimit,
+		AllowedPriorityClasses: req.AllowedPriorityClasses,
+		UpdatedBy:              userID,
+		CreatedAt:              now,
+		UpdatedAt:              now,
+	}
+
+	if err := limits.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.jobProfileRepo.SetLimits(ctx, limits); err != nil {
+		logger.WithError(err).Error("Failed to save job limits")
+		return nil, status.Errorf(codes.Internal, "failed to set job limits: %v", err)
+	}
+
+	logger.Info("Job limits set successfully")
+	return convertJobLimitsToProto(limits), nil
+}
+
+// GetJobLimits retrieves the job limits of an organization, or the default
+// limits if it has none
+func (s *JobProfileServiceServer) GetJobLimits(ctx context.Context, req *pb.GetJobLimitsRequest) (*pb.JobLimits, error) {
+	orgID, err := uuid.Parse(req.OrganizationId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid organization_id: %v", err)
+	}
+
+	limits, err := s.jobProfiles.Limits(ctx, orgID)
+	if err != nil {
+		s.logger.WithError(err).WithField("org_id", req.OrganizationId).Error("Failed to get job limits")
+		return nil, status.Errorf(codes.Internal, "failed to get job limits: %v", err)
+	}
+
+	return convertJobLimitsToProto(limits), nil
+}
+
+func convertJobProfileToProto(profile *domain.JobProfile) *pb.JobProfile {
+	protoProfile := &pb.JobProfile{
+		OrganizationId: profile.OrganizationID.String(),
+		ProjectId:      profile.ProjectID.String(),
+		Settings:       convertJobSettingsToProto(&profile.Settings),
+		CreatedAt:      timestamppb.New(profile.CreatedAt),
+		UpdatedAt:      timestamppb.New(profile.UpdatedAt),
+	}
+
+	if profile.UpdatedBy != uuid.Nil {
+		protoProfile.UpdatedBy = profile.UpdatedBy.String()
+	}
+
+	return protoProfile
+}
+
+func convertJobLimitsToProto(limits *domain.JobLimits) *pb.JobLimits {
+	protoLimits := &pb.JobLimits{
+		OrganizationId:         limits.OrganizationID.String(),
+		MaxCpu:                 limits.MaxCPU,
+		MaxMemory:              limits.MaxMemory,
+		MaxEphemeralStorage:    limits.MaxEphemeralStorage,
+		MaxDeadlineSeconds:     limits.MaxDeadlineSeconds,
+		MaxBackoffLimit:        limits.MaxBackoffLimit,
+		AllowedPriorityClasses: limits.AllowedPriorityClasses,
+		IsDefault:              limits.CreatedAt.IsZero(),
+	}
+
+	if limits.UpdatedBy != uuid.Nil {
+		protoLimits.UpdatedBy = limits.UpdatedBy.String()
+	}
+	if !limits.UpdatedAt.IsZero() {
+		protoLimits.UpdatedAt = timestamppb.New(limits.UpdatedAt)
+	}
+
+	return protoLimits
+}
+
+func convertJobSettingsToProto(settings *domain.JobSettings) *pb.JobSettings {
+	protoSettings := &pb.JobSettings{
+		RequestsCpu:           settings.RequestsCPU,
+		RequestsMemory:        settings.RequestsMemory,
+		LimitsCpu:             settings.LimitsCPU,
+		LimitsMemory:          settings.LimitsMemory,
+		EphemeralStorage:      settings.EphemeralStorage,
+		ActiveDeadlineSeconds: settings.ActiveDeadlineSeconds,
+		BackoffLimit:          settings.BackoffLimit,
+		NodeSelector:          settings.NodeSelector,
+		PriorityClassName:     settings.PriorityClassName,
+	}
+
+	for _, t := range settings.Tolerations {
+		protoSettings.Tolerations = append(protoSettings.Tolerations, &pb.Toleration{
+			Key:               t.Key,
+			Operator:          t.Operator,
+			Value:             t.Value,
+			Effect:            t.Effect,
+			TolerationSeconds: t.TolerationSeconds,
+		})
+	}
+
+	return protoSettings
+}
+
+func convertJobSettingsFromProto(protoSettings *pb.JobSettings) *domain.JobSettings {
+	settings := &domain.JobSettings{
+		RequestsCPU:           protoSettings.RequestsCpu,
+		RequestsMemory:        protoSettings.RequestsMemory,
+		LimitsCPU:             protoSettings.LimitsCpu,
+		LimitsMemory:          protoSettings.LimitsMemory,
+		EphemeralStorage:      protoSettings.EphemeralStorage,
+		ActiveDeadlineSeconds: protoSettings.ActiveDeadlineSeconds,
+		BackoffLimit:          protoSettings.BackoffLimit,
+		NodeSelector:          protoSettings.NodeSelector,
+		PriorityClassName:     protoSettings.PriorityClassName,
+	}
+
+	for _, t := range protoSettings.Tolerations {
+		settings.Tolerations = append(settings.Tolerations, domain.Toleration{
+			Key:               t.Key,
+			Operator:          t.Operator,
+			Value:             t.Value,
+			Effect:            t.Effect,
+			TolerationSeconds: t.TolerationSeconds,
+		})
+	}
+
+	return settings
+}
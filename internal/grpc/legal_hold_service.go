@@ -0,0 +1,270 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"time"
+
+	pb "github.com/cloud-scan/cloudscan-orchestrator/generated/proto"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// LegalHoldServiceServer implements the gRPC LegalHoldService interface
+type LegalHoldServiceServer struct {
+	pb.UnimplementedLegalHoldServiceServer
+	legalHoldRepo interfaces.LegalHoldRepository
+	logger        *log.Entry
+}
+
+// NewLegalHoldServiceServer creates a new legal hold service server
+func NewLegalHoldServiceServer(legalHoldRepo interfaces.LegalHoldRepository) *LegalHoldServiceServer {
+	return &LegalHoldServiceServer{
+		legalHoldRepo: legalHoldRepo,
+		logger:        log.WithField("component", "grpc-legal-hold-service"),
+	}
+}
+
+// PlaceLegalHold places a hold on an organization, project or scan
+func (s *LegalHoldServiceServer) PlaceLegalHold(ctx context.Context, req *pb.PlaceLegalHoldRequest) (*pb.LegalHold, error) {
+	logger := s.logger.WithFields(log.Fields{
+		"org_id":     req.OrganizationId,
+		"project_id": req.ProjectId,
+		"scan_id":    req.ScanId,
+		"user_id":    req.UserId,
+	})
+	logger.Info("Placing legal hold")
+
+	if req.OrganizationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
+	}
+	if strings.TrimSpace(req.Reason) == "" {
+		return nil, status.Error(codes.InvalidArgument, "reason is required")
+	}
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	orgID, err := uuid.Parse(req.OrganizationId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid organization_id: %v", err)
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user_id: %v", err)
+	}
+
+	hold := &domain.LegalHold{
+		ID:             uuid.New(),
+		OrganizationID: orgID,
+		Reason:         req.Reason,
+		RequestedBy:    userID,
+		PlacedAt:       time.Now(),
+	}
+
+	if req.ProjectId != "" {
+		projectID, err := uuid.Parse(req.ProjectId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid project_id: %v", err)
+		}
+		hold.ProjectID = &projectID
+	}
+
+	if req.ScanId != "" {
+		scanID, err := uuid.Parse(req.ScanId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid scan_id: %v", err)
+		}
+		hold.ScanID = &scanID
+	}
+
+	err = s.legalHoldRepo.Place(ctx, hold, auditSource(ctx))
+	if errors.Is(err, domain.ErrScanNotFound) {
+		return nil, status.Errorf(codes.NotFound, "scan not found: %v", err)
+	}
+	if errors.Is(err, domain.ErrProjectNotFound) || errors.Is(err, domain.ErrOrganizationNotFound) {
+		return nil, status.Errorf(codes.NotFound, "%v", err)
+	}
+	if err != nil {
+		logger.WithError(err).Error("Failed to place legal hold")
+		return nil, status.Errorf(codes.Internal, "failed to place legal hold: %v", err)
+	}
+
+	logger.WithFields(log.Fields{
+		"hold_id": hold.ID.String(),
+		"scope":   hold.Scope(),
+	}).Info("Legal hold placed successfully")
+	return convertLegalHoldToProto(hold), nil
+}
+
+// ReleaseLegalHold releases an active hold
+func (s *LegalHoldServiceServer) ReleaseLegalHold(ctx context.Context, req *pb.ReleaseLegalHoldRequest) (*pb.LegalHold, error) {
+	logger := s.logger.WithFields(log.Fields{
+		"hold_id": req.Id,
+		"user_id": req.UserId,
+	})
+	logger.Info("Releasing legal hold")
+
+	holdID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid hold_id: %v", err)
+	}
+
+	if strings.TrimSpace(req.Reason) == "" {
+		return nil, status.Error(codes.InvalidArgument, "reason is required")
+	}
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user_id: %v", err)
+	}
+
+	hold, err := s.legalHoldRepo.Release(ctx, holdID, userID, req.Reason, auditSource(ctx))
+	if errors.Is(err, domain.ErrLegalHoldNotFound) {
+		return nil, status.Errorf(codes.NotFound, "%v", err)
+	}
+	if errors.Is(err, domain.ErrLegalHoldReleased) {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+	if err != nil {
+		logger.WithError(err).Error("Failed to release legal hold")
+		return nil, status.Errorf(codes.Internal, "failed to release legal hold: %v", err)
+	}
+
+	logger.Info("Legal hold released successfully")
+	return convertLegalHoldToProto(hold), nil
+}
+
+// GetLegalHold retrieves a hold by ID
+func (s *LegalHoldServiceServer) GetLegalHold(ctx context.Context, req *pb.GetLegalHoldRequest) (*pb.LegalHold, error) {
+	holdID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid hold_id: %v", err)
+	}
+
+	hold, err := s.legalHoldRepo.Get(ctx, holdID)
+	if err != nil {
+		s.logger.WithError(err).WithField("hold_id", req.Id).Error("Failed to get legal hold")
+		return nil, status.Errorf(codes.NotFound, "legal hold not found: %v", err)
+	}
+
+	return convertLegalHoldToProto(hold), nil
+}
+
+// ListLegalHolds lists holds, only active ones unless include_released is set
+func (s *LegalHoldServiceServer) ListLegalHolds(ctx context.Context, req *pb.ListLegalHoldsRequest) (*pb.ListLegalHoldsResponse, error) {
+	filter := interfaces.LegalHoldFilter{
+		ActiveOnly: !req.IncludeReleased,
+		Limit:      int(req.PageSize),
+	}
+
+	if req.OrganizationId != "" {
+		orgID, err := uuid.Parse(req.OrganizationId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid organization_id: %v", err)
+		}
+		filter.OrganizationID = &orgID
+	}
+
+	if req.ProjectId != "" {
+		projectID, err := uuid.Parse(req.ProjectId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid project_id: %v", err)
+		}
+		filter.ProjectID = &projectID
+	}
+
+	if req.ScanId != "" {
+		scanID, err := uuid.Parse(req.ScanId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid scan_id: %v", err)
+		}
+		filter.ScanID = &scanID
+	}
+
+	holds, err := s.legalHoldRepo.List(ctx, filter)
+	if err != nil {
+		s.logger.WithError(err).Error("Failed to list legal holds")
+		return nil, status.Errorf(codes.Internal, "failed to list legal holds: %v", err)
+	}
+
+	protoHolds := make([]*pb.LegalHold, len(holds))
+	for i, hold := range holds {
+		protoHolds[i] = convertLegalHoldToProto(hold)
+	}
+
+	return &pb.ListLegalHoldsResponse{
+		Holds:      protoHolds,
+		TotalCount: int32(len(protoHolds)),
+	}, nil
+}
+
+// auditSource returns the caller's address and user agent for the audit log.
+// The address forwarded by the API gateway takes precedence over the peer's.
+func auditSource(ctx context.Context) domain.AuditSource {
+	source := domain.AuditSource{}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get("x-forwarded-for"); len(values) > 0 {
+		first := strings.TrimSpace(strings.Split(values[0], ",")[0])
+		if net.ParseIP(first) != nil {
+			source.IPAddress = first
+		}
+	}
+	if source.IPAddress == "" {
+		if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+			if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil && net.ParseIP(host) != nil {
+				source.IPAddress = host
+			}
+		}
+	}
+
+	if values := md.Get("user-agent"); len(values) > 0 {
+		source.UserAgent = values[0]
+	}
+
+	return source
+}
+
+func convertLegalHoldToProto(hold *domain.LegalHold) *pb.LegalHold {
+	protoHold := &pb.LegalHold{
+		Id:             hold.ID.String(),
+		OrganizationId: hold.OrganizationID.String(),
+		Scope:          string(hold.Scope()),
+		Reason:         hold.Reason,
+		RequestedBy:    hold.RequestedBy.String(),
+		PlacedAt:       timestamppb.New(hold.PlacedAt),
+		Active:         hold.IsActive(),
+	}
+
+	if hold.ProjectID != nil {
+		protoHold.ProjectId = hold.ProjectID.String()
+	}
+	if hold.ScanID != nil {
+		protoHold.ScanId = hold.ScanID.String()
+	}
+	if hold.ReleasedAt != nil {
+		protoHold.ReleasedAt = timestamppb.New(*hold.ReleasedAt)
+	}
+	if hold.ReleasedBy != nil {
+		protoHold.ReleasedBy = hold.ReleasedBy.String()
+	}
+	if hold.ReleaseReason != nil {
+		protoHold.ReleaseReason = *hold.ReleaseReason
+	}
+
+	return protoHold
+}
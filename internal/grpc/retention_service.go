@@ -0,0 +1,253 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/cloud-scan/cloudscan-orchestrator/generated/proto"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Page sizes of PreviewRetention
+const (
+	defaultPreviewPageSize = 100
+	maxPreviewPageSize     = 1000
+)
+
+// RetentionServiceServer implements the gRPC RetentionService interface
+type RetentionServiceServer struct {
+	pb.UnimplementedRetentionServiceServer
+	retentionRepo interfaces.RetentionRepository
+	defaultPolicy domain.RetentionPolicy
+	logger        *log.Entry
+}
+
+// NewRetentionServiceServer creates a new retention service server. The
+// default policy applies to scans without an organization or project policy.
+func NewRetentionServiceServer(retentionRepo interfaces.RetentionRepository, defaultPolicy domain.RetentionPolicy) *RetentionServiceServer {
+	return &RetentionServiceServer{
+		retentionRepo: retentionRepo,
+		defaultPolicy: defaultPolicy,
+		logger:        log.WithField("component", "grpc-retention-service"),
+	}
+}
+
+// SetRetentionPolicy creates or replaces the policy of an organization or project
+func (s *RetentionServiceServer) SetRetentionPolicy(ctx context.Context, req *pb.SetRetentionPolicyRequest) (*pb.RetentionPolicy, error) {
+	logger := s.logger.WithFields(log.Fields{
+		"org_id":     req.OrganizationId,
+		"project_id": req.ProjectId,
+	})
+	logger.Info("Setting retention policy")
+
+	if req.OrganizationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
+	}
+
+	orgID, err := uuid.Parse(req.OrganizationId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid organization_id: %v", err)
+	}
+
+	var projectID *uuid.UUID
+	if req.ProjectId != "" {
+		id, err := uuid.Parse(req.ProjectId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid project_id: %v", err)
+		}
+		projectID = &id
+	}
+
+	var userID uuid.UUID
+	if req.UserId != "" {
+		userID, err = uuid.Parse(req.UserId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid user_id: %v", err)
+		}
+	}
+
+	now := time.Now()
+	policy := &domain.RetentionPolicy{
+		ID:                      uuid.New(),
+		OrganizationID:          orgID,
+		ProjectID:               projectID,
+		KeepDays:                int(req.KeepDays),
+		KeepLastPerBranch:       int(req.KeepLastPerBranch),
+		KeepLatestDefaultBranch: req.KeepLatestDefaultBranch,
+		DefaultBranch:           req.DefaultBranch,
+		CreatedBy:               userID,
+		CreatedAt:               now,
+		UpdatedAt:               now,
+	}
+
+	if err := policy.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.retentionRepo.Set(ctx, policy); err != nil {
+		logger.WithError(err).Error("Failed to save retention policy")
+		return nil, status.Errorf(codes.Internal, "failed to set retention policy: %v", err)
+	}
+
+	logger.WithField("policy_id", policy.ID.String()).Info("Retention policy set successfully")
+	return convertRetentionPolicyToProto(policy), nil
+}
+
+// GetRetentionPolicy retrieves a policy by ID
+func (s *RetentionServiceServer) GetRetentionPolicy(ctx context.Context, req *pb.GetRetentionPolicyRequest) (*pb.RetentionPolicy, error) {
+	policyID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid policy_id: %v", err)
+	}
+
+	policy, err := s.retentionRepo.Get(ctx, policyID)
+	if err != nil {
+		s.logger.WithError(err).WithField("policy_id", req.Id).Error("Failed to get retention policy")
+		return nil, status.Errorf(codes.NotFound, "retention policy not found: %v", err)
+	}
+
+	return convertRetentionPolicyToProto(policy), nil
+}
+
+// ListRetentionPolicies lists policies of an organization or project
+func (s *RetentionServiceServer) ListRetentionPolicies(ctx context.Context, req *pb.ListRetentionPoliciesRequest) (*pb.ListRetentionPoliciesResponse, error) {
+	filter := interfaces.RetentionPolicyFilter{
+		Limit: int(req.PageSize),
+	}
+
+	if req.OrganizationId != "" {
+		orgID, err := uuid.Parse(req.OrganizationId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid organization_id: %v", err)
+		}
+		filter.OrganizationID = &orgID
+	}
+
+	if req.ProjectId != "" {
+		projectID, err := uuid.Parse(req.ProjectId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid project_id: %v", err)
+		}
+		filter.ProjectID = &projectID
+	}
+
+	policies, err := s.retentionRepo.List(ctx, filter)
+	if err != nil {
+		s.logger.WithError(err).Error("Failed to list retention policies")
+		return nil, status.Errorf(codes.Internal, "failed to list retention policies: %v", err)
+	}
+
+	protoPolicies := make([]*pb.RetentionPolicy, len(policies))
+	for i, policy := range policies {
+		protoPolicies[i] = convertRetentionPolicyToProto(policy)
+	}
+
+	return &pb.ListRetentionPoliciesResponse{
+		Policies:   protoPolicies,
+		TotalCount: int32(len(protoPolicies)),
+	}, nil
+}
+
+// DeleteRetentionPolicy deletes a policy; its scans fall back to the next broader policy
+func (s *RetentionServiceServer) DeleteRetentionPolicy(ctx context.Context, req *pb.DeleteRetentionPolicyRequest) (*emptypb.Empty, error) {
+	logger := s.logger.WithField("policy_id", req.Id)
+	logger.Info("Deleting retention policy")
+
+	policyID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid policy_id: %v", err)
+	}
+
+	if err := s.retentionRepo.Delete(ctx, policyID); err != nil {
+		logger.WithError(err).Error("Failed to delete retention policy")
+		return nil, status.Errorf(codes.NotFound, "retention policy not found: %v", err)
+	}
+
+	logger.Info("Retention policy deleted successfully")
+	return &emptypb.Empty{}, nil
+}
+
+// PreviewRetention lists the scans of an organization or project that the
+// next cleanup would delete, without deleting anything
+func (s *RetentionServiceServer) PreviewRetention(ctx context.Context, req *pb.PreviewRetentionRequest) (*pb.PreviewRetentionResponse, error) {
+	if req.OrganizationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
+	}
+
+	orgID, err := uuid.Parse(req.OrganizationId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid organization_id: %v", err)
+	}
+
+	query := interfaces.ExpiredScansQuery{
+		OrganizationID: &orgID,
+		Default:        s.defaultPolicy,
+		Now:            time.Now(),
+	}
+
+	if req.ProjectId != "" {
+		projectID, err := uuid.Parse(req.ProjectId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid project_id: %v", err)
+		}
+		query.ProjectID = &projectID
+	}
+
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = defaultPreviewPageSize
+	}
+	if pageSize > maxPreviewPageSize {
+		pageSize = maxPreviewPageSize
+	}
+
+	// Ask for one more scan to tell whether the list is complete
+	query.Limit = pageSize + 1
+	scans, err := s.retentionRepo.ListExpiredScans(ctx, query)
+	if err != nil {
+		s.logger.WithError(err).Error("Failed to list expired scans")
+		return nil, status.Errorf(codes.Internal, "failed to preview retention: %v", err)
+	}
+
+	truncated := len(scans) > pageSize
+	if truncated {
+		scans = scans[:pageSize]
+	}
+
+	protoScans := make([]*pb.Scan, len(scans))
+	for i, scan := range scans {
+		protoScans[i] = convertScanToProto(scan)
+	}
+
+	return &pb.PreviewRetentionResponse{
+		Scans:      protoScans,
+		TotalCount: int32(len(protoScans)),
+		Truncated:  truncated,
+	}, nil
+}
+
+func convertRetentionPolicyToProto(policy *domain.RetentionPolicy) *pb.RetentionPolicy {
+	protoPolicy := &pb.RetentionPolicy{
+		Id:                      policy.ID.String(),
+		OrganizationId:          policy.OrganizationID.String(),
+		KeepDays:                int32(policy.KeepDays),
+		KeepLastPerBranch:       int32(policy.KeepLastPerBranch),
+		KeepLatestDefaultBranch: policy.KeepLatestDefaultBranch,
+		DefaultBranch:           policy.DefaultBranch,
+		CreatedAt:               timestamppb.New(policy.CreatedAt),
+		UpdatedAt:               timestamppb.New(policy.UpdatedAt),
+	}
+
+	if policy.ProjectID != nil {
+		protoPolicy.ProjectId = policy.ProjectID.String()
+	}
+
+	return protoPolicy
+}
@@ -0,0 +1,288 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/cloud-scan/cloudscan-orchestrator/generated/proto"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ScheduleServiceServer implements the gRPC ScheduleService interface
+type ScheduleServiceServer struct {
+	pb.UnimplementedScheduleServiceServer
+	scheduleRepo interfaces.ScheduleRepository
+	logger       *log.Entry
+}
+
+// NewScheduleServiceServer creates a new schedule service server
+func NewScheduleServiceServer(scheduleRepo interfaces.ScheduleRepository) *ScheduleServiceServer {
+	return &ScheduleServiceServer{
+		scheduleRepo: scheduleRepo,
+		logger:       log.WithField("component", "grpc-schedule-service"),
+	}
+}
+
+// CreateSchedule creates a new recurring scan schedule
+func (s *ScheduleServiceServer) CreateSchedule(ctx context.Context, req *pb.CreateScheduleRequest) (*pb.Schedule, error) {
+	logger := s.logger.WithFields(log.Fields{
+		"org_id":     req.OrganizationId,
+		"project_id": req.ProjectId,
+		"cron":       req.CronExpression,
+	})
+	logger.Info("Creating scan schedule")
+
+	if req.OrganizationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
+	}
+	if req.ProjectId == "" {
+		return nil, status.Error(codes.InvalidArgument, "project_id is required")
+	}
+	if req.GitUrl == "" {
+		return nil, status.Error(codes.InvalidArgument, "git_url is required")
+	}
+	if len(req.ScanTypes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one scan_type is required")
+	}
+
+	orgID, err := uuid.Parse(req.OrganizationId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid organization_id: %v", err)
+	}
+	projectID, err := uuid.Parse(req.ProjectId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid project_id: %v", err)
+	}
+
+	var userID uuid.UUID
+	if req.UserId != "" {
+		userID, err = uuid.Parse(req.UserId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid user_id: %v", err)
+		}
+	}
+
+	now := time.Now()
+	schedule := &domain.ScanSchedule{
+		ID:             uuid.New(),
+		OrganizationID: orgID,
+		ProjectID:      projectID,
+		CronExpression: req.CronExpression,
+		Timezone:       defaultTimezone(req.Timezone),
+		ScanTypes:      convertScanTypesFromProto(req.ScanTypes),
+		RepositoryURL:  req.GitUrl,
+		Branch:         defaultBranch(req.GitBranch),
+		Enabled:        true,
+		CreatedBy:      userID,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+
+	if err := s.planNextRun(schedule, now); err != nil {
+		return nil, err
+	}
+
+	if err := s.scheduleRepo.Create(ctx, schedule); err != nil {
+		logger.WithError(err).Error("Failed to save schedule to database")
+		return nil, status.Errorf(codes.Internal, "failed to create schedule: %v", err)
+	}
+
+	logger.WithFields(log.Fields{
+		"schedule_id": schedule.ID.String(),
+		"next_run_at": schedule.NextRunAt,
+	}).Info("Schedule created successfully")
+
+	return convertScheduleToProto(schedule), nil
+}
+
+// GetSchedule retrieves a schedule by ID
+func (s *ScheduleServiceServer) GetSchedule(ctx context.Context, req *pb.GetScheduleRequest) (*pb.Schedule, error) {
+	scheduleID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid schedule_id: %v", err)
+	}
+
+	schedule, err := s.scheduleRepo.Get(ctx, scheduleID)
+	if err != nil {
+		s.logger.WithError(err).WithField("schedule_id", req.Id).Error("Failed to get schedule")
+		return nil, status.Errorf(codes.NotFound, "schedule not found: %v", err)
+	}
+
+	return convertScheduleToProto(schedule), nil
+}
+
+// ListSchedules lists schedules for an organization or project
+func (s *ScheduleServiceServer) ListSchedules(ctx context.Context, req *pb.ListSchedulesRequest) (*pb.ListSchedulesResponse, error) {
+	filter := interfaces.ScheduleFilter{
+		Limit: int(req.PageSize),
+	}
+
+	if req.OrganizationId != "" {
+		orgID, err := uuid.Parse(req.OrganizationId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid organization_id: %v", err)
+		}
+		filter.OrganizationID = &orgID
+	}
+
+	if req.ProjectId != "" {
+		projectID, err := uuid.Parse(req.ProjectId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid project_id: %v", err)
+		}
+		filter.ProjectID = &projectID
+	}
+
+	schedules, err := s.scheduleRepo.List(ctx, filter)
+	if err != nil {
+		s.logger.WithError(err).Error("Failed to list schedules")
+		return nil, status.Errorf(codes.Internal, "failed to list schedules: %v", err)
+	}
+
+	protoSchedules := make([]*pb.Schedule, len(schedules))
+	for i, schedule := range schedules {
+		protoSchedules[i] = convertScheduleToProto(schedule)
+	}
+
+	return &pb.ListSchedulesResponse{
+		Schedules:  protoSchedules,
+		TotalCount: int32(len(protoSchedules)),
+	}, nil
+}
+
+// UpdateSchedule replaces a schedule's definition and re-plans its next run
+func (s *ScheduleServiceServer) UpdateSchedule(ctx context.Context, req *pb.UpdateScheduleRequest) (*pb.Schedule, error) {
+	logger := s.logger.WithField("schedule_id", req.Id)
+	logger.Info("Updating scan schedule")
+
+	scheduleID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid schedule_id: %v", err)
+	}
+	if req.GitUrl == "" {
+		return nil, status.Error(codes.InvalidArgument, "git_url is required")
+	}
+	if len(req.ScanTypes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one scan_type is required")
+	}
+
+	schedule, err := s.scheduleRepo.Get(ctx, scheduleID)
+	if err != nil {
+		logger.WithError(err).Error("Failed to get schedule")
+		return nil, status.Errorf(codes.NotFound, "schedule not found: %v", err)
+	}
+
+	schedule.CronExpression = req.CronExpression
+	schedule.Timezone = defaultTimezone(req.Timezone)
+	schedule.ScanTypes = convertScanTypesFromProto(req.ScanTypes)
+	schedule.RepositoryURL = req.GitUrl
+	schedule.Branch = defaultBranch(req.GitBranch)
+	schedule.Enabled = req.Enabled
+
+	if err := s.planNextRun(schedule, time.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := s.scheduleRepo.Update(ctx, schedule); err != nil {
+		logger.WithError(err).Error("Failed to update schedule")
+		return nil, status.Errorf(codes.Internal, "failed to update schedule: %v", err)
+	}
+
+	logger.Info("Schedule updated successfully")
+	return convertScheduleToProto(schedule), nil
+}
+
+// DeleteSchedule deletes a schedule; scans it already created are kept
+func (s *ScheduleServiceServer) DeleteSchedule(ctx context.Context, req *pb.DeleteScheduleRequest) (*emptypb.Empty, error) {
+	logger := s.logger.WithField("schedule_id", req.Id)
+	logger.Info("Deleting scan schedule")
+
+	scheduleID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid schedule_id: %v", err)
+	}
+
+	if err := s.scheduleRepo.Delete(ctx, scheduleID); err != nil {
+		logger.WithError(err).Error("Failed to delete schedule")
+		return nil, status.Errorf(codes.NotFound, "schedule not found: %v", err)
+	}
+
+	logger.Info("Schedule deleted successfully")
+	return &emptypb.Empty{}, nil
+}
+
+// planNextRun validates the schedule and sets its next run time.
+// Disabled schedules have no next run.
+func (s *ScheduleServiceServer) planNextRun(schedule *domain.ScanSchedule, now time.Time) error {
+	if err := schedule.Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if !schedule.Enabled {
+		schedule.NextRunAt = nil
+		return nil
+	}
+
+	nextRun, err := schedule.NextRunAfter(now)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	schedule.NextRunAt = &nextRun
+
+	return nil
+}
+
+// defaultTimezone returns UTC when no timezone is given
+func defaultTimezone(tz string) string {
+	if tz == "" {
+		return "UTC"
+	}
+	return tz
+}
+
+// defaultBranch returns main when no branch is given
+func defaultBranch(branch string) string {
+	if branch == "" {
+		return "main"
+	}
+	return branch
+}
+
+func convertScheduleToProto(schedule *domain.ScanSchedule) *pb.Schedule {
+	protoSchedule := &pb.Schedule{
+		Id:             schedule.ID.String(),
+		OrganizationId: schedule.OrganizationID.String(),
+		ProjectId:      schedule.ProjectID.String(),
+		CronExpression: schedule.CronExpression,
+		Timezone:       schedule.Timezone,
+		GitUrl:         schedule.RepositoryURL,
+		GitBranch:      schedule.Branch,
+		Enabled:        schedule.Enabled,
+		CreatedAt:      timestamppb.New(schedule.CreatedAt),
+		UpdatedAt:      timestamppb.New(schedule.UpdatedAt),
+	}
+
+	protoSchedule.ScanTypes = make([]pb.ScanType, len(schedule.ScanTypes))
+	for i, st := range schedule.ScanTypes {
+		protoSchedule.ScanTypes[i] = ConvertScanTypeToProto(st)
+	}
+
+	if schedule.NextRunAt != nil {
+		protoSchedule.NextRunAt = timestamppb.New(*schedule.NextRunAt)
+	}
+	if schedule.LastRunAt != nil {
+		protoSchedule.LastRunAt = timestamppb.New(*schedule.LastRunAt)
+	}
+	if schedule.LastScanID != nil {
+		protoSchedule.LastScanId = schedule.LastScanID.String()
+	}
+
+	return protoSchedule
+}
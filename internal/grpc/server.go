@@ -6,7 +6,9 @@ import (
 
 	pb "github.com/cloud-scan/cloudscan-orchestrator/generated/proto"
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
@@ -18,17 +20,42 @@ type Server struct {
 }
 
 // NewServer creates a new gRPC server
-func NewServer(port string, scanService *ScanServiceServer) *Server {
-	// Create gRPC server with interceptors
+func NewServer(
+	port string,
+	scanService *ScanServiceServer,
+	scheduleService *ScheduleServiceServer,
+	integrationService *IntegrationServiceServer,
+	webhookService *WebhookServiceServer,
+	retentionService *RetentionServiceServer,
+	legalHoldService *LegalHoldServiceServer,
+	jobProfileService *JobProfileServiceServer,
+	credentialService *CredentialServiceServer,
+	healthService healthpb.HealthServer,
+) *Server {
+	// Create gRPC server with interceptors. The OpenTelemetry stats handler
+	// starts a server span per RPC, continuing the caller's trace if any.
 	grpcServer := grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
 		grpc.ChainUnaryInterceptor(
+			metricsInterceptor(),
 			loggingInterceptor(),
 			errorHandlingInterceptor(),
 		),
+		grpc.ChainStreamInterceptor(
+			metricsStreamInterceptor(),
+		),
 	)
 
 	// Register services
 	pb.RegisterScanServiceServer(grpcServer, scanService)
+	pb.RegisterScheduleServiceServer(grpcServer, scheduleService)
+	pb.RegisterIntegrationServiceServer(grpcServer, integrationService)
+	pb.RegisterWebhookServiceServer(grpcServer, webhookService)
+	pb.RegisterRetentionServiceServer(grpcServer, retentionService)
+	pb.RegisterLegalHoldServiceServer(grpcServer, legalHoldService)
+	pb.RegisterJobProfileServiceServer(grpcServer, jobProfileService)
+	pb.RegisterCredentialServiceServer(grpcServer, credentialService)
+	healthpb.RegisterHealthServer(grpcServer, healthService)
 
 	// Register reflection service for development
 	reflection.Register(grpcServer)
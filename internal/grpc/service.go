@@ -2,41 +2,75 @@ package grpc
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
 	"time"
 
 	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
 	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/jobprofiles"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/metrics"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/tracing"
 	pb "github.com/cloud-scan/cloudscan-orchestrator/generated/proto"
 	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// scanLogChunkSize is the largest chunk of a live log sent by GetScanLogs
+const scanLogChunkSize = 32 * 1024
+
+// maxRequestIDLength is the longest request ID of a runner callback
+const maxRequestIDLength = 128
+
 // ScanServiceServer implements the gRPC ScanService interface
 type ScanServiceServer struct {
 	pb.UnimplementedScanServiceServer
 	scanRepo      interfaces.ScanRepository
+	subScanRepo   interfaces.SubScanRepository
 	findingRepo   interfaces.FindingRepository
+	operationRepo interfaces.OperationRepository
+	requestRepo   interfaces.RunnerRequestRepository
 	storageClient interfaces.StorageClient
 	jobDispatcher interfaces.JobDispatcher
+	jobNamespace  string // Default namespace of jobs
+	scanners      interfaces.ScannerRegistry
+	jobProfiles   *jobprofiles.Resolver
+	retryPolicy   domain.RetryPolicy
 	logger        *log.Entry
 }
 
 // NewScanServiceServer creates a new gRPC service server
 func NewScanServiceServer(
 	scanRepo interfaces.ScanRepository,
+	subScanRepo interfaces.SubScanRepository,
 	findingRepo interfaces.FindingRepository,
+	operationRepo interfaces.OperationRepository,
+	requestRepo interfaces.RunnerRequestRepository,
 	storageClient interfaces.StorageClient,
 	jobDispatcher interfaces.JobDispatcher,
+	jobNamespace string,
+	scanners interfaces.ScannerRegistry,
+	jobProfiles *jobprofiles.Resolver,
+	retryPolicy domain.RetryPolicy,
 ) *ScanServiceServer {
 	return &ScanServiceServer{
 		scanRepo:      scanRepo,
+		subScanRepo:   subScanRepo,
 		findingRepo:   findingRepo,
+		operationRepo: operationRepo,
+		requestRepo:   requestRepo,
 		storageClient: storageClient,
 		jobDispatcher: jobDispatcher,
+		jobNamespace:  jobNamespace,
+		scanners:      scanners,
+		jobProfiles:   jobProfiles,
+		retryPolicy:   retryPolicy,
 		logger:        log.WithField("component", "grpc-service"),
 	}
 }
@@ -87,9 +121,21 @@ func (s *ScanServiceServer) CreateScan(ctx context.Context, req *pb.CreateScanRe
 	}
 
 	// Convert scan types
-	scanTypes := make([]domain.ScanType, len(req.ScanTypes))
-	for i, st := range req.ScanTypes {
-		scanTypes[i] = convertScanTypeFromProto(st)
+	scanTypes := convertScanTypesFromProto(req.ScanTypes)
+
+	// Job settings must fit the organization's limits together with the
+	// project's profile
+	var jobSettings *domain.JobSettings
+	if req.JobSettings != nil {
+		jobSettings = convertJobSettingsFromProto(req.JobSettings)
+		_, err := s.jobProfiles.Resolve(ctx, orgID, projectID, jobSettings)
+		if errors.Is(err, jobprofiles.ErrInvalidSettings) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if err != nil {
+			logger.WithError(err).Error("Failed to check job settings")
+			return nil, status.Errorf(codes.Internal, "failed to check job settings: %v", err)
+		}
 	}
 
 	// Create scan domain model
@@ -105,6 +151,8 @@ func (s *ScanServiceServer) CreateScan(ctx context.Context, req *pb.CreateScanRe
 		Branch:           stringPtr(req.GitBranch),
 		CommitSHA:        stringPtr(req.GitCommit),
 		SourceArchiveKey: stringPtr(req.SourceArtifactId),
+		JobSettings:      jobSettings,
+		TraceParent:      tracing.TraceParent(ctx),
 		CreatedAt:        now,
 		UpdatedAt:        now,
 	}
@@ -139,6 +187,12 @@ func (s *ScanServiceServer) GetScan(ctx context.Context, req *pb.GetScanRequest)
 		return nil, status.Errorf(codes.NotFound, "scan not found: %v", err)
 	}
 
+	scan.SubScans, err = s.subScanRepo.ListByScan(ctx, scanID)
+	if err != nil {
+		logger.WithError(err).Error("Failed to list sub-scans")
+		return nil, status.Errorf(codes.Internal, "failed to get sub-scans: %v", err)
+	}
+
 	return convertScanToProto(scan), nil
 }
 
@@ -213,29 +267,62 @@ func (s *ScanServiceServer) CancelScan(ctx context.Context, req *pb.CancelScanRe
 	}
 
 	// Check if scan can be cancelled
-	if scan.Status == domain.ScanStatusCompleted || scan.Status == domain.ScanStatusFailed {
+	if scan.Status == domain.ScanStatusCompleted || scan.Status == domain.ScanStatusPartial || scan.Status == domain.ScanStatusFailed {
 		return nil, status.Error(codes.FailedPrecondition, "scan already completed")
 	}
 	if scan.Status == domain.ScanStatusCancelled {
 		return &emptypb.Empty{}, nil // Already cancelled
 	}
+	if scan.Status == domain.ScanStatusDeleting {
+		return nil, status.Error(codes.FailedPrecondition, "scan is being deleted")
+	}
 
 	// Cancel the Kubernetes job if running
 	if scan.JobName != nil && *scan.JobName != "" {
-		jobNamespace := stringValue(scan.JobNamespace)
+		jobNamespace := domain.JobNamespace(scan.JobNamespace, s.jobNamespace)
 		jobName := stringValue(scan.JobName)
 		if err := s.jobDispatcher.CancelJob(ctx, jobNamespace, jobName); err != nil {
 			logger.WithError(err).Warn("Failed to cancel Kubernetes job")
 		}
 	}
 
-	// Update scan status
-	if err := s.scanRepo.UpdateStatus(ctx, scanID, domain.ScanStatusCancelled); err != nil {
+	subs, err := s.subScanRepo.ListByScan(ctx, scanID)
+	if err != nil {
+		logger.WithError(err).Error("Failed to list sub-scans")
+		return nil, status.Errorf(codes.Internal, "failed to cancel scan: %v", err)
+	}
+	for _, sub := range subs {
+		if sub.IsTerminal() || sub.JobName == nil {
+			continue
+		}
+		if err := s.jobDispatcher.CancelJob(ctx, domain.JobNamespace(sub.JobNamespace, s.jobNamespace), *sub.JobName); err != nil {
+			logger.WithError(err).WithField("scan_type", sub.ScanType).Warn("Failed to cancel Kubernetes job")
+		}
+	}
+
+	// Update scan status. The scan is cancelled as a whole, so it stays
+	// cancelled when its sub-scans are marked below.
+	if err := s.scanRepo.UpdateStatus(ctx, scanID, domain.ScanStatusCancelled); errors.Is(err, domain.ErrScanDeleting) {
+		return nil, status.Error(codes.FailedPrecondition, "scan is being deleted")
+	} else if err != nil {
 		logger.WithError(err).Error("Failed to update scan status")
 		return nil, status.Errorf(codes.Internal, "failed to cancel scan: %v", err)
 	}
 
+	now := time.Now()
+	for _, sub := range subs {
+		if sub.IsTerminal() {
+			continue
+		}
+		sub.Status = domain.ScanStatusCancelled
+		sub.CompletedAt = &now
+		if _, err := s.subScanRepo.Update(ctx, sub); err != nil {
+			logger.WithError(err).WithField("scan_type", sub.ScanType).Warn("Failed to cancel sub-scan")
+		}
+	}
+
 	logger.Info("Scan cancelled successfully")
+
 	return &emptypb.Empty{}, nil
 }
 
@@ -294,12 +381,44 @@ func (s *ScanServiceServer) UpdateScan(ctx context.Context, req *pb.UpdateScanRe
 		return nil, status.Errorf(codes.InvalidArgument, "invalid scan_id: %v", err)
 	}
 
+	// Scans are only marked deleting through DeleteScan
+	if req.Status == pb.ScanStatus_DELETING {
+		return nil, status.Error(codes.InvalidArgument, "status DELETING can't be set directly, use DeleteScan")
+	}
+
+	failureReason := domain.FailureReason(req.FailureReason)
+	if failureReason != "" && !failureReason.IsValid() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid failure_reason: %s", req.FailureReason)
+	}
+
 	// Get existing scan
 	scan, err := s.scanRepo.Get(ctx, scanID)
 	if err != nil {
 		logger.WithError(err).Error("Failed to get scan")
 		return nil, status.Errorf(codes.NotFound, "scan not found: %v", err)
 	}
+	if scan.Status == domain.ScanStatusDeleting {
+		return nil, status.Error(codes.FailedPrecondition, "scan is being deleted")
+	}
+
+	request, err := newRunnerRequest(scan, req.RequestId, "UpdateScan")
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ScanType != pb.ScanType_SCAN_TYPE_UNSPECIFIED {
+		return s.updateSubScan(ctx, logger, scan, request, req)
+	}
+
+	// Scans with a job per scan type are only updated through their sub-scans
+	subs, err := s.subScanRepo.ListByScan(ctx, scanID)
+	if err != nil {
+		logger.WithError(err).Error("Failed to list sub-scans")
+		return nil, status.Errorf(codes.Internal, "failed to update scan: %v", err)
+	}
+	if len(subs) > 0 {
+		return nil, status.Error(codes.InvalidArgument, "scan_type is required, the scan runs a job per scan type")
+	}
 
 	// Update fields
 	if req.Status != pb.ScanStatus_SCAN_STATUS_UNSPECIFIED {
@@ -310,17 +429,171 @@ func (s *ScanServiceServer) UpdateScan(ctx context.Context, req *pb.UpdateScanRe
 		scan.FindingsCount = int(req.TotalFindings)
 	}
 
+	if len(req.FindingsBySeverity) > 0 {
+		scan.CriticalCount = int(req.FindingsBySeverity["critical"])
+		scan.HighCount = int(req.FindingsBySeverity["high"])
+		scan.MediumCount = int(req.FindingsBySeverity["medium"])
+		scan.LowCount = int(req.FindingsBySeverity["low"])
+	}
+
 	if req.ErrorMessage != "" {
 		scan.ErrorMessage = stringPtr(req.ErrorMessage)
 	}
 
+	// Scans without sub-scans predate retries, so their failures are final
+	if scan.Status == domain.ScanStatusFailed {
+		scan.FailureReason = runnerFailureReason(failureReason)
+	}
+
 	// Update in database
-	if err := s.scanRepo.Update(ctx, scan); err != nil {
+	if request == nil {
+		err = s.scanRepo.Update(ctx, scan)
+	} else {
+		err = s.scanRepo.UpdateOnce(ctx, request, scan)
+	}
+	if errors.Is(err, domain.ErrRequestReplayed) {
+		return s.replayUpdateScan(ctx, logger, request)
+	} else if errors.Is(err, domain.ErrScanDeleting) {
+		return nil, status.Error(codes.FailedPrecondition, "scan is being deleted")
+	} else if err != nil {
 		logger.WithError(err).Error("Failed to update scan")
 		return nil, status.Errorf(codes.Internal, "failed to update scan: %v", err)
 	}
 
 	logger.Info("Scan updated successfully")
+
+	response := convertScanToProto(scan)
+	s.saveResponse(ctx, logger, request, response)
+	return response, nil
+}
+
+// updateSubScan applies a runner's update to the sub-scan of its scan type and
+// returns the scan as aggregated from its sub-scans
+func (s *ScanServiceServer) updateSubScan(ctx context.Context, logger *log.Entry, scan *domain.Scan, request *domain.RunnerRequest, req *pb.UpdateScanRequest) (*pb.Scan, error) {
+	scanType := convertScanTypeFromProto(req.ScanType)
+	logger = logger.WithField("scan_type", scanType)
+
+	// A partial status only results from aggregating sub-scans, and only the
+	// dispatcher queues sub-scans
+	if req.Status == pb.ScanStatus_PARTIAL || req.Status == pb.ScanStatus_QUEUED {
+		return nil, status.Errorf(codes.InvalidArgument, "status %s can't be set on a sub-scan", req.Status)
+	}
+	if req.Attempt < 1 {
+		return nil, status.Error(codes.InvalidArgument, "attempt is required, the runner's SCAN_ATTEMPT")
+	}
+
+	subs, err := s.subScanRepo.ListByScan(ctx, scan.ID)
+	if err != nil {
+		logger.WithError(err).Error("Failed to list sub-scans")
+		return nil, status.Errorf(codes.Internal, "failed to update scan: %v", err)
+	}
+
+	var sub *domain.SubScan
+	for _, candidate := range subs {
+		if candidate.ScanType == scanType {
+			sub = candidate
+			break
+		}
+	}
+	if sub == nil {
+		return nil, status.Errorf(codes.NotFound, "scan has no %s sub-scan", scanType)
+	}
+
+	// Every callback is a heartbeat of the runner
+	now := time.Now()
+	if sub.Status == domain.ScanStatusRunning {
+		sub.LastHeartbeatAt = &now
+	}
+
+	// Update fields. A failure is retried like one observed by the sweeper
+	// if the runner reports it as transient.
+	failed, retried := false, false
+	switch newStatus := convertScanStatusFromProto(req.Status); {
+	case req.Status == pb.ScanStatus_SCAN_STATUS_UNSPECIFIED:
+	case newStatus == domain.ScanStatusFailed:
+		failed = true
+		retried = sub.Fail(runnerFailureReason(domain.FailureReason(req.FailureReason)), req.ErrorMessage, s.retryPolicy, now)
+	default:
+		sub.Status = newStatus
+		if sub.IsTerminal() && sub.CompletedAt == nil {
+			sub.CompletedAt = &now
+		}
+	}
+
+	if req.TotalFindings > 0 {
+		sub.FindingsCount = int(req.TotalFindings)
+	}
+
+	if len(req.FindingsBySeverity) > 0 {
+		sub.CriticalCount = int(req.FindingsBySeverity["critical"])
+		sub.HighCount = int(req.FindingsBySeverity["high"])
+		sub.MediumCount = int(req.FindingsBySeverity["medium"])
+		sub.LowCount = int(req.FindingsBySeverity["low"])
+	}
+
+	if req.ErrorMessage != "" {
+		sub.ErrorMessage = stringPtr(req.ErrorMessage)
+	}
+
+	// The callback must come from the current attempt while it runs; a late
+	// callback of a replaced job mustn't finish or restart its retry
+	updated, err := s.subScanRepo.UpdateFromRunner(ctx, request, int(req.Attempt), sub)
+	if errors.Is(err, domain.ErrRequestReplayed) {
+		return s.replayUpdateScan(ctx, logger, request)
+	}
+	if errors.Is(err, domain.ErrStaleAttempt) {
+		return nil, status.Errorf(codes.FailedPrecondition, "attempt %d was replaced, the sub-scan runs attempt %d", req.Attempt, sub.Attempt)
+	}
+	if errors.Is(err, domain.ErrSubScanNotRunning) {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s sub-scan is no longer running", scanType)
+	}
+	if errors.Is(err, domain.ErrScanDeleting) {
+		return nil, status.Error(codes.FailedPrecondition, "scan is being deleted")
+	}
+	if err != nil {
+		logger.WithError(err).Error("Failed to update sub-scan")
+		return nil, status.Errorf(codes.Internal, "failed to update scan: %v", err)
+	}
+
+	if failed {
+		metrics.JobFailure(string(sub.FailureReason), retried)
+	}
+	if retried {
+		logger.WithFields(log.Fields{
+			"failure_reason": sub.FailureReason,
+			"retry_at":       sub.RetryAt,
+		}).Info("Runner reported a transient failure, queued sub-scan for a retry")
+	}
+
+	logger.WithField("status", updated.Status).Info("Sub-scan updated successfully")
+
+	response := convertScanToProto(updated)
+	s.saveResponse(ctx, logger, request, response)
+	return response, nil
+}
+
+// replayUpdateScan answers an UpdateScan replay with the original response.
+// If it wasn't recorded the scan's current state is returned.
+func (s *ScanServiceServer) replayUpdateScan(ctx context.Context, logger *log.Entry, request *domain.RunnerRequest) (*pb.Scan, error) {
+	logger = logger.WithField("request_id", request.RequestID)
+	logger.Info("Update was applied before, returning its response")
+
+	response := &pb.Scan{}
+	recorded, err := s.replayedResponse(ctx, request, response)
+	if err != nil || recorded {
+		return response, err
+	}
+
+	scan, err := s.scanRepo.Get(ctx, request.ScanID)
+	if err != nil {
+		logger.WithError(err).Error("Failed to get scan")
+		return nil, status.Errorf(codes.NotFound, "scan not found: %v", err)
+	}
+	scan.SubScans, err = s.subScanRepo.ListByScan(ctx, request.ScanID)
+	if err != nil {
+		logger.WithError(err).Error("Failed to list sub-scans")
+		return nil, status.Errorf(codes.Internal, "failed to get sub-scans: %v", err)
+	}
 	return convertScanToProto(scan), nil
 }
 
@@ -341,91 +614,485 @@ func (s *ScanServiceServer) CreateFindings(ctx context.Context, req *pb.CreateFi
 		return nil, status.Errorf(codes.InvalidArgument, "invalid scan_id: %v", err)
 	}
 
-	// Verify scan exists
-	_, err = s.scanRepo.Get(ctx, scanID)
+	// Verify scan exists; findings reference it by ID and creation time
+	scan, err := s.scanRepo.Get(ctx, scanID)
 	if err != nil {
 		logger.WithError(err).Error("Failed to get scan")
 		return nil, status.Errorf(codes.NotFound, "scan not found: %v", err)
 	}
 
+	// The scan's status is checked with the findings, after a replay returned
+	// the original response
+	target := &domain.FindingUpload{
+		ScanID:        scan.ID,
+		ScanCreatedAt: scan.CreatedAt,
+		Attempt:       int(req.Attempt),
+	}
+	if target.Attempt < 1 {
+		target.Attempt = 1
+	}
+	if req.ScanType == pb.ScanType_SCAN_TYPE_UNSPECIFIED {
+		subs, err := s.subScanRepo.ListByScan(ctx, scan.ID)
+		if err != nil {
+			logger.WithError(err).Error("Failed to list sub-scans")
+			return nil, status.Errorf(codes.Internal, "failed to create findings: %v", err)
+		}
+		if len(subs) > 0 {
+			return nil, status.Error(codes.InvalidArgument, "scan_type is required, the scan runs a job per scan type")
+		}
+		if target.Attempt != 1 {
+			return nil, status.Errorf(codes.FailedPrecondition, "scan has no attempt %d", target.Attempt)
+		}
+	} else {
+		target.ScanType = convertScanTypeFromProto(req.ScanType)
+	}
+
+	request, err := newRunnerRequest(scan, req.RequestId, "CreateFindings")
+	if err != nil {
+		return nil, err
+	}
+
 	// Convert proto findings to domain
 	findings := make([]*domain.Finding, len(req.Findings))
 	for i, protoFinding := range req.Findings {
-		findings[i] = convertFindingFromProto(protoFinding, scanID)
+		findings[i] = convertFindingFromProto(protoFinding, scan)
+	}
+
+	response := &pb.CreateFindingsResponse{
+		CreatedCount: int32(len(findings)),
+	}
+
+	// The response is known up front, so it's recorded with the findings
+	if request != nil {
+		if request.Response, err = proto.Marshal(response); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to encode response: %v", err)
+		}
 	}
 
 	// Create findings in database
-	if err := s.findingRepo.CreateBatch(ctx, findings); err != nil {
+	err = s.findingRepo.CreateBatch(ctx, request, target, findings)
+	if errors.Is(err, domain.ErrRequestReplayed) {
+		logger.WithField("request_id", request.RequestID).Info("Findings were created before, returning the original response")
+		replayed := &pb.CreateFindingsResponse{}
+		recorded, err := s.replayedResponse(ctx, request, replayed)
+		if err != nil {
+			return nil, err
+		}
+		if !recorded {
+			return response, nil
+		}
+		return replayed, nil
+	}
+	if findingsRefused(err) {
+		return nil, status.Errorf(codes.FailedPrecondition, "findings of attempt %d no longer accepted: %v", target.Attempt, err)
+	}
+	if err != nil {
 		logger.WithError(err).Error("Failed to create findings")
 		return nil, status.Errorf(codes.Internal, "failed to create findings: %v", err)
 	}
 
 	logger.Info("Findings created successfully")
-	return &pb.CreateFindingsResponse{
-		CreatedCount: int32(len(findings)),
-	}, nil
+
+	s.findingsIngested(ctx, logger, scan, findings)
+
+	return response, nil
 }
 
-// DeleteScan deletes a scan and all its data (findings, artifacts, k8s job)
-func (s *ScanServiceServer) DeleteScan(ctx context.Context, req *pb.DeleteScanRequest) (*emptypb.Empty, error) {
-	logger := s.logger.WithField("scan_id", req.Id)
-	logger.Info("Deleting scan")
+// findingsRefused reports whether a runner's findings were refused because
+// their attempt was replaced or their sub-scan or scan is finished
+func findingsRefused(err error) bool {
+	return errors.Is(err, domain.ErrStaleAttempt) ||
+		errors.Is(err, domain.ErrSubScanNotRunning) ||
+		errors.Is(err, domain.ErrSubScanNotFound) ||
+		errors.Is(err, domain.ErrScanFinished) ||
+		errors.Is(err, domain.ErrScanDeleting)
+}
 
-	scanID, err := uuid.Parse(req.Id)
+// maxUploadBatchSize is the maximum number of findings in a batch of UploadFindings
+const maxUploadBatchSize = 5000
+
+// UploadFindings receives a runner's findings in numbered batches (called by
+// runner jobs). Each batch is committed on its own and acknowledged; batches
+// committed before, e.g. by an interrupted stream, are acknowledged again
+// without creating their findings twice.
+func (s *ScanServiceServer) UploadFindings(stream pb.ScanService_UploadFindingsServer) error {
+	ctx := stream.Context()
+
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return nil
+	}
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid scan_id: %v", err)
+		return err
+	}
+
+	logger := s.logger.WithFields(log.Fields{
+		"scan_id":   first.ScanId,
+		"scan_type": first.ScanType,
+		"attempt":   first.Attempt,
+	})
+
+	scan, upload, err := s.startUpload(ctx, logger, first)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Receiving findings upload")
+
+	req := first
+	for {
+		if req.ScanId != "" && req.ScanId != first.ScanId {
+			return status.Error(codes.InvalidArgument, "scan_id can't change during an upload")
+		}
+		if len(req.Findings) > maxUploadBatchSize {
+			return status.Errorf(codes.InvalidArgument, "a batch has at most %d findings", maxUploadBatchSize)
+		}
+
+		ack, err := s.uploadBatch(ctx, logger, scan, upload, req)
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(ack); err != nil {
+			return err
+		}
+
+		req, err = stream.Recv()
+		if err == io.EOF {
+			logger.WithField("last_sequence", ack.LastSequence).Info("Findings upload finished")
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// startUpload returns the scan and upload that the first message of an
+// upload refers to
+func (s *ScanServiceServer) startUpload(ctx context.Context, logger *log.Entry, req *pb.UploadFindingsRequest) (*domain.Scan, *domain.FindingUpload, error) {
+	scanID, err := uuid.Parse(req.ScanId)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "invalid scan_id: %v", err)
 	}
 
-	// Get scan to retrieve job name and artifact keys
 	scan, err := s.scanRepo.Get(ctx, scanID)
 	if err != nil {
 		logger.WithError(err).Error("Failed to get scan")
-		return nil, status.Errorf(codes.NotFound, "scan not found: %v", err)
+		return nil, nil, status.Errorf(codes.NotFound, "scan not found: %v", err)
+	}
+	if scan.Status == domain.ScanStatusDeleting {
+		return nil, nil, status.Error(codes.FailedPrecondition, "scan is being deleted")
+	}
+	if scan.IsTerminal() {
+		return nil, nil, status.Errorf(codes.FailedPrecondition, "scan is %s", scan.Status)
 	}
 
-	// 1. Delete Kubernetes job if it exists
-	if scan.JobName != nil && *scan.JobName != "" {
-		jobNamespace := stringValue(scan.JobNamespace)
-		if err := s.jobDispatcher.DeleteJob(ctx, jobNamespace, *scan.JobName); err != nil {
-			logger.WithError(err).Warn("Failed to delete Kubernetes job, continuing with cleanup")
-		} else {
-			logger.Debug("Deleted Kubernetes job")
+	upload := &domain.FindingUpload{
+		ScanID:        scan.ID,
+		ScanCreatedAt: scan.CreatedAt,
+		Attempt:       int(req.Attempt),
+	}
+	if upload.Attempt < 1 {
+		upload.Attempt = 1
+	}
+
+	subs, err := s.subScanRepo.ListByScan(ctx, scan.ID)
+	if err != nil {
+		logger.WithError(err).Error("Failed to list sub-scans")
+		return nil, nil, status.Errorf(codes.Internal, "failed to upload findings: %v", err)
+	}
+
+	// Scans without a job per scan type have a single attempt
+	if req.ScanType == pb.ScanType_SCAN_TYPE_UNSPECIFIED {
+		if len(subs) > 0 {
+			return nil, nil, status.Error(codes.InvalidArgument, "scan_type is required, the scan runs a job per scan type")
+		}
+		if upload.Attempt != 1 {
+			return nil, nil, status.Errorf(codes.FailedPrecondition, "scan has no attempt %d", upload.Attempt)
+		}
+		return scan, upload, nil
+	}
+
+	// Runners of scans with a job per scan type upload their sub-scan's
+	// findings, from its current attempt while it runs
+	upload.ScanType = convertScanTypeFromProto(req.ScanType)
+	var sub *domain.SubScan
+	for _, candidate := range subs {
+		if candidate.ScanType == upload.ScanType {
+			sub = candidate
+			break
+		}
+	}
+	if sub == nil {
+		return nil, nil, status.Errorf(codes.NotFound, "scan has no %s sub-scan", upload.ScanType)
+	}
+	if sub.Attempt != upload.Attempt {
+		return nil, nil, status.Errorf(codes.FailedPrecondition, "attempt %d was replaced, the sub-scan runs attempt %d", upload.Attempt, sub.Attempt)
+	}
+	if sub.Status != domain.ScanStatusRunning {
+		return nil, nil, status.Errorf(codes.FailedPrecondition, "%s sub-scan is %s", sub.ScanType, sub.Status)
+	}
+
+	return scan, upload, nil
+}
+
+// uploadBatch commits one batch of an upload and returns its acknowledgement
+func (s *ScanServiceServer) uploadBatch(ctx context.Context, logger *log.Entry, scan *domain.Scan, upload *domain.FindingUpload, req *pb.UploadFindingsRequest) (*pb.UploadFindingsResponse, error) {
+	// Sequence 0 only asks where to resume
+	if req.Sequence == 0 {
+		if len(req.Findings) > 0 {
+			return nil, status.Error(codes.InvalidArgument, "sequence must start at 1")
+		}
+		lastSequence, err := s.findingRepo.LastUploadedSequence(ctx, upload)
+		if err != nil {
+			logger.WithError(err).Error("Failed to get finding upload")
+			return nil, status.Errorf(codes.Internal, "failed to upload findings: %v", err)
+		}
+		return &pb.UploadFindingsResponse{LastSequence: lastSequence}, nil
+	}
+	if req.Sequence < 0 {
+		return nil, status.Error(codes.InvalidArgument, "sequence must start at 1")
+	}
+
+	findings := make([]*domain.Finding, len(req.Findings))
+	for i, protoFinding := range req.Findings {
+		findings[i] = convertFindingFromProto(protoFinding, scan)
+		if findings[i].ScanType == "" && upload.ScanType != "" {
+			findings[i].ScanType = upload.ScanType
+			findings[i].ToolName = deriveToolName(upload.ScanType)
+		}
+	}
+
+	batchLogger := logger.WithFields(log.Fields{
+		"sequence": req.Sequence,
+		"count":    len(findings),
+	})
+
+	lastSequence, err := s.findingRepo.UploadBatch(ctx, upload, req.Sequence, findings)
+	if errors.Is(err, domain.ErrBatchAlreadyUploaded) {
+		batchLogger.Info("Skipped findings batch uploaded before")
+		return &pb.UploadFindingsResponse{
+			Sequence:     req.Sequence,
+			LastSequence: lastSequence,
+			Duplicate:    true,
+		}, nil
+	}
+	if errors.Is(err, domain.ErrBatchOutOfSequence) {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v, resume after batch %d", err, lastSequence)
+	}
+	if findingsRefused(err) {
+		return nil, status.Errorf(codes.FailedPrecondition, "upload of attempt %d no longer accepted: %v", upload.Attempt, err)
+	}
+	if err != nil {
+		batchLogger.WithError(err).Error("Failed to create findings")
+		return nil, status.Errorf(codes.Internal, "failed to create findings: %v", err)
+	}
+
+	batchLogger.Debug("Findings batch created")
+	s.findingsIngested(ctx, batchLogger, scan, findings)
+
+	return &pb.UploadFindingsResponse{
+		Sequence:     req.Sequence,
+		LastSequence: lastSequence,
+		CreatedCount: int32(len(findings)),
+	}, nil
+}
+
+// findingsIngested counts created findings. Reporting findings is a heartbeat
+// of the runners of their scan types.
+func (s *ScanServiceServer) findingsIngested(ctx context.Context, logger *log.Entry, scan *domain.Scan, findings []*domain.Finding) {
+	now := time.Now()
+	heartbeats := make(map[domain.ScanType]bool)
+	for _, finding := range findings {
+		metrics.FindingsIngestedTotal.WithLabelValues(string(finding.ScanType), string(finding.Severity)).Inc()
+
+		if finding.ScanType != "" && !heartbeats[finding.ScanType] {
+			heartbeats[finding.ScanType] = true
+			if err := s.subScanRepo.RecordHeartbeat(ctx, scan.ID, finding.ScanType, now); err != nil {
+				logger.WithError(err).WithField("scan_type", finding.ScanType).Warn("Failed to record heartbeat")
+			}
 		}
 	}
+}
+
+// newRunnerRequest returns the runner request of a callback to method with a
+// request ID, or nil for a callback without one
+func newRunnerRequest(scan *domain.Scan, requestID, method string) (*domain.RunnerRequest, error) {
+	if requestID == "" {
+		return nil, nil
+	}
+	if len(requestID) > maxRequestIDLength {
+		return nil, status.Errorf(codes.InvalidArgument, "request_id is longer than %d characters", maxRequestIDLength)
+	}
+	return &domain.RunnerRequest{
+		ScanID:        scan.ID,
+		ScanCreatedAt: scan.CreatedAt,
+		RequestID:     requestID,
+		Method:        method,
+	}, nil
+}
+
+// replayedResponse decodes the recorded response of a replayed runner request
+// into response. It returns false if the request was applied but its response
+// wasn't recorded.
+func (s *ScanServiceServer) replayedResponse(ctx context.Context, request *domain.RunnerRequest, response proto.Message) (bool, error) {
+	applied, err := s.requestRepo.Get(ctx, request.ScanID, request.RequestID)
+	if err != nil {
+		return false, status.Errorf(codes.Internal, "failed to get replayed request: %v", err)
+	}
+	if applied.Method != request.Method {
+		return false, status.Errorf(codes.InvalidArgument, "request_id was already used with %s", applied.Method)
+	}
+	if applied.Response == nil {
+		return false, nil
+	}
+	if err := proto.Unmarshal(applied.Response, response); err != nil {
+		return false, status.Errorf(codes.Internal, "failed to decode replayed response: %v", err)
+	}
+	return true, nil
+}
 
-	// 2. Collect artifact IDs to delete
-	var artifactIDs []string
-	if scan.SourceArchiveKey != nil && *scan.SourceArchiveKey != "" {
-		artifactIDs = append(artifactIDs, *scan.SourceArchiveKey)
+// saveResponse records the response of an applied runner request. Replays of
+// a request whose response is lost get the scan's current state instead.
+func (s *ScanServiceServer) saveResponse(ctx context.Context, logger *log.Entry, request *domain.RunnerRequest, response proto.Message) {
+	if request == nil {
+		return
 	}
 
-	// 3. Delete artifacts from storage service
-	if len(artifactIDs) > 0 {
-		if err := s.storageClient.DeleteArtifacts(ctx, artifactIDs); err != nil {
-			logger.WithError(err).Warn("Failed to delete artifacts from storage, continuing with cleanup")
-		} else {
-			logger.WithField("artifact_count", len(artifactIDs)).Debug("Deleted artifacts from storage")
+	var err error
+	request.Response, err = proto.Marshal(response)
+	if err == nil {
+		err = s.requestRepo.SaveResponse(ctx, request)
+	}
+	if err != nil {
+		logger.WithError(err).WithField("request_id", request.RequestID).Warn("Failed to record response of runner request")
+	}
+}
+
+// ReportProgress records what a runner is doing (called by runner jobs). Each
+// report is a heartbeat of the runner.
+func (s *ScanServiceServer) ReportProgress(ctx context.Context, req *pb.ReportProgressRequest) (*pb.ReportProgressResponse, error) {
+	logger := s.logger.WithFields(log.Fields{
+		"scan_id":   req.ScanId,
+		"scan_type": req.ScanType,
+		"phase":     req.Phase,
+	})
+	logger.Debug("Recording runner progress")
+
+	scanID, err := uuid.Parse(req.ScanId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid scan_id: %v", err)
+	}
+	if req.Phase == pb.ProgressPhase_PROGRESS_PHASE_UNSPECIFIED {
+		return nil, status.Error(codes.InvalidArgument, "phase is required")
+	}
+	if req.Percent < 0 || req.Percent > 100 {
+		return nil, status.Error(codes.InvalidArgument, "percent must be between 0 and 100")
+	}
+
+	progress := &domain.Progress{
+		Phase:     convertProgressPhaseFromProto(req.Phase),
+		Scanner:   req.Scanner,
+		Percent:   int(req.Percent),
+		Message:   req.Message,
+		UpdatedAt: time.Now(),
+	}
+
+	attempt := int(req.Attempt)
+	if attempt < 1 {
+		attempt = 1
+	}
+
+	if req.ScanType != pb.ScanType_SCAN_TYPE_UNSPECIFIED {
+		sub, err := s.subScanRepo.RecordProgress(ctx, scanID, convertScanTypeFromProto(req.ScanType), attempt, progress)
+		if errors.Is(err, domain.ErrScanNotFound) {
+			return nil, status.Error(codes.NotFound, "scan not found")
+		}
+		if errors.Is(err, domain.ErrSubScanNotFound) {
+			return nil, status.Errorf(codes.NotFound, "scan has no %s sub-scan", convertScanTypeFromProto(req.ScanType))
+		}
+		if errors.Is(err, domain.ErrScanDeleting) {
+			return nil, status.Error(codes.FailedPrecondition, "scan is being deleted")
 		}
+		if errors.Is(err, domain.ErrStaleAttempt) {
+			return nil, status.Errorf(codes.FailedPrecondition, "attempt %d was replaced: %v", attempt, err)
+		}
+		if err != nil {
+			logger.WithError(err).Error("Failed to record progress")
+			return nil, status.Errorf(codes.Internal, "failed to record progress: %v", err)
+		}
+		return &pb.ReportProgressResponse{Status: convertScanStatusToProto(sub.Status)}, nil
+	}
+
+	scan, err := s.scanRepo.Get(ctx, scanID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "scan not found: %v", err)
+	}
+	if scan.Status == domain.ScanStatusDeleting {
+		return nil, status.Error(codes.FailedPrecondition, "scan is being deleted")
 	}
 
-	// 4. Delete findings from database
-	if err := s.findingRepo.DeleteByScanID(ctx, scan.ID); err != nil {
-		logger.WithError(err).Error("Failed to delete findings")
-		return nil, status.Errorf(codes.Internal, "failed to delete findings: %v", err)
+	// Scans with a job per scan type are only updated through their sub-scans
+	subs, err := s.subScanRepo.ListByScan(ctx, scanID)
+	if err != nil {
+		logger.WithError(err).Error("Failed to list sub-scans")
+		return nil, status.Errorf(codes.Internal, "failed to record progress: %v", err)
+	}
+	if len(subs) > 0 {
+		return nil, status.Error(codes.InvalidArgument, "scan_type is required, the scan runs a job per scan type")
+	}
+	if attempt != 1 {
+		return nil, status.Errorf(codes.FailedPrecondition, "scan has no attempt %d", attempt)
 	}
-	logger.Debug("Deleted findings from database")
 
-	// 5. Delete scan from database
-	if err := s.scanRepo.Delete(ctx, scan.ID); err != nil {
-		logger.WithError(err).Error("Failed to delete scan")
+	// Updating the scan is the heartbeat of its runner. Only the progress is
+	// written, the scan may have completed since it was read.
+	scanStatus, err := s.scanRepo.RecordProgress(ctx, scanID, progress)
+	if errors.Is(err, domain.ErrScanNotFound) {
+		return nil, status.Error(codes.NotFound, "scan not found")
+	}
+	if err != nil {
+		logger.WithError(err).Error("Failed to record progress")
+		return nil, status.Errorf(codes.Internal, "failed to record progress: %v", err)
+	}
+	if scanStatus == domain.ScanStatusDeleting {
+		return nil, status.Error(codes.FailedPrecondition, "scan is being deleted")
+	}
+
+	return &pb.ReportProgressResponse{Status: convertScanStatusToProto(scanStatus)}, nil
+}
+
+// DeleteScan marks a scan deleting and returns the operation that tracks the
+// removal of its Kubernetes job, artifacts, findings and row
+func (s *ScanServiceServer) DeleteScan(ctx context.Context, req *pb.DeleteScanRequest) (*pb.DeleteScanResponse, error) {
+	logger := s.logger.WithField("scan_id", req.Id)
+	logger.Info("Deleting scan")
+
+	scanID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid scan_id: %v", err)
+	}
+
+	operation, err := s.operationRepo.StartScanDeletion(ctx, scanID)
+	if errors.Is(err, domain.ErrScanNotFound) {
+		return nil, status.Errorf(codes.NotFound, "scan not found: %v", err)
+	}
+	if errors.Is(err, domain.ErrLegalHold) {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot delete scan: %v", err)
+	}
+	if err != nil {
+		logger.WithError(err).Error("Failed to start scan deletion")
 		return nil, status.Errorf(codes.Internal, "failed to delete scan: %v", err)
 	}
 
-	logger.Info("Scan deleted successfully")
-	return &emptypb.Empty{}, nil
+	logger.WithField("operation_id", operation.ID.String()).Info("Scan deletion started")
+	return &pb.DeleteScanResponse{
+		Operation: convertOperationToProto(operation),
+	}, nil
 }
 
-// DeleteProjectScans deletes all scans for a project
+// DeleteProjectScans marks all scans of a project deleting and returns the
+// operation that tracks their removal
 func (s *ScanServiceServer) DeleteProjectScans(ctx context.Context, req *pb.DeleteProjectScansRequest) (*pb.DeleteProjectScansResponse, error) {
 	logger := s.logger.WithField("project_id", req.ProjectId)
 	logger.Info("Deleting all scans for project")
@@ -435,33 +1102,237 @@ func (s *ScanServiceServer) DeleteProjectScans(ctx context.Context, req *pb.Dele
 		return nil, status.Errorf(codes.InvalidArgument, "invalid project_id: %v", err)
 	}
 
-	// List all scans for this project
-	filter := interfaces.ScanFilter{
-		ProjectID: &projectID,
+	operation, err := s.operationRepo.StartProjectDeletion(ctx, projectID)
+	if errors.Is(err, domain.ErrLegalHold) {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot delete project scans: %v", err)
 	}
-	scans, err := s.scanRepo.List(ctx, filter)
 	if err != nil {
-		logger.WithError(err).Error("Failed to list project scans")
-		return nil, status.Errorf(codes.Internal, "failed to list project scans: %v", err)
+		logger.WithError(err).Error("Failed to start project scans deletion")
+		return nil, status.Errorf(codes.Internal, "failed to delete project scans: %v", err)
+	}
+
+	logger.WithFields(log.Fields{
+		"operation_id": operation.ID.String(),
+		"scan_count":   operation.TotalItems,
+	}).Info("Project scans deletion started")
+	return &pb.DeleteProjectScansResponse{
+		DeletedCount: int32(operation.TotalItems),
+		Operation:    convertOperationToProto(operation),
+	}, nil
+}
+
+// GetOperation retrieves a long-running operation to poll its progress
+func (s *ScanServiceServer) GetOperation(ctx context.Context, req *pb.GetOperationRequest) (*pb.Operation, error) {
+	logger := s.logger.WithField("operation_id", req.Id)
+	logger.Debug("Getting operation")
+
+	operationID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid operation_id: %v", err)
 	}
 
-	logger.WithField("scan_count", len(scans)).Info("Found scans to delete")
+	operation, err := s.operationRepo.Get(ctx, operationID)
+	if err != nil {
+		logger.WithError(err).Error("Failed to get operation")
+		return nil, status.Errorf(codes.NotFound, "operation not found: %v", err)
+	}
+
+	return convertOperationToProto(operation), nil
+}
+
+// GetScanLogs sends the download URL of the archived runner logs, or streams
+// the log of the scan's job while it is still available. With follow set the
+// stream stays open until the job exits.
+func (s *ScanServiceServer) GetScanLogs(req *pb.GetScanLogsRequest, stream pb.ScanService_GetScanLogsServer) error {
+	ctx := stream.Context()
+	logger := s.logger.WithField("scan_id", req.ScanId)
+
+	scanID, err := uuid.Parse(req.ScanId)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid scan_id: %v", err)
+	}
+
+	scan, err := s.scanRepo.Get(ctx, scanID)
+	if err != nil {
+		logger.WithError(err).Error("Failed to get scan")
+		return status.Errorf(codes.NotFound, "scan not found: %v", err)
+	}
+
+	if scan.LogsArtifactID != nil && *scan.LogsArtifactID != "" {
+		artifact, err := s.storageClient.GetArtifact(ctx, *scan.LogsArtifactID)
+		if err != nil {
+			logger.WithError(err).Error("Failed to get logs artifact")
+			return status.Errorf(codes.Internal, "failed to get scan logs: %v", err)
+		}
+		return stream.Send(&pb.ScanLogChunk{
+			DownloadUrl:          artifact.SignedURL,
+			DownloadUrlExpiresAt: timestamppb.New(artifact.Expiration),
+		})
+	}
 
-	deletedCount := 0
-	for _, scan := range scans {
-		// Delete each scan using DeleteScan logic
-		if _, err := s.DeleteScan(ctx, &pb.DeleteScanRequest{Id: scan.ID.String()}); err != nil {
-			logger.WithError(err).WithField("scan_id", scan.ID.String()).Error("Failed to delete scan")
-			// Continue with other scans even if one fails
+	// Scans dispatched before one job per scan type have a single job
+	if scan.JobName != nil && *scan.JobName != "" {
+		follow := req.Follow && !scan.IsTerminal()
+		logs, err := s.jobDispatcher.StreamJobLogs(ctx, domain.JobNamespace(scan.JobNamespace, s.jobNamespace), *scan.JobName, follow)
+		if err != nil {
+			logger.WithError(err).Warn("Failed to stream job logs")
+			return status.Errorf(codes.NotFound, "scan logs not available: %v", err)
+		}
+		defer logs.Close()
+
+		return s.sendLogs(ctx, logger, stream, logs)
+	}
+
+	subs, err := s.subScanRepo.ListByScan(ctx, scanID)
+	if err != nil {
+		logger.WithError(err).Error("Failed to list sub-scans")
+		return status.Errorf(codes.Internal, "failed to get scan logs: %v", err)
+	}
+
+	var selected []*domain.SubScan
+	for _, sub := range subs {
+		if sub.JobName == nil {
 			continue
 		}
-		deletedCount++
+		if req.ScanType == pb.ScanType_SCAN_TYPE_UNSPECIFIED || sub.ScanType == convertScanTypeFromProto(req.ScanType) {
+			selected = append(selected, sub)
+		}
+	}
+	if len(selected) == 0 {
+		if req.ScanType != pb.ScanType_SCAN_TYPE_UNSPECIFIED && len(subs) > 0 {
+			return status.Errorf(codes.NotFound, "scan has no %s job", convertScanTypeFromProto(req.ScanType))
+		}
+		return status.Error(codes.FailedPrecondition, "scan has not started")
 	}
 
-	logger.WithField("deleted_count", deletedCount).Info("Project scans deleted")
-	return &pb.DeleteProjectScansResponse{
-		DeletedCount: int32(deletedCount),
-	}, nil
+	// A single stream can only follow one job
+	if req.Follow && !scan.IsTerminal() && len(selected) > 1 {
+		return status.Error(codes.InvalidArgument, "scan_type is required to follow a scan with several scan types")
+	}
+
+	// Several logs are sent one after the other, each behind a header
+	for _, sub := range selected {
+		subLogger := logger.WithField("scan_type", sub.ScanType)
+
+		if len(selected) > 1 {
+			header := fmt.Sprintf("==> %s (%s) <==\n", sub.ScanType, sub.ScannerName)
+			if err := stream.Send(&pb.ScanLogChunk{Data: []byte(header)}); err != nil {
+				return err
+			}
+		}
+
+		follow := req.Follow && !sub.IsTerminal()
+		logs, err := s.jobDispatcher.StreamJobLogs(ctx, domain.JobNamespace(sub.JobNamespace, s.jobNamespace), *sub.JobName, follow)
+		if err != nil {
+			subLogger.WithError(err).Warn("Failed to stream job logs")
+			if len(selected) == 1 {
+				return status.Errorf(codes.NotFound, "scan logs not available: %v", err)
+			}
+			notice := fmt.Sprintf("[logs not available: %v]\n", err)
+			if err := stream.Send(&pb.ScanLogChunk{Data: []byte(notice)}); err != nil {
+				return err
+			}
+			continue
+		}
+
+		err = s.sendLogs(ctx, subLogger, stream, logs)
+		logs.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sendLogs streams a job log in chunks of at most scanLogChunkSize
+func (s *ScanServiceServer) sendLogs(ctx context.Context, logger *log.Entry, stream pb.ScanService_GetScanLogsServer, logs io.Reader) error {
+	buf := make([]byte, scanLogChunkSize)
+	for {
+		n, err := logs.Read(buf)
+		if n > 0 {
+			if err := stream.Send(&pb.ScanLogChunk{Data: buf[:n]}); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return status.FromContextError(ctx.Err()).Err()
+			}
+			logger.WithError(err).Error("Failed to read job logs")
+			return status.Errorf(codes.Internal, "failed to read scan logs: %v", err)
+		}
+	}
+}
+
+// ListScanners returns the scanner and version that runs each scan type
+func (s *ScanServiceServer) ListScanners(ctx context.Context, req *pb.ListScannersRequest) (*pb.ListScannersResponse, error) {
+	scanners, err := s.scanners.List(ctx)
+	if err != nil {
+		s.logger.WithError(err).Error("Failed to list scanners")
+		return nil, status.Errorf(codes.Internal, "failed to list scanners: %v", err)
+	}
+
+	protoScanners := make([]*pb.Scanner, 0, len(scanners))
+	for i := range scanners {
+		if !scanners[i].Enabled && !req.IncludeDisabled {
+			continue
+		}
+		protoScanners = append(protoScanners, convertScannerToProto(&scanners[i]))
+	}
+
+	return &pb.ListScannersResponse{Scanners: protoScanners}, nil
+}
+
+// SetScanner creates or replaces the scanner of a scan type
+func (s *ScanServiceServer) SetScanner(ctx context.Context, req *pb.SetScannerRequest) (*pb.Scanner, error) {
+	scanner := &interfaces.ScannerConfig{
+		ScanType: convertScanTypeFromProto(req.ScanType),
+		Name:     req.Name,
+		Image:    req.Image,
+		Version:  req.Version,
+		Digest:   req.Digest,
+		Enabled:  req.Enabled,
+		Args:     req.Args,
+		Env:      req.Env,
+		Resources: interfaces.JobResources{
+			Requests: interfaces.ResourceList{CPU: req.RequestsCpu, Memory: req.RequestsMemory},
+			Limits:   interfaces.ResourceList{CPU: req.LimitsCpu, Memory: req.LimitsMemory},
+		},
+		Timeout: time.Duration(req.TimeoutSeconds) * time.Second,
+	}
+	logger := s.logger.WithFields(log.Fields{
+		"scan_type": scanner.ScanType,
+		"scanner":   scanner.Name,
+		"version":   scanner.Version,
+	})
+
+	err := s.scanners.Set(ctx, scanner)
+	if errors.Is(err, domain.ErrInvalidScanner) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, domain.ErrScannersReadOnly) {
+		return nil, status.Error(codes.FailedPrecondition, "scanners come from the configuration; set SCANNER_REGISTRY_SOURCE=database to manage them")
+	}
+	if err != nil {
+		logger.WithError(err).Error("Failed to set scanner")
+		return nil, status.Errorf(codes.Internal, "failed to set scanner: %v", err)
+	}
+
+	logger.Info("Scanner set")
+	return convertScannerToProto(scanner), nil
+}
+
+// runnerFailureReason returns the reason of a failure reported by a runner,
+// which is a runner error if it reported none
+func runnerFailureReason(reason domain.FailureReason) domain.FailureReason {
+	if reason == "" {
+		return domain.FailureReasonRunner
+	}
+	return reason
 }
 
 // Conversion functions
@@ -483,7 +1354,7 @@ func convertScanToProto(scan *domain.Scan) *pb.Scan {
 	// Convert scan types
 	protoScan.ScanTypes = make([]pb.ScanType, len(scan.ScanTypes))
 	for i, st := range scan.ScanTypes {
-		protoScan.ScanTypes[i] = convertScanTypeToProto(st)
+		protoScan.ScanTypes[i] = ConvertScanTypeToProto(st)
 	}
 
 	// Add completion time if available
@@ -496,6 +1367,13 @@ func convertScanToProto(scan *domain.Scan) *pb.Scan {
 		protoScan.ErrorMessage = *scan.ErrorMessage
 	}
 
+	protoScan.FailureReason = string(scan.FailureReason)
+	protoScan.Progress = convertProgressToProto(scan.Progress)
+
+	if scan.JobSettings != nil {
+		protoScan.JobSettings = convertJobSettingsToProto(scan.JobSettings)
+	}
+
 	// Build findings by severity map
 	protoScan.FindingsBySeverity = map[string]int32{
 		"critical": int32(scan.CriticalCount),
@@ -504,14 +1382,93 @@ func convertScanToProto(scan *domain.Scan) *pb.Scan {
 		"low":      int32(scan.LowCount),
 	}
 
+	for _, sub := range scan.SubScans {
+		protoScan.SubScans = append(protoScan.SubScans, convertSubScanToProto(sub))
+	}
+
 	return protoScan
 }
 
+func convertSubScanToProto(sub *domain.SubScan) *pb.SubScan {
+	protoSub := &pb.SubScan{
+		ScanType:      ConvertScanTypeToProto(sub.ScanType),
+		ScannerName:   sub.ScannerName,
+		ScannerImage:  sub.ScannerImage,
+		Status:        convertScanStatusToProto(sub.Status),
+		JobName:       stringValue(sub.JobName),
+		TotalFindings: int32(sub.FindingsCount),
+		ErrorMessage:  stringValue(sub.ErrorMessage),
+		FailureReason: string(sub.FailureReason),
+		Attempt:       int32(sub.Attempt),
+		FindingsBySeverity: map[string]int32{
+			"critical": int32(sub.CriticalCount),
+			"high":     int32(sub.HighCount),
+			"medium":   int32(sub.MediumCount),
+			"low":      int32(sub.LowCount),
+		},
+	}
+
+	if sub.StartedAt != nil {
+		protoSub.StartedAt = timestamppb.New(*sub.StartedAt)
+	}
+	if sub.CompletedAt != nil {
+		protoSub.CompletedAt = timestamppb.New(*sub.CompletedAt)
+	}
+	if sub.RetryAt != nil {
+		protoSub.RetryAt = timestamppb.New(*sub.RetryAt)
+	}
+	if sub.LastHeartbeatAt != nil {
+		protoSub.LastHeartbeatAt = timestamppb.New(*sub.LastHeartbeatAt)
+	}
+	protoSub.Progress = convertProgressToProto(sub.Progress)
+
+	return protoSub
+}
+
+func convertScannerToProto(scanner *interfaces.ScannerConfig) *pb.Scanner {
+	return &pb.Scanner{
+		ScanType:       ConvertScanTypeToProto(scanner.ScanType),
+		Name:           scanner.Name,
+		Image:          scanner.Image,
+		Version:        scanner.Version,
+		Digest:         scanner.Digest,
+		Enabled:        scanner.Enabled,
+		TimeoutSeconds: int64(scanner.Timeout / time.Second),
+	}
+}
+
+func convertOperationToProto(operation *domain.Operation) *pb.Operation {
+	protoOperation := &pb.Operation{
+		Id:             operation.ID.String(),
+		Type:           string(operation.Type),
+		Status:         string(operation.Status),
+		TotalItems:     int32(operation.TotalItems),
+		CompletedItems: int32(operation.CompletedItems),
+		FailedItems:    int32(operation.FailedItems),
+		LastError:      stringValue(operation.LastError),
+		CreatedAt:      timestamppb.New(operation.CreatedAt),
+		UpdatedAt:      timestamppb.New(operation.UpdatedAt),
+		Done:           operation.IsDone(),
+	}
+
+	if operation.ScanID != nil {
+		protoOperation.ScanId = operation.ScanID.String()
+	}
+	if operation.ProjectID != nil {
+		protoOperation.ProjectId = operation.ProjectID.String()
+	}
+	if operation.CompletedAt != nil {
+		protoOperation.CompletedAt = timestamppb.New(*operation.CompletedAt)
+	}
+
+	return protoOperation
+}
+
 func convertFindingToProto(finding *domain.Finding) *pb.Finding {
 	return &pb.Finding{
 		Id:          finding.ID.String(),
 		ScanId:      finding.ScanID.String(),
-		ScanType:    convertScanTypeToProto(finding.ScanType),
+		ScanType:    ConvertScanTypeToProto(finding.ScanType),
 		Severity:    convertSeverityToProto(finding.Severity),
 		Title:       finding.Title,
 		Description: finding.Description,
@@ -523,25 +1480,26 @@ func convertFindingToProto(finding *domain.Finding) *pb.Finding {
 	}
 }
 
-func convertFindingFromProto(protoFinding *pb.Finding, scanID uuid.UUID) *domain.Finding {
+func convertFindingFromProto(protoFinding *pb.Finding, scan *domain.Scan) *domain.Finding {
 	scanType := convertScanTypeFromProto(protoFinding.ScanType)
 
 	// Derive tool name from scan type (required field in database)
 	toolName := deriveToolName(scanType)
 
 	return &domain.Finding{
-		ID:          uuid.New(),
-		ScanID:      scanID,
-		ScanType:    scanType,
-		ToolName:    toolName,
-		Severity:    convertSeverityFromProto(protoFinding.Severity),
-		Title:       protoFinding.Title,
-		Description: protoFinding.Description,
-		FilePath:    protoFinding.FilePath,
-		StartLine:   int(protoFinding.LineNumber),
-		CodeSnippet: protoFinding.CodeSnippet,
-		CVEID:       protoFinding.CveId,
-		CWEID:       protoFinding.CweId,
+		ID:            uuid.New(),
+		ScanID:        scan.ID,
+		ScanCreatedAt: scan.CreatedAt,
+		ScanType:      scanType,
+		ToolName:      toolName,
+		Severity:      convertSeverityFromProto(protoFinding.Severity),
+		Title:         protoFinding.Title,
+		Description:   protoFinding.Description,
+		FilePath:      protoFinding.FilePath,
+		StartLine:     int(protoFinding.LineNumber),
+		CodeSnippet:   protoFinding.CodeSnippet,
+		CVEID:         protoFinding.CveId,
+		CWEID:         protoFinding.CweId,
 	}
 }
 
@@ -561,7 +1519,9 @@ func deriveToolName(scanType domain.ScanType) string {
 	}
 }
 
-func convertScanTypeToProto(st domain.ScanType) pb.ScanType {
+// ConvertScanTypeToProto maps a domain scan type to its proto enum value, or
+// SCAN_TYPE_UNSPECIFIED for an unknown one
+func ConvertScanTypeToProto(st domain.ScanType) pb.ScanType {
 	switch st {
 	case domain.ScanTypeSAST:
 		return pb.ScanType_SAST
@@ -591,6 +1551,14 @@ func convertScanTypeFromProto(st pb.ScanType) domain.ScanType {
 	}
 }
 
+func convertScanTypesFromProto(sts []pb.ScanType) []domain.ScanType {
+	scanTypes := make([]domain.ScanType, len(sts))
+	for i, st := range sts {
+		scanTypes[i] = convertScanTypeFromProto(st)
+	}
+	return scanTypes
+}
+
 func convertScanStatusToProto(status domain.ScanStatus) pb.ScanStatus {
 	switch status {
 	case domain.ScanStatusQueued:
@@ -599,10 +1567,14 @@ func convertScanStatusToProto(status domain.ScanStatus) pb.ScanStatus {
 		return pb.ScanStatus_RUNNING
 	case domain.ScanStatusCompleted:
 		return pb.ScanStatus_COMPLETED
+	case domain.ScanStatusPartial:
+		return pb.ScanStatus_PARTIAL
 	case domain.ScanStatusFailed:
 		return pb.ScanStatus_FAILED
 	case domain.ScanStatusCancelled:
 		return pb.ScanStatus_CANCELLED
+	case domain.ScanStatusDeleting:
+		return pb.ScanStatus_DELETING
 	default:
 		return pb.ScanStatus_SCAN_STATUS_UNSPECIFIED
 	}
@@ -616,10 +1588,57 @@ func convertScanStatusFromProto(status pb.ScanStatus) domain.ScanStatus {
 		return domain.ScanStatusRunning
 	case pb.ScanStatus_COMPLETED:
 		return domain.ScanStatusCompleted
+	case pb.ScanStatus_PARTIAL:
+		return domain.ScanStatusPartial
 	case pb.ScanStatus_FAILED:
 		return domain.ScanStatusFailed
 	case pb.ScanStatus_CANCELLED:
 		return domain.ScanStatusCancelled
+	case pb.ScanStatus_DELETING:
+		return domain.ScanStatusDeleting
+	default:
+		return ""
+	}
+}
+
+func convertProgressToProto(progress *domain.Progress) *pb.Progress {
+	if progress == nil {
+		return nil
+	}
+	return &pb.Progress{
+		Phase:     convertProgressPhaseToProto(progress.Phase),
+		Scanner:   progress.Scanner,
+		Percent:   int32(progress.Percent),
+		Message:   progress.Message,
+		UpdatedAt: timestamppb.New(progress.UpdatedAt),
+	}
+}
+
+func convertProgressPhaseToProto(phase domain.ProgressPhase) pb.ProgressPhase {
+	switch phase {
+	case domain.ProgressPhaseDownloading:
+		return pb.ProgressPhase_DOWNLOADING
+	case domain.ProgressPhaseCloning:
+		return pb.ProgressPhase_CLONING
+	case domain.ProgressPhaseScanning:
+		return pb.ProgressPhase_SCANNING
+	case domain.ProgressPhaseUploading:
+		return pb.ProgressPhase_UPLOADING
+	default:
+		return pb.ProgressPhase_PROGRESS_PHASE_UNSPECIFIED
+	}
+}
+
+func convertProgressPhaseFromProto(phase pb.ProgressPhase) domain.ProgressPhase {
+	switch phase {
+	case pb.ProgressPhase_DOWNLOADING:
+		return domain.ProgressPhaseDownloading
+	case pb.ProgressPhase_CLONING:
+		return domain.ProgressPhaseCloning
+	case pb.ProgressPhase_SCANNING:
+		return domain.ProgressPhaseScanning
+	case pb.ProgressPhase_UPLOADING:
+		return domain.ProgressPhaseUploading
 	default:
 		return ""
 	}
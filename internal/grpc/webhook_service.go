@@ -0,0 +1,354 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/cloud-scan/cloudscan-orchestrator/generated/proto"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/webhooks"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// WebhookServiceServer implements the gRPC WebhookService interface
+type WebhookServiceServer struct {
+	pb.UnimplementedWebhookServiceServer
+	webhookRepo interfaces.WebhookRepository
+	endpoints   webhooks.EndpointPolicy
+	logger      *log.Entry
+}
+
+// NewWebhookServiceServer creates a new webhook service server
+func NewWebhookServiceServer(webhookRepo interfaces.WebhookRepository, endpoints webhooks.EndpointPolicy) *WebhookServiceServer {
+	return &WebhookServiceServer{
+		webhookRepo: webhookRepo,
+		endpoints:   endpoints,
+		logger:      log.WithField("component", "grpc-webhook-service"),
+	}
+}
+
+// CreateWebhookSubscription creates a subscription. The signing secret is only returned here.
+func (s *WebhookServiceServer) CreateWebhookSubscription(ctx context.Context, req *pb.CreateWebhookSubscriptionRequest) (*pb.WebhookSubscription, error) {
+	logger := s.logger.WithFields(log.Fields{
+		"org_id": req.OrganizationId,
+		"url":    req.Url,
+	})
+	logger.Info("Creating webhook subscription")
+
+	orgID, err := uuid.Parse(req.OrganizationId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid organization_id: %v", err)
+	}
+	if err := s.validateWebhookURL(ctx, req.Url); err != nil {
+		return nil, err
+	}
+	eventTypes, err := convertEventTypesFromProto(req.EventTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := req.Secret
+	if secret == "" {
+		secret, err = generateWebhookSecret()
+		if err != nil {
+			logger.WithError(err).Error("Failed to generate webhook secret")
+			return nil, status.Errorf(codes.Internal, "failed to generate webhook secret: %v", err)
+		}
+	}
+
+	now := time.Now()
+	subscription := &domain.WebhookSubscription{
+		ID:             uuid.New(),
+		OrganizationID: orgID,
+		URL:            req.Url,
+		Secret:         secret,
+		EventTypes:     eventTypes,
+		Enabled:        true,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+
+	if err := s.webhookRepo.CreateSubscription(ctx, subscription); err != nil {
+		logger.WithError(err).Error("Failed to save webhook subscription to database")
+		return nil, status.Errorf(codes.Internal, "failed to create webhook subscription: %v", err)
+	}
+
+	logger.WithField("subscription_id", subscription.ID.String()).Info("Webhook subscription created successfully")
+
+	protoSubscription := convertSubscriptionToProto(subscription)
+	protoSubscription.Secret = subscription.Secret
+	return protoSubscription, nil
+}
+
+// ListWebhookSubscriptions lists the subscriptions of an organization
+func (s *WebhookServiceServer) ListWebhookSubscriptions(ctx context.Context, req *pb.ListWebhookSubscriptionsRequest) (*pb.ListWebhookSubscriptionsResponse, error) {
+	orgID, err := uuid.Parse(req.OrganizationId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid organization_id: %v", err)
+	}
+
+	subscriptions, err := s.webhookRepo.ListSubscriptions(ctx, orgID)
+	if err != nil {
+		s.logger.WithError(err).Error("Failed to list webhook subscriptions")
+		return nil, status.Errorf(codes.Internal, "failed to list webhook subscriptions: %v", err)
+	}
+
+	protoSubscriptions := make([]*pb.WebhookSubscription, len(subscriptions))
+	for i, subscription := range subscriptions {
+		protoSubscriptions[i] = convertSubscriptionToProto(subscription)
+	}
+
+	return &pb.ListWebhookSubscriptionsResponse{
+		Subscriptions: protoSubscriptions,
+	}, nil
+}
+
+// UpdateWebhookSubscription replaces a subscription's URL, event filter and enabled flag.
+// The secret is rotated only when a new one is given.
+func (s *WebhookServiceServer) UpdateWebhookSubscription(ctx context.Context, req *pb.UpdateWebhookSubscriptionRequest) (*pb.WebhookSubscription, error) {
+	logger := s.logger.WithField("subscription_id", req.Id)
+	logger.Info("Updating webhook subscription")
+
+	subscriptionID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid subscription_id: %v", err)
+	}
+	if err := s.validateWebhookURL(ctx, req.Url); err != nil {
+		return nil, err
+	}
+	eventTypes, err := convertEventTypesFromProto(req.EventTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription, err := s.webhookRepo.GetSubscription(ctx, subscriptionID)
+	if err != nil {
+		logger.WithError(err).Error("Failed to get webhook subscription")
+		return nil, status.Errorf(codes.NotFound, "webhook subscription not found: %v", err)
+	}
+
+	subscription.URL = req.Url
+	subscription.EventTypes = eventTypes
+	subscription.Enabled = req.Enabled
+	if req.Secret != "" {
+		subscription.Secret = req.Secret
+	}
+
+	if err := s.webhookRepo.UpdateSubscription(ctx, subscription); err != nil {
+		logger.WithError(err).Error("Failed to update webhook subscription")
+		return nil, status.Errorf(codes.Internal, "failed to update webhook subscription: %v", err)
+	}
+
+	logger.Info("Webhook subscription updated successfully")
+	return convertSubscriptionToProto(subscription), nil
+}
+
+// DeleteWebhookSubscription deletes a subscription and its deliveries
+func (s *WebhookServiceServer) DeleteWebhookSubscription(ctx context.Context, req *pb.DeleteWebhookSubscriptionRequest) (*emptypb.Empty, error) {
+	logger := s.logger.WithField("subscription_id", req.Id)
+	logger.Info("Deleting webhook subscription")
+
+	subscriptionID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid subscription_id: %v", err)
+	}
+
+	if err := s.webhookRepo.DeleteSubscription(ctx, subscriptionID); err != nil {
+		logger.WithError(err).Error("Failed to delete webhook subscription")
+		return nil, status.Errorf(codes.NotFound, "webhook subscription not found: %v", err)
+	}
+
+	logger.Info("Webhook subscription deleted successfully")
+	return &emptypb.Empty{}, nil
+}
+
+// ListWebhookDeliveries lists deliveries of an organization or subscription, newest first
+func (s *WebhookServiceServer) ListWebhookDeliveries(ctx context.Context, req *pb.ListWebhookDeliveriesRequest) (*pb.ListWebhookDeliveriesResponse, error) {
+	filter := interfaces.WebhookDeliveryFilter{
+		Limit: int(req.PageSize),
+	}
+
+	if req.OrganizationId != "" {
+		orgID, err := uuid.Parse(req.OrganizationId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid organization_id: %v", err)
+		}
+		filter.OrganizationID = &orgID
+	}
+
+	if req.SubscriptionId != "" {
+		subscriptionID, err := uuid.Parse(req.SubscriptionId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid subscription_id: %v", err)
+		}
+		filter.SubscriptionID = &subscriptionID
+	}
+
+	if filter.OrganizationID == nil && filter.SubscriptionID == nil {
+		return nil, status.Error(codes.InvalidArgument, "organization_id or subscription_id is required")
+	}
+
+	if req.Status != "" {
+		deliveryStatus := domain.WebhookDeliveryStatus(req.Status)
+		filter.Status = &deliveryStatus
+	}
+
+	deliveries, err := s.webhookRepo.ListDeliveries(ctx, filter)
+	if err != nil {
+		s.logger.WithError(err).Error("Failed to list webhook deliveries")
+		return nil, status.Errorf(codes.Internal, "failed to list webhook deliveries: %v", err)
+	}
+
+	protoDeliveries := make([]*pb.WebhookDelivery, len(deliveries))
+	for i, delivery := range deliveries {
+		protoDeliveries[i] = convertDeliveryToProto(delivery)
+	}
+
+	return &pb.ListWebhookDeliveriesResponse{
+		Deliveries: protoDeliveries,
+		TotalCount: int32(len(protoDeliveries)),
+	}, nil
+}
+
+// GetWebhookDelivery retrieves a delivery with its attempt log
+func (s *WebhookServiceServer) GetWebhookDelivery(ctx context.Context, req *pb.GetWebhookDeliveryRequest) (*pb.WebhookDelivery, error) {
+	deliveryID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid delivery_id: %v", err)
+	}
+
+	return s.getDeliveryWithLog(ctx, deliveryID)
+}
+
+// ReplayWebhookDelivery queues a delivery for immediate redelivery, whatever its current status
+func (s *WebhookServiceServer) ReplayWebhookDelivery(ctx context.Context, req *pb.ReplayWebhookDeliveryRequest) (*pb.WebhookDelivery, error) {
+	logger := s.logger.WithField("delivery_id", req.Id)
+	logger.Info("Replaying webhook delivery")
+
+	deliveryID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid delivery_id: %v", err)
+	}
+
+	if err := s.webhookRepo.ResetDelivery(ctx, deliveryID); err != nil {
+		logger.WithError(err).Error("Failed to replay webhook delivery")
+		return nil, status.Errorf(codes.NotFound, "webhook delivery not found: %v", err)
+	}
+
+	logger.Info("Webhook delivery queued for replay")
+	return s.getDeliveryWithLog(ctx, deliveryID)
+}
+
+func (s *WebhookServiceServer) getDeliveryWithLog(ctx context.Context, deliveryID uuid.UUID) (*pb.WebhookDelivery, error) {
+	delivery, err := s.webhookRepo.GetDelivery(ctx, deliveryID)
+	if err != nil {
+		s.logger.WithError(err).WithField("delivery_id", deliveryID.String()).Error("Failed to get webhook delivery")
+		return nil, status.Errorf(codes.NotFound, "webhook delivery not found: %v", err)
+	}
+
+	attempts, err := s.webhookRepo.ListAttempts(ctx, deliveryID)
+	if err != nil {
+		s.logger.WithError(err).WithField("delivery_id", deliveryID.String()).Error("Failed to list webhook delivery attempts")
+		return nil, status.Errorf(codes.Internal, "failed to list delivery attempts: %v", err)
+	}
+
+	protoDelivery := convertDeliveryToProto(delivery)
+	protoDelivery.AttemptLog = make([]*pb.WebhookDeliveryAttempt, len(attempts))
+	for i, attempt := range attempts {
+		protoDelivery.AttemptLog[i] = convertDeliveryAttemptToProto(attempt)
+	}
+
+	return protoDelivery, nil
+}
+
+// validateWebhookURL requires an absolute URL the endpoint policy allows:
+// https, and a host resolving to public addresses only, unless configured
+// otherwise
+func (s *WebhookServiceServer) validateWebhookURL(ctx context.Context, rawURL string) error {
+	if rawURL == "" {
+		return status.Error(codes.InvalidArgument, "url is required")
+	}
+	if err := s.endpoints.CheckURL(ctx, rawURL); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return nil
+}
+
+func convertEventTypesFromProto(eventTypes []string) ([]domain.EventType, error) {
+	result := make([]domain.EventType, len(eventTypes))
+	for i, t := range eventTypes {
+		eventType := domain.EventType(t)
+		if !eventType.IsValid() {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown event type: %q", t)
+		}
+		result[i] = eventType
+	}
+	return result, nil
+}
+
+func convertSubscriptionToProto(subscription *domain.WebhookSubscription) *pb.WebhookSubscription {
+	protoSubscription := &pb.WebhookSubscription{
+		Id:             subscription.ID.String(),
+		OrganizationId: subscription.OrganizationID.String(),
+		Url:            subscription.URL,
+		Enabled:        subscription.Enabled,
+		CreatedAt:      timestamppb.New(subscription.CreatedAt),
+		UpdatedAt:      timestamppb.New(subscription.UpdatedAt),
+	}
+
+	protoSubscription.EventTypes = make([]string, len(subscription.EventTypes))
+	for i, t := range subscription.EventTypes {
+		protoSubscription.EventTypes[i] = string(t)
+	}
+
+	return protoSubscription
+}
+
+func convertDeliveryToProto(delivery *domain.WebhookDelivery) *pb.WebhookDelivery {
+	protoDelivery := &pb.WebhookDelivery{
+		Id:             delivery.ID.String(),
+		SubscriptionId: delivery.SubscriptionID.String(),
+		EventId:        delivery.EventID.String(),
+		EventType:      string(delivery.EventType),
+		Status:         string(delivery.Status),
+		Attempts:       int32(delivery.Attempts),
+		NextAttemptAt:  timestamppb.New(delivery.NextAttemptAt),
+		CreatedAt:      timestamppb.New(delivery.CreatedAt),
+		Payload:        string(delivery.Payload),
+	}
+
+	if delivery.LastStatusCode != nil {
+		protoDelivery.LastStatusCode = int32(*delivery.LastStatusCode)
+	}
+	if delivery.LastError != nil {
+		protoDelivery.LastError = *delivery.LastError
+	}
+	if delivery.DeliveredAt != nil {
+		protoDelivery.DeliveredAt = timestamppb.New(*delivery.DeliveredAt)
+	}
+
+	return protoDelivery
+}
+
+func convertDeliveryAttemptToProto(attempt *domain.WebhookDeliveryAttempt) *pb.WebhookDeliveryAttempt {
+	protoAttempt := &pb.WebhookDeliveryAttempt{
+		Attempt:     int32(attempt.Attempt),
+		DurationMs:  attempt.DurationMs,
+		AttemptedAt: timestamppb.New(attempt.AttemptedAt),
+	}
+
+	if attempt.StatusCode != nil {
+		protoAttempt.StatusCode = int32(*attempt.StatusCode)
+	}
+	if attempt.Error != nil {
+		protoAttempt.Error = *attempt.Error
+	}
+
+	return protoAttempt
+}
@@ -0,0 +1,25 @@
+package health
+
+import (
+	"sync"
+	"time"
+)
+
+// heartbeats holds the last heartbeat of every worker
+var heartbeats sync.Map // worker name -> time.Time
+
+// Beat records that the worker is alive. Periodic workers call it at the start
+// of every cycle and for every item or batch they process, so a long cycle
+// isn't taken for a stalled worker.
+func Beat(worker string) {
+	heartbeats.Store(worker, time.Now())
+}
+
+// LastBeat returns the worker's last heartbeat
+func LastBeat(worker string) (time.Time, bool) {
+	last, ok := heartbeats.Load(worker)
+	if !ok {
+		return time.Time{}, false
+	}
+	return last.(time.Time), true
+}
@@ -0,0 +1,104 @@
+package health
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// serviceName is reported by the HTTP endpoints
+const serviceName = "orchestrator"
+
+// statusResponse is the body of the health endpoints. Fields that don't apply
+// to an endpoint are omitted.
+type statusResponse struct {
+	Status    string                  `json:"status"`
+	Service   string                  `json:"service"`
+	Version   string                  `json:"version"`
+	Commit    string                  `json:"commit"`
+	BuildDate string                  `json:"buildDate"`
+	Failing   []string                `json:"failing,omitempty"` // critical dependencies that are down
+	Stalled   []string                `json:"stalled,omitempty"` // workers that stopped beating
+	Checks    map[string]CheckResult  `json:"checks,omitempty"`
+	Workers   map[string]WorkerResult `json:"workers,omitempty"`
+}
+
+// Register adds the health endpoints to the mux:
+//
+//	GET /health - liveness: 503 if a worker stalled
+//	GET /ready  - readiness: 503 if a critical dependency is down
+//	GET /status - every dependency and worker in detail, always 200
+func (m *Monitor) Register(mux *http.ServeMux) {
+	mux.HandleFunc("GET /health", m.handleHealth)
+	mux.HandleFunc("GET /ready", m.handleReady)
+	mux.HandleFunc("GET /status", m.handleStatus)
+}
+
+func (m *Monitor) handleHealth(w http.ResponseWriter, r *http.Request) {
+	live, stalled := m.Live()
+
+	resp := m.newResponse("healthy")
+	resp.Stalled = stalled
+	if !live {
+		resp.Status = "unhealthy"
+		writeJSON(w, http.StatusServiceUnavailable, resp)
+		return
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (m *Monitor) handleReady(w http.ResponseWriter, r *http.Request) {
+	ready, failing := m.Ready()
+
+	resp := m.newResponse("ready")
+	resp.Failing = failing
+	if !ready {
+		resp.Status = "not_ready"
+		writeJSON(w, http.StatusServiceUnavailable, resp)
+		return
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// handleStatus reports "healthy", "degraded" (a non-critical dependency is
+// down) or "unhealthy" (not ready or not live)
+func (m *Monitor) handleStatus(w http.ResponseWriter, r *http.Request) {
+	live, stalled := m.Live()
+	ready, failing := m.Ready()
+
+	resp := m.newResponse("healthy")
+	resp.Failing = failing
+	resp.Stalled = stalled
+	resp.Checks = m.Checks()
+	resp.Workers = m.Workers()
+
+	switch {
+	case !live || !ready:
+		resp.Status = "unhealthy"
+	default:
+		for _, result := range resp.Checks {
+			if result.Status != StatusUp {
+				resp.Status = "degraded"
+				break
+			}
+		}
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (m *Monitor) newResponse(status string) *statusResponse {
+	return &statusResponse{
+		Status:    status,
+		Service:   serviceName,
+		Version:   m.config.Build.Version,
+		Commit:    m.config.Build.Commit,
+		BuildDate: m.config.Build.BuildDate,
+	}
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,290 @@
+package health
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Status is the state of a dependency or worker
+type Status string
+
+const (
+	StatusUp      Status = "up"
+	StatusDown    Status = "down"
+	StatusUnknown Status = "unknown" // not checked yet
+)
+
+// CheckFunc returns an error if a dependency is unusable
+type CheckFunc func(ctx context.Context) error
+
+// CheckResult is the cached outcome of a dependency check
+type CheckResult struct {
+	Status    Status     `json:"status"`
+	Critical  bool       `json:"critical"`
+	Error     string     `json:"error,omitempty"`
+	LatencyMS int64      `json:"latency_ms"`
+	CheckedAt *time.Time `json:"checked_at,omitempty"`
+}
+
+// WorkerResult is the heartbeat state of a background worker
+type WorkerResult struct {
+	Status        Status     `json:"status"`
+	Interval      string     `json:"interval"`
+	LastHeartbeat *time.Time `json:"last_heartbeat,omitempty"`
+}
+
+// MonitorConfig configures a Monitor
+type MonitorConfig struct {
+	Interval       time.Duration // How often dependencies are checked
+	Timeout        time.Duration // Timeout of a single check
+	HeartbeatGrace time.Duration // Slack on top of two missed worker heartbeats
+	Build          BuildInfo     // Reported by the HTTP endpoints
+}
+
+// BuildInfo identifies the running build
+type BuildInfo struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit"`
+	BuildDate string `json:"buildDate"`
+}
+
+type check struct {
+	name     string
+	critical bool
+	fn       CheckFunc
+}
+
+// Monitor periodically checks dependencies and watches worker heartbeats.
+// Probes read the cached results, so they are cheap and never block on a
+// slow dependency. The gRPC health service mirrors readiness.
+type Monitor struct {
+	config     MonitorConfig
+	checks     []check
+	workers    map[string]time.Duration // expected heartbeat interval per worker
+	startedAt  time.Time
+	grpcHealth *grpchealth.Server
+	logger     *log.Entry
+	stopChan   chan struct{}
+
+	mu       sync.RWMutex
+	results  map[string]CheckResult
+	stopping bool
+}
+
+// NewMonitor creates a new health monitor
+func NewMonitor(config MonitorConfig) *Monitor {
+	grpcHealth := grpchealth.NewServer()
+	grpcHealth.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+
+	return &Monitor{
+		config:     config,
+		workers:    make(map[string]time.Duration),
+		startedAt:  time.Now(),
+		grpcHealth: grpcHealth,
+		logger:     log.WithField("component", "health-monitor"),
+		stopChan:   make(chan struct{}),
+		results:    make(map[string]CheckResult),
+	}
+}
+
+// AddCheck registers a dependency check. Failing critical checks make the
+// orchestrator not ready; failing non-critical checks only degrade its status.
+// Checks must be added before Start.
+func (m *Monitor) AddCheck(name string, critical bool, fn CheckFunc) {
+	m.checks = append(m.checks, check{name: name, critical: critical, fn: fn})
+	m.results[name] = CheckResult{Status: StatusUnknown, Critical: critical}
+}
+
+// WatchWorker registers a worker that calls Beat at least once per interval.
+// Workers must be watched before Start.
+func (m *Monitor) WatchWorker(name string, interval time.Duration) {
+	m.workers[name] = interval
+}
+
+// GRPCHealthServer returns the grpc.health.v1 service reporting readiness
+func (m *Monitor) GRPCHealthServer() healthpb.HealthServer {
+	return m.grpcHealth
+}
+
+// Start begins checking dependencies
+func (m *Monitor) Start(ctx context.Context) {
+	m.logger.WithFields(log.Fields{
+		"checks":   len(m.checks),
+		"workers":  len(m.workers),
+		"interval": m.config.Interval,
+	}).Info("Starting health monitor")
+
+	ticker := time.NewTicker(m.config.Interval)
+	defer ticker.Stop()
+
+	// Run immediately on start
+	m.runChecks(ctx)
+
+	for {
+		select {
+		case <-ticker.C:
+			m.runChecks(ctx)
+		case <-m.stopChan:
+			m.logger.Info("Health monitor stopped")
+			return
+		case <-ctx.Done():
+			m.logger.Info("Health monitor context cancelled")
+			return
+		}
+	}
+}
+
+// Stop stops the monitor and reports not ready, so load balancers drain the
+// replica during graceful shutdown
+func (m *Monitor) Stop() {
+	m.mu.Lock()
+	m.stopping = true
+	m.mu.Unlock()
+
+	m.grpcHealth.Shutdown()
+	close(m.stopChan)
+}
+
+// runChecks runs all checks concurrently and caches their results
+func (m *Monitor) runChecks(ctx context.Context) {
+	var wg sync.WaitGroup
+	results := make([]CheckResult, len(m.checks))
+
+	for i, c := range m.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = m.runCheck(ctx, c)
+		}()
+	}
+	wg.Wait()
+
+	m.mu.Lock()
+	for i, c := range m.checks {
+		previous := m.results[c.name]
+		m.results[c.name] = results[i]
+
+		if results[i].Status != previous.Status {
+			logger := m.logger.WithFields(log.Fields{
+				"dependency": c.name,
+				"critical":   c.critical,
+			})
+			if results[i].Status == StatusDown {
+				logger.WithField("error", results[i].Error).Warn("Dependency is down")
+			} else if previous.Status == StatusDown {
+				logger.Info("Dependency recovered")
+			}
+		}
+	}
+	m.mu.Unlock()
+
+	if ready, _ := m.Ready(); ready {
+		m.grpcHealth.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	} else {
+		m.grpcHealth.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	}
+}
+
+// runCheck runs a single check with the configured timeout
+func (m *Monitor) runCheck(ctx context.Context, c check) (result CheckResult) {
+	ctx, cancel := context.WithTimeout(ctx, m.config.Timeout)
+	defer cancel()
+
+	start := time.Now()
+	result = CheckResult{Status: StatusUp, Critical: c.critical}
+	defer func() {
+		result.LatencyMS = time.Since(start).Milliseconds()
+		result.CheckedAt = &start
+	}()
+
+	// A panicking check reports its dependency as down instead of crashing
+	defer func() {
+		if r := recover(); r != nil {
+			result.Status = StatusDown
+			result.Error = "check panicked"
+		}
+	}()
+
+	if err := c.fn(ctx); err != nil {
+		result.Status = StatusDown
+		result.Error = err.Error()
+	}
+	return result
+}
+
+// Ready reports whether every critical dependency is up. It also returns the
+// names of the critical dependencies that are not.
+func (m *Monitor) Ready() (bool, []string) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if m.stopping {
+		return false, nil
+	}
+
+	var failing []string
+	for name, result := range m.results {
+		if result.Critical && result.Status != StatusUp {
+			failing = append(failing, name)
+		}
+	}
+	sort.Strings(failing)
+
+	return len(failing) == 0, failing
+}
+
+// Live reports whether every watched worker is beating. It also returns the
+// names of the stalled workers.
+func (m *Monitor) Live() (bool, []string) {
+	var stalled []string
+	for name, result := range m.Workers() {
+		if result.Status == StatusDown {
+			stalled = append(stalled, name)
+		}
+	}
+	sort.Strings(stalled)
+
+	return len(stalled) == 0, stalled
+}
+
+// Checks returns the cached dependency check results
+func (m *Monitor) Checks() map[string]CheckResult {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	results := make(map[string]CheckResult, len(m.results))
+	for name, result := range m.results {
+		results[name] = result
+	}
+	return results
+}
+
+// Workers returns the heartbeat state of the watched workers. A worker is
+// stalled when it missed two heartbeats plus the grace period; a worker that
+// never beat is measured from the monitor's creation.
+func (m *Monitor) Workers() map[string]WorkerResult {
+	now := time.Now()
+	results := make(map[string]WorkerResult, len(m.workers))
+
+	for name, interval := range m.workers {
+		result := WorkerResult{Status: StatusUp, Interval: interval.String()}
+
+		since := m.startedAt
+		if last, ok := LastBeat(name); ok {
+			result.LastHeartbeat = &last
+			since = last
+		}
+		if now.Sub(since) > 2*interval+m.config.HeartbeatGrace {
+			result.Status = StatusDown
+		}
+
+		results[name] = result
+	}
+	return results
+}
@@ -0,0 +1,68 @@
+package health
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+)
+
+// RedisCheck returns a check that sends PING to Redis, authenticating first if
+// a password is set. It speaks the Redis protocol directly, so checking Redis
+// doesn't require a client library.
+func RedisCheck(addr, password string, useTLS bool) CheckFunc {
+	return func(ctx context.Context) error {
+		var dialer interface {
+			DialContext(ctx context.Context, network, addr string) (net.Conn, error)
+		} = &net.Dialer{}
+		if useTLS {
+			dialer = &tls.Dialer{}
+		}
+
+		conn, err := dialer.DialContext(ctx, "tcp", addr)
+		if err != nil {
+			return fmt.Errorf("failed to connect to redis: %w", err)
+		}
+		defer conn.Close()
+
+		if deadline, ok := ctx.Deadline(); ok {
+			conn.SetDeadline(deadline)
+		} else {
+			conn.SetDeadline(time.Now().Add(5 * time.Second))
+		}
+
+		reader := bufio.NewReader(conn)
+		if password != "" {
+			if err := redisCommand(conn, reader, "+OK", "AUTH", password); err != nil {
+				return err
+			}
+		}
+		return redisCommand(conn, reader, "+PONG", "PING")
+	}
+}
+
+// redisCommand sends a command and checks the reply line
+func redisCommand(conn net.Conn, reader *bufio.Reader, want string, args ...string) error {
+	var cmd strings.Builder
+	fmt.Fprintf(&cmd, "*%d\r\n", len(args))
+	for _, arg := range args {
+		fmt.Fprintf(&cmd, "$%d\r\n%s\r\n", len(arg), arg)
+	}
+
+	if _, err := conn.Write([]byte(cmd.String())); err != nil {
+		return fmt.Errorf("failed to send %s to redis: %w", args[0], err)
+	}
+
+	reply, err := reader.ReadString('\n')
+	if err != nil {
+		return fmt.Errorf("failed to read redis %s reply: %w", args[0], err)
+	}
+	reply = strings.TrimRight(reply, "\r\n")
+	if reply != want {
+		return fmt.Errorf("unexpected redis %s reply: %s", args[0], reply)
+	}
+	return nil
+}
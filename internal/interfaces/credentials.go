@@ -0,0 +1,27 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+)
+
+// KeyManager wraps the data keys that encrypt repository credentials at rest.
+// Implementations back onto a key management service; the key itself never
+// leaves it.
+type KeyManager interface {
+	// KeyID identifies the key new data keys are wrapped with
+	KeyID() string
+
+	// WrapKey encrypts a data key with the current key
+	WrapKey(ctx context.Context, dataKey []byte) ([]byte, error)
+
+	// UnwrapKey decrypts a data key wrapped with the key keyID
+	UnwrapKey(ctx context.Context, keyID string, wrappedKey []byte) ([]byte, error)
+}
+
+// GitHubAppClient mints the installation tokens of GitHub Apps
+type GitHubAppClient interface {
+	// InstallationToken returns a token with read access to the contents of
+	// the installation's repositories, and when it expires
+	InstallationToken(ctx context.Context, appID, installationID int64, privateKey []byte) (string, time.Time, error)
+}
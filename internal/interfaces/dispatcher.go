@@ -2,17 +2,48 @@ package interfaces
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
 
 	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+	"github.com/google/uuid"
 	batchv1 "k8s.io/api/batch/v1"
 )
 
+// ErrJobNotFound is returned when a Kubernetes Job doesn't exist
+var ErrJobNotFound = errors.New("job not found")
+
+// ErrPodNotFound is returned when a Kubernetes Job has no pod, e.g. once it
+// was deleted or before one was scheduled
+var ErrPodNotFound = errors.New("pod not found")
+
 // JobDispatcher defines the interface for Kubernetes job operations
 type JobDispatcher interface {
-	// CreateJob creates a new Kubernetes Job for a scan
-	CreateJob(ctx context.Context, scan *domain.Scan) (*batchv1.Job, error)
+	// CreateJob creates the Kubernetes Job running one scanner of a scan. The
+	// job settings of the scan and its project, if any, take precedence over
+	// the scanner's and the job config's. The scan's credentials Secret, if
+	// it has one, is mounted into the runner. Retries, attempts after the
+	// first, get a job of their own.
+	CreateJob(ctx context.Context, scan *domain.Scan, scanner *ScannerConfig, settings *domain.JobSettings, credentialsSecret string, attempt int) (*batchv1.Job, error)
+
+	// DefaultJobSettings returns the settings the scanner's jobs get from the
+	// scanner and the job config, before job settings override them
+	DefaultJobSettings(scanner *ScannerConfig) domain.JobSettings
+
+	// CreateCredentialsSecret creates the Secret holding the git credentials
+	// of a scan, shared by its jobs, or replaces its data if it exists
+	CreateCredentialsSecret(ctx context.Context, scan *domain.Scan, credentials *GitCredentials) (*CredentialsSecret, error)
 
-	// GetJob retrieves a Kubernetes Job by name
+	// DeleteCredentialsSecret deletes a credentials Secret; a missing Secret isn't an error
+	DeleteCredentialsSecret(ctx context.Context, namespace, name string) error
+
+	// ListCredentialsSecrets lists the credentials Secrets in a namespace
+	ListCredentialsSecrets(ctx context.Context, namespace string) ([]CredentialsSecret, error)
+
+	// GetJob retrieves a Kubernetes Job by name, or returns ErrJobNotFound
 	GetJob(ctx context.Context, namespace, name string) (*batchv1.Job, error)
 
 	// DeleteJob deletes a Kubernetes Job
@@ -21,12 +52,17 @@ type JobDispatcher interface {
 	// ListJobs lists all jobs in a namespace with optional label selector
 	ListJobs(ctx context.Context, namespace string, labelSelector string) (*batchv1.JobList, error)
 
-	// GetJobStatus returns the current status of a job
+	// GetJobStatus returns the current status of a job, or ErrJobNotFound
 	GetJobStatus(ctx context.Context, namespace, name string) (*JobStatus, error)
 
-	// GetJobLogs retrieves logs from the job's pod
+	// GetJobLogs retrieves the last lines of the job pod's log
 	GetJobLogs(ctx context.Context, namespace, name string) (string, error)
 
+	// StreamJobLogs opens the complete log of the job's pod. With follow set
+	// the stream delivers new lines until the container exits. ErrPodNotFound
+	// is returned if the job has no pod.
+	StreamJobLogs(ctx context.Context, namespace, name string, follow bool) (io.ReadCloser, error)
+
 	// WatchJob watches for job status changes
 	WatchJob(ctx context.Context, namespace, name string) (<-chan *JobStatus, error)
 
@@ -34,6 +70,31 @@ type JobDispatcher interface {
 	CancelJob(ctx context.Context, namespace, name string) error
 }
 
+// Types of GitCredentials, as the runner sees them
+const (
+	GitCredentialsSSH   = "ssh"
+	GitCredentialsHTTPS = "https"
+)
+
+// GitCredentials are the credentials the runners of a scan clone its
+// repository with
+type GitCredentials struct {
+	Type       string     // GitCredentialsSSH or GitCredentialsHTTPS
+	PrivateKey []byte     // SSH
+	KnownHosts []byte     // SSH; empty accepts the host key on first use
+	Username   string     // HTTPS
+	Password   string     // HTTPS
+	ExpiresAt  *time.Time // Set for tokens minted for the scan
+}
+
+// CredentialsSecret identifies the credentials Secret of a scan
+type CredentialsSecret struct {
+	Namespace string
+	Name      string
+	ScanID    uuid.UUID
+	CreatedAt time.Time
+}
+
 // JobStatus represents the status of a Kubernetes Job
 type JobStatus struct {
 	Name       string
@@ -45,6 +106,31 @@ type JobStatus struct {
 	CompletionTime *string
 	Conditions []JobCondition
 	PodName    string
+	Pod        *PodStatus // Latest pod of the job, nil if it has none
+	CreatedAt  time.Time
+}
+
+// PodStatus is the state of a job's pod and its runner container, from which
+// failures are classified
+type PodStatus struct {
+	Name      string
+	Phase     string // Pending, Running, Succeeded, Failed or Unknown
+	Reason    string // e.g. Evicted
+	Message   string
+	CreatedAt time.Time
+
+	// Unschedulable is set while the scheduler finds no node for the pod
+	Unschedulable     bool
+	SchedulingMessage string
+
+	// Runner container. A waiting container has a reason such as
+	// ImagePullBackOff; a terminated one a reason such as OOMKilled or Error.
+	WaitingReason    string
+	WaitingMessage   string
+	Terminated       bool
+	TerminatedReason string
+	ExitCode         int32
+	StartedAt        *time.Time // Set while the runner container runs
 }
 
 // JobCondition represents a condition in the job status
@@ -57,12 +143,72 @@ type JobCondition struct {
 
 // ScannerConfig represents configuration for a specific scanner
 type ScannerConfig struct {
-	Name    string
-	Image   string
-	Version string
-	Enabled bool
-	Args    []string
-	Env     map[string]string
+	ScanType  domain.ScanType // Scan type the scanner runs
+	Name      string
+	Image     string
+	Version   string
+	Digest    string // Pins the image, e.g. sha256:...; optional
+	Enabled   bool
+	Args      []string
+	Env       map[string]string
+	Resources JobResources  // Unset values fall back to JobConfig.Resources
+	Timeout   time.Duration // Job deadline; 0 falls back to JobConfig.ActiveDeadlineSeconds
+}
+
+// ImageRef returns the image reference the scanner's jobs run, pinned to the
+// digest if there is one
+func (s *ScannerConfig) ImageRef() string {
+	ref := s.Image + ":" + s.Version
+	if s.Digest != "" {
+		ref += "@" + s.Digest
+	}
+	return ref
+}
+
+// Validate checks that the scanner can be run; domain.ErrInvalidScanner is
+// wrapped if it can't
+func (s *ScannerConfig) Validate() error {
+	switch s.ScanType {
+	case domain.ScanTypeSAST, domain.ScanTypeSCA, domain.ScanTypeSecrets, domain.ScanTypeLicense:
+	default:
+		return fmt.Errorf("%w: unknown scan type %q", domain.ErrInvalidScanner, s.ScanType)
+	}
+	if s.Name == "" || s.Image == "" || s.Version == "" {
+		return fmt.Errorf("%w: scanner for %s needs a name, an image and a version", domain.ErrInvalidScanner, s.ScanType)
+	}
+	if s.Digest != "" && !strings.Contains(s.Digest, ":") {
+		return fmt.Errorf("%w: scanner digest for %s must be of the form <algorithm>:<hex>", domain.ErrInvalidScanner, s.ScanType)
+	}
+	if s.Timeout < 0 {
+		return fmt.Errorf("%w: scanner timeout for %s must not be negative", domain.ErrInvalidScanner, s.ScanType)
+	}
+
+	// Resources are checked like job settings, which override them
+	resources := domain.JobSettings{
+		RequestsCPU:    s.Resources.Requests.CPU,
+		RequestsMemory: s.Resources.Requests.Memory,
+		LimitsCPU:      s.Resources.Limits.CPU,
+		LimitsMemory:   s.Resources.Limits.Memory,
+	}
+	if err := resources.Validate(); err != nil {
+		return fmt.Errorf("%w: scanner for %s: %v", domain.ErrInvalidScanner, s.ScanType, err)
+	}
+	return nil
+}
+
+// ScannerRegistry resolves the scanner that runs each scan type
+type ScannerRegistry interface {
+	// Get returns the enabled scanner for the scan type, or
+	// domain.ErrScannerUnavailable if there is none
+	Get(ctx context.Context, scanType domain.ScanType) (*ScannerConfig, error)
+
+	// List returns every registered scanner, enabled or not, ordered by scan type
+	List(ctx context.Context) ([]ScannerConfig, error)
+
+	// Set creates or replaces the scanner of its scan type. The scanner is
+	// validated first. domain.ErrScannersReadOnly is returned if the scanners
+	// come from the configuration.
+	Set(ctx context.Context, scanner *ScannerConfig) error
 }
 
 // JobConfig represents configuration for creating Kubernetes Jobs
@@ -76,10 +222,41 @@ type JobConfig struct {
 	TTLSecondsAfterFinished *int32
 	BackoffLimit            *int32
 	ActiveDeadlineSeconds   *int64
+	EphemeralStorage        string // Size of the checkout volume; empty is unlimited
+	Security                PodSecurity
+	NetworkPolicy           NetworkPolicyConfig
 	OrchestratorEndpoint    string // gRPC endpoint for runner to call back
 	StorageServiceEndpoint  string // gRPC endpoint for storage service
 }
 
+// PodSecurity represents the security context of runner pods
+type PodSecurity struct {
+	RunAsNonRoot                 bool
+	RunAsUser                    *int64
+	RunAsGroup                   *int64
+	FSGroup                      *int64
+	ReadOnlyRootFilesystem       bool
+	DropAllCapabilities          bool
+	SeccompProfile               string // RuntimeDefault, Unconfined, or empty to leave unset
+	AutomountServiceAccountToken bool
+}
+
+// NetworkPolicyConfig represents the egress policy created with every runner
+// job. Runners may reach the cluster DNS, the orchestrator, the storage service, the git
+// host of their scan, the object store serving their source archive and the
+// allowed CIDRs, nothing else.
+type NetworkPolicyConfig struct {
+	Enabled              bool
+	OrchestratorSelector string // Label selector of the orchestrator's pods, in any namespace
+	StorageSelector      string // Label selector of the storage service's pods, in any namespace
+	DNSNamespaceSelector string // Label selector of the cluster DNS namespace
+	DNSSelector          string // Label selector of the cluster DNS pods
+	GitPorts             []int32
+	AllowedCIDRs         []string
+	GitHosts             []string // If set, the only git hosts allowed
+	BlockedCIDRs         []string // Git and object store hosts resolving into these, or private and reserved ranges, are refused
+}
+
 // JobResources represents resource requests and limits for a job
 type JobResources struct {
 	Requests ResourceList
@@ -0,0 +1,25 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+)
+
+// EventPublisher receives scan lifecycle and finding events relayed from the outbox
+type EventPublisher interface {
+	// Publish hands the event over for delivery. A returned error makes the relay
+	// retry the event later, so events may be received more than once and
+	// implementations should deduplicate on the event ID.
+	Publish(ctx context.Context, event *domain.Event) error
+}
+
+// MessageBroker sends encoded events to an event bus such as NATS or Kafka
+type MessageBroker interface {
+	// Publish sends a message on the subject and returns once the broker has
+	// accepted it. Headers carry metadata such as the event ID and content type.
+	Publish(ctx context.Context, subject string, data []byte, headers map[string]string) error
+
+	// Close flushes pending messages and releases the connection
+	Close() error
+}
@@ -0,0 +1,40 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+)
+
+// CommitStatusClient reports scan results to a Git provider (or any HTTP endpoint)
+// as a commit status / pull request check
+type CommitStatusClient interface {
+	// Name identifies the client in logs
+	Name() string
+
+	// Supports returns true if the client can report statuses for the repository
+	Supports(repositoryURL string) bool
+
+	// PostStatus publishes the status for the commit
+	PostStatus(ctx context.Context, status *CommitStatus) error
+}
+
+// CommitStatus is the provider-independent result reported for a commit
+type CommitStatus struct {
+	RepositoryURL string // Normalized host/owner/repo
+	CommitSHA     string
+	State         CommitState
+	Context       string // Name of the check, e.g. "cloudscan"
+	Description   string // Severity summary
+	TargetURL     string // Link to the scan in the UI
+	Scan          *domain.Scan
+}
+
+// CommitState is the outcome reported for a commit
+type CommitState string
+
+const (
+	CommitStateSuccess CommitState = "success" // Scan completed below the failure threshold
+	CommitStateFailure CommitState = "failure" // Scan completed with findings at or above the threshold
+	CommitStateError   CommitState = "error"   // Scan failed or was cancelled
+)
@@ -19,17 +19,51 @@ type ScanRepository interface {
 	// Update updates an existing scan
 	Update(ctx context.Context, scan *domain.Scan) error
 
+	// UpdateOnce updates a scan and records the runner request in the same
+	// transaction. domain.ErrRequestReplayed is returned, and nothing
+	// updated, if the request was recorded before.
+	UpdateOnce(ctx context.Context, request *domain.RunnerRequest, scan *domain.Scan) error
+
 	// List retrieves scans with optional filters
 	List(ctx context.Context, filter ScanFilter) ([]*domain.Scan, error)
 
-	// Delete deletes a scan (soft delete)
+	// Delete deletes a scan and its findings in a single transaction. A scan
+	// under legal hold is refused with an error wrapping domain.ErrLegalHold.
 	Delete(ctx context.Context, id uuid.UUID) error
 
 	// UpdateStatus updates only the status of a scan
 	UpdateStatus(ctx context.Context, id uuid.UUID, status domain.ScanStatus) error
 
+	// RecordProgress records a runner's progress on the scan if it is running,
+	// without touching other columns, and returns the scan's status
+	RecordProgress(ctx context.Context, id uuid.UUID, progress *domain.Progress) (domain.ScanStatus, error)
+
+	// StatusSummary counts scans by status and finds the oldest queued scan
+	StatusSummary(ctx context.Context) (*ScanStatusSummary, error)
+
 	// GetByJobName retrieves a scan by Kubernetes job name
 	GetByJobName(ctx context.Context, jobName string) (*domain.Scan, error)
+
+	// ClaimLogArchives locks finished scans completed after completedAfter
+	// whose logs aren't archived yet, and pushes their claim back by lease so
+	// other replicas skip them while they are archived
+	ClaimLogArchives(ctx context.Context, completedAfter, now time.Time, lease time.Duration, limit int) ([]*domain.Scan, error)
+
+	// SetLogsArtifact records the artifact holding the scan's archived logs.
+	// domain.ErrScanNotFound is returned if the scan is gone or being deleted.
+	SetLogsArtifact(ctx context.Context, id uuid.UUID, artifactID string) error
+
+	// LockCommit serializes work on a commit of a project across replicas,
+	// e.g. checking for an existing scan and creating one. It waits for the
+	// lock; unlock must be called once done.
+	LockCommit(ctx context.Context, projectID uuid.UUID, commitSHA string) (unlock func(), err error)
+}
+
+// ScanStatusSummary is the number of scans per status and the creation time of
+// the oldest queued scan (nil when the queue is empty)
+type ScanStatusSummary struct {
+	Counts         map[domain.ScanStatus]int
+	OldestQueuedAt *time.Time
 }
 
 // ScanFilter represents filter criteria for listing scans
@@ -38,6 +72,7 @@ type ScanFilter struct {
 	ProjectID      *uuid.UUID
 	UserID         *uuid.UUID
 	Status         *domain.ScanStatus
+	CommitSHA      *string
 	ScanTypes      []domain.ScanType
 	CreatedBefore  *time.Time
 	Limit          int
@@ -47,8 +82,25 @@ type ScanFilter struct {
 
 // FindingRepository defines the interface for finding persistence operations
 type FindingRepository interface {
-	// CreateBatch creates multiple findings in a single transaction
-	CreateBatch(ctx context.Context, findings []*domain.Finding) error
+	// CreateBatch creates multiple findings in a single transaction. A
+	// non-nil runner request is recorded with them; domain.ErrRequestReplayed
+	// is returned, and nothing created, if it was recorded before. The target
+	// names the scan, scan type and attempt the findings come from; they are
+	// refused with domain.ErrStaleAttempt or domain.ErrSubScanNotRunning, or
+	// for scans without sub-scans domain.ErrScanFinished or
+	// domain.ErrScanDeleting, like the batches of UploadBatch.
+	CreateBatch(ctx context.Context, request *domain.RunnerRequest, target *domain.FindingUpload, findings []*domain.Finding) error
+
+	// UploadBatch creates the findings of batch sequence of an upload in one
+	// transaction and returns the upload's last committed sequence. A batch
+	// committed before returns ErrBatchAlreadyUploaded; one that doesn't
+	// follow the last ErrBatchOutOfSequence. Batches of a sub-scan that isn't
+	// running return ErrSubScanNotRunning, of a replaced attempt
+	// ErrStaleAttempt.
+	UploadBatch(ctx context.Context, upload *domain.FindingUpload, sequence int64, findings []*domain.Finding) (int64, error)
+
+	// LastUploadedSequence returns the last committed sequence of an upload
+	LastUploadedSequence(ctx context.Context, upload *domain.FindingUpload) (int64, error)
 
 	// GetByScanID retrieves all findings for a scan
 	GetByScanID(ctx context.Context, scanID uuid.UUID) ([]*domain.Finding, error)
@@ -61,6 +113,11 @@ type FindingRepository interface {
 
 	// DeleteByScanID deletes all findings for a scan
 	DeleteByScanID(ctx context.Context, scanID uuid.UUID) error
+
+	// ReconcileOrphans processes up to limit findings written before findings
+	// referenced scans by creation time: the scan's creation time is filled in,
+	// or the finding is deleted if its scan no longer exists
+	ReconcileOrphans(ctx context.Context, limit int) (backfilled int, deleted int, err error)
 }
 
 // FindingFilter represents filter criteria for listing findings
@@ -100,4 +157,440 @@ type OrganizationRepository interface {
 	GetBySlug(ctx context.Context, slug string) (*domain.Organization, error)
 	Update(ctx context.Context, org *domain.Organization) error
 	List(ctx context.Context) ([]*domain.Organization, error)
-}
\ No newline at end of file
+}
+
+// ScheduleRepository defines the interface for scan schedule persistence
+type ScheduleRepository interface {
+	// Create creates a new schedule
+	Create(ctx context.Context, schedule *domain.ScanSchedule) error
+
+	// Get retrieves a schedule by ID
+	Get(ctx context.Context, id uuid.UUID) (*domain.ScanSchedule, error)
+
+	// Update updates an existing schedule's definition and next run time
+	Update(ctx context.Context, schedule *domain.ScanSchedule) error
+
+	// List retrieves schedules with optional filters
+	List(ctx context.Context, filter ScheduleFilter) ([]*domain.ScanSchedule, error)
+
+	// Delete deletes a schedule
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// ListDue retrieves enabled schedules whose next run time is at or before now
+	ListDue(ctx context.Context, now time.Time, limit int) ([]*domain.ScanSchedule, error)
+
+	// ClaimRun atomically moves next_run_at from expected to next.
+	// Returns false if another replica already claimed this run.
+	ClaimRun(ctx context.Context, id uuid.UUID, expected, next time.Time) (bool, error)
+
+	// EnqueueRun claims a run like ClaimRun and, in the same transaction,
+	// creates the scan and records it as the schedule's last run. Returns
+	// false, and creates nothing, if another replica already claimed the run.
+	EnqueueRun(ctx context.Context, id uuid.UUID, expected, next time.Time, scan *domain.Scan) (bool, error)
+}
+
+// ScheduleFilter represents filter criteria for listing schedules
+type ScheduleFilter struct {
+	OrganizationID *uuid.UUID
+	ProjectID      *uuid.UUID
+	Enabled        *bool
+	Limit          int
+	Offset         int
+}
+
+// IntegrationRepository defines the interface for repository integration persistence
+type IntegrationRepository interface {
+	// Create creates a new repository integration
+	Create(ctx context.Context, integration *domain.RepositoryIntegration) error
+
+	// Get retrieves an integration by ID
+	Get(ctx context.Context, id uuid.UUID) (*domain.RepositoryIntegration, error)
+
+	// GetByRepository retrieves the integration for a normalized repository URL
+	GetByRepository(ctx context.Context, provider domain.GitProvider, repositoryURL string) (*domain.RepositoryIntegration, error)
+
+	// List retrieves integrations for an organization, optionally narrowed to a project
+	List(ctx context.Context, organizationID uuid.UUID, projectID *uuid.UUID) ([]*domain.RepositoryIntegration, error)
+
+	// Delete deletes an integration
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// WebhookRepository defines the interface for webhook subscriptions and the delivery outbox
+type WebhookRepository interface {
+	// CreateSubscription creates a new webhook subscription
+	CreateSubscription(ctx context.Context, subscription *domain.WebhookSubscription) error
+
+	// GetSubscription retrieves a subscription by ID
+	GetSubscription(ctx context.Context, id uuid.UUID) (*domain.WebhookSubscription, error)
+
+	// UpdateSubscription updates a subscription's URL, secret, event filter and enabled flag
+	UpdateSubscription(ctx context.Context, subscription *domain.WebhookSubscription) error
+
+	// ListSubscriptions retrieves the subscriptions of an organization
+	ListSubscriptions(ctx context.Context, organizationID uuid.UUID) ([]*domain.WebhookSubscription, error)
+
+	// DeleteSubscription deletes a subscription and its deliveries
+	DeleteSubscription(ctx context.Context, id uuid.UUID) error
+
+	// EnqueueDeliveries inserts deliveries into the outbox, skipping events
+	// already enqueued for the subscription
+	EnqueueDeliveries(ctx context.Context, deliveries []*domain.WebhookDelivery) error
+
+	// ClaimDueDeliveries locks pending deliveries that are due and pushes their
+	// next attempt back by lease so other replicas skip them while they are sent.
+	// The subscription URL and secret are loaded into the returned deliveries.
+	ClaimDueDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*domain.WebhookDelivery, error)
+
+	// RecordAttempt stores the attempt in the delivery log and updates the delivery state
+	RecordAttempt(ctx context.Context, delivery *domain.WebhookDelivery, attempt *domain.WebhookDeliveryAttempt) error
+
+	// GetDelivery retrieves a delivery by ID
+	GetDelivery(ctx context.Context, id uuid.UUID) (*domain.WebhookDelivery, error)
+
+	// ListDeliveries retrieves deliveries with optional filters, newest first
+	ListDeliveries(ctx context.Context, filter WebhookDeliveryFilter) ([]*domain.WebhookDelivery, error)
+
+	// ListAttempts retrieves the delivery log of a delivery
+	ListAttempts(ctx context.Context, deliveryID uuid.UUID) ([]*domain.WebhookDeliveryAttempt, error)
+
+	// ResetDelivery makes a delivery pending again for immediate redelivery
+	ResetDelivery(ctx context.Context, id uuid.UUID) error
+}
+
+// WebhookDeliveryFilter represents filter criteria for listing webhook deliveries
+type WebhookDeliveryFilter struct {
+	OrganizationID *uuid.UUID
+	SubscriptionID *uuid.UUID
+	Status         *domain.WebhookDeliveryStatus
+	Limit          int
+	Offset         int
+}
+
+// CommitStatusRepository defines the interface for commit statuses waiting to
+// be reported to Git providers
+type CommitStatusRepository interface {
+	// EnqueueDeliveries inserts deliveries, skipping events already enqueued
+	// for the client
+	EnqueueDeliveries(ctx context.Context, deliveries []*domain.CommitStatusDelivery) error
+
+	// ClaimDueDeliveries locks pending deliveries that are due and pushes their
+	// next attempt back by lease so other replicas skip them while they are sent
+	ClaimDueDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*domain.CommitStatusDelivery, error)
+
+	// RecordAttempt updates the delivery state after an attempt
+	RecordAttempt(ctx context.Context, delivery *domain.CommitStatusDelivery) error
+}
+
+// EventOutboxRepository defines the interface for the transactional event outbox.
+// Events are written by ScanRepository and FindingRepository in the same
+// transaction as the change they describe.
+type EventOutboxRepository interface {
+	// ClaimPending locks unpublished events that are due, oldest first, and pushes
+	// their next attempt back by lease so other replicas skip them while they are relayed
+	ClaimPending(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*domain.OutboxEvent, error)
+
+	// MarkPublished records that the event has been handed to every sink
+	MarkPublished(ctx context.Context, id uuid.UUID, publishedAt time.Time) error
+
+	// MarkFailed records a failed relay attempt and schedules the next one
+	MarkFailed(ctx context.Context, id uuid.UUID, lastError string, nextAttemptAt time.Time) error
+
+	// DeletePublished removes events published before the given time
+	DeletePublished(ctx context.Context, before time.Time) (int, error)
+}
+
+// PartitionRepository manages the monthly range partitions of the scans table.
+// Partitions are named scans_YYYY_MM.
+type PartitionRepository interface {
+	// List returns the attached partitions ordered by lower bound
+	List(ctx context.Context) ([]*domain.Partition, error)
+
+	// CreateMonth creates the partition for the month starting at month, unless
+	// it already exists
+	CreateMonth(ctx context.Context, month time.Time) error
+
+	// ArchiveFindings moves the findings and sub-scans of the partition's scans
+	// into the tables <partition>_findings and <partition>_sub_scans of the
+	// schema. Both reference their scan, so they must be moved or deleted
+	// before the partition can be detached. It returns the findings moved.
+	ArchiveFindings(ctx context.Context, name, schema string) (int, error)
+
+	// DeleteFindings deletes the findings and sub-scans of the partition's
+	// scans and returns the findings deleted
+	DeleteFindings(ctx context.Context, name string) (int, error)
+
+	// HasHeldScans returns true if a legal hold covers any of the partition's scans
+	HasHeldScans(ctx context.Context, name string) (bool, error)
+
+	// ArtifactIDs returns the storage artifacts of the partition's scans, so
+	// they can be deleted before the partition expires
+	ArtifactIDs(ctx context.Context, name string) ([]string, error)
+
+	// Detach detaches the partition without blocking writes to scans. A detach
+	// that was interrupted is finalized. The partition is recorded until it
+	// is archived or dropped.
+	Detach(ctx context.Context, partition *domain.Partition) error
+
+	// ListDetached returns the partitions detached by Detach but neither
+	// archived nor dropped yet
+	ListDetached(ctx context.Context) ([]string, error)
+
+	// Archive moves a detached partition into the schema, creating it if needed
+	Archive(ctx context.Context, name, schema string) error
+
+	// Drop drops a detached partition
+	Drop(ctx context.Context, name string) error
+
+	// TryLock takes a session lock so only one replica maintains partitions at a
+	// time. It returns false if another replica holds the lock.
+	TryLock(ctx context.Context) (unlock func(), locked bool, err error)
+}
+
+// OperationRepository defines the interface for long-running operations and the
+// scan deletions they track
+type OperationRepository interface {
+	// Get retrieves an operation by ID
+	Get(ctx context.Context, id uuid.UUID) (*domain.Operation, error)
+
+	// StartScanDeletion marks the scan deleting and creates its delete_scan
+	// operation in one transaction. If the scan is already being deleted its
+	// operation is returned instead, and a deletion that gave up is retried.
+	// A scan under legal hold is refused with an error wrapping domain.ErrLegalHold.
+	StartScanDeletion(ctx context.Context, scanID uuid.UUID) (*domain.Operation, error)
+
+	// StartProjectDeletion marks every scan of the project deleting and creates a
+	// delete_project_scans operation in one transaction. Scans that are already
+	// being deleted stay with their operation. The deletion is refused with an
+	// error wrapping domain.ErrLegalHold if the project, its organization or
+	// any of its scans is under legal hold.
+	StartProjectDeletion(ctx context.Context, projectID uuid.UUID) (*domain.Operation, error)
+
+	// StartRetentionDeletion marks the scans deleting and creates an
+	// apply_retention operation in one transaction. Only scans still in a
+	// final state and not under legal hold are included.
+	StartRetentionDeletion(ctx context.Context, scanIDs []uuid.UUID) (*domain.Operation, error)
+
+	// ClaimDueScanDeletions locks pending deletions that are due and pushes their
+	// next attempt back by lease so other replicas skip them while they run
+	ClaimDueScanDeletions(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*domain.ScanDeletion, error)
+
+	// CompleteScanDeletion deletes the scan with its findings and the deletion,
+	// and counts it as completed on the operation, in one transaction. A scan
+	// placed under legal hold meanwhile is refused with an error wrapping
+	// domain.ErrLegalHold.
+	CompleteScanDeletion(ctx context.Context, deletion *domain.ScanDeletion) error
+
+	// RetryScanDeletion records a failed attempt and schedules the next one
+	RetryScanDeletion(ctx context.Context, deletion *domain.ScanDeletion, lastError string, nextAttemptAt time.Time) error
+
+	// FailScanDeletion gives up on the deletion and counts it as failed on the
+	// operation. The scan stays deleting; deleting it again retries.
+	FailScanDeletion(ctx context.Context, deletion *domain.ScanDeletion, lastError string) error
+
+	// RefuseScanDeletion drops a deletion that a legal hold refuses and counts
+	// it as failed on the operation. The scan gets back the status it had
+	// before it was marked deleting, with a scan event, in the same transaction.
+	RefuseScanDeletion(ctx context.Context, deletion *domain.ScanDeletion, lastError string) error
+}
+
+// RetentionRepository defines the interface for retention policies and the
+// scans they expire
+type RetentionRepository interface {
+	// Set creates the policy of the organization or project, or replaces the
+	// rules of the existing one. ID and CreatedAt are set from the stored policy.
+	Set(ctx context.Context, policy *domain.RetentionPolicy) error
+
+	// Get retrieves a policy by ID
+	Get(ctx context.Context, id uuid.UUID) (*domain.RetentionPolicy, error)
+
+	// List retrieves policies with optional filters
+	List(ctx context.Context, filter RetentionPolicyFilter) ([]*domain.RetentionPolicy, error)
+
+	// Delete deletes a policy; its scans fall back to the next broader policy
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// ListExpiredScans retrieves scans in a final state that no rule of their
+	// policy keeps and no legal hold covers, oldest first
+	ListExpiredScans(ctx context.Context, query ExpiredScansQuery) ([]*domain.Scan, error)
+}
+
+// RetentionPolicyFilter represents filter criteria for listing retention policies
+type RetentionPolicyFilter struct {
+	OrganizationID *uuid.UUID
+	ProjectID      *uuid.UUID
+	Limit          int
+}
+
+// ExpiredScansQuery selects a page of scans expired by retention policies.
+// Pages continue after the scan identified by AfterCreatedAt and AfterID.
+type ExpiredScansQuery struct {
+	OrganizationID *uuid.UUID
+	ProjectID      *uuid.UUID
+	Default        domain.RetentionPolicy // Applies to scans without an organization or project policy
+	Now            time.Time
+	AfterCreatedAt time.Time
+	AfterID        uuid.UUID
+	Limit          int
+}
+
+// LegalHoldRepository defines the interface for legal holds. Placing and
+// releasing a hold is recorded in audit_logs in the same transaction.
+type LegalHoldRepository interface {
+	// Place creates an active hold. A scan hold takes its project from the
+	// scan, which is locked so the hold can't race a deletion being started;
+	// domain.ErrScanNotFound is returned if the scan doesn't exist or belongs
+	// to another organization or project. domain.ErrProjectNotFound and
+	// domain.ErrOrganizationNotFound are returned for a project or
+	// organization without a row or scans, or a project of another organization.
+	Place(ctx context.Context, hold *domain.LegalHold, source domain.AuditSource) error
+
+	// Release releases an active hold. domain.ErrLegalHoldReleased is returned
+	// if it was already released.
+	Release(ctx context.Context, id, releasedBy uuid.UUID, reason string, source domain.AuditSource) (*domain.LegalHold, error)
+
+	// Get retrieves a hold by ID
+	Get(ctx context.Context, id uuid.UUID) (*domain.LegalHold, error)
+
+	// List retrieves holds with optional filters, newest first
+	List(ctx context.Context, filter LegalHoldFilter) ([]*domain.LegalHold, error)
+
+	// GetActiveForScan returns an active hold covering the scan, or nil if there is none
+	GetActiveForScan(ctx context.Context, scanID uuid.UUID) (*domain.LegalHold, error)
+}
+
+// LegalHoldFilter represents filter criteria for listing legal holds
+type LegalHoldFilter struct {
+	OrganizationID *uuid.UUID
+	ProjectID      *uuid.UUID // Matches project holds and scan holds of the project
+	ScanID         *uuid.UUID
+	ActiveOnly     bool
+	Limit          int
+}
+
+// SubScanRepository defines the interface for the per-scan-type jobs of a
+// scan. Every write aggregates the sub-scans into the parent scan in the same
+// transaction and writes the scan event to the outbox if its status changed.
+type SubScanRepository interface {
+	// Create records the sub-scans of a dispatched scan and aggregates them
+	// into the scan, which also gets the given job namespace
+	Create(ctx context.Context, scan *domain.Scan, subs []*domain.SubScan) error
+
+	// ListByScan returns the scan's sub-scans ordered by scan type
+	ListByScan(ctx context.Context, scanID uuid.UUID) ([]*domain.SubScan, error)
+
+	// Update updates a sub-scan and returns its parent scan as aggregated.
+	// A scan that already reached a terminal status keeps it.
+	Update(ctx context.Context, sub *domain.SubScan) (*domain.Scan, error)
+
+	// UpdateFromRunner applies a report of the runner of attempt like Update
+	// and records the runner request, if not nil, in the same transaction.
+	// Nothing is updated, and domain.ErrRequestReplayed returned, if the
+	// request was recorded before; domain.ErrStaleAttempt if attempt isn't
+	// the sub-scan's current one; domain.ErrSubScanNotRunning if the sub-scan
+	// finished or is queued for a retry.
+	UpdateFromRunner(ctx context.Context, request *domain.RunnerRequest, attempt int, sub *domain.SubScan) (*domain.Scan, error)
+
+	// UpdateRunning updates a sub-scan like Update if it is still running the
+	// attempt it was read with. domain.ErrStaleAttempt or
+	// domain.ErrSubScanNotRunning is returned, and nothing updated, if it was
+	// retried or its runner reported the outcome meanwhile.
+	UpdateRunning(ctx context.Context, sub *domain.SubScan) (*domain.Scan, error)
+
+	// RecordRetry records the dispatch of a sub-scan's retry like Update and
+	// deletes the findings of its earlier attempts in the same transaction
+	RecordRetry(ctx context.Context, sub *domain.SubScan) (*domain.Scan, error)
+
+	// ListRetriesDue returns up to limit sub-scans queued for a retry due at
+	// now, of scans that are still running, oldest first
+	ListRetriesDue(ctx context.Context, now time.Time, limit int) ([]*domain.SubScan, error)
+
+	// RecordHeartbeat records a runner callback at the given time if the
+	// sub-scan is running
+	RecordHeartbeat(ctx context.Context, scanID uuid.UUID, scanType domain.ScanType, at time.Time) error
+
+	// RecordProgress records a runner's progress report, and heartbeat, if
+	// the sub-scan is running, and combines it into the scan's progress. It
+	// returns the sub-scan, ErrSubScanNotFound, or ErrStaleAttempt if the
+	// sub-scan runs another attempt.
+	RecordProgress(ctx context.Context, scanID uuid.UUID, scanType domain.ScanType, attempt int, progress *domain.Progress) (*domain.SubScan, error)
+
+	// ClaimLogArchives locks finished sub-scans completed after
+	// completedAfter, and those queued for a retry, whose current attempt's
+	// log isn't archived yet, and pushes their claim back by lease so other
+	// replicas skip them
+	ClaimLogArchives(ctx context.Context, completedAfter, now time.Time, lease time.Duration, limit int) ([]*domain.SubScan, error)
+
+	// AddLog records the archived log of an attempt of a sub-scan. It returns
+	// false, and records nothing, if the scan is gone or being deleted or the
+	// attempt's log was recorded before; the artifact is then unreferenced.
+	AddLog(ctx context.Context, entry *domain.SubScanLog) (bool, error)
+
+	// ListLogs returns the archived logs of the scan's sub-scans ordered by
+	// scan type and attempt
+	ListLogs(ctx context.Context, scanID uuid.UUID) ([]*domain.SubScanLog, error)
+
+	// DeleteLogs deletes the records of the scan's archived sub-scan logs
+	DeleteLogs(ctx context.Context, scanID uuid.UUID) error
+}
+
+// ScannerRepository defines the interface for scanners managed in the database
+type ScannerRepository interface {
+	// List returns the scanners of the scanners table, enabled or not
+	List(ctx context.Context) ([]ScannerConfig, error)
+
+	// Set creates or replaces the scanner of its scan type
+	Set(ctx context.Context, scanner *ScannerConfig) error
+}
+
+// JobProfileRepository defines the interface for the job profiles of projects
+// and the job limits of organizations
+type JobProfileRepository interface {
+	// SetProfile creates the project's profile or replaces its settings.
+	// CreatedAt is set from the stored profile. domain.ErrProjectNotFound is
+	// returned if the project doesn't belong to the profile's organization.
+	SetProfile(ctx context.Context, profile *domain.JobProfile) error
+
+	// GetProfile returns the project's profile, or ErrJobProfileNotFound
+	GetProfile(ctx context.Context, projectID uuid.UUID) (*domain.JobProfile, error)
+
+	// DeleteProfile deletes the project's profile; its scans fall back to the
+	// scanner and job config settings
+	DeleteProfile(ctx context.Context, projectID uuid.UUID) error
+
+	// SetLimits creates the organization's limits or replaces them. Existing
+	// profiles aren't checked; scans whose settings exceed the new limits
+	// fail at dispatch.
+	SetLimits(ctx context.Context, limits *domain.JobLimits) error
+
+	// GetLimits returns the organization's limits, or nil if it has none
+	GetLimits(ctx context.Context, organizationID uuid.UUID) (*domain.JobLimits, error)
+}
+
+// CredentialRepository defines the interface for the repository credentials
+// of projects. Setting and deleting a credential is recorded in audit_logs in
+// the same transaction.
+type CredentialRepository interface {
+	// Set creates the project's credential or replaces it. CreatedAt is set
+	// from the stored credential.
+	Set(ctx context.Context, credential *domain.RepositoryCredential, source domain.AuditSource) error
+
+	// Get returns the project's credential, or ErrCredentialNotFound
+	Get(ctx context.Context, projectID uuid.UUID) (*domain.RepositoryCredential, error)
+
+	// Delete deletes the project's credential; scans already dispatched keep
+	// their secret until they finish
+	Delete(ctx context.Context, projectID, deletedBy uuid.UUID, source domain.AuditSource) error
+}
+
+// RunnerRequestRepository defines the interface for the runner requests made
+// with a request ID. Requests are recorded by the repository method that
+// applies them.
+type RunnerRequestRepository interface {
+	// Get returns the scan's runner request with the request ID, or
+	// domain.ErrRunnerRequestNotFound
+	Get(ctx context.Context, scanID uuid.UUID, requestID string) (*domain.RunnerRequest, error)
+
+	// SaveResponse records the response of an applied runner request
+	SaveResponse(ctx context.Context, request *domain.RunnerRequest) error
+}
@@ -2,6 +2,7 @@ package interfaces
 
 import (
 	"context"
+	"io"
 	"time"
 )
 
@@ -12,10 +13,19 @@ type StorageClient interface {
 	// CreateArtifact creates a new artifact and returns a presigned upload URL
 	CreateArtifact(ctx context.Context, req *CreateArtifactRequest) (*CreateArtifactResponse, error)
 
+	// UploadArtifact creates an artifact and uploads its content to the
+	// presigned URL. req.FileSize must be the exact size of content. The
+	// artifact is deleted again if the upload fails.
+	UploadArtifact(ctx context.Context, req *CreateArtifactRequest, content io.Reader) (*CreateArtifactResponse, error)
+
 	// GetArtifact retrieves artifact info and returns a presigned download URL
 	GetArtifact(ctx context.Context, artifactID string) (*GetArtifactResponse, error)
 
-	// DeleteArtifacts marks artifacts for deletion
+	// DownloadArtifact opens the content of an artifact from its presigned
+	// download URL
+	DownloadArtifact(ctx context.Context, artifactID string) (io.ReadCloser, error)
+
+	// DeleteArtifacts marks artifacts for deletion; missing artifacts count as deleted
 	DeleteArtifacts(ctx context.Context, artifactIDs []string) error
 
 	// InitiateMultipartUpload starts a multipart upload session
@@ -30,17 +40,23 @@ type StorageClient interface {
 	// AbortMultipartUpload aborts a multipart upload
 	AbortMultipartUpload(ctx context.Context, artifactID, uploadID string) error
 
+	// HealthCheck checks that the storage service is reachable and serving
+	HealthCheck(ctx context.Context) error
+
 	// Close closes the gRPC connection
 	Close() error
 }
 
 // CreateArtifactRequest represents a request to create an artifact
 type CreateArtifactRequest struct {
-	FileName     string
-	FileHash     string
-	FileSize     string
-	ArtifactType ArtifactType
-	UploadType   UploadType
+	ScanID         string
+	OrganizationID string
+	FileName       string
+	FileHash       string
+	FileSize       string
+	ContentType    string // Defaults to application/zip
+	ArtifactType   ArtifactType
+	UploadType     UploadType
 }
 
 // CreateArtifactResponse represents the response from creating an artifact
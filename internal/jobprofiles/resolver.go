@@ -0,0 +1,98 @@
+// Package jobprofiles resolves the job settings of a scan from its project's
+// profile and its own overrides, within its organization's limits.
+package jobprofiles
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+	"github.com/google/uuid"
+)
+
+// ErrInvalidSettings wraps settings that are malformed or exceed the limits.
+// Retrying doesn't help.
+var ErrInvalidSettings = errors.New("invalid job settings")
+
+// Resolver resolves and checks job settings
+type Resolver struct {
+	repo          interfaces.JobProfileRepository
+	defaultLimits domain.JobLimits
+}
+
+// NewResolver creates a resolver. The default limits apply to organizations
+// without limits of their own.
+func NewResolver(repo interfaces.JobProfileRepository, defaultLimits domain.JobLimits) *Resolver {
+	return &Resolver{repo: repo, defaultLimits: defaultLimits}
+}
+
+// Limits returns the organization's limits, or the default limits
+func (r *Resolver) Limits(ctx context.Context, organizationID uuid.UUID) (*domain.JobLimits, error) {
+	limits, err := r.repo.GetLimits(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	if limits == nil {
+		defaults := r.defaultLimits
+		defaults.OrganizationID = organizationID
+		return &defaults, nil
+	}
+	return limits, nil
+}
+
+// Resolve returns the settings of a scan of the project: the project's
+// profile overridden by the scan's settings, which may be nil. The result is
+// checked against the organization's limits; ErrInvalidSettings is wrapped if
+// it doesn't pass.
+func (r *Resolver) Resolve(ctx context.Context, organizationID, projectID uuid.UUID, override *domain.JobSettings) (*domain.JobSettings, error) {
+	settings := domain.JobSettings{}
+	profile, err := r.repo.GetProfile(ctx, projectID)
+	if err != nil && !errors.Is(err, domain.ErrJobProfileNotFound) {
+		return nil, err
+	}
+	if profile != nil {
+		settings = profile.Settings
+	}
+	settings = settings.Merge(override)
+
+	if err := r.Check(ctx, organizationID, &settings); err != nil {
+		return nil, err
+	}
+	return &settings, nil
+}
+
+// Check validates the settings against the organization's limits; ErrInvalidSettings
+// is wrapped if they don't pass
+func (r *Resolver) Check(ctx context.Context, organizationID uuid.UUID, settings *domain.JobSettings) error {
+	if err := settings.Validate(); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSettings, err)
+	}
+
+	limits, err := r.Limits(ctx, organizationID)
+	if err != nil {
+		return err
+	}
+	return check(limits, settings)
+}
+
+// CheckEffective checks the settings a scanner's jobs run with, its defaults
+// overridden by the resolved settings, against the limits. A default request
+// may exceed a set limit, or a scanner's timeout the maximum deadline, so
+// settings that pass Check may still not pass here; ErrInvalidSettings is
+// wrapped then.
+func CheckEffective(limits *domain.JobLimits, defaults domain.JobSettings, settings *domain.JobSettings) error {
+	effective := defaults.Merge(settings)
+	return check(limits, &effective)
+}
+
+func check(limits *domain.JobLimits, settings *domain.JobSettings) error {
+	if err := settings.Validate(); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSettings, err)
+	}
+	if err := limits.Check(settings); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSettings, err)
+	}
+	return nil
+}
@@ -60,4 +60,13 @@ func VerifyConnection(ctx context.Context, clientset *kubernetes.Clientset) erro
 
 	log.Info("Successfully connected to Kubernetes cluster")
 	return nil
-}
\ No newline at end of file
+}
+
+// HealthCheck checks that the Kubernetes API server is reachable and ready
+func HealthCheck(ctx context.Context, clientset *kubernetes.Clientset) error {
+	err := clientset.Discovery().RESTClient().Get().AbsPath("/readyz").Do(ctx).Error()
+	if err != nil {
+		return fmt.Errorf("kubernetes API not ready: %w", err)
+	}
+	return nil
+}
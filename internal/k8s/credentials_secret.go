@@ -0,0 +1,164 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// The credentials Secret of a scan is mounted read-only into its runners.
+// Its "type" key is ssh or https; ssh credentials hold ssh-privatekey and
+// optionally known_hosts, https credentials username and password.
+const (
+	credentialsVolume    = "git-credentials"
+	credentialsMountPath = "/var/run/cloudscan/git-credentials"
+
+	credentialsLabel    = "cloudscan.io/git-credentials"
+	scanIDAnnotation    = "cloudscan.io/scan-id"    // Full ID of the scan
+	expiresAtAnnotation = "cloudscan.io/expires-at" // When a token minted for the scan expires
+)
+
+// CreateCredentialsSecret creates the credentials Secret of a scan, or
+// replaces the data of the Secret left by an earlier dispatch attempt. A
+// Secret of the same name annotated with another scan is never replaced.
+func (d *JobDispatcher) CreateCredentialsSecret(ctx context.Context, scan *domain.Scan, credentials *interfaces.GitCredentials) (*interfaces.CredentialsSecret, error) {
+	secret := d.buildCredentialsSecret(scan, credentials)
+	logger := d.logger.WithFields(log.Fields{
+		"scan_id":     scan.ID.String(),
+		"secret_name": secret.Name,
+	})
+
+	reqCtx, end := startRequest(ctx, "create_secret", secret.Namespace, secret.Name)
+	created, err := d.clientset.CoreV1().Secrets(secret.Namespace).Create(reqCtx, secret, metav1.CreateOptions{})
+	end(err)
+	if errors.IsAlreadyExists(err) {
+		created, err = d.replaceCredentialsSecret(ctx, secret)
+	}
+	if err != nil {
+		logger.WithError(err).Error("Failed to create credentials secret")
+		return nil, fmt.Errorf("failed to create credentials secret: %w", err)
+	}
+
+	logger.Info("Created credentials secret")
+	return &interfaces.CredentialsSecret{
+		Namespace: created.Namespace,
+		Name:      created.Name,
+		ScanID:    scan.ID,
+		CreatedAt: created.CreationTimestamp.Time,
+	}, nil
+}
+
+// replaceCredentialsSecret replaces an existing credentials Secret if it
+// belongs to the same scan
+func (d *JobDispatcher) replaceCredentialsSecret(ctx context.Context, secret *corev1.Secret) (*corev1.Secret, error) {
+	reqCtx, end := startRequest(ctx, "get_secret", secret.Namespace, secret.Name)
+	existing, err := d.clientset.CoreV1().Secrets(secret.Namespace).Get(reqCtx, secret.Name, metav1.GetOptions{})
+	end(err)
+	if err != nil {
+		return nil, err
+	}
+
+	if owner := existing.Annotations[scanIDAnnotation]; owner != secret.Annotations[scanIDAnnotation] {
+		return nil, fmt.Errorf("secret %s belongs to scan %q", secret.Name, owner)
+	}
+
+	secret.ResourceVersion = existing.ResourceVersion
+	reqCtx, end = startRequest(ctx, "update_secret", secret.Namespace, secret.Name)
+	updated, err := d.clientset.CoreV1().Secrets(secret.Namespace).Update(reqCtx, secret, metav1.UpdateOptions{})
+	end(err)
+	return updated, err
+}
+
+// DeleteCredentialsSecret deletes a credentials Secret
+func (d *JobDispatcher) DeleteCredentialsSecret(ctx context.Context, namespace, name string) error {
+	reqCtx, end := startRequest(ctx, "delete_secret", namespace, name)
+	err := d.clientset.CoreV1().Secrets(namespace).Delete(reqCtx, name, metav1.DeleteOptions{})
+	end(err)
+	if err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete credentials secret: %w", err)
+	}
+	return nil
+}
+
+// ListCredentialsSecrets lists the credentials Secrets in a namespace.
+// Secrets without a valid scan ID are skipped.
+func (d *JobDispatcher) ListCredentialsSecrets(ctx context.Context, namespace string) ([]interfaces.CredentialsSecret, error) {
+	reqCtx, end := startRequest(ctx, "list_secrets", namespace, "")
+	list, err := d.clientset.CoreV1().Secrets(namespace).List(reqCtx, metav1.ListOptions{
+		LabelSelector: credentialsLabel + "=true",
+	})
+	end(err)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list credentials secrets: %w", err)
+	}
+
+	secrets := make([]interfaces.CredentialsSecret, 0, len(list.Items))
+	for _, item := range list.Items {
+		scanID, err := uuid.Parse(item.Annotations[scanIDAnnotation])
+		if err != nil {
+			d.logger.WithField("secret_name", item.Name).Warn("Credentials secret has no scan ID")
+			continue
+		}
+		secrets = append(secrets, interfaces.CredentialsSecret{
+			Namespace: item.Namespace,
+			Name:      item.Name,
+			ScanID:    scanID,
+			CreatedAt: item.CreationTimestamp.Time,
+		})
+	}
+	return secrets, nil
+}
+
+// buildCredentialsSecret constructs the credentials Secret of a scan
+func (d *JobDispatcher) buildCredentialsSecret(scan *domain.Scan, credentials *interfaces.GitCredentials) *corev1.Secret {
+	data := map[string][]byte{
+		"type": []byte(credentials.Type),
+	}
+	switch credentials.Type {
+	case interfaces.GitCredentialsSSH:
+		data[corev1.SSHAuthPrivateKey] = credentials.PrivateKey
+		if len(credentials.KnownHosts) > 0 {
+			data["known_hosts"] = credentials.KnownHosts
+		}
+	case interfaces.GitCredentialsHTTPS:
+		data[corev1.BasicAuthUsernameKey] = []byte(credentials.Username)
+		data[corev1.BasicAuthPasswordKey] = []byte(credentials.Password)
+	}
+
+	annotations := map[string]string{
+		scanIDAnnotation: scan.ID.String(),
+	}
+	if credentials.ExpiresAt != nil {
+		annotations[expiresAtAnnotation] = credentials.ExpiresAt.UTC().Format(time.RFC3339)
+	}
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      credentialsSecretName(scan),
+			Namespace: d.config.Namespace,
+			Labels: map[string]string{
+				"app":            "cloudscan-runner",
+				"scan-id":        scan.ID.String()[:8],
+				"project-id":     scan.ProjectID.String(),
+				credentialsLabel: "true",
+			},
+			Annotations: annotations,
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: data,
+	}
+}
+
+// credentialsSecretName returns the name of the scan's credentials Secret. It
+// holds the full scan ID; scans sharing an ID prefix must never share a Secret.
+func credentialsSecretName(scan *domain.Scan) string {
+	return fmt.Sprintf("scan-%s-git-credentials", scan.ID.String())
+}
@@ -3,13 +3,21 @@ package k8s
 import (
 	"context"
 	"fmt"
+	"io"
+	"sort"
+	"strconv"
 	"time"
 
 	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
 	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/metrics"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/tracing"
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -17,6 +25,15 @@ import (
 	"k8s.io/client-go/kubernetes"
 )
 
+// The runner checks out the source into the workspace volume. Both volumes
+// stay writable with a read-only root filesystem.
+const (
+	workspaceVolume    = "workspace"
+	workspaceMountPath = "/workspace"
+	tmpVolume          = "tmp"
+	tmpMountPath       = "/tmp"
+)
+
 // JobDispatcher implements interfaces.JobDispatcher using Kubernetes
 type JobDispatcher struct {
 	clientset     *kubernetes.Clientset
@@ -35,9 +52,13 @@ func NewJobDispatcher(clientset *kubernetes.Clientset, config *interfaces.JobCon
 	}
 }
 
-// CreateJob creates a new Kubernetes Job for a scan
-func (d *JobDispatcher) CreateJob(ctx context.Context, scan *domain.Scan) (*batchv1.Job, error) {
-	logger := d.logger.WithField("scan_id", scan.ID.String())
+// CreateJob creates the Kubernetes Job running one scanner of a scan
+func (d *JobDispatcher) CreateJob(ctx context.Context, scan *domain.Scan, scanner *interfaces.ScannerConfig, settings *domain.JobSettings, credentialsSecret string, attempt int) (*batchv1.Job, error) {
+	logger := d.logger.WithFields(log.Fields{
+		"scan_id":   scan.ID.String(),
+		"scan_type": scanner.ScanType,
+		"scanner":   scanner.Name,
+	})
 	logger.Info("Creating Kubernetes job for scan")
 
 	// If this is an artifact-based scan, get the presigned download URL
@@ -55,29 +76,60 @@ func (d *JobDispatcher) CreateJob(ctx context.Context, scan *domain.Scan) (*batc
 		logger.WithField("expiration", artifactResp.Expiration).Info("Retrieved artifact download URL")
 	}
 
-	// Generate job name (must be DNS-1123 compliant)
-	jobName := fmt.Sprintf("scan-%s", scan.ID.String()[:8])
+	// Generate job name (must be DNS-1123 compliant). A retry can't reuse
+	// the name while the failed job is being deleted.
+	jobName := fmt.Sprintf("scan-%s-%s", scan.ID.String()[:8], scanner.ScanType)
+	if attempt > 1 {
+		jobName = fmt.Sprintf("%s-%d", jobName, attempt)
+	}
 
 	// Build job spec with download URL
-	job := d.buildJobSpec(jobName, scan, downloadURL)
+	job := d.buildJobSpec(ctx, jobName, scan, scanner, settings, downloadURL, credentialsSecret, attempt)
+
+	// The egress policy exists before the job's pod can start
+	var policy *networkingv1.NetworkPolicy
+	if d.config.NetworkPolicy.Enabled {
+		var err error
+		policy, err = d.createNetworkPolicy(ctx, jobName, scan, downloadURL)
+		if err != nil {
+			logger.WithError(err).Error("Failed to create network policy")
+			return nil, err
+		}
+	}
 
 	// Create job in Kubernetes
-	createdJob, err := d.clientset.BatchV1().Jobs(d.config.Namespace).Create(ctx, job, metav1.CreateOptions{})
+	reqCtx, end := startRequest(ctx, "create_job", d.config.Namespace, jobName)
+	createdJob, err := d.clientset.BatchV1().Jobs(d.config.Namespace).Create(reqCtx, job, metav1.CreateOptions{})
+	end(err)
 	if err != nil {
 		logger.WithError(err).Error("Failed to create Kubernetes job")
+		if policy != nil {
+			if err := d.deleteNetworkPolicy(ctx, d.config.Namespace, jobName); err != nil {
+				logger.WithError(err).Warn("Failed to delete network policy of uncreated job")
+			}
+		}
 		return nil, fmt.Errorf("failed to create job: %w", err)
 	}
 
+	// DeleteJob removes the policy if this fails
+	if policy != nil {
+		if err := d.adoptNetworkPolicy(ctx, policy, createdJob); err != nil {
+			logger.WithError(err).Warn("Failed to set the job as owner of its network policy")
+		}
+	}
+
 	logger.WithField("job_name", jobName).Info("Successfully created Kubernetes job")
 	return createdJob, nil
 }
 
 // GetJob retrieves a Kubernetes Job by name
 func (d *JobDispatcher) GetJob(ctx context.Context, namespace, name string) (*batchv1.Job, error) {
-	job, err := d.clientset.BatchV1().Jobs(namespace).Get(ctx, name, metav1.GetOptions{})
+	reqCtx, end := startRequest(ctx, "get_job", namespace, name)
+	job, err := d.clientset.BatchV1().Jobs(namespace).Get(reqCtx, name, metav1.GetOptions{})
+	end(err)
 	if err != nil {
 		if errors.IsNotFound(err) {
-			return nil, fmt.Errorf("job not found: %s", name)
+			return nil, fmt.Errorf("%w: %s", interfaces.ErrJobNotFound, name)
 		}
 		return nil, fmt.Errorf("failed to get job: %w", err)
 	}
@@ -92,9 +144,11 @@ func (d *JobDispatcher) DeleteJob(ctx context.Context, namespace, name string) e
 	})
 
 	deletePolicy := metav1.DeletePropagationBackground
-	err := d.clientset.BatchV1().Jobs(namespace).Delete(ctx, name, metav1.DeleteOptions{
+	reqCtx, end := startRequest(ctx, "delete_job", namespace, name)
+	err := d.clientset.BatchV1().Jobs(namespace).Delete(reqCtx, name, metav1.DeleteOptions{
 		PropagationPolicy: &deletePolicy,
 	})
+	end(err)
 
 	if err != nil {
 		if errors.IsNotFound(err) {
@@ -105,15 +159,24 @@ func (d *JobDispatcher) DeleteJob(ctx context.Context, namespace, name string) e
 		return fmt.Errorf("failed to delete job: %w", err)
 	}
 
+	// Usually garbage collected with the job, unless it never became its owner
+	if d.config.NetworkPolicy.Enabled {
+		if err := d.deleteNetworkPolicy(ctx, namespace, name); err != nil {
+			logger.WithError(err).Warn("Failed to delete network policy of job")
+		}
+	}
+
 	logger.Info("Successfully deleted Kubernetes job")
 	return nil
 }
 
 // ListJobs lists all jobs in a namespace with optional label selector
 func (d *JobDispatcher) ListJobs(ctx context.Context, namespace string, labelSelector string) (*batchv1.JobList, error) {
-	jobs, err := d.clientset.BatchV1().Jobs(namespace).List(ctx, metav1.ListOptions{
+	reqCtx, end := startRequest(ctx, "list_jobs", namespace, "")
+	jobs, err := d.clientset.BatchV1().Jobs(namespace).List(reqCtx, metav1.ListOptions{
 		LabelSelector: labelSelector,
 	})
+	end(err)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list jobs: %w", err)
 	}
@@ -133,6 +196,7 @@ func (d *JobDispatcher) GetJobStatus(ctx context.Context, namespace, name string
 		Active:    job.Status.Active,
 		Succeeded: job.Status.Succeeded,
 		Failed:    job.Status.Failed,
+		CreatedAt: job.CreationTimestamp.Time,
 	}
 
 	if job.Status.StartTime != nil {
@@ -155,43 +219,119 @@ func (d *JobDispatcher) GetJobStatus(ctx context.Context, namespace, name string
 		})
 	}
 
-	// Get pod name for logs
-	podName, err := d.getPodNameForJob(ctx, namespace, name)
+	// The latest pod names the logs and tells why the job failed
+	pod, err := d.getLatestPod(ctx, namespace, name)
 	if err == nil {
-		status.PodName = podName
+		status.PodName = pod.Name
+		status.Pod = convertPodStatus(pod)
 	}
 
 	return status, nil
 }
 
-// GetJobLogs retrieves logs from the job's pod
+// convertPodStatus returns the state of a pod and its runner container
+func convertPodStatus(pod *corev1.Pod) *interfaces.PodStatus {
+	status := &interfaces.PodStatus{
+		Name:      pod.Name,
+		Phase:     string(pod.Status.Phase),
+		Reason:    pod.Status.Reason,
+		Message:   pod.Status.Message,
+		CreatedAt: pod.CreationTimestamp.Time,
+	}
+
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodScheduled && cond.Status == corev1.ConditionFalse && cond.Reason == corev1.PodReasonUnschedulable {
+			status.Unschedulable = true
+			status.SchedulingMessage = cond.Message
+		}
+	}
+
+	for _, container := range pod.Status.ContainerStatuses {
+		if container.Name != "runner" {
+			continue
+		}
+		if waiting := container.State.Waiting; waiting != nil {
+			status.WaitingReason = waiting.Reason
+			status.WaitingMessage = waiting.Message
+		}
+		if running := container.State.Running; running != nil {
+			startedAt := running.StartedAt.Time
+			status.StartedAt = &startedAt
+		}
+		// A container restarted in place keeps its last termination
+		terminated := container.State.Terminated
+		if terminated == nil {
+			terminated = container.LastTerminationState.Terminated
+		}
+		if terminated != nil {
+			status.Terminated = true
+			status.TerminatedReason = terminated.Reason
+			status.ExitCode = terminated.ExitCode
+		}
+	}
+
+	return status
+}
+
+// jobLogTailLines is how many lines GetJobLogs returns; a runner reports its
+// failure at the end of the log
+const jobLogTailLines = 50
+
+// maxJobLogTailBytes caps the tail read by GetJobLogs
+const maxJobLogTailBytes = 64 * 1024
+
+// GetJobLogs retrieves the last lines of the job pod's log
 func (d *JobDispatcher) GetJobLogs(ctx context.Context, namespace, name string) (string, error) {
-	podName, err := d.getPodNameForJob(ctx, namespace, name)
+	tailLines := int64(jobLogTailLines)
+	logs, err := d.streamPodLogs(ctx, namespace, name, &corev1.PodLogOptions{TailLines: &tailLines})
 	if err != nil {
 		return "", err
 	}
+	defer logs.Close()
 
-	req := d.clientset.CoreV1().Pods(namespace).GetLogs(podName, &corev1.PodLogOptions{})
-	logs, err := req.Stream(ctx)
+	data, err := io.ReadAll(io.LimitReader(logs, maxJobLogTailBytes))
 	if err != nil {
-		return "", fmt.Errorf("failed to stream logs: %w", err)
+		return "", fmt.Errorf("failed to read logs: %w", err)
 	}
-	defer logs.Close()
 
-	buf := make([]byte, 2000)
-	n, err := logs.Read(buf)
-	if err != nil && err.Error() != "EOF" {
-		return "", fmt.Errorf("failed to read logs: %w", err)
+	return string(data), nil
+}
+
+// StreamJobLogs opens the complete log of the job's pod. With follow set the
+// stream stays open and delivers new lines until the container exits.
+func (d *JobDispatcher) StreamJobLogs(ctx context.Context, namespace, name string, follow bool) (io.ReadCloser, error) {
+	return d.streamPodLogs(ctx, namespace, name, &corev1.PodLogOptions{Follow: follow})
+}
+
+// streamPodLogs opens the log of the job's latest pod
+func (d *JobDispatcher) streamPodLogs(ctx context.Context, namespace, name string, opts *corev1.PodLogOptions) (io.ReadCloser, error) {
+	podName, err := d.getPodNameForJob(ctx, namespace, name)
+	if err != nil {
+		return nil, err
 	}
 
-	return string(buf[:n]), nil
+	req := d.clientset.CoreV1().Pods(namespace).GetLogs(podName, opts)
+	reqCtx, end := startRequest(ctx, "get_pod_logs", namespace, name)
+	logs, err := req.Stream(reqCtx)
+	end(err)
+	if err != nil {
+		// The pod was removed since it was listed
+		if errors.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: %s", interfaces.ErrPodNotFound, podName)
+		}
+		return nil, fmt.Errorf("failed to stream logs: %w", err)
+	}
+
+	return logs, nil
 }
 
 // WatchJob watches for job status changes
 func (d *JobDispatcher) WatchJob(ctx context.Context, namespace, name string) (<-chan *interfaces.JobStatus, error) {
-	watcher, err := d.clientset.BatchV1().Jobs(namespace).Watch(ctx, metav1.ListOptions{
+	reqCtx, end := startRequest(ctx, "watch_job", namespace, name)
+	watcher, err := d.clientset.BatchV1().Jobs(namespace).Watch(reqCtx, metav1.ListOptions{
 		FieldSelector: fmt.Sprintf("metadata.name=%s", name),
 	})
+	end(err)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create watcher: %w", err)
 	}
@@ -270,11 +410,13 @@ func (d *JobDispatcher) CancelJob(ctx context.Context, namespace, name string) e
 
 	// Delete pods associated with the job
 	deletePolicy := metav1.DeletePropagationForeground
-	err = d.clientset.CoreV1().Pods(namespace).DeleteCollection(ctx, metav1.DeleteOptions{
+	reqCtx, end := startRequest(ctx, "delete_pods", namespace, name)
+	err = d.clientset.CoreV1().Pods(namespace).DeleteCollection(reqCtx, metav1.DeleteOptions{
 		PropagationPolicy: &deletePolicy,
 	}, metav1.ListOptions{
 		LabelSelector: fmt.Sprintf("job-name=%s", job.Name),
 	})
+	end(err)
 
 	if err != nil {
 		logger.WithError(err).Error("Failed to delete job pods")
@@ -285,29 +427,33 @@ func (d *JobDispatcher) CancelJob(ctx context.Context, namespace, name string) e
 	return nil
 }
 
-// buildJobSpec constructs a Kubernetes Job specification for a scan
-func (d *JobDispatcher) buildJobSpec(jobName string, scan *domain.Scan, downloadURL string) *batchv1.Job {
-	// Convert scan types to comma-separated string
-	scanTypes := make([]string, len(scan.ScanTypes))
-	for i, st := range scan.ScanTypes {
-		scanTypes[i] = string(st)
-	}
-	scanTypesStr := ""
-	for i, st := range scanTypes {
-		if i > 0 {
-			scanTypesStr += ","
-		}
-		scanTypesStr += st
+// buildJobSpec constructs a Kubernetes Job specification running one scanner
+// of a scan. Settings may be nil.
+func (d *JobDispatcher) buildJobSpec(ctx context.Context, jobName string, scan *domain.Scan, scanner *interfaces.ScannerConfig, settings *domain.JobSettings, downloadURL, credentialsSecret string, attempt int) *batchv1.Job {
+	if settings == nil {
+		settings = &domain.JobSettings{}
 	}
 
-	// Build environment variables for the runner
+	// Build environment variables for the runner. SCAN_TYPES is kept for
+	// runners that predate one job per scan type.
 	env := []corev1.EnvVar{
 		{Name: "SCAN_ID", Value: scan.ID.String()},
 		{Name: "ORGANIZATION_ID", Value: scan.OrganizationID.String()},
 		{Name: "PROJECT_ID", Value: scan.ProjectID.String()},
-		{Name: "SCAN_TYPES", Value: scanTypesStr},
+		{Name: "SCAN_TYPE", Value: string(scanner.ScanType)},
+		{Name: "SCAN_TYPES", Value: string(scanner.ScanType)},
+		{Name: "SCANNER_NAME", Value: scanner.Name},
+		{Name: "SCANNER_VERSION", Value: scanner.Version},
+		{Name: "SCAN_ATTEMPT", Value: strconv.Itoa(attempt)},
 		{Name: "ORCHESTRATOR_ENDPOINT", Value: d.config.OrchestratorEndpoint},
 		{Name: "STORAGE_SERVICE_ENDPOINT", Value: d.config.StorageServiceEndpoint},
+		{Name: "WORKSPACE_DIR", Value: workspaceMountPath},
+		{Name: "TMPDIR", Value: tmpMountPath},
+	}
+
+	// Scanners cache into their home, which must be writable
+	if d.config.Security.ReadOnlyRootFilesystem {
+		env = append(env, corev1.EnvVar{Name: "HOME", Value: tmpMountPath})
 	}
 
 	// Add optional fields if present
@@ -330,40 +476,138 @@ func (d *JobDispatcher) buildJobSpec(jobName string, scan *domain.Scan, download
 		env = append(env, corev1.EnvVar{Name: "COMMIT_SHA", Value: *scan.CommitSHA})
 	}
 
-	// Build resource requirements
+	// The runner configures git from the mounted credentials
+	if credentialsSecret != "" {
+		env = append(env,
+			corev1.EnvVar{Name: "GIT_CREDENTIALS_DIR", Value: credentialsMountPath},
+			corev1.EnvVar{Name: "GIT_CREDENTIALS_TYPE", ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: credentialsSecret},
+					Key:                  "type",
+				},
+			}},
+		)
+	}
+
+	// Propagate the trace context so the runner's calls back to the
+	// orchestrator join the scan's trace
+	traceEnv := tracing.EnvCarrier(ctx)
+	traceKeys := make([]string, 0, len(traceEnv))
+	for k := range traceEnv {
+		traceKeys = append(traceKeys, k)
+	}
+	sort.Strings(traceKeys)
+	for _, k := range traceKeys {
+		env = append(env, corev1.EnvVar{Name: k, Value: traceEnv[k]})
+	}
+
+	// Scanner specific settings, sorted so the spec is stable
+	scannerKeys := make([]string, 0, len(scanner.Env))
+	for k := range scanner.Env {
+		scannerKeys = append(scannerKeys, k)
+	}
+	sort.Strings(scannerKeys)
+	for _, k := range scannerKeys {
+		env = append(env, corev1.EnvVar{Name: k, Value: scanner.Env[k]})
+	}
+
+	// Build resource requirements; the scanner's own values take precedence,
+	// and the job settings over those
+	effective := d.DefaultJobSettings(scanner).Merge(settings)
+	requests := interfaces.ResourceList{CPU: effective.RequestsCPU, Memory: effective.RequestsMemory}
+	limits := interfaces.ResourceList{CPU: effective.LimitsCPU, Memory: effective.LimitsMemory}
+	ephemeralStorage := effective.EphemeralStorage
+
 	resources := corev1.ResourceRequirements{}
-	if d.config.Resources.Requests.CPU != "" || d.config.Resources.Requests.Memory != "" {
+	if requests.CPU != "" || requests.Memory != "" {
 		resources.Requests = corev1.ResourceList{}
-		if d.config.Resources.Requests.CPU != "" {
-			resources.Requests[corev1.ResourceCPU] = parseQuantity(d.config.Resources.Requests.CPU)
+		if requests.CPU != "" {
+			resources.Requests[corev1.ResourceCPU] = parseQuantity(requests.CPU)
 		}
-		if d.config.Resources.Requests.Memory != "" {
-			resources.Requests[corev1.ResourceMemory] = parseQuantity(d.config.Resources.Requests.Memory)
+		if requests.Memory != "" {
+			resources.Requests[corev1.ResourceMemory] = parseQuantity(requests.Memory)
 		}
 	}
-	if d.config.Resources.Limits.CPU != "" || d.config.Resources.Limits.Memory != "" {
+	if limits.CPU != "" || limits.Memory != "" {
 		resources.Limits = corev1.ResourceList{}
-		if d.config.Resources.Limits.CPU != "" {
-			resources.Limits[corev1.ResourceCPU] = parseQuantity(d.config.Resources.Limits.CPU)
+		if limits.CPU != "" {
+			resources.Limits[corev1.ResourceCPU] = parseQuantity(limits.CPU)
+		}
+		if limits.Memory != "" {
+			resources.Limits[corev1.ResourceMemory] = parseQuantity(limits.Memory)
 		}
-		if d.config.Resources.Limits.Memory != "" {
-			resources.Limits[corev1.ResourceMemory] = parseQuantity(d.config.Resources.Limits.Memory)
+	}
+
+	// The checkout lives on an emptyDir, sized and accounted as ephemeral storage
+	workspace := &corev1.EmptyDirVolumeSource{}
+	if ephemeralStorage != "" {
+		quantity := parseQuantity(ephemeralStorage)
+		workspace.SizeLimit = &quantity
+		if resources.Requests == nil {
+			resources.Requests = corev1.ResourceList{}
 		}
+		if resources.Limits == nil {
+			resources.Limits = corev1.ResourceList{}
+		}
+		resources.Requests[corev1.ResourceEphemeralStorage] = quantity
+		resources.Limits[corev1.ResourceEphemeralStorage] = quantity
 	}
 
 	// Build container spec
 	container := corev1.Container{
 		Name:            "runner",
-		Image:           fmt.Sprintf("%s:%s", d.config.RunnerImage, d.config.RunnerVersion),
+		Image:           scanner.ImageRef(),
+		Args:            scanner.Args,
 		ImagePullPolicy: corev1.PullIfNotPresent,
 		Env:             env,
 		Resources:       resources,
+		VolumeMounts: []corev1.VolumeMount{
+			{Name: workspaceVolume, MountPath: workspaceMountPath},
+			{Name: tmpVolume, MountPath: tmpMountPath},
+		},
+		SecurityContext: d.containerSecurityContext(),
 	}
 
 	// Build pod spec
+	automountToken := d.config.Security.AutomountServiceAccountToken
 	podSpec := corev1.PodSpec{
 		RestartPolicy: corev1.RestartPolicyNever,
 		Containers:    []corev1.Container{container},
+		Volumes: []corev1.Volume{
+			{Name: workspaceVolume, VolumeSource: corev1.VolumeSource{EmptyDir: workspace}},
+			{Name: tmpVolume, VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+		},
+		SecurityContext:              d.podSecurityContext(),
+		AutomountServiceAccountToken: &automountToken,
+		NodeSelector:                 settings.NodeSelector,
+		PriorityClassName:            settings.PriorityClassName,
+	}
+
+	// Readable by the runner's group, the pod's fsGroup, and no one else
+	if credentialsSecret != "" {
+		mode := int32(0440)
+		podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+			Name: credentialsVolume,
+			VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{
+				SecretName:  credentialsSecret,
+				DefaultMode: &mode,
+			}},
+		})
+		podSpec.Containers[0].VolumeMounts = append(podSpec.Containers[0].VolumeMounts, corev1.VolumeMount{
+			Name:      credentialsVolume,
+			MountPath: credentialsMountPath,
+			ReadOnly:  true,
+		})
+	}
+
+	for _, t := range settings.Tolerations {
+		podSpec.Tolerations = append(podSpec.Tolerations, corev1.Toleration{
+			Key:               t.Key,
+			Operator:          corev1.TolerationOperator(t.Operator),
+			Value:             t.Value,
+			Effect:            corev1.TaintEffect(t.Effect),
+			TolerationSeconds: t.TolerationSeconds,
+		})
 	}
 
 	if d.config.ServiceAccount != "" {
@@ -376,6 +620,7 @@ func (d *JobDispatcher) buildJobSpec(jobName string, scan *domain.Scan, download
 		"scan-id":         scan.ID.String()[:8],
 		"organization-id": scan.OrganizationID.String(),
 		"project-id":      scan.ProjectID.String(),
+		"scan-type":       string(scanner.ScanType),
 	}
 
 	// Build Job spec
@@ -400,15 +645,79 @@ func (d *JobDispatcher) buildJobSpec(jobName string, scan *domain.Scan, download
 		job.Spec.TTLSecondsAfterFinished = d.config.TTLSecondsAfterFinished
 	}
 
-	if d.config.BackoffLimit != nil {
-		job.Spec.BackoffLimit = d.config.BackoffLimit
+	job.Spec.BackoffLimit = effective.BackoffLimit
+	job.Spec.ActiveDeadlineSeconds = effective.ActiveDeadlineSeconds
+
+	return job
+}
+
+// DefaultJobSettings returns the settings the scanner's jobs get from the
+// scanner and the job config: the scanner's resources and timeout take
+// precedence over the config's
+func (d *JobDispatcher) DefaultJobSettings(scanner *interfaces.ScannerConfig) domain.JobSettings {
+	requests := d.config.Resources.Requests
+	limits := d.config.Resources.Limits
+	overrideResources(&requests, scanner.Resources.Requests.CPU, scanner.Resources.Requests.Memory)
+	overrideResources(&limits, scanner.Resources.Limits.CPU, scanner.Resources.Limits.Memory)
+
+	settings := domain.JobSettings{
+		RequestsCPU:           requests.CPU,
+		RequestsMemory:        requests.Memory,
+		LimitsCPU:             limits.CPU,
+		LimitsMemory:          limits.Memory,
+		EphemeralStorage:      d.config.EphemeralStorage,
+		ActiveDeadlineSeconds: d.config.ActiveDeadlineSeconds,
+		BackoffLimit:          d.config.BackoffLimit,
+	}
+	if scanner.Timeout > 0 {
+		deadline := int64(scanner.Timeout.Seconds())
+		settings.ActiveDeadlineSeconds = &deadline
+	}
+	return settings
+}
+
+// podSecurityContext returns the security context of runner pods
+func (d *JobDispatcher) podSecurityContext() *corev1.PodSecurityContext {
+	security := d.config.Security
+	podContext := &corev1.PodSecurityContext{
+		RunAsUser:  security.RunAsUser,
+		RunAsGroup: security.RunAsGroup,
+		FSGroup:    security.FSGroup,
+	}
+	if security.RunAsNonRoot {
+		runAsNonRoot := true
+		podContext.RunAsNonRoot = &runAsNonRoot
 	}
+	if security.SeccompProfile != "" {
+		podContext.SeccompProfile = &corev1.SeccompProfile{Type: corev1.SeccompProfileType(security.SeccompProfile)}
+	}
+	return podContext
+}
 
-	if d.config.ActiveDeadlineSeconds != nil {
-		job.Spec.ActiveDeadlineSeconds = d.config.ActiveDeadlineSeconds
+// containerSecurityContext returns the security context of the runner container
+func (d *JobDispatcher) containerSecurityContext() *corev1.SecurityContext {
+	security := d.config.Security
+	containerContext := &corev1.SecurityContext{}
+	if security.ReadOnlyRootFilesystem {
+		readOnly := true
+		containerContext.ReadOnlyRootFilesystem = &readOnly
+	}
+	if security.DropAllCapabilities {
+		allowPrivilegeEscalation := false
+		containerContext.AllowPrivilegeEscalation = &allowPrivilegeEscalation
+		containerContext.Capabilities = &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}}
 	}
+	return containerContext
+}
 
-	return job
+// overrideResources replaces the CPU and memory of the list with the values set
+func overrideResources(list *interfaces.ResourceList, cpu, memory string) {
+	if cpu != "" {
+		list.CPU = cpu
+	}
+	if memory != "" {
+		list.Memory = memory
+	}
 }
 
 // parseQuantity is a helper to parse resource quantity strings
@@ -423,17 +732,63 @@ func parseQuantity(s string) resource.Quantity {
 
 // getPodNameForJob gets the pod name associated with a job
 func (d *JobDispatcher) getPodNameForJob(ctx context.Context, namespace, jobName string) (string, error) {
-	pods, err := d.clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+	pod, err := d.getLatestPod(ctx, namespace, jobName)
+	if err != nil {
+		return "", err
+	}
+	return pod.Name, nil
+}
+
+// getLatestPod returns the job's most recently created pod
+func (d *JobDispatcher) getLatestPod(ctx context.Context, namespace, jobName string) (*corev1.Pod, error) {
+	reqCtx, end := startRequest(ctx, "list_pods", namespace, jobName)
+	pods, err := d.clientset.CoreV1().Pods(namespace).List(reqCtx, metav1.ListOptions{
 		LabelSelector: fmt.Sprintf("job-name=%s", jobName),
 	})
+	end(err)
 
 	if err != nil {
-		return "", fmt.Errorf("failed to list pods: %w", err)
+		return nil, fmt.Errorf("failed to list pods: %w", err)
 	}
 
 	if len(pods.Items) == 0 {
-		return "", fmt.Errorf("no pods found for job: %s", jobName)
+		return nil, fmt.Errorf("%w: no pods for job %s", interfaces.ErrPodNotFound, jobName)
 	}
 
-	return pods.Items[0].Name, nil
-}
\ No newline at end of file
+	// A retried job has a pod per attempt; the latest one has the final outcome
+	latest := &pods.Items[0]
+	for i := range pods.Items {
+		if latest.CreationTimestamp.Before(&pods.Items[i].CreationTimestamp) {
+			latest = &pods.Items[i]
+		}
+	}
+
+	return latest, nil
+}
+
+// startRequest starts a span for a Kubernetes API call. The returned function
+// ends the span and records the call latency; the result is "success" or the
+// API status reason of the error, e.g. "NotFound".
+func startRequest(ctx context.Context, operation, namespace, name string) (context.Context, func(error)) {
+	attrs := []attribute.KeyValue{attribute.String("k8s.namespace.name", namespace)}
+	if name != "" {
+		attrs = append(attrs, attribute.String("k8s.job.name", name))
+	}
+	ctx, span := tracing.Tracer.Start(ctx, "k8s."+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+	)
+	start := time.Now()
+
+	return ctx, func(err error) {
+		result := "success"
+		if err != nil {
+			result = string(errors.ReasonForError(err))
+			if result == "" {
+				result = "error"
+			}
+		}
+		metrics.KubernetesRequestDuration.WithLabelValues(operation, result).Observe(time.Since(start).Seconds())
+		tracing.End(span, err)
+	}
+}
@@ -0,0 +1,335 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/netguard"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// createNetworkPolicy creates the egress policy of a job before the job, so
+// its pod never runs unrestricted. The policy is named after the job.
+func (d *JobDispatcher) createNetworkPolicy(ctx context.Context, jobName string, scan *domain.Scan, downloadURL string) (*networkingv1.NetworkPolicy, error) {
+	policy, err := d.buildNetworkPolicy(ctx, jobName, scan, downloadURL)
+	if err != nil {
+		return nil, err
+	}
+
+	reqCtx, end := startRequest(ctx, "create_network_policy", d.config.Namespace, jobName)
+	created, err := d.clientset.NetworkingV1().NetworkPolicies(d.config.Namespace).Create(reqCtx, policy, metav1.CreateOptions{})
+	end(err)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create network policy: %w", err)
+	}
+
+	return created, nil
+}
+
+// adoptNetworkPolicy makes the job own its policy, so the policy is garbage
+// collected with the job
+func (d *JobDispatcher) adoptNetworkPolicy(ctx context.Context, policy *networkingv1.NetworkPolicy, job *batchv1.Job) error {
+	policy.OwnerReferences = []metav1.OwnerReference{
+		*metav1.NewControllerRef(job, batchv1.SchemeGroupVersion.WithKind("Job")),
+	}
+
+	reqCtx, end := startRequest(ctx, "update_network_policy", policy.Namespace, job.Name)
+	_, err := d.clientset.NetworkingV1().NetworkPolicies(policy.Namespace).Update(reqCtx, policy, metav1.UpdateOptions{})
+	end(err)
+	if err != nil {
+		return fmt.Errorf("failed to update network policy: %w", err)
+	}
+
+	return nil
+}
+
+// deleteNetworkPolicy deletes the policy of a job, if there is one
+func (d *JobDispatcher) deleteNetworkPolicy(ctx context.Context, namespace, name string) error {
+	reqCtx, end := startRequest(ctx, "delete_network_policy", namespace, name)
+	err := d.clientset.NetworkingV1().NetworkPolicies(namespace).Delete(reqCtx, name, metav1.DeleteOptions{})
+	end(err)
+	if err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete network policy: %w", err)
+	}
+	return nil
+}
+
+// buildNetworkPolicy constructs the policy allowing the job's pods egress to
+// the cluster DNS, the orchestrator, the storage service, the scan's git host, the
+// object store serving its source archive and the allowed CIDRs. Ingress is denied.
+func (d *JobDispatcher) buildNetworkPolicy(ctx context.Context, jobName string, scan *domain.Scan, downloadURL string) (*networkingv1.NetworkPolicy, error) {
+	cfg := d.config.NetworkPolicy
+
+	orchestrator, err := metav1.ParseToLabelSelector(cfg.OrchestratorSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid orchestrator selector: %w", err)
+	}
+	storage, err := metav1.ParseToLabelSelector(cfg.StorageSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid storage selector: %w", err)
+	}
+	dnsNamespace, err := metav1.ParseToLabelSelector(cfg.DNSNamespaceSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid DNS namespace selector: %w", err)
+	}
+	dns, err := metav1.ParseToLabelSelector(cfg.DNSSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid DNS selector: %w", err)
+	}
+	anyNamespace := &metav1.LabelSelector{}
+
+	udp := corev1.ProtocolUDP
+	tcp := corev1.ProtocolTCP
+	dnsPort := intstr.FromInt32(53)
+
+	// DNS is only allowed to the cluster DNS; other resolvers could carry
+	// data out in queries
+	egress := []networkingv1.NetworkPolicyEgressRule{
+		{
+			To: []networkingv1.NetworkPolicyPeer{
+				{NamespaceSelector: dnsNamespace, PodSelector: dns},
+			},
+			Ports: []networkingv1.NetworkPolicyPort{
+				{Protocol: &udp, Port: &dnsPort},
+				{Protocol: &tcp, Port: &dnsPort},
+			},
+		},
+		{
+			To: []networkingv1.NetworkPolicyPeer{
+				{NamespaceSelector: anyNamespace, PodSelector: orchestrator},
+				{NamespaceSelector: anyNamespace, PodSelector: storage},
+			},
+		},
+	}
+
+	// The git host is allowed by address; NetworkPolicies can't match names.
+	// Every job resolves it anew, so a retry picks up changed addresses.
+	if scan.RepositoryURL != nil && *scan.RepositoryURL != "" {
+		addrs, err := d.gitHostAddresses(ctx, *scan.RepositoryURL)
+		if err != nil {
+			return nil, err
+		}
+
+		rule := networkingv1.NetworkPolicyEgressRule{}
+		for _, addr := range addrs {
+			rule.To = append(rule.To, networkingv1.NetworkPolicyPeer{IPBlock: &networkingv1.IPBlock{CIDR: hostCIDR(addr)}})
+		}
+		for _, port := range cfg.GitPorts {
+			p := intstr.FromInt32(port)
+			rule.Ports = append(rule.Ports, networkingv1.NetworkPolicyPort{Protocol: &tcp, Port: &p})
+		}
+		if len(rule.To) > 0 {
+			egress = append(egress, rule)
+		}
+	}
+
+	// The source archive is downloaded from a presigned URL of the object
+	// store, which is allowed by address and port like the git host
+	if downloadURL != "" {
+		addrs, port, err := d.sourceArchiveAddresses(ctx, downloadURL)
+		if err != nil {
+			return nil, err
+		}
+
+		rule := networkingv1.NetworkPolicyEgressRule{}
+		for _, addr := range addrs {
+			rule.To = append(rule.To, networkingv1.NetworkPolicyPeer{IPBlock: &networkingv1.IPBlock{CIDR: hostCIDR(addr)}})
+		}
+		p := intstr.FromInt32(port)
+		rule.Ports = []networkingv1.NetworkPolicyPort{{Protocol: &tcp, Port: &p}}
+		if len(rule.To) > 0 {
+			egress = append(egress, rule)
+		}
+	}
+
+	if len(cfg.AllowedCIDRs) > 0 {
+		rule := networkingv1.NetworkPolicyEgressRule{}
+		for _, cidr := range cfg.AllowedCIDRs {
+			rule.To = append(rule.To, networkingv1.NetworkPolicyPeer{IPBlock: &networkingv1.IPBlock{CIDR: cidr}})
+		}
+		egress = append(egress, rule)
+	}
+
+	return &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      jobName,
+			Namespace: d.config.Namespace,
+			Labels: map[string]string{
+				"app":     "cloudscan-runner",
+				"scan-id": scan.ID.String()[:8],
+			},
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{
+				MatchLabels: map[string]string{"job-name": jobName},
+			},
+			PolicyTypes: []networkingv1.PolicyType{
+				networkingv1.PolicyTypeIngress,
+				networkingv1.PolicyTypeEgress,
+			},
+			Egress: egress,
+		},
+	}, nil
+}
+
+// gitHostAddresses resolves the git host of a repository URL to the addresses
+// its job may reach. A host not on the git host allow-list is refused.
+func (d *JobDispatcher) gitHostAddresses(ctx context.Context, repositoryURL string) ([]net.IP, error) {
+	cfg := d.config.NetworkPolicy
+
+	host, err := gitHost(repositoryURL)
+	if err != nil {
+		return nil, err
+	}
+	if len(cfg.GitHosts) > 0 && !containsHost(cfg.GitHosts, host) {
+		return nil, fmt.Errorf("git host %s is not allowed", host)
+	}
+
+	return d.hostAddresses(ctx, "git host", host)
+}
+
+// sourceArchiveAddresses resolves the host of a source archive download URL
+// to the addresses and port its job may reach. An object store inside the
+// cluster or on a private network resolves to addresses that are refused;
+// it has to be covered by the allowed CIDRs instead.
+func (d *JobDispatcher) sourceArchiveAddresses(ctx context.Context, downloadURL string) ([]net.IP, int32, error) {
+	u, err := url.Parse(downloadURL)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid source archive URL: %w", err)
+	}
+	if u.Hostname() == "" {
+		return nil, 0, fmt.Errorf("source archive URL has no host")
+	}
+
+	var port int32
+	switch {
+	case u.Port() != "":
+		p, err := strconv.ParseUint(u.Port(), 10, 16)
+		if err != nil || p == 0 {
+			return nil, 0, fmt.Errorf("source archive URL has invalid port %q", u.Port())
+		}
+		port = int32(p)
+	case u.Scheme == "https":
+		port = 443
+	case u.Scheme == "http":
+		port = 80
+	default:
+		return nil, 0, fmt.Errorf("source archive URL has unsupported scheme %q", u.Scheme)
+	}
+
+	addrs, err := d.hostAddresses(ctx, "source archive host", u.Hostname())
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w; allow the object store with RUNNER_NETWORK_POLICY_ALLOWED_CIDRS", err)
+	}
+	return addrs, port, nil
+}
+
+// hostAddresses resolves a host to the addresses a job may reach. Addresses
+// in the allowed CIDRs are left out, they are reachable anyway. A host
+// resolving to a loopback, private, link-local, reserved or blocked address
+// is refused, so a URL can't open egress to the cloud metadata endpoint or
+// services inside the cluster.
+func (d *JobDispatcher) hostAddresses(ctx context.Context, kind, host string) ([]net.IP, error) {
+	cfg := d.config.NetworkPolicy
+
+	allowed, err := parseCIDRs(cfg.AllowedCIDRs)
+	if err != nil {
+		return nil, fmt.Errorf("invalid allowed CIDRs: %w", err)
+	}
+	blocked, err := parseCIDRs(cfg.BlockedCIDRs)
+	if err != nil {
+		return nil, fmt.Errorf("invalid blocked CIDRs: %w", err)
+	}
+
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve %s %s: %w", kind, host, err)
+	}
+
+	var ips []net.IP
+	for _, addr := range addrs {
+		ip := addr.IP
+		switch {
+		case inNetworks(allowed, ip):
+		case !netguard.Public(ip), inNetworks(blocked, ip):
+			return nil, fmt.Errorf("%s %s resolves to disallowed address %s", kind, host, ip)
+		default:
+			ips = append(ips, ip)
+		}
+	}
+	return ips, nil
+}
+
+// containsHost returns true if hosts contains host, ignoring case
+func containsHost(hosts []string, host string) bool {
+	for _, h := range hosts {
+		if strings.EqualFold(h, host) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseCIDRs parses a list of CIDRs
+func parseCIDRs(cidrs []string) ([]*net.IPNet, error) {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, err
+		}
+		networks = append(networks, network)
+	}
+	return networks, nil
+}
+
+// inNetworks returns true if ip is in any of the networks
+func inNetworks(networks []*net.IPNet, ip net.IP) bool {
+	for _, network := range networks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// gitHost returns the host of a repository URL, either a URL or the scp-like
+// user@host:path form
+func gitHost(repositoryURL string) (string, error) {
+	if !strings.Contains(repositoryURL, "://") {
+		if at := strings.Index(repositoryURL, "@"); at >= 0 {
+			repositoryURL = repositoryURL[at+1:]
+		}
+		if colon := strings.Index(repositoryURL, ":"); colon > 0 {
+			return repositoryURL[:colon], nil
+		}
+		return "", fmt.Errorf("invalid repository URL %q", repositoryURL)
+	}
+
+	u, err := url.Parse(repositoryURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid repository URL: %w", err)
+	}
+	if u.Hostname() == "" {
+		return "", fmt.Errorf("repository URL %q has no host", repositoryURL)
+	}
+	return u.Hostname(), nil
+}
+
+// hostCIDR returns the single-address CIDR of an IP
+func hostCIDR(ip net.IP) string {
+	if ip.To4() != nil {
+		return ip.String() + "/32"
+	}
+	return ip.String() + "/128"
+}
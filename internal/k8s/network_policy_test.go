@@ -0,0 +1,149 @@
+package k8s
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+	"github.com/google/uuid"
+	networkingv1 "k8s.io/api/networking/v1"
+)
+
+// testPolicyDispatcher returns a dispatcher building policies from cfg
+func testPolicyDispatcher(cfg interfaces.NetworkPolicyConfig) *JobDispatcher {
+	cfg.Enabled = true
+	cfg.OrchestratorSelector = "app=cloudscan-orchestrator"
+	cfg.StorageSelector = "app=cloudscan-storage"
+	cfg.DNSNamespaceSelector = "kubernetes.io/metadata.name=kube-system"
+	cfg.DNSSelector = "k8s-app=kube-dns"
+	cfg.GitPorts = []int32{443, 22}
+
+	return &JobDispatcher{
+		config: &interfaces.JobConfig{
+			Namespace:     "cloudscan",
+			NetworkPolicy: cfg,
+		},
+	}
+}
+
+// testUploadScan returns an upload scan, one with a source archive and no
+// repository URL
+func testUploadScan() *domain.Scan {
+	key := "uploads/source.tar.gz"
+	return &domain.Scan{ID: uuid.New(), SourceArchiveKey: &key}
+}
+
+// findIPBlockRule returns the egress rule allowing cidr, if there is one
+func findIPBlockRule(policy *networkingv1.NetworkPolicy, cidr string) *networkingv1.NetworkPolicyEgressRule {
+	for i, rule := range policy.Spec.Egress {
+		for _, peer := range rule.To {
+			if peer.IPBlock != nil && peer.IPBlock.CIDR == cidr {
+				return &policy.Spec.Egress[i]
+			}
+		}
+	}
+	return nil
+}
+
+func TestBuildNetworkPolicyAllowsSourceArchiveHost(t *testing.T) {
+	tests := []struct {
+		name        string
+		downloadURL string
+		cidr        string
+		port        int32
+	}{
+		{"explicit port", "https://93.184.216.34:9000/sources/source.tar.gz?X-Amz-Signature=abc", "93.184.216.34/32", 9000},
+		{"https", "https://93.184.216.34/sources/source.tar.gz?X-Amz-Signature=abc", "93.184.216.34/32", 443},
+		{"http", "http://93.184.216.34/sources/source.tar.gz", "93.184.216.34/32", 80},
+		{"IPv6", "https://[2606:2800:220:1::1]/sources/source.tar.gz", "2606:2800:220:1::1/128", 443},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := testPolicyDispatcher(interfaces.NetworkPolicyConfig{})
+
+			policy, err := d.buildNetworkPolicy(context.Background(), "scan-job", testUploadScan(), tt.downloadURL)
+			if err != nil {
+				t.Fatalf("buildNetworkPolicy() error = %v", err)
+			}
+
+			rule := findIPBlockRule(policy, tt.cidr)
+			if rule == nil {
+				t.Fatalf("no egress rule allows %s", tt.cidr)
+			}
+			if len(rule.Ports) != 1 || rule.Ports[0].Port.IntVal != tt.port {
+				t.Errorf("ports = %v, want only %d", rule.Ports, tt.port)
+			}
+		})
+	}
+}
+
+func TestBuildNetworkPolicyWithoutSourceArchive(t *testing.T) {
+	d := testPolicyDispatcher(interfaces.NetworkPolicyConfig{})
+
+	policy, err := d.buildNetworkPolicy(context.Background(), "scan-job", &domain.Scan{ID: uuid.New()}, "")
+	if err != nil {
+		t.Fatalf("buildNetworkPolicy() error = %v", err)
+	}
+	for _, rule := range policy.Spec.Egress {
+		for _, peer := range rule.To {
+			if peer.IPBlock != nil {
+				t.Errorf("unexpected egress to %s", peer.IPBlock.CIDR)
+			}
+		}
+	}
+}
+
+func TestBuildNetworkPolicyRefusesPrivateSourceArchiveHost(t *testing.T) {
+	tests := []struct {
+		name        string
+		downloadURL string
+		cfg         interfaces.NetworkPolicyConfig
+	}{
+		{"private", "http://10.0.0.5:9000/sources/source.tar.gz", interfaces.NetworkPolicyConfig{}},
+		{"loopback", "http://127.0.0.1:9000/sources/source.tar.gz", interfaces.NetworkPolicyConfig{}},
+		{"metadata endpoint", "http://169.254.169.254/latest", interfaces.NetworkPolicyConfig{}},
+		{"blocked", "https://93.184.216.34/sources/source.tar.gz", interfaces.NetworkPolicyConfig{BlockedCIDRs: []string{"93.184.216.0/24"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := testPolicyDispatcher(tt.cfg)
+
+			_, err := d.buildNetworkPolicy(context.Background(), "scan-job", testUploadScan(), tt.downloadURL)
+			if err == nil {
+				t.Fatal("buildNetworkPolicy() error = nil, want refused source archive host")
+			}
+			if !strings.Contains(err.Error(), "RUNNER_NETWORK_POLICY_ALLOWED_CIDRS") {
+				t.Errorf("error = %q, want it to name RUNNER_NETWORK_POLICY_ALLOWED_CIDRS", err)
+			}
+		})
+	}
+}
+
+func TestBuildNetworkPolicySourceArchiveHostInAllowedCIDRs(t *testing.T) {
+	d := testPolicyDispatcher(interfaces.NetworkPolicyConfig{AllowedCIDRs: []string{"10.0.0.0/8"}})
+
+	policy, err := d.buildNetworkPolicy(context.Background(), "scan-job", testUploadScan(), "http://10.0.0.5:9000/sources/source.tar.gz")
+	if err != nil {
+		t.Fatalf("buildNetworkPolicy() error = %v", err)
+	}
+	if findIPBlockRule(policy, "10.0.0.5/32") != nil {
+		t.Error("address in the allowed CIDRs got a rule of its own")
+	}
+	if findIPBlockRule(policy, "10.0.0.0/8") == nil {
+		t.Error("no egress rule allows the allowed CIDRs")
+	}
+}
+
+func TestBuildNetworkPolicyInvalidSourceArchiveURL(t *testing.T) {
+	for _, downloadURL := range []string{"ftp://93.184.216.34/source.tar.gz", "https:///source.tar.gz", "https://93.184.216.34:0/source.tar.gz"} {
+		d := testPolicyDispatcher(interfaces.NetworkPolicyConfig{})
+
+		if _, err := d.buildNetworkPolicy(context.Background(), "scan-job", testUploadScan(), downloadURL); err == nil {
+			t.Errorf("buildNetworkPolicy(%q) error = nil, want invalid URL", downloadURL)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package kms
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+)
+
+// Seal encrypts a secret with a new data key and wraps the data key with the
+// key manager's current key. The additional data, e.g. the ID of the record
+// holding the secret, must be passed to Open again, so a sealed secret can't
+// be moved to another record.
+func Seal(ctx context.Context, keyManager interfaces.KeyManager, plaintext, additionalData []byte) (domain.SealedSecret, error) {
+	dataKey := make([]byte, keySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return domain.SealedSecret{}, fmt.Errorf("failed to generate data key: %w", err)
+	}
+
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return domain.SealedSecret{}, err
+	}
+	ciphertext, err := seal(aead, plaintext, additionalData)
+	if err != nil {
+		return domain.SealedSecret{}, err
+	}
+
+	keyID := keyManager.KeyID()
+	wrappedKey, err := keyManager.WrapKey(ctx, dataKey)
+	if err != nil {
+		return domain.SealedSecret{}, fmt.Errorf("failed to wrap data key: %w", err)
+	}
+
+	return domain.SealedSecret{
+		KeyID:      keyID,
+		WrappedKey: wrappedKey,
+		Ciphertext: ciphertext,
+	}, nil
+}
+
+// Open unwraps the secret's data key and decrypts the secret
+func Open(ctx context.Context, keyManager interfaces.KeyManager, secret domain.SealedSecret, additionalData []byte) ([]byte, error) {
+	dataKey, err := keyManager.UnwrapKey(ctx, secret.KeyID, secret.WrappedKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap data key: %w", err)
+	}
+
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return open(aead, secret.Ciphertext, additionalData)
+}
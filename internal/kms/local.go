@@ -0,0 +1,113 @@
+// Package kms encrypts repository credentials at rest with envelope
+// encryption: each secret is sealed with its own data key, which a key
+// manager wraps with a key it holds.
+package kms
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"os"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+)
+
+// keySize is the size of local and data keys, for AES-256
+const keySize = 32
+
+// LocalKeyManager wraps data keys with an AES-256 key read from a file. It
+// is meant for development; production deployments should back onto a key
+// management service.
+type LocalKeyManager struct {
+	keyID string
+	aead  cipher.AEAD
+}
+
+// NewLocalKeyManager reads the key from a file holding 32 bytes, raw or
+// base64-encoded, e.g. as generated by `openssl rand -base64 32`
+func NewLocalKeyManager(path string) (interfaces.KeyManager, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key file: %w", err)
+	}
+
+	key := data
+	if len(key) != keySize {
+		key, err = base64.StdEncoding.DecodeString(string(bytes.TrimSpace(data)))
+		if err != nil || len(key) != keySize {
+			return nil, fmt.Errorf("key file must hold %d bytes, raw or base64-encoded", keySize)
+		}
+	}
+
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	// The ID names the key without revealing it, so a changed key file is
+	// detected rather than failing to decrypt
+	sum := sha256.Sum256(key)
+	return &LocalKeyManager{
+		keyID: "local:" + hex.EncodeToString(sum[:8]),
+		aead:  aead,
+	}, nil
+}
+
+// KeyID returns the ID of the local key
+func (m *LocalKeyManager) KeyID() string {
+	return m.keyID
+}
+
+// WrapKey encrypts a data key with the local key
+func (m *LocalKeyManager) WrapKey(ctx context.Context, dataKey []byte) ([]byte, error) {
+	return seal(m.aead, dataKey, []byte(m.keyID))
+}
+
+// UnwrapKey decrypts a data key wrapped with the local key
+func (m *LocalKeyManager) UnwrapKey(ctx context.Context, keyID string, wrappedKey []byte) ([]byte, error) {
+	if keyID != m.keyID {
+		return nil, fmt.Errorf("data key was wrapped with unknown key %s", keyID)
+	}
+	return open(m.aead, wrappedKey, []byte(m.keyID))
+}
+
+// newAEAD returns AES-GCM with the key
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	return aead, nil
+}
+
+// seal encrypts plaintext with a random nonce, which prefixes the result
+func seal(aead cipher.AEAD, plaintext, additionalData []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+// open decrypts the result of seal
+func open(aead cipher.AEAD, sealed, additionalData []byte) ([]byte, error) {
+	if len(sealed) < aead.NonceSize() {
+		return nil, fmt.Errorf("ciphertext is too short")
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, additionalData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt: %w", err)
+	}
+	return plaintext, nil
+}
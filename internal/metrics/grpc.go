@@ -0,0 +1,37 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// StorageClientInterceptor observes the latency of storage service calls
+func StorageClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+
+		StorageRequestDuration.
+			WithLabelValues(method, status.Code(err).String()).
+			Observe(time.Since(start).Seconds())
+
+		return err
+	}
+}
+
+// ObserveGRPCRequest records a handled gRPC request
+func ObserveGRPCRequest(method string, start time.Time, err error) {
+	code := status.Code(err).String()
+	GRPCRequestsTotal.WithLabelValues(method, code).Inc()
+	GRPCRequestDuration.WithLabelValues(method, code).Observe(time.Since(start).Seconds())
+}
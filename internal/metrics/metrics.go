@@ -0,0 +1,173 @@
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "cloudscan"
+
+// Registry holds every orchestrator metric. It is served by Handler.
+var Registry = prometheus.NewRegistry()
+
+var (
+	// BuildInfo is always 1 and labelled with the build metadata
+	BuildInfo = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "orchestrator_info",
+		Help:      "Build info of the orchestrator.",
+	}, []string{"version", "commit", "buildDate"})
+
+	// GRPCRequestsTotal counts handled gRPC requests
+	GRPCRequestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "grpc_requests_total",
+		Help:      "Total gRPC requests by method and status code.",
+	}, []string{"method", "code"})
+
+	// GRPCRequestDuration observes gRPC handler latency
+	GRPCRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "grpc_request_duration_seconds",
+		Help:      "gRPC request latency by method and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "code"})
+
+	// WorkerCycleDuration observes how long a background worker cycle takes
+	WorkerCycleDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "worker_cycle_duration_seconds",
+		Help:      "Duration of background worker cycles.",
+		Buckets:   []float64{.01, .05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60, 300},
+	}, []string{"worker"})
+
+	// WorkerErrorsTotal counts errors during background worker cycles
+	WorkerErrorsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "worker_errors_total",
+		Help:      "Errors during background worker cycles.",
+	}, []string{"worker"})
+
+	// KubernetesRequestDuration observes Kubernetes API call latency
+	KubernetesRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "k8s_request_duration_seconds",
+		Help:      "Kubernetes API call latency by operation and result.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"operation", "result"})
+
+	// StorageRequestDuration observes storage service call latency
+	StorageRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "storage_request_duration_seconds",
+		Help:      "Storage service call latency by method and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "code"})
+
+	// FindingsIngestedTotal counts findings reported by runners
+	FindingsIngestedTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "findings_ingested_total",
+		Help:      "Findings ingested by scan type and severity.",
+	}, []string{"scan_type", "severity"})
+
+	// ScanDuration observes the run time of finished scans
+	ScanDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "scan_duration_seconds",
+		Help:      "Run time of finished scans, from job start to completion, by final status.",
+		Buckets:   []float64{10, 30, 60, 120, 300, 600, 900, 1800, 3600, 7200},
+	}, []string{"status"})
+
+	// Partitions is the number of partitions attached to a partitioned table
+	Partitions = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "partitions",
+		Help:      "Partitions attached to a partitioned table.",
+	}, []string{"table"})
+
+	// PartitionCoverage is how far ahead rows can be inserted without a new partition
+	PartitionCoverage = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "partition_coverage_seconds",
+		Help:      "Time from now until the end of the contiguous partitions covering now; 0 if now is not covered.",
+	}, []string{"table"})
+
+	// PartitionOperationsTotal counts partitions created, detached, archived and dropped
+	PartitionOperationsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "partition_operations_total",
+		Help:      "Partition maintenance operations by table and operation.",
+	}, []string{"table", "operation"})
+
+	// RetentionScansTotal counts scans expired by retention policies
+	RetentionScansTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "retention_scans_total",
+		Help:      "Scans expired by retention policies, by mode (delete or dry_run).",
+	}, []string{"mode"})
+
+	// LogArchivesTotal counts attempts to archive runner logs
+	LogArchivesTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "log_archives_total",
+		Help:      "Runner log archive attempts, by result (archived or failed).",
+	}, []string{"result"})
+
+	// JobFailuresTotal counts failed scan jobs
+	JobFailuresTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "job_failures_total",
+		Help:      "Failed scan jobs by failure reason and outcome (retried or failed).",
+	}, []string{"reason", "outcome"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		BuildInfo,
+		GRPCRequestsTotal,
+		GRPCRequestDuration,
+		WorkerCycleDuration,
+		WorkerErrorsTotal,
+		KubernetesRequestDuration,
+		StorageRequestDuration,
+		FindingsIngestedTotal,
+		ScanDuration,
+		Partitions,
+		PartitionCoverage,
+		PartitionOperationsTotal,
+		RetentionScansTotal,
+		LogArchivesTotal,
+		JobFailuresTotal,
+	)
+}
+
+// Handler serves the metrics of Registry in the Prometheus exposition format
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
+
+// WorkerCycle starts timing a worker cycle. Call ObserveDuration on the returned
+// timer when the cycle ends.
+func WorkerCycle(worker string) *prometheus.Timer {
+	return prometheus.NewTimer(WorkerCycleDuration.WithLabelValues(worker))
+}
+
+// JobFailure counts a failed scan job and whether it is retried
+func JobFailure(reason string, retried bool) {
+	outcome := "failed"
+	if retried {
+		outcome = "retried"
+	}
+	JobFailuresTotal.WithLabelValues(reason, outcome).Inc()
+}
+
+// WorkerError counts an error of the worker
+func WorkerError(worker string) {
+	WorkerErrorsTotal.WithLabelValues(worker).Inc()
+}
@@ -0,0 +1,61 @@
+// Package netguard keeps connections made on behalf of users, e.g. to webhook
+// URLs or git hosts, away from internal addresses
+package netguard
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"syscall"
+)
+
+// ErrNotPublic is returned for a connection to an address that isn't public
+var ErrNotPublic = errors.New("address is not public")
+
+// reservedNetworks are non-public ranges net.IP's predicates don't cover:
+// "this network", shared address space, the benchmarking range and NAT64
+var reservedNetworks = mustParseCIDRs("0.0.0.0/8", "100.64.0.0/10", "198.18.0.0/15", "64:ff9b::/96")
+
+// Public returns true if ip is a public unicast address: not loopback,
+// private (RFC 1918, fc00::/7), link-local (including the cloud metadata
+// endpoint 169.254.169.254), multicast, unspecified or reserved
+func Public(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() {
+		return false
+	}
+	for _, network := range reservedNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// Control is a net.Dialer Control hook refusing connections to addresses
+// that aren't public. It runs after name resolution, so a host resolving to
+// another address than when it was checked is still refused.
+func Control(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !Public(ip) {
+		return fmt.Errorf("%w: %s", ErrNotPublic, host)
+	}
+	return nil
+}
+
+// mustParseCIDRs parses a list of constant CIDRs
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, len(cidrs))
+	for i, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks[i] = network
+	}
+	return networks
+}
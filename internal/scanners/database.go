@@ -0,0 +1,122 @@
+package scanners
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+	log "github.com/sirupsen/logrus"
+)
+
+// DatabaseRegistry implements interfaces.ScannerRegistry over the scanners
+// table. A row overrides the configured scanner of its scan type, so scanners
+// can be upgraded or disabled without a restart; scan types without a row
+// keep their configured scanner.
+type DatabaseRegistry struct {
+	repo   interfaces.ScannerRepository
+	config *StaticRegistry
+	ttl    time.Duration
+	logger *log.Entry
+
+	mu       sync.Mutex
+	scanners []interfaces.ScannerConfig // Merged scanners, nil until loaded
+	loadedAt time.Time
+}
+
+// NewDatabaseRegistry creates a registry reading the scanners table at most
+// once per ttl and falling back to the configured scanners. A zero ttl reads
+// the table on every lookup.
+func NewDatabaseRegistry(repo interfaces.ScannerRepository, config *StaticRegistry, ttl time.Duration) *DatabaseRegistry {
+	return &DatabaseRegistry{
+		repo:   repo,
+		config: config,
+		ttl:    ttl,
+		logger: log.WithField("component", "scanner-registry"),
+	}
+}
+
+// Get returns the enabled scanner for the scan type
+func (r *DatabaseRegistry) Get(ctx context.Context, scanType domain.ScanType) (*interfaces.ScannerConfig, error) {
+	scanners, err := r.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, scanner := range scanners {
+		if scanner.ScanType == scanType && scanner.Enabled {
+			return &scanner, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", domain.ErrScannerUnavailable, scanType)
+}
+
+// List returns the configured scanners overridden by the scanners table,
+// ordered by scan type
+func (r *DatabaseRegistry) List(ctx context.Context) ([]interfaces.ScannerConfig, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.scanners == nil || time.Since(r.loadedAt) >= r.ttl {
+		scanners, err := r.load(ctx)
+		if err != nil {
+			return nil, err
+		}
+		r.scanners = scanners
+		r.loadedAt = time.Now()
+	}
+
+	// Callers get their own copy to modify
+	scanners := make([]interfaces.ScannerConfig, len(r.scanners))
+	copy(scanners, r.scanners)
+	return scanners, nil
+}
+
+// Set validates the scanner and stores it in the scanners table. The next
+// lookup reads the table again.
+func (r *DatabaseRegistry) Set(ctx context.Context, scanner *interfaces.ScannerConfig) error {
+	if err := scanner.Validate(); err != nil {
+		return err
+	}
+	if err := r.repo.Set(ctx, scanner); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	r.scanners = nil
+	r.mu.Unlock()
+	return nil
+}
+
+// load merges the rows of the scanners table into the configured scanners.
+// Rows that can't be run, e.g. edited by hand, are skipped, so their scan
+// type keeps its configured scanner.
+func (r *DatabaseRegistry) load(ctx context.Context) ([]interfaces.ScannerConfig, error) {
+	rows, err := r.repo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := make(map[domain.ScanType]interfaces.ScannerConfig, len(r.config.scanners)+len(rows))
+	for scanType, scanner := range r.config.scanners {
+		merged[scanType] = scanner
+	}
+	for _, scanner := range rows {
+		if err := scanner.Validate(); err != nil {
+			r.logger.WithError(err).WithField("scan_type", scanner.ScanType).Warn("Ignoring invalid scanner of the scanners table")
+			continue
+		}
+		merged[scanner.ScanType] = scanner
+	}
+
+	scanners := make([]interfaces.ScannerConfig, 0, len(merged))
+	for _, scanner := range merged {
+		scanners = append(scanners, scanner)
+	}
+	sort.Slice(scanners, func(i, j int) bool {
+		return scanners[i].ScanType < scanners[j].ScanType
+	})
+	return scanners, nil
+}
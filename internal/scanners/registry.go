@@ -0,0 +1,63 @@
+// Package scanners resolves the scanner image and settings that run each scan type.
+package scanners
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+)
+
+// StaticRegistry implements interfaces.ScannerRegistry over a fixed set of
+// scanners loaded from the configuration at startup
+type StaticRegistry struct {
+	scanners map[domain.ScanType]interfaces.ScannerConfig
+}
+
+// NewStaticRegistry creates a registry of the scanners. Each scan type may be
+// registered once.
+func NewStaticRegistry(scanners []interfaces.ScannerConfig) (*StaticRegistry, error) {
+	registry := &StaticRegistry{scanners: make(map[domain.ScanType]interfaces.ScannerConfig, len(scanners))}
+	for _, scanner := range scanners {
+		if scanner.ScanType == "" {
+			return nil, fmt.Errorf("scanner %q has no scan type", scanner.Name)
+		}
+		if scanner.Name == "" || scanner.Image == "" {
+			return nil, fmt.Errorf("scanner for %s needs a name and an image", scanner.ScanType)
+		}
+		if _, ok := registry.scanners[scanner.ScanType]; ok {
+			return nil, fmt.Errorf("scan type %s has more than one scanner", scanner.ScanType)
+		}
+		registry.scanners[scanner.ScanType] = scanner
+	}
+	return registry, nil
+}
+
+// Get returns the enabled scanner for the scan type
+func (r *StaticRegistry) Get(ctx context.Context, scanType domain.ScanType) (*interfaces.ScannerConfig, error) {
+	scanner, ok := r.scanners[scanType]
+	if !ok || !scanner.Enabled {
+		return nil, fmt.Errorf("%w: %s", domain.ErrScannerUnavailable, scanType)
+	}
+	return &scanner, nil
+}
+
+// List returns every registered scanner ordered by scan type
+func (r *StaticRegistry) List(ctx context.Context) ([]interfaces.ScannerConfig, error) {
+	scanners := make([]interfaces.ScannerConfig, 0, len(r.scanners))
+	for _, scanner := range r.scanners {
+		scanners = append(scanners, scanner)
+	}
+	sort.Slice(scanners, func(i, j int) bool {
+		return scanners[i].ScanType < scanners[j].ScanType
+	})
+	return scanners, nil
+}
+
+// Set returns domain.ErrScannersReadOnly; configured scanners only change
+// with the configuration
+func (r *StaticRegistry) Set(ctx context.Context, scanner *interfaces.ScannerConfig) error {
+	return domain.ErrScannersReadOnly
+}
@@ -0,0 +1,122 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ServiceName identifies the orchestrator in traces
+const ServiceName = "cloudscan-orchestrator"
+
+// Tracer creates the orchestrator's own spans. Until Init installs a tracer
+// provider it produces no-op spans that still carry incoming trace context.
+var Tracer = otel.Tracer("github.com/cloud-scan/cloudscan-orchestrator")
+
+// Config configures trace export
+type Config struct {
+	Enabled  bool
+	Endpoint string // OTLP/gRPC collector endpoint, e.g. http://jaeger:4317; OTEL_EXPORTER_OTLP_ENDPOINT when empty
+	Version  string
+}
+
+// Init installs the W3C trace context propagator and, if enabled, a tracer
+// provider exporting spans over OTLP/gRPC. The sampler follows OTEL_TRACES_SAMPLER
+// (default: parent based, always on). The returned function flushes pending spans.
+func Init(ctx context.Context, cfg Config) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	if !cfg.Enabled {
+		log.Info("Tracing disabled (set JAEGER_ENABLED=true to enable)")
+		return func(context.Context) error { return nil }, nil
+	}
+
+	var opts []otlptracegrpc.Option
+	if cfg.Endpoint != "" {
+		endpoint := cfg.Endpoint
+		if !strings.Contains(endpoint, "://") {
+			endpoint = "http://" + endpoint
+		}
+		opts = append(opts, otlptracegrpc.WithEndpointURL(endpoint))
+	}
+
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP exporter: %w", err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(
+		attribute.String("service.name", ServiceName),
+		attribute.String("service.version", cfg.Version),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create trace resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+
+	log.WithField("endpoint", cfg.Endpoint).Info("Tracing enabled")
+
+	return provider.Shutdown, nil
+}
+
+// TraceParent returns the W3C traceparent of the span in ctx, or "" if there is none
+func TraceParent(ctx context.Context) string {
+	carrier := propagation.MapCarrier{}
+	propagation.TraceContext{}.Inject(ctx, carrier)
+	return carrier.Get("traceparent")
+}
+
+// WithTraceParent returns ctx with the remote span described by traceParent as
+// parent, so spans of background work join the trace that started it. An empty
+// or invalid traceParent returns ctx unchanged.
+func WithTraceParent(ctx context.Context, traceParent string) context.Context {
+	if traceParent == "" {
+		return ctx
+	}
+	return propagation.TraceContext{}.Extract(ctx, propagation.MapCarrier{"traceparent": traceParent})
+}
+
+// EnvCarrier returns the trace context of ctx as environment variables
+// (TRACEPARENT, TRACESTATE, BAGGAGE) for propagation into child processes
+func EnvCarrier(ctx context.Context) map[string]string {
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+
+	env := make(map[string]string, len(carrier))
+	for k, v := range carrier {
+		env[strings.ToUpper(k)] = v
+	}
+	return env
+}
+
+// SetError records err on the span and marks it failed. A nil err is ignored.
+func SetError(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
+
+// End records err on the span, if any, and ends it
+func End(span trace.Span, err error) {
+	SetError(span, err)
+	span.End()
+}
@@ -0,0 +1,89 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/netguard"
+)
+
+// ErrEndpointNotAllowed is returned for a webhook URL the policy refuses
+var ErrEndpointNotAllowed = errors.New("webhook endpoint not allowed")
+
+// EndpointPolicy restricts where webhooks are sent. By default only https
+// URLs of public addresses are allowed, so a subscription can't make the
+// delivery worker reach services inside the network.
+type EndpointPolicy struct {
+	AllowHTTP             bool // Also allow plain http URLs
+	AllowPrivateAddresses bool // Also allow loopback, private, link-local and reserved addresses
+}
+
+// CheckURL returns an error wrapping ErrEndpointNotAllowed if webhooks can't
+// be sent to the URL. The host is resolved, and refused if any of its
+// addresses isn't public.
+func (p EndpointPolicy) CheckURL(ctx context.Context, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return fmt.Errorf("%w: invalid url %q", ErrEndpointNotAllowed, rawURL)
+	}
+	if err := p.CheckScheme(u); err != nil {
+		return err
+	}
+	if p.AllowPrivateAddresses {
+		return nil
+	}
+
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, u.Hostname())
+	if err != nil {
+		return fmt.Errorf("%w: can't resolve %s: %v", ErrEndpointNotAllowed, u.Hostname(), err)
+	}
+	for _, addr := range addrs {
+		if !netguard.Public(addr.IP) {
+			return fmt.Errorf("%w: %s resolves to non-public address %s", ErrEndpointNotAllowed, u.Hostname(), addr.IP)
+		}
+	}
+	return nil
+}
+
+// CheckScheme requires https unless http is allowed. Deliveries check it
+// again, for subscriptions created under a laxer policy.
+func (p EndpointPolicy) CheckScheme(u *url.URL) error {
+	switch {
+	case u.Scheme == "https":
+	case u.Scheme == "http" && p.AllowHTTP:
+	default:
+		return fmt.Errorf("%w: scheme %q", ErrEndpointNotAllowed, u.Scheme)
+	}
+	return nil
+}
+
+// Client returns an HTTP client for deliveries that enforces the policy on
+// every connection, including redirects: addresses are checked after
+// resolution, so a host can't pass CheckURL and resolve elsewhere later.
+// Proxies aren't used, they would hide the endpoint's address.
+func (p EndpointPolicy) Client(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{Timeout: timeout}
+	if !p.AllowPrivateAddresses {
+		dialer.Control = netguard.Control
+	}
+
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: timeout,
+			MaxIdleConnsPerHost: 2,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= 10 {
+				return errors.New("stopped after 10 redirects")
+			}
+			return p.CheckScheme(req.URL)
+		},
+	}
+}
@@ -0,0 +1,82 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+)
+
+// Publisher writes events into the webhook outbox, one delivery per matching
+// subscription. Deliveries are sent by the webhook delivery worker.
+type Publisher struct {
+	webhookRepo interfaces.WebhookRepository
+	logger      *log.Entry
+}
+
+// NewPublisher creates a new webhook publisher
+func NewPublisher(webhookRepo interfaces.WebhookRepository) *Publisher {
+	return &Publisher{
+		webhookRepo: webhookRepo,
+		logger:      log.WithField("component", "webhook-publisher"),
+	}
+}
+
+// Publish enqueues the event for every enabled subscription of the event's organization
+func (p *Publisher) Publish(ctx context.Context, event *domain.Event) error {
+	subscriptions, err := p.webhookRepo.ListSubscriptions(ctx, event.OrganizationID)
+	if err != nil {
+		return fmt.Errorf("failed to list webhook subscriptions: %w", err)
+	}
+
+	var payload []byte
+	var deliveries []*domain.WebhookDelivery
+	now := time.Now()
+
+	for _, subscription := range subscriptions {
+		if !subscription.Enabled || !subscription.Matches(event.Type) {
+			continue
+		}
+
+		if payload == nil {
+			payload, err = json.Marshal(event)
+			if err != nil {
+				return fmt.Errorf("failed to encode event: %w", err)
+			}
+		}
+
+		deliveries = append(deliveries, &domain.WebhookDelivery{
+			ID:             uuid.New(),
+			SubscriptionID: subscription.ID,
+			OrganizationID: event.OrganizationID,
+			EventID:        event.ID,
+			EventType:      event.Type,
+			Payload:        payload,
+			Status:         domain.WebhookDeliveryPending,
+			NextAttemptAt:  now,
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		})
+	}
+
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	if err := p.webhookRepo.EnqueueDeliveries(ctx, deliveries); err != nil {
+		return err
+	}
+
+	p.logger.WithFields(log.Fields{
+		"event_id":   event.ID.String(),
+		"event_type": event.Type,
+		"count":      len(deliveries),
+	}).Debug("Enqueued webhook deliveries")
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"time"
+)
+
+// Headers sent with every webhook delivery
+const (
+	HeaderEvent     = "X-CloudScan-Event"
+	HeaderDelivery  = "X-CloudScan-Delivery"
+	HeaderTimestamp = "X-CloudScan-Timestamp"
+	HeaderSignature = "X-CloudScan-Signature"
+)
+
+// Sign computes the X-CloudScan-Signature header value: "sha256=" followed by the
+// hex-encoded HMAC-SHA256 of "<timestamp>.<body>" keyed with the subscription secret.
+// Including the timestamp lets receivers reject replayed requests.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
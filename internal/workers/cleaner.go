@@ -7,50 +7,58 @@ import (
 
 	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
 	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/metrics"
+	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
 )
 
-// Cleaner enforces data retention policies by cleaning up old scans
+// CleanerConfig configures the retention cleaner
+type CleanerConfig struct {
+	CleanupTime   string                 // HH:MM format for daily cleanup time
+	BatchSize     int                    // Scans selected and scheduled for deletion at a time
+	DryRun        bool                   // Only report the scans that would be deleted
+	DefaultPolicy domain.RetentionPolicy // Applies to scans without an organization or project policy
+}
+
+// Cleaner enforces data retention policies by deleting expired scans. Each
+// scan is governed by its project's policy, else its organization's, else the
+// default policy. Expired scans are handed to the scan deleter in batches,
+// each tracked by an apply_retention operation.
 type Cleaner struct {
-	scanRepo         interfaces.ScanRepository
-	findingRepo      interfaces.FindingRepository
-	storageClient    interfaces.StorageClient
-	jobDispatcher    interfaces.JobDispatcher
-	retentionDays    int
-	cleanupTime      string // HH:MM format for daily cleanup time
-	defaultNamespace string
-	logger           *log.Entry
-	stopChan         chan struct{}
+	retentionRepo interfaces.RetentionRepository
+	operationRepo interfaces.OperationRepository
+	config        CleanerConfig
+	logger        *log.Entry
+	stopChan      chan struct{}
 }
 
 // NewCleaner creates a new cleaner worker
 func NewCleaner(
-	scanRepo interfaces.ScanRepository,
-	findingRepo interfaces.FindingRepository,
-	storageClient interfaces.StorageClient,
-	jobDispatcher interfaces.JobDispatcher,
-	retentionDays int,
-	cleanupTime string,
-	defaultNamespace string,
+	retentionRepo interfaces.RetentionRepository,
+	operationRepo interfaces.OperationRepository,
+	config CleanerConfig,
 ) *Cleaner {
+	if config.BatchSize < 1 {
+		config.BatchSize = 1
+	}
 	return &Cleaner{
-		scanRepo:         scanRepo,
-		findingRepo:      findingRepo,
-		storageClient:    storageClient,
-		jobDispatcher:    jobDispatcher,
-		retentionDays:    retentionDays,
-		cleanupTime:      cleanupTime,
-		defaultNamespace: defaultNamespace,
-		logger:           log.WithField("component", "cleaner"),
-		stopChan:         make(chan struct{}),
+		retentionRepo: retentionRepo,
+		operationRepo: operationRepo,
+		config:        config,
+		logger:        log.WithField("component", "cleaner"),
+		stopChan:      make(chan struct{}),
 	}
 }
 
 // Start begins the cleaner's cleanup schedule
 func (c *Cleaner) Start(ctx context.Context) {
 	c.logger.WithFields(log.Fields{
-		"retention_days": c.retentionDays,
-		"cleanup_time":   c.cleanupTime,
+		"cleanup_time":        c.config.CleanupTime,
+		"batch_size":          c.config.BatchSize,
+		"dry_run":             c.config.DryRun,
+		"default_keep_days":   c.config.DefaultPolicy.KeepDays,
+		"default_keep_last":   c.config.DefaultPolicy.KeepLastPerBranch,
+		"default_keep_latest": c.config.DefaultPolicy.KeepLatestDefaultBranch,
 	}).Info("Starting cleaner worker")
 
 	// Calculate next cleanup time
@@ -87,8 +95,8 @@ func (c *Cleaner) calculateNextCleanup() time.Time {
 	// Parse cleanup time (e.g., "00:00" for midnight)
 	cleanupHour := 0
 	cleanupMinute := 0
-	if c.cleanupTime != "" {
-		_, _ = fmt.Sscanf(c.cleanupTime, "%d:%d", &cleanupHour, &cleanupMinute)
+	if c.config.CleanupTime != "" {
+		_, _ = fmt.Sscanf(c.config.CleanupTime, "%d:%d", &cleanupHour, &cleanupMinute)
 	}
 
 	// Calculate next cleanup time
@@ -106,112 +114,103 @@ func (c *Cleaner) calculateNextCleanup() time.Time {
 	return nextCleanup
 }
 
-// cleanup performs the actual cleanup operation
+// cleanup applies the retention policies batch by batch
 func (c *Cleaner) cleanup(ctx context.Context) {
-	c.logger.Info("Starting cleanup cycle")
-
-	// Calculate cutoff date
-	cutoffDate := time.Now().AddDate(0, 0, -c.retentionDays)
-	c.logger.WithField("cutoff_date", cutoffDate).Info("Cleaning scans older than cutoff date")
-
-	// Query for old scans in FINAL states only (exclude QUEUED and RUNNING)
-	// This prevents deleting active scans that are older than retention period
-	finalStatuses := []domain.ScanStatus{
-		domain.ScanStatusCompleted,
-		domain.ScanStatusFailed,
-		domain.ScanStatusCancelled,
-	}
+	c.logger.WithField("dry_run", c.config.DryRun).Info("Starting cleanup cycle")
+	defer metrics.WorkerCycle("cleaner").ObserveDuration()
 
-	var allScansToClean []*domain.Scan
-
-	// Query for each final status
-	for _, status := range finalStatuses {
-		filter := interfaces.ScanFilter{
-			CreatedBefore: &cutoffDate,
-			Status:        &status,
-		}
+	query := interfaces.ExpiredScansQuery{
+		Default: c.config.DefaultPolicy,
+		Now:     time.Now(),
+		Limit:   c.config.BatchSize,
+	}
 
-		scans, err := c.scanRepo.List(ctx, filter)
+	expiredCount := 0
+	scheduledCount := 0
+	for {
+		scans, err := c.retentionRepo.ListExpiredScans(ctx, query)
 		if err != nil {
-			c.logger.WithError(err).WithField("status", status).Error("Failed to list old scans")
-			continue
+			c.logger.WithError(err).Error("Failed to list expired scans")
+			metrics.WorkerError("cleaner")
+			break
 		}
+		if len(scans) == 0 {
+			break
+		}
+		expiredCount += len(scans)
 
-		allScansToClean = append(allScansToClean, scans...)
-	}
-
-	c.logger.WithField("count", len(allScansToClean)).Info("Found old scans in final states to clean up")
+		if c.config.DryRun {
+			c.report(scans)
+		} else {
+			scheduled, err := c.scheduleDeletion(ctx, scans)
+			if err != nil {
+				c.logger.WithError(err).Error("Failed to schedule deletion of expired scans")
+				metrics.WorkerError("cleaner")
+				break
+			}
+			scheduledCount += scheduled
+		}
 
-	successCount := 0
-	failureCount := 0
+		// Continue after the last scan, so a dry run doesn't see it again
+		last := scans[len(scans)-1]
+		query.AfterCreatedAt = last.CreatedAt
+		query.AfterID = last.ID
+		if len(scans) < c.config.BatchSize {
+			break
+		}
 
-	// Clean up each scan
-	for _, scan := range allScansToClean {
-		if err := c.cleanupScan(ctx, scan); err != nil {
-			c.logger.WithError(err).WithField("scan_id", scan.ID.String()).Error("Failed to cleanup scan")
-			failureCount++
-		} else {
-			successCount++
+		select {
+		case <-c.stopChan:
+			return
+		case <-ctx.Done():
+			return
+		default:
 		}
 	}
 
 	c.logger.WithFields(log.Fields{
-		"success_count": successCount,
-		"failure_count": failureCount,
-		"total_count":   len(allScansToClean),
+		"expired_count":   expiredCount,
+		"scheduled_count": scheduledCount,
+		"dry_run":         c.config.DryRun,
 	}).Info("Cleanup cycle completed")
 }
 
-// cleanupScan cleans up a single scan
-func (c *Cleaner) cleanupScan(ctx context.Context, scan *domain.Scan) error {
-	logger := c.logger.WithField("scan_id", scan.ID.String())
-	logger.Debug("Cleaning up scan")
-
-	// 1. Delete Kubernetes job if it exists
-	if scan.JobName != nil && *scan.JobName != "" {
-		// Use scan's namespace or fall back to default namespace
-		jobNamespace := c.defaultNamespace
-		if scan.JobNamespace != nil && *scan.JobNamespace != "" {
-			jobNamespace = *scan.JobNamespace
-		}
-		if err := c.jobDispatcher.DeleteJob(ctx, jobNamespace, *scan.JobName); err != nil {
-			logger.WithError(err).Warn("Failed to delete Kubernetes job")
-			// Continue cleanup even if job deletion fails
-		} else {
-			logger.Debug("Deleted Kubernetes job")
+// report logs the scans a real run would delete
+func (c *Cleaner) report(scans []*domain.Scan) {
+	for _, scan := range scans {
+		logger := c.logger.WithFields(log.Fields{
+			"scan_id":    scan.ID.String(),
+			"org_id":     scan.OrganizationID.String(),
+			"project_id": scan.ProjectID.String(),
+			"status":     scan.Status,
+			"created_at": scan.CreatedAt,
+		})
+		if scan.Branch != nil {
+			logger = logger.WithField("branch", *scan.Branch)
 		}
+		logger.Info("Dry run: would delete scan")
 	}
+	metrics.RetentionScansTotal.WithLabelValues("dry_run").Add(float64(len(scans)))
+}
 
-	// 2. Collect artifact IDs to delete
-	var artifactIDs []string
-	if scan.SourceArchiveKey != nil && *scan.SourceArchiveKey != "" {
-		artifactIDs = append(artifactIDs, *scan.SourceArchiveKey)
+// scheduleDeletion marks the scans deleting in one apply_retention operation
+// and returns how many were scheduled
+func (c *Cleaner) scheduleDeletion(ctx context.Context, scans []*domain.Scan) (int, error) {
+	scanIDs := make([]uuid.UUID, len(scans))
+	for i, scan := range scans {
+		scanIDs[i] = scan.ID
 	}
-	// TODO: Add results artifact key when implemented
 
-	// 3. Delete artifacts from storage service
-	if len(artifactIDs) > 0 {
-		if err := c.storageClient.DeleteArtifacts(ctx, artifactIDs); err != nil {
-			logger.WithError(err).Warn("Failed to delete artifacts from storage")
-			// Continue cleanup even if artifact deletion fails
-		} else {
-			logger.WithField("artifact_count", len(artifactIDs)).Debug("Deleted artifacts from storage")
-		}
+	operation, err := c.operationRepo.StartRetentionDeletion(ctx, scanIDs)
+	if err != nil {
+		return 0, err
 	}
 
-	// 4. Delete findings from database
-	if err := c.findingRepo.DeleteByScanID(ctx, scan.ID); err != nil {
-		logger.WithError(err).Error("Failed to delete findings")
-		return err
-	}
-	logger.Debug("Deleted findings from database")
-
-	// 5. Delete scan from database
-	if err := c.scanRepo.Delete(ctx, scan.ID); err != nil {
-		logger.WithError(err).Error("Failed to delete scan")
-		return err
-	}
-	logger.Info("Successfully cleaned up scan")
+	c.logger.WithFields(log.Fields{
+		"operation_id": operation.ID.String(),
+		"scan_count":   operation.TotalItems,
+	}).Info("Scheduled deletion of expired scans")
+	metrics.RetentionScansTotal.WithLabelValues("delete").Add(float64(operation.TotalItems))
 
-	return nil
+	return operation.TotalItems, nil
 }
@@ -0,0 +1,301 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/health"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+)
+
+// commitStatusLease keeps other replicas away from claimed deliveries while
+// they are sent; it exceeds the timeout of a status request
+const commitStatusLease = time.Minute
+
+// CommitStatusConfig configures how scan results are reported to Git providers
+type CommitStatusConfig struct {
+	Context        string          // Check name shown on the commit, e.g. "cloudscan"
+	UIBaseURL      string          // Scans are linked as <UIBaseURL>/scans/<id>; no link when empty
+	FailOn         domain.Severity // Completed scans with findings at or above this severity report failure
+	Interval       time.Duration   // How often to poll for due deliveries
+	BatchSize      int             // Deliveries claimed per poll
+	MaxAttempts    int             // Delivery attempts per client before giving up
+	InitialBackoff time.Duration   // Delay before the first retry, doubled after each attempt
+	MaxBackoff     time.Duration   // Upper bound for the retry delay
+}
+
+// CommitStatusNotifier posts the outcome of finished scans to the Git provider of
+// the scanned commit. Relayed events are stored as one delivery per client, which
+// is sent and retried with exponential backoff, so restarts don't lose them.
+type CommitStatusNotifier struct {
+	statusRepo interfaces.CommitStatusRepository
+	clients    []interfaces.CommitStatusClient
+	config     CommitStatusConfig
+	logger     *log.Entry
+	stopChan   chan struct{}
+}
+
+// NewCommitStatusNotifier creates a new commit status notifier
+func NewCommitStatusNotifier(statusRepo interfaces.CommitStatusRepository, clients []interfaces.CommitStatusClient, config CommitStatusConfig) *CommitStatusNotifier {
+	if config.MaxAttempts < 1 {
+		config.MaxAttempts = 1
+	}
+	return &CommitStatusNotifier{
+		statusRepo: statusRepo,
+		clients:    clients,
+		config:     config,
+		logger:     log.WithField("component", "commit-status-notifier"),
+		stopChan:   make(chan struct{}),
+	}
+}
+
+// Publish enqueues a delivery for every client supporting the repository when a
+// scan of a known commit finished. The event relay retries the event if this fails.
+func (n *CommitStatusNotifier) Publish(ctx context.Context, event *domain.Event) error {
+	switch event.Type {
+	case domain.EventScanCompleted, domain.EventScanFailed, domain.EventScanCancelled:
+	default:
+		return nil
+	}
+
+	scan := event.Scan
+	if len(n.clients) == 0 || scan == nil || scan.CommitSHA == nil || *scan.CommitSHA == "" ||
+		scan.RepositoryURL == nil || *scan.RepositoryURL == "" {
+		return nil
+	}
+
+	repositoryURL := domain.NormalizeRepositoryURL(*scan.RepositoryURL)
+	now := time.Now()
+
+	var deliveries []*domain.CommitStatusDelivery
+	for _, client := range n.clients {
+		if !client.Supports(repositoryURL) {
+			continue
+		}
+		deliveries = append(deliveries, &domain.CommitStatusDelivery{
+			ID:            uuid.New(),
+			EventID:       event.ID,
+			ScanID:        scan.ID,
+			Client:        client.Name(),
+			Scan:          scan,
+			Status:        domain.CommitStatusDeliveryPending,
+			NextAttemptAt: now,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		})
+	}
+
+	if err := n.statusRepo.EnqueueDeliveries(ctx, deliveries); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Start begins the delivery loop
+func (n *CommitStatusNotifier) Start(ctx context.Context) {
+	n.logger.WithFields(log.Fields{
+		"clients":  len(n.clients),
+		"interval": n.config.Interval,
+	}).Info("Starting commit status notifier")
+
+	ticker := time.NewTicker(n.config.Interval)
+	defer ticker.Stop()
+
+	// Run immediately on start
+	n.deliverDue(ctx)
+
+	for {
+		select {
+		case <-ticker.C:
+			n.deliverDue(ctx)
+		case <-n.stopChan:
+			n.logger.Info("Commit status notifier stopped")
+			return
+		case <-ctx.Done():
+			n.logger.Info("Commit status notifier context cancelled")
+			return
+		}
+	}
+}
+
+// Stop gracefully stops the notifier
+func (n *CommitStatusNotifier) Stop() {
+	close(n.stopChan)
+}
+
+// deliverDue claims due deliveries and sends them concurrently
+func (n *CommitStatusNotifier) deliverDue(ctx context.Context) {
+	health.Beat("commit-status-notifier")
+
+	deliveries, err := n.statusRepo.ClaimDueDeliveries(ctx, time.Now(), commitStatusLease+n.config.Interval, n.config.BatchSize)
+	if err != nil {
+		n.logger.WithError(err).Error("Failed to claim commit status deliveries")
+		return
+	}
+
+	if len(deliveries) == 0 {
+		return
+	}
+
+	n.logger.WithField("count", len(deliveries)).Debug("Reporting commit statuses")
+
+	var wg sync.WaitGroup
+	for _, delivery := range deliveries {
+		wg.Add(1)
+		go func(delivery *domain.CommitStatusDelivery) {
+			defer wg.Done()
+			n.deliver(ctx, delivery)
+		}(delivery)
+	}
+	wg.Wait()
+}
+
+// deliver posts a single delivery and records the attempt
+func (n *CommitStatusNotifier) deliver(ctx context.Context, delivery *domain.CommitStatusDelivery) {
+	status := n.buildStatus(delivery.Scan)
+	logger := n.logger.WithFields(log.Fields{
+		"delivery_id": delivery.ID.String(),
+		"scan_id":     delivery.ScanID.String(),
+		"client":      delivery.Client,
+		"repository":  status.RepositoryURL,
+		"commit_sha":  status.CommitSHA,
+		"state":       status.State,
+	})
+
+	// Deliveries of a client removed from the configuration can't be sent
+	retryable := false
+	err := fmt.Errorf("commit status client %q is not configured", delivery.Client)
+	if client := n.client(delivery.Client); client != nil {
+		err = client.PostStatus(ctx, status)
+
+		var temporary interface{ Temporary() bool }
+		retryable = !errors.As(err, &temporary) || temporary.Temporary()
+	}
+
+	now := time.Now()
+	delivery.Attempts++
+
+	switch {
+	case err == nil:
+		delivery.Status = domain.CommitStatusDeliverySucceeded
+		delivery.DeliveredAt = &now
+		delivery.LastError = nil
+		logger.Info("Reported commit status")
+	case !retryable || delivery.Attempts >= n.config.MaxAttempts:
+		msg := err.Error()
+		delivery.Status = domain.CommitStatusDeliveryFailed
+		delivery.LastError = &msg
+		logger.WithError(err).WithField("attempts", delivery.Attempts).Error("Failed to report commit status")
+	default:
+		msg := err.Error()
+		delivery.Status = domain.CommitStatusDeliveryPending
+		delivery.LastError = &msg
+		delivery.NextAttemptAt = now.Add(n.backoff(delivery.Attempts))
+		logger.WithError(err).WithFields(log.Fields{
+			"attempts":        delivery.Attempts,
+			"next_attempt_at": delivery.NextAttemptAt,
+		}).Warn("Commit status delivery failed, will retry")
+	}
+
+	if err := n.statusRepo.RecordAttempt(ctx, delivery); err != nil {
+		logger.WithError(err).Error("Failed to record commit status delivery attempt")
+	}
+}
+
+// client returns the configured client with the name, or nil
+func (n *CommitStatusNotifier) client(name string) interfaces.CommitStatusClient {
+	for _, client := range n.clients {
+		if client.Name() == name {
+			return client
+		}
+	}
+	return nil
+}
+
+// backoff returns the delay after the given number of failed attempts
+func (n *CommitStatusNotifier) backoff(attempts int) time.Duration {
+	delay := n.config.InitialBackoff
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if n.config.MaxBackoff > 0 && delay >= n.config.MaxBackoff {
+			return n.config.MaxBackoff
+		}
+	}
+	return delay
+}
+
+// buildStatus derives the commit state and severity summary from a finished scan
+func (n *CommitStatusNotifier) buildStatus(scan *domain.Scan) *interfaces.CommitStatus {
+	status := &interfaces.CommitStatus{
+		RepositoryURL: domain.NormalizeRepositoryURL(*scan.RepositoryURL),
+		CommitSHA:     *scan.CommitSHA,
+		Context:       n.config.Context,
+		Scan:          scan,
+	}
+
+	if n.config.UIBaseURL != "" {
+		status.TargetURL = fmt.Sprintf("%s/scans/%s", strings.TrimSuffix(n.config.UIBaseURL, "/"), scan.ID)
+	}
+
+	switch scan.Status {
+	case domain.ScanStatusCompleted:
+		status.State = interfaces.CommitStateSuccess
+		if n.exceedsThreshold(scan) {
+			status.State = interfaces.CommitStateFailure
+		}
+		status.Description = severitySummary(scan)
+	case domain.ScanStatusPartial:
+		// Findings of the scanners that ran can still fail the check
+		status.State = interfaces.CommitStateError
+		if n.exceedsThreshold(scan) {
+			status.State = interfaces.CommitStateFailure
+		}
+		status.Description = "Some scanners failed. " + severitySummary(scan)
+	case domain.ScanStatusCancelled:
+		status.State = interfaces.CommitStateError
+		status.Description = "Scan cancelled"
+	default:
+		status.State = interfaces.CommitStateError
+		status.Description = "Scan failed"
+	}
+
+	return status
+}
+
+// exceedsThreshold returns true if the scan has findings at or above the FailOn severity
+func (n *CommitStatusNotifier) exceedsThreshold(scan *domain.Scan) bool {
+	if n.config.FailOn == "" {
+		return false
+	}
+
+	threshold := n.config.FailOn.GetPriority()
+	counts := map[domain.Severity]int{
+		domain.SeverityCritical: scan.CriticalCount,
+		domain.SeverityHigh:     scan.HighCount,
+		domain.SeverityMedium:   scan.MediumCount,
+		domain.SeverityLow:      scan.LowCount,
+	}
+	for severity, count := range counts {
+		if count > 0 && severity.GetPriority() >= threshold {
+			return true
+		}
+	}
+	return false
+}
+
+// severitySummary formats the finding counts, e.g. "2 critical, 5 high, 0 medium, 1 low"
+func severitySummary(scan *domain.Scan) string {
+	if scan.FindingsCount == 0 {
+		return "No findings"
+	}
+	return fmt.Sprintf("%d critical, %d high, %d medium, %d low",
+		scan.CriticalCount, scan.HighCount, scan.MediumCount, scan.LowCount)
+}
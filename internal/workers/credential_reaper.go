@@ -0,0 +1,140 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/health"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/metrics"
+	log "github.com/sirupsen/logrus"
+)
+
+// CredentialReaper deletes the credentials Secret of every scan that reached
+// a terminal state, whichever way it got there: reported by its runner,
+// swept, cancelled or deleted. Secrets older than the maximum age are
+// deleted regardless, so a scan that never finishes doesn't keep one.
+type CredentialReaper struct {
+	scanRepo      interfaces.ScanRepository
+	jobDispatcher interfaces.JobDispatcher
+	namespace     string
+	interval      time.Duration
+	maxAge        time.Duration
+	logger        *log.Entry
+	stopChan      chan struct{}
+}
+
+// NewCredentialReaper creates a new credential reaper worker
+func NewCredentialReaper(
+	scanRepo interfaces.ScanRepository,
+	jobDispatcher interfaces.JobDispatcher,
+	namespace string,
+	interval time.Duration,
+	maxAge time.Duration,
+) *CredentialReaper {
+	return &CredentialReaper{
+		scanRepo:      scanRepo,
+		jobDispatcher: jobDispatcher,
+		namespace:     namespace,
+		interval:      interval,
+		maxAge:        maxAge,
+		logger:        log.WithField("component", "credential-reaper"),
+		stopChan:      make(chan struct{}),
+	}
+}
+
+// Start begins the reaper's loop
+func (r *CredentialReaper) Start(ctx context.Context) {
+	r.logger.WithFields(log.Fields{
+		"interval": r.interval,
+		"max_age":  r.maxAge,
+	}).Info("Starting credential reaper worker")
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	// Run immediately on start
+	r.reap(ctx)
+
+	for {
+		select {
+		case <-ticker.C:
+			r.reap(ctx)
+		case <-r.stopChan:
+			r.logger.Info("Credential reaper worker stopped")
+			return
+		case <-ctx.Done():
+			r.logger.Info("Credential reaper worker context cancelled")
+			return
+		}
+	}
+}
+
+// Stop gracefully stops the worker
+func (r *CredentialReaper) Stop() {
+	close(r.stopChan)
+}
+
+// reap deletes the credentials Secrets that are no longer needed
+func (r *CredentialReaper) reap(ctx context.Context) {
+	defer metrics.WorkerCycle("credential-reaper").ObserveDuration()
+	health.Beat("credential-reaper")
+
+	secrets, err := r.jobDispatcher.ListCredentialsSecrets(ctx, r.namespace)
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to list credentials secrets")
+		metrics.WorkerError("credential-reaper")
+		return
+	}
+
+	for _, secret := range secrets {
+		health.Beat("credential-reaper")
+		logger := r.logger.WithFields(log.Fields{
+			"scan_id":     secret.ScanID.String(),
+			"secret_name": secret.Name,
+		})
+
+		reason, err := r.reapReason(ctx, secret)
+		if err != nil {
+			logger.WithError(err).Warn("Failed to get scan of credentials secret")
+			metrics.WorkerError("credential-reaper")
+			continue
+		}
+		if reason == "" {
+			continue
+		}
+
+		if err := r.jobDispatcher.DeleteCredentialsSecret(ctx, secret.Namespace, secret.Name); err != nil {
+			logger.WithError(err).Warn("Failed to delete credentials secret, will retry")
+			metrics.WorkerError("credential-reaper")
+			continue
+		}
+		logger.WithField("reason", reason).Info("Deleted credentials secret")
+	}
+}
+
+// reapReason returns why the secret can be deleted, or an empty reason if
+// its scan still needs it
+func (r *CredentialReaper) reapReason(ctx context.Context, secret interfaces.CredentialsSecret) (string, error) {
+	if r.maxAge > 0 && time.Since(secret.CreatedAt) > r.maxAge {
+		return "expired", nil
+	}
+
+	scan, err := r.scanRepo.Get(ctx, secret.ScanID)
+	if errors.Is(err, domain.ErrScanNotFound) {
+		return "scan deleted", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case scan.IsTerminal():
+		return "scan " + string(scan.Status), nil
+	case scan.Status == domain.ScanStatusDeleting:
+		return "scan deleting", nil
+	}
+	return "", nil
+}
@@ -2,34 +2,63 @@ package workers
 
 import (
 	"context"
+	"errors"
 	"time"
 
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/credentials"
 	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/health"
 	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/jobprofiles"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/metrics"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/tracing"
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	batchv1 "k8s.io/api/batch/v1"
 )
 
-// Dispatcher picks up queued scans and creates Kubernetes jobs for them
+// retryBatchSize is the maximum number of sub-scan retries dispatched per cycle
+const retryBatchSize = 50
+
+// Dispatcher picks up queued scans and creates a Kubernetes job for each of
+// their scan types. Sub-scans queued for a retry get a new job once their
+// backoff elapsed.
 type Dispatcher struct {
-	scanRepo      interfaces.ScanRepository
-	jobDispatcher interfaces.JobDispatcher
-	interval      time.Duration
-	logger        *log.Entry
-	stopChan      chan struct{}
+	scanRepo        interfaces.ScanRepository
+	subScanRepo     interfaces.SubScanRepository
+	scannerRegistry interfaces.ScannerRegistry
+	jobProfiles     *jobprofiles.Resolver
+	credentials     *credentials.Provider
+	jobDispatcher   interfaces.JobDispatcher
+	logArchiver     *LogArchiver // Archives failed attempts' logs before a retry; nil if disabled
+	interval        time.Duration
+	logger          *log.Entry
+	stopChan        chan struct{}
 }
 
 // NewDispatcher creates a new dispatcher worker
 func NewDispatcher(
 	scanRepo interfaces.ScanRepository,
+	subScanRepo interfaces.SubScanRepository,
+	scannerRegistry interfaces.ScannerRegistry,
+	jobProfiles *jobprofiles.Resolver,
+	credentials *credentials.Provider,
 	jobDispatcher interfaces.JobDispatcher,
+	logArchiver *LogArchiver,
 	interval time.Duration,
 ) *Dispatcher {
 	return &Dispatcher{
-		scanRepo:      scanRepo,
-		jobDispatcher: jobDispatcher,
-		interval:      interval,
-		logger:        log.WithField("component", "dispatcher"),
-		stopChan:      make(chan struct{}),
+		scanRepo:        scanRepo,
+		subScanRepo:     subScanRepo,
+		scannerRegistry: scannerRegistry,
+		jobProfiles:     jobProfiles,
+		credentials:     credentials,
+		jobDispatcher:   jobDispatcher,
+		logArchiver:     logArchiver,
+		interval:        interval,
+		logger:          log.WithField("component", "dispatcher"),
+		stopChan:        make(chan struct{}),
 	}
 }
 
@@ -65,31 +94,36 @@ func (d *Dispatcher) Stop() {
 // dispatch processes queued scans
 func (d *Dispatcher) dispatch(ctx context.Context) {
 	d.logger.Debug("Starting dispatch cycle")
+	defer metrics.WorkerCycle("dispatcher").ObserveDuration()
+	health.Beat("dispatcher")
 
 	// Query for queued scans
 	queuedStatus := domain.ScanStatusQueued
 	scans, err := d.scanRepo.List(ctx, interfaces.ScanFilter{Status: &queuedStatus})
 	if err != nil {
 		d.logger.WithError(err).Error("Failed to list queued scans")
+		metrics.WorkerError("dispatcher")
 		return
 	}
 
-	if len(scans) == 0 {
+	if len(scans) > 0 {
+		d.logger.WithField("count", len(scans)).Info("Found queued scans to dispatch")
+	} else {
 		d.logger.Debug("No queued scans to dispatch")
-		return
 	}
 
-	d.logger.WithField("count", len(scans)).Info("Found queued scans to dispatch")
-
 	// Dispatch each queued scan
 	for _, scan := range scans {
+		health.Beat("dispatcher")
 		d.dispatchScan(ctx, scan)
 	}
 
+	d.dispatchRetries(ctx)
+
 	d.logger.Debug("Dispatch cycle completed")
 }
 
-// dispatchScan creates a Kubernetes job for a single scan
+// dispatchScan creates the Kubernetes jobs of a single scan
 func (d *Dispatcher) dispatchScan(ctx context.Context, scan *domain.Scan) {
 	logger := d.logger.WithFields(log.Fields{
 		"scan_id":    scan.ID.String(),
@@ -98,38 +132,333 @@ func (d *Dispatcher) dispatchScan(ctx context.Context, scan *domain.Scan) {
 
 	logger.Info("Dispatching scan")
 
-	// Create Kubernetes job
-	job, err := d.jobDispatcher.CreateJob(ctx, scan)
+	// Continue the trace of the request that created the scan. The job spec
+	// carries this span's context to the runner.
+	ctx, span := tracing.Tracer.Start(tracing.WithTraceParent(ctx, scan.TraceParent), "dispatcher.dispatch_scan",
+		trace.WithAttributes(attribute.String("cloudscan.scan_id", scan.ID.String())),
+	)
+	defer span.End()
+
+	if len(scan.ScanTypes) == 0 {
+		logger.Error("Scan has no scan types")
+		d.failScan(ctx, logger, scan, "scan has no scan types")
+		return
+	}
+
+	// The project's profile may have changed, or the organization's limits
+	// been lowered, since the scan was created
+	settings, err := d.jobProfiles.Resolve(ctx, scan.OrganizationID, scan.ProjectID, scan.JobSettings)
+	if errors.Is(err, jobprofiles.ErrInvalidSettings) {
+		logger.WithError(err).Error("Scan has invalid job settings")
+		d.failScan(ctx, logger, scan, err.Error())
+		return
+	}
+	var limits *domain.JobLimits
+	if err == nil {
+		limits, err = d.jobProfiles.Limits(ctx, scan.OrganizationID)
+	}
+	if err != nil {
+		// The scan stays queued for the next cycle
+		logger.WithError(err).Error("Failed to resolve job settings")
+		metrics.WorkerError("dispatcher")
+		tracing.SetError(span, err)
+		return
+	}
+
+	// The jobs of the scan share one credentials Secret, which the
+	// credential reaper deletes once the scan finished
+	secret, err := d.credentialsSecret(ctx, scan)
+	if errors.Is(err, credentials.ErrInvalidCredential) {
+		logger.WithError(err).Error("Scan has an unusable repository credential")
+		d.failScan(ctx, logger, scan, err.Error())
+		return
+	}
+	if err != nil {
+		// The scan stays queued for the next cycle
+		logger.WithError(err).Error("Failed to create credentials secret")
+		metrics.WorkerError("dispatcher")
+		tracing.SetError(span, err)
+		return
+	}
+	var secretName string
+	if secret != nil {
+		secretName = secret.Name
+	}
+
+	// Create a job per scan type. A scan type whose job can't be created
+	// fails on its own; the others still run.
+	subs := make([]*domain.SubScan, len(scan.ScanTypes))
+	for i, scanType := range scan.ScanTypes {
+		subs[i] = d.dispatchSubScan(ctx, logger, scan, scanType, settings, limits, secretName)
+		if subs[i].JobNamespace != nil && scan.JobNamespace == nil {
+			scan.JobNamespace = subs[i].JobNamespace
+		}
+	}
+
+	if err := d.subScanRepo.Create(ctx, scan, subs); err != nil {
+		logger.WithError(err).Error("Failed to record sub-scans")
+		metrics.WorkerError("dispatcher")
+		tracing.SetError(span, err)
+
+		// The scan stays queued; remove its jobs so the next cycle can recreate them
+		for _, sub := range subs {
+			if sub.JobName == nil {
+				continue
+			}
+			if err := d.jobDispatcher.DeleteJob(ctx, *sub.JobNamespace, *sub.JobName); err != nil {
+				logger.WithError(err).WithField("job_name", *sub.JobName).Warn("Failed to delete job of undispatched scan")
+			}
+		}
+		if secret != nil {
+			if err := d.jobDispatcher.DeleteCredentialsSecret(ctx, secret.Namespace, secret.Name); err != nil {
+				logger.WithError(err).Warn("Failed to delete credentials secret of undispatched scan")
+			}
+		}
+		return
+	}
+
+	logger.WithField("status", scan.Status).Info("Successfully dispatched scan")
+}
+
+// dispatchSubScan creates the job running the scan type's scanner and returns
+// the sub-scan tracking it, failed if the job couldn't be created or the
+// scanner's effective settings exceed the limits
+func (d *Dispatcher) dispatchSubScan(ctx context.Context, logger *log.Entry, scan *domain.Scan, scanType domain.ScanType, settings *domain.JobSettings, limits *domain.JobLimits, credentialsSecret string) *domain.SubScan {
+	logger = logger.WithField("scan_type", scanType)
+	now := time.Now()
+	sub := &domain.SubScan{
+		ScanID:        scan.ID,
+		ScanCreatedAt: scan.CreatedAt,
+		ScanType:      scanType,
+		Status:        domain.ScanStatusFailed,
+		Attempt:       1,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	scanner, err := d.scannerRegistry.Get(ctx, scanType)
+	if err == nil {
+		sub.ScannerName = scanner.Name
+		sub.ScannerImage = scanner.ImageRef()
+
+		var job *batchv1.Job
+		err = jobprofiles.CheckEffective(limits, d.jobDispatcher.DefaultJobSettings(scanner), settings)
+		if err == nil {
+			job, err = d.jobDispatcher.CreateJob(ctx, scan, scanner, settings, credentialsSecret, sub.Attempt)
+		}
+		if err == nil {
+			jobName := job.Name
+			jobNamespace := job.Namespace
+			sub.JobName = &jobName
+			sub.JobNamespace = &jobNamespace
+			sub.Status = domain.ScanStatusRunning
+			sub.StartedAt = &now
+
+			logger.WithFields(log.Fields{
+				"job_name":      jobName,
+				"job_namespace": jobNamespace,
+			}).Info("Created job for scan type")
+			return sub
+		}
+	}
+
+	logger.WithError(err).Error("Failed to create Kubernetes job")
+	metrics.WorkerError("dispatcher")
+
+	errMsg := err.Error()
+	sub.ErrorMessage = &errMsg
+	sub.FailureReason = domain.FailureReasonDispatch
+	sub.CompletedAt = &now
+	return sub
+}
+
+// credentialsSecret creates the Secret holding the scan's git credentials,
+// or replaces its data, e.g. with a new GitHub App token for a retry. It
+// returns nil if the scan needs no credentials.
+func (d *Dispatcher) credentialsSecret(ctx context.Context, scan *domain.Scan) (*interfaces.CredentialsSecret, error) {
+	gitCredentials, err := d.credentials.ForScan(ctx, scan)
+	if err != nil || gitCredentials == nil {
+		return nil, err
+	}
+	return d.jobDispatcher.CreateCredentialsSecret(ctx, scan, gitCredentials)
+}
+
+// dispatchRetries creates a new job for every sub-scan whose retry is due
+func (d *Dispatcher) dispatchRetries(ctx context.Context) {
+	subs, err := d.subScanRepo.ListRetriesDue(ctx, time.Now(), retryBatchSize)
+	if err != nil {
+		d.logger.WithError(err).Error("Failed to list due sub-scan retries")
+		metrics.WorkerError("dispatcher")
+		return
+	}
+
+	for _, sub := range subs {
+		health.Beat("dispatcher")
+		d.retrySubScan(ctx, sub)
+	}
+}
+
+// retrySubScan replaces the failed job of a sub-scan queued for a retry.
+// Settings, scanner and credentials are resolved again, as they may have
+// changed since the scan was dispatched. A retry that can't be dispatched
+// fails the sub-scan, except for errors worth trying again next cycle.
+func (d *Dispatcher) retrySubScan(ctx context.Context, sub *domain.SubScan) {
+	logger := d.logger.WithFields(log.Fields{
+		"scan_id":   sub.ScanID.String(),
+		"scan_type": sub.ScanType,
+		"attempt":   sub.Attempt + 1,
+	})
+
+	scan, err := d.scanRepo.Get(ctx, sub.ScanID)
+	if err != nil {
+		logger.WithError(err).Error("Failed to get scan of sub-scan retry")
+		metrics.WorkerError("dispatcher")
+		return
+	}
+
+	ctx, span := tracing.Tracer.Start(tracing.WithTraceParent(ctx, scan.TraceParent), "dispatcher.retry_sub_scan",
+		trace.WithAttributes(
+			attribute.String("cloudscan.scan_id", scan.ID.String()),
+			attribute.String("cloudscan.scan_type", string(sub.ScanType)),
+			attribute.Int("cloudscan.attempt", sub.Attempt+1),
+		),
+	)
+	defer span.End()
+
+	settings, err := d.jobProfiles.Resolve(ctx, scan.OrganizationID, scan.ProjectID, scan.JobSettings)
+	if errors.Is(err, jobprofiles.ErrInvalidSettings) {
+		d.failRetry(ctx, logger, scan, sub, err)
+		return
+	}
+	var limits *domain.JobLimits
+	if err == nil {
+		limits, err = d.jobProfiles.Limits(ctx, scan.OrganizationID)
+	}
 	if err != nil {
-		logger.WithError(err).Error("Failed to create Kubernetes job")
+		logger.WithError(err).Error("Failed to resolve job settings")
+		metrics.WorkerError("dispatcher")
+		tracing.SetError(span, err)
+		return
+	}
+
+	scanner, err := d.scannerRegistry.Get(ctx, sub.ScanType)
+	if err == nil {
+		err = jobprofiles.CheckEffective(limits, d.jobDispatcher.DefaultJobSettings(scanner), settings)
+	}
+	if err != nil {
+		d.failRetry(ctx, logger, scan, sub, err)
+		return
+	}
+
+	secret, err := d.credentialsSecret(ctx, scan)
+	if errors.Is(err, credentials.ErrInvalidCredential) {
+		d.failRetry(ctx, logger, scan, sub, err)
+		return
+	}
+	if err != nil {
+		logger.WithError(err).Error("Failed to create credentials secret")
+		metrics.WorkerError("dispatcher")
+		tracing.SetError(span, err)
+		return
+	}
+	var secretName string
+	if secret != nil {
+		secretName = secret.Name
+	}
 
-		// Update scan status to failed
-		scan.Status = domain.ScanStatusFailed
-		errMsg := err.Error()
-		scan.ErrorMessage = &errMsg
+	// The failed attempt's log goes with its job, so it is archived first
+	if d.logArchiver != nil {
+		if err := d.logArchiver.ArchiveAttempt(ctx, scan, sub); err != nil {
+			logger.WithError(err).Error("Failed to archive logs of failed attempt")
+			metrics.WorkerError("dispatcher")
+			tracing.SetError(span, err)
+			return
+		}
+	}
 
-		if updateErr := d.scanRepo.Update(ctx, scan); updateErr != nil {
-			logger.WithError(updateErr).Error("Failed to update scan status to failed")
+	// The failed job goes first, so its pods don't linger next to the retry's
+	if sub.JobName != nil && sub.JobNamespace != nil {
+		if err := d.jobDispatcher.DeleteJob(ctx, *sub.JobNamespace, *sub.JobName); err != nil {
+			logger.WithError(err).WithField("job_name", *sub.JobName).Warn("Failed to delete failed job")
 		}
+	}
+
+	job, err := d.jobDispatcher.CreateJob(ctx, scan, scanner, settings, secretName, sub.Attempt+1)
+	if err != nil {
+		tracing.SetError(span, err)
+		d.failRetry(ctx, logger, scan, sub, err)
 		return
 	}
 
-	// Update scan with job information
+	now := time.Now()
 	jobName := job.Name
 	jobNamespace := job.Namespace
-	scan.JobName = &jobName
-	scan.JobNamespace = &jobNamespace
-	scan.Status = domain.ScanStatusRunning
+	sub.Attempt++
+	sub.Status = domain.ScanStatusRunning
+	sub.ScannerName = scanner.Name
+	sub.ScannerImage = scanner.ImageRef()
+	sub.JobName = &jobName
+	sub.JobNamespace = &jobNamespace
+	sub.StartedAt = &now
+	sub.CompletedAt = nil
+	sub.RetryAt = nil
+	sub.LastHeartbeatAt = nil
+	sub.Progress = nil
+
+	// The retry reports all findings again; those of earlier attempts go
+	sub.FindingsCount = 0
+	sub.CriticalCount = 0
+	sub.HighCount = 0
+	sub.MediumCount = 0
+	sub.LowCount = 0
+
+	if _, err := d.subScanRepo.RecordRetry(ctx, sub); err != nil {
+		logger.WithError(err).Error("Failed to record sub-scan retry")
+		metrics.WorkerError("dispatcher")
+		tracing.SetError(span, err)
+
+		// The sub-scan stays queued; the next cycle replaces this job
+		if err := d.jobDispatcher.DeleteJob(ctx, jobNamespace, jobName); err != nil {
+			logger.WithError(err).WithField("job_name", jobName).Warn("Failed to delete job of unrecorded retry")
+		}
+		return
+	}
+
+	logger.WithField("job_name", jobName).Info("Retried sub-scan")
+}
+
+// failRetry fails a sub-scan whose retry can't be dispatched
+func (d *Dispatcher) failRetry(ctx context.Context, logger *log.Entry, scan *domain.Scan, sub *domain.SubScan, cause error) {
+	logger.WithError(cause).Error("Failed to dispatch sub-scan retry")
+	metrics.WorkerError("dispatcher")
+
 	now := time.Now()
-	scan.StartedAt = &now
+	message := cause.Error()
+	sub.Status = domain.ScanStatusFailed
+	sub.FailureReason = domain.FailureReasonDispatch
+	sub.ErrorMessage = &message
+	sub.RetryAt = nil
+	sub.CompletedAt = &now
 
-	if err := d.scanRepo.Update(ctx, scan); err != nil {
-		logger.WithError(err).Error("Failed to update scan with job information")
+	updated, err := d.subScanRepo.Update(ctx, sub)
+	if err != nil {
+		logger.WithError(err).Error("Failed to update sub-scan status to failed")
+		metrics.WorkerError("dispatcher")
 		return
 	}
+	if updated.IsTerminal() && !scan.IsTerminal() {
+		logger.WithField("new_status", updated.Status).Info("Updated scan status")
+	}
+}
 
-	logger.WithFields(log.Fields{
-		"job_name":      jobName,
-		"job_namespace": jobNamespace,
-	}).Info("Successfully dispatched scan")
+// failScan marks a scan that can't be dispatched failed
+func (d *Dispatcher) failScan(ctx context.Context, logger *log.Entry, scan *domain.Scan, message string) {
+	scan.Status = domain.ScanStatusFailed
+	scan.ErrorMessage = &message
+	scan.FailureReason = domain.FailureReasonDispatch
+
+	if err := d.scanRepo.Update(ctx, scan); err != nil {
+		logger.WithError(err).Error("Failed to update scan status to failed")
+		metrics.WorkerError("dispatcher")
+	}
 }
@@ -0,0 +1,187 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/health"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// eventRelayLease keeps other replicas away from claimed events while they are published
+	eventRelayLease = time.Minute
+
+	// eventOutboxPurgeInterval is how often published events are purged from the outbox
+	eventOutboxPurgeInterval = time.Hour
+)
+
+// EventRelayConfig configures the outbox relay worker
+type EventRelayConfig struct {
+	Interval       time.Duration // How often to poll the outbox
+	BatchSize      int           // Events claimed per poll
+	InitialBackoff time.Duration // Delay before the first retry, doubled after each attempt
+	MaxBackoff     time.Duration // Upper bound for the retry delay
+	Retention      time.Duration // How long published events are kept in the outbox
+}
+
+// EventRelay publishes events from the transactional outbox to the event
+// publisher (message broker, webhooks, commit statuses). Events are relayed in
+// the order they were written; failed events are retried with exponential
+// backoff and never dropped, so delivery is at least once.
+type EventRelay struct {
+	outboxRepo interfaces.EventOutboxRepository
+	publisher  interfaces.EventPublisher
+	config     EventRelayConfig
+	lastPurge  time.Time
+	logger     *log.Entry
+	stopChan   chan struct{}
+}
+
+// NewEventRelay creates a new outbox relay worker
+func NewEventRelay(outboxRepo interfaces.EventOutboxRepository, publisher interfaces.EventPublisher, config EventRelayConfig) *EventRelay {
+	if config.BatchSize < 1 {
+		config.BatchSize = 1
+	}
+	return &EventRelay{
+		outboxRepo: outboxRepo,
+		publisher:  publisher,
+		config:     config,
+		logger:     log.WithField("component", "event-relay"),
+		stopChan:   make(chan struct{}),
+	}
+}
+
+// Start begins the relay loop
+func (r *EventRelay) Start(ctx context.Context) {
+	r.logger.WithField("interval", r.config.Interval).Info("Starting event relay worker")
+
+	ticker := time.NewTicker(r.config.Interval)
+	defer ticker.Stop()
+
+	// Run immediately on start
+	r.relay(ctx)
+
+	for {
+		select {
+		case <-ticker.C:
+			r.relay(ctx)
+		case <-r.stopChan:
+			r.logger.Info("Event relay worker stopped")
+			return
+		case <-ctx.Done():
+			r.logger.Info("Event relay worker context cancelled")
+			return
+		}
+	}
+}
+
+// Stop gracefully stops the relay worker
+func (r *EventRelay) Stop() {
+	close(r.stopChan)
+}
+
+// relay publishes due events batch by batch until the outbox is drained
+func (r *EventRelay) relay(ctx context.Context) {
+	for {
+		health.Beat("event-relay")
+
+		n := r.relayBatch(ctx)
+		if n < r.config.BatchSize {
+			break
+		}
+
+		select {
+		case <-r.stopChan:
+			return
+		case <-ctx.Done():
+			return
+		default:
+		}
+	}
+
+	r.purge(ctx)
+}
+
+// relayBatch claims and publishes one batch and returns the number of claimed events
+func (r *EventRelay) relayBatch(ctx context.Context) int {
+	events, err := r.outboxRepo.ClaimPending(ctx, time.Now(), eventRelayLease, r.config.BatchSize)
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to claim outbox events")
+		return 0
+	}
+
+	if len(events) == 0 {
+		return 0
+	}
+
+	r.logger.WithField("count", len(events)).Debug("Relaying outbox events")
+
+	for _, event := range events {
+		r.publish(ctx, event)
+	}
+
+	return len(events)
+}
+
+// publish hands a single event to the publisher and records the outcome
+func (r *EventRelay) publish(ctx context.Context, event *domain.OutboxEvent) {
+	logger := r.logger.WithFields(log.Fields{
+		"event_id":   event.Event.ID.String(),
+		"event_type": event.Event.Type,
+	})
+
+	if err := r.publisher.Publish(ctx, event.Event); err != nil {
+		attempts := event.Attempts + 1
+		nextAttemptAt := time.Now().Add(r.backoff(attempts))
+
+		logger.WithError(err).WithFields(log.Fields{
+			"attempts":        attempts,
+			"next_attempt_at": nextAttemptAt,
+		}).Warn("Failed to publish event, will retry")
+
+		if err := r.outboxRepo.MarkFailed(ctx, event.Event.ID, err.Error(), nextAttemptAt); err != nil {
+			logger.WithError(err).Error("Failed to record event relay failure")
+		}
+		return
+	}
+
+	if err := r.outboxRepo.MarkPublished(ctx, event.Event.ID, time.Now()); err != nil {
+		logger.WithError(err).Error("Failed to mark event published")
+		return
+	}
+
+	logger.Debug("Event published")
+}
+
+// purge removes published events older than the retention period, at most once per purge interval
+func (r *EventRelay) purge(ctx context.Context) {
+	if r.config.Retention <= 0 || time.Since(r.lastPurge) < eventOutboxPurgeInterval {
+		return
+	}
+	r.lastPurge = time.Now()
+
+	deleted, err := r.outboxRepo.DeletePublished(ctx, time.Now().Add(-r.config.Retention))
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to purge published outbox events")
+		return
+	}
+
+	if deleted > 0 {
+		r.logger.WithField("deleted", deleted).Info("Purged published outbox events")
+	}
+}
+
+// backoff returns the delay after the given number of failed attempts
+func (r *EventRelay) backoff(attempts int) time.Duration {
+	delay := r.config.InitialBackoff
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if r.config.MaxBackoff > 0 && delay >= r.config.MaxBackoff {
+			return r.config.MaxBackoff
+		}
+	}
+	return delay
+}
@@ -0,0 +1,72 @@
+package workers
+
+import (
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+)
+
+// Exit codes by which the runner reports why it failed. Any other non-zero
+// exit code is a runner error.
+const (
+	runnerExitScanner         = 2 // The scanner failed on the source
+	runnerExitStorageDownload = 3 // The source archive couldn't be downloaded
+	runnerExitClone           = 4 // The repository couldn't be cloned
+	runnerExitResultsUpload   = 5 // Findings couldn't be reported to the orchestrator
+)
+
+// runnerExitReasons maps the runner's exit codes to failure reasons
+var runnerExitReasons = map[int32]domain.FailureReason{
+	runnerExitScanner:         domain.FailureReasonScanner,
+	runnerExitStorageDownload: domain.FailureReasonStorageDownload,
+	runnerExitClone:           domain.FailureReasonClone,
+	runnerExitResultsUpload:   domain.FailureReasonResultsUpload,
+}
+
+// classifyJobFailure returns why a failed job failed, from its conditions and
+// the state of its latest pod
+func classifyJobFailure(status *interfaces.JobStatus) domain.FailureReason {
+	pod := status.Pod
+
+	// A pod that never pulled its image waits until the deadline fails the job
+	if pod != nil && isImagePullFailure(pod.WaitingReason) {
+		return domain.FailureReasonImagePull
+	}
+
+	for _, cond := range status.Conditions {
+		if cond.Type == "Failed" && cond.Status == "True" && cond.Reason == "DeadlineExceeded" {
+			return domain.FailureReasonDeadlineExceeded
+		}
+	}
+
+	if pod == nil {
+		return domain.FailureReasonUnknown
+	}
+
+	if pod.Reason == "Evicted" {
+		return domain.FailureReasonEvicted
+	}
+
+	if !pod.Terminated {
+		return domain.FailureReasonUnknown
+	}
+	if pod.TerminatedReason == "OOMKilled" {
+		return domain.FailureReasonOOMKilled
+	}
+	if reason, ok := runnerExitReasons[pod.ExitCode]; ok {
+		return reason
+	}
+	if pod.ExitCode != 0 {
+		return domain.FailureReasonRunner
+	}
+	return domain.FailureReasonUnknown
+}
+
+// isImagePullFailure returns true if a container waits because its image
+// can't be pulled
+func isImagePullFailure(waitingReason string) bool {
+	switch waitingReason {
+	case "ErrImagePull", "ImagePullBackOff", "InvalidImageName", "ErrImageNeverPull":
+		return true
+	}
+	return false
+}
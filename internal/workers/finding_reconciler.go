@@ -0,0 +1,105 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/health"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/metrics"
+	log "github.com/sirupsen/logrus"
+)
+
+// FindingReconciler brings findings written before they referenced scans by
+// creation time under the scans foreign key. Each batch links findings to
+// their scan, or deletes them if the scan was deleted while findings were
+// still removed by hand. Once every finding is reconciled a cycle is a single
+// cheap query.
+type FindingReconciler struct {
+	findingRepo interfaces.FindingRepository
+	interval    time.Duration
+	batchSize   int
+	logger      *log.Entry
+	stopChan    chan struct{}
+}
+
+// NewFindingReconciler creates a new orphan finding reconciliation worker
+func NewFindingReconciler(findingRepo interfaces.FindingRepository, interval time.Duration, batchSize int) *FindingReconciler {
+	if batchSize < 1 {
+		batchSize = 1
+	}
+	return &FindingReconciler{
+		findingRepo: findingRepo,
+		interval:    interval,
+		batchSize:   batchSize,
+		logger:      log.WithField("component", "finding-reconciler"),
+		stopChan:    make(chan struct{}),
+	}
+}
+
+// Start begins the reconciliation loop
+func (r *FindingReconciler) Start(ctx context.Context) {
+	r.logger.WithField("interval", r.interval).Info("Starting finding reconciler worker")
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	// Run immediately on start
+	r.reconcile(ctx)
+
+	for {
+		select {
+		case <-ticker.C:
+			r.reconcile(ctx)
+		case <-r.stopChan:
+			r.logger.Info("Finding reconciler worker stopped")
+			return
+		case <-ctx.Done():
+			r.logger.Info("Finding reconciler worker context cancelled")
+			return
+		}
+	}
+}
+
+// Stop gracefully stops the worker
+func (r *FindingReconciler) Stop() {
+	close(r.stopChan)
+}
+
+// reconcile processes batches until no unreconciled findings are left
+func (r *FindingReconciler) reconcile(ctx context.Context) {
+	defer metrics.WorkerCycle("finding-reconciler").ObserveDuration()
+
+	totalBackfilled, totalDeleted := 0, 0
+	for {
+		health.Beat("finding-reconciler")
+
+		backfilled, deleted, err := r.findingRepo.ReconcileOrphans(ctx, r.batchSize)
+		if err != nil {
+			r.logger.WithError(err).Error("Failed to reconcile findings")
+			metrics.WorkerError("finding-reconciler")
+			break
+		}
+
+		totalBackfilled += backfilled
+		totalDeleted += deleted
+		if backfilled+deleted < r.batchSize {
+			break
+		}
+
+		select {
+		case <-r.stopChan:
+			return
+		case <-ctx.Done():
+			return
+		default:
+		}
+	}
+
+	if totalBackfilled > 0 || totalDeleted > 0 {
+		r.logger.WithFields(log.Fields{
+			"backfilled": totalBackfilled,
+			"deleted":    totalDeleted,
+		}).Info("Reconciled findings")
+	}
+}
@@ -0,0 +1,547 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/health"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/metrics"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/tracing"
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// logArchiveLease is how long a claimed scan is hidden from other replicas. A
+// failed archive is retried after the lease.
+const logArchiveLease = 5 * time.Minute
+
+// LogArchiveConfig configures the log archiver
+type LogArchiveConfig struct {
+	Interval  time.Duration // How often finished scans are checked
+	BatchSize int           // Maximum scans claimed per cycle
+	Window    time.Duration // Scans that finished longer ago are skipped; their job is gone
+	MaxBytes  int64         // Logs are truncated after this many bytes
+}
+
+// LogArchiver uploads the complete runner log of every finished scan as a
+// logs artifact before the job's TTL removes the pod, and records the
+// artifact on the scan. The log of each attempt of a sub-scan is archived on
+// its own once the attempt's job exited, or before a retry replaces the job;
+// the archive of a scan with sub-scans combines them, one after the other.
+type LogArchiver struct {
+	scanRepo         interfaces.ScanRepository
+	subScanRepo      interfaces.SubScanRepository
+	jobDispatcher    interfaces.JobDispatcher
+	storageClient    interfaces.StorageClient
+	defaultNamespace string
+	config           LogArchiveConfig
+	logger           *log.Entry
+	stopChan         chan struct{}
+}
+
+// NewLogArchiver creates a new log archiver worker
+func NewLogArchiver(
+	scanRepo interfaces.ScanRepository,
+	subScanRepo interfaces.SubScanRepository,
+	jobDispatcher interfaces.JobDispatcher,
+	storageClient interfaces.StorageClient,
+	defaultNamespace string,
+	config LogArchiveConfig,
+) *LogArchiver {
+	if config.BatchSize < 1 {
+		config.BatchSize = 1
+	}
+	return &LogArchiver{
+		scanRepo:         scanRepo,
+		subScanRepo:      subScanRepo,
+		jobDispatcher:    jobDispatcher,
+		storageClient:    storageClient,
+		defaultNamespace: defaultNamespace,
+		config:           config,
+		logger:           log.WithField("component", "log-archiver"),
+		stopChan:         make(chan struct{}),
+	}
+}
+
+// Start begins the archive loop
+func (a *LogArchiver) Start(ctx context.Context) {
+	a.logger.WithFields(log.Fields{
+		"interval":   a.config.Interval,
+		"batch_size": a.config.BatchSize,
+		"window":     a.config.Window,
+		"max_bytes":  a.config.MaxBytes,
+	}).Info("Starting log archiver worker")
+
+	ticker := time.NewTicker(a.config.Interval)
+	defer ticker.Stop()
+
+	// Run immediately on start
+	a.archiveDue(ctx)
+
+	for {
+		select {
+		case <-ticker.C:
+			a.archiveDue(ctx)
+		case <-a.stopChan:
+			a.logger.Info("Log archiver worker stopped")
+			return
+		case <-ctx.Done():
+			a.logger.Info("Log archiver worker context cancelled")
+			return
+		}
+	}
+}
+
+// Stop gracefully stops the worker
+func (a *LogArchiver) Stop() {
+	close(a.stopChan)
+}
+
+// archiveDue claims finished sub-scans and scans and archives their logs
+// until none are left
+func (a *LogArchiver) archiveDue(ctx context.Context) {
+	defer metrics.WorkerCycle("log-archiver").ObserveDuration()
+
+	for {
+		health.Beat("log-archiver")
+
+		now := time.Now()
+		subs, err := a.subScanRepo.ClaimLogArchives(ctx, now.Add(-a.config.Window), now, logArchiveLease, a.config.BatchSize)
+		if err != nil {
+			a.logger.WithError(err).Error("Failed to claim sub-scans for log archiving")
+			metrics.WorkerError("log-archiver")
+			return
+		}
+
+		for _, sub := range subs {
+			a.processSubScan(ctx, sub)
+		}
+
+		if len(subs) < a.config.BatchSize {
+			break
+		}
+		if a.stopped(ctx) {
+			return
+		}
+	}
+
+	for {
+		health.Beat("log-archiver")
+
+		now := time.Now()
+		scans, err := a.scanRepo.ClaimLogArchives(ctx, now.Add(-a.config.Window), now, logArchiveLease, a.config.BatchSize)
+		if err != nil {
+			a.logger.WithError(err).Error("Failed to claim scans for log archiving")
+			metrics.WorkerError("log-archiver")
+			return
+		}
+
+		for _, scan := range scans {
+			a.process(ctx, scan)
+		}
+
+		if len(scans) < a.config.BatchSize || a.stopped(ctx) {
+			return
+		}
+	}
+}
+
+// stopped reports whether the worker was stopped
+func (a *LogArchiver) stopped(ctx context.Context) bool {
+	select {
+	case <-a.stopChan:
+		return true
+	case <-ctx.Done():
+		return true
+	default:
+		return false
+	}
+}
+
+// processSubScan archives the log of the current attempt of one sub-scan
+func (a *LogArchiver) processSubScan(ctx context.Context, sub *domain.SubScan) {
+	logger := a.logger.WithFields(log.Fields{
+		"scan_id":   sub.ScanID.String(),
+		"scan_type": sub.ScanType,
+		"attempt":   sub.Attempt,
+	})
+
+	scan, err := a.scanRepo.Get(ctx, sub.ScanID)
+	if errors.Is(err, domain.ErrScanNotFound) {
+		return
+	}
+	if err != nil {
+		logger.WithError(err).Warn("Failed to get scan of sub-scan, will retry")
+		metrics.WorkerError("log-archiver")
+		return
+	}
+
+	active, err := a.jobActive(ctx, domain.JobNamespace(sub.JobNamespace, a.defaultNamespace), *sub.JobName)
+	if err == nil && active {
+		logger.Debug("Job still running, will archive its logs later")
+		return
+	}
+	if err == nil {
+		err = a.archiveSubScan(ctx, scan, sub)
+	}
+	if err != nil {
+		logger.WithError(err).Warn("Failed to archive sub-scan logs, will retry")
+		metrics.WorkerError("log-archiver")
+		metrics.LogArchivesTotal.WithLabelValues("failed").Inc()
+		return
+	}
+
+	logger.Debug("Archived sub-scan logs")
+}
+
+// process archives the logs of one scan and records the outcome
+func (a *LogArchiver) process(ctx context.Context, scan *domain.Scan) {
+	logger := a.logger.WithField("scan_id", scan.ID.String())
+
+	artifactID, err := a.archive(ctx, scan)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to archive scan logs, will retry")
+		metrics.WorkerError("log-archiver")
+		metrics.LogArchivesTotal.WithLabelValues("failed").Inc()
+		return
+	}
+	if artifactID == "" {
+		logger.Debug("Job still running, will archive its logs later")
+		return
+	}
+
+	logger.WithField("artifact_id", artifactID).Info("Archived scan logs")
+	metrics.LogArchivesTotal.WithLabelValues("archived").Inc()
+}
+
+// archive uploads the scan's logs and returns the artifact ID, or an empty ID
+// if a job's pod hasn't finished yet
+func (a *LogArchiver) archive(ctx context.Context, scan *domain.Scan) (artifactID string, err error) {
+	ctx, span := tracing.Tracer.Start(tracing.WithTraceParent(ctx, scan.TraceParent), "log_archiver.archive",
+		trace.WithAttributes(attribute.String("cloudscan.scan_id", scan.ID.String())),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	var sources []logSource
+	var archived []*domain.SubScanLog
+	if scan.JobName != nil && *scan.JobName != "" {
+		namespace := domain.JobNamespace(scan.JobNamespace, a.defaultNamespace)
+
+		// A runner may report its result before its container exits
+		active, err := a.jobActive(ctx, namespace, *scan.JobName)
+		if err != nil || active {
+			return "", err
+		}
+		sources = []logSource{a.jobLog(namespace, *scan.JobName)}
+	} else {
+		archived, err = a.subScanLogs(ctx, scan)
+		if err != nil || archived == nil {
+			return "", err
+		}
+		sources = a.combinedLogs(archived)
+	}
+
+	artifactID, err = a.upload(ctx, scan, scan.ID.String()+".log", sources)
+	if err != nil {
+		return "", err
+	}
+
+	if err := a.scanRepo.SetLogsArtifact(ctx, scan.ID, artifactID); err != nil {
+		// The scan was deleted meanwhile; nothing will reference the artifact
+		if errors.Is(err, domain.ErrScanNotFound) {
+			a.deleteArtifact(ctx, artifactID)
+		}
+		return "", err
+	}
+
+	// The scan's archive holds the logs of the attempts now
+	if len(archived) > 0 {
+		a.deleteSubScanLogs(ctx, scan, archived)
+	}
+
+	return artifactID, nil
+}
+
+// subScanLogs archives the log of every sub-scan's current attempt that isn't
+// archived yet and returns the archived logs of the scan, or nil if a job
+// hasn't finished yet
+func (a *LogArchiver) subScanLogs(ctx context.Context, scan *domain.Scan) ([]*domain.SubScanLog, error) {
+	subs, err := a.subScanRepo.ListByScan(ctx, scan.ID)
+	if err != nil {
+		return nil, err
+	}
+	archived, err := a.subScanRepo.ListLogs(ctx, scan.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	isArchived := make(map[domain.ScanType]map[int]bool)
+	for _, entry := range archived {
+		if isArchived[entry.ScanType] == nil {
+			isArchived[entry.ScanType] = make(map[int]bool)
+		}
+		isArchived[entry.ScanType][entry.Attempt] = true
+	}
+
+	added := false
+	for _, sub := range subs {
+		if sub.JobName == nil || isArchived[sub.ScanType][sub.Attempt] {
+			continue
+		}
+
+		active, err := a.jobActive(ctx, domain.JobNamespace(sub.JobNamespace, a.defaultNamespace), *sub.JobName)
+		if err != nil || active {
+			return nil, err
+		}
+		if err := a.archiveSubScan(ctx, scan, sub); err != nil {
+			return nil, err
+		}
+		added = true
+	}
+
+	if added {
+		return a.subScanRepo.ListLogs(ctx, scan.ID)
+	}
+	return archived, nil
+}
+
+// ArchiveAttempt archives the log of the sub-scan's current attempt unless it
+// was archived before. The dispatcher calls it before it deletes the job of a
+// failed attempt for a retry; the log of a runner that is still running is
+// archived as far as it goes.
+func (a *LogArchiver) ArchiveAttempt(ctx context.Context, scan *domain.Scan, sub *domain.SubScan) error {
+	if sub.JobName == nil {
+		return nil
+	}
+
+	archived, err := a.subScanRepo.ListLogs(ctx, scan.ID)
+	if err != nil {
+		return err
+	}
+	for _, entry := range archived {
+		if entry.ScanType == sub.ScanType && entry.Attempt == sub.Attempt {
+			return nil
+		}
+	}
+
+	return a.archiveSubScan(ctx, scan, sub)
+}
+
+// archiveSubScan uploads the log of the sub-scan's current attempt and
+// records it
+func (a *LogArchiver) archiveSubScan(ctx context.Context, scan *domain.Scan, sub *domain.SubScan) error {
+	namespace := domain.JobNamespace(sub.JobNamespace, a.defaultNamespace)
+	fileName := fmt.Sprintf("%s-%s-%d.log", scan.ID, sub.ScanType, sub.Attempt)
+
+	artifactID, err := a.upload(ctx, scan, fileName, []logSource{a.jobLog(namespace, *sub.JobName)})
+	if err != nil {
+		return err
+	}
+
+	recorded, err := a.subScanRepo.AddLog(ctx, &domain.SubScanLog{
+		ScanID:        scan.ID,
+		ScanCreatedAt: scan.CreatedAt,
+		ScanType:      sub.ScanType,
+		Attempt:       sub.Attempt,
+		ScannerName:   sub.ScannerName,
+		ArtifactID:    artifactID,
+		CreatedAt:     time.Now(),
+	})
+	if err != nil || !recorded {
+		a.deleteArtifact(ctx, artifactID)
+	}
+	return err
+}
+
+// deleteSubScanLogs deletes the archived attempt logs combined into the scan's
+// archive. Logs whose artifacts can't be deleted stay recorded, so the scan's
+// deletion removes them.
+func (a *LogArchiver) deleteSubScanLogs(ctx context.Context, scan *domain.Scan, archived []*domain.SubScanLog) {
+	artifactIDs := make([]string, len(archived))
+	for i, entry := range archived {
+		artifactIDs[i] = entry.ArtifactID
+	}
+
+	logger := a.logger.WithField("scan_id", scan.ID.String())
+	if err := a.storageClient.DeleteArtifacts(ctx, artifactIDs); err != nil {
+		logger.WithError(err).Warn("Failed to delete archived sub-scan logs")
+		return
+	}
+	if err := a.subScanRepo.DeleteLogs(ctx, scan.ID); err != nil {
+		logger.WithError(err).Warn("Failed to delete records of archived sub-scan logs")
+	}
+}
+
+// deleteArtifact deletes an artifact nothing references
+func (a *LogArchiver) deleteArtifact(ctx context.Context, artifactID string) {
+	if err := a.storageClient.DeleteArtifacts(ctx, []string{artifactID}); err != nil {
+		a.logger.WithError(err).WithField("artifact_id", artifactID).Warn("Failed to delete unreferenced logs artifact")
+	}
+}
+
+// jobActive reports whether the job still has a running pod. A job that is
+// gone counts as finished; its log is noted as not available.
+func (a *LogArchiver) jobActive(ctx context.Context, namespace, name string) (bool, error) {
+	jobStatus, err := a.jobDispatcher.GetJobStatus(ctx, namespace, name)
+	if errors.Is(err, interfaces.ErrJobNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return jobStatus.Active > 0, nil
+}
+
+// upload spools the logs of the sources to disk and uploads them as a logs
+// artifact of the scan. It returns the artifact ID.
+func (a *LogArchiver) upload(ctx context.Context, scan *domain.Scan, fileName string, sources []logSource) (string, error) {
+	// Presigned uploads need the size up front, so the log is spooled to disk
+	file, err := os.CreateTemp("", "scan-logs-*.log")
+	if err != nil {
+		return "", fmt.Errorf("failed to create log spool file: %w", err)
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	size, err := a.spool(ctx, file, sources)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return "", fmt.Errorf("failed to rewind log spool file: %w", err)
+	}
+
+	artifact, err := a.storageClient.UploadArtifact(ctx, &interfaces.CreateArtifactRequest{
+		ScanID:         scan.ID.String(),
+		OrganizationID: scan.OrganizationID.String(),
+		FileName:       fileName,
+		FileSize:       strconv.FormatInt(size, 10),
+		ContentType:    "text/plain; charset=utf-8",
+		ArtifactType:   interfaces.ArtifactTypeLogs,
+		UploadType:     interfaces.UploadTypeSimple,
+	}, file)
+	if err != nil {
+		return "", err
+	}
+
+	return artifact.ArtifactID, nil
+}
+
+// logSource is a log that is part of an archive
+type logSource struct {
+	header string // Written before the log if the archive has several logs
+	open   func(ctx context.Context) (io.ReadCloser, error)
+}
+
+// jobLog returns the log of a job's pod
+func (a *LogArchiver) jobLog(namespace, name string) logSource {
+	return logSource{
+		open: func(ctx context.Context) (io.ReadCloser, error) {
+			return a.jobDispatcher.StreamJobLogs(ctx, namespace, name, false)
+		},
+	}
+}
+
+// combinedLogs returns the archived attempt logs of a scan's sub-scans, each
+// behind a header naming its scan type and scanner, and its attempt if the
+// sub-scan was retried
+func (a *LogArchiver) combinedLogs(archived []*domain.SubScanLog) []logSource {
+	attempts := make(map[domain.ScanType]int)
+	for _, entry := range archived {
+		attempts[entry.ScanType]++
+	}
+
+	sources := make([]logSource, len(archived))
+	for i, entry := range archived {
+		artifactID := entry.ArtifactID
+		sources[i].open = func(ctx context.Context) (io.ReadCloser, error) {
+			return a.storageClient.DownloadArtifact(ctx, artifactID)
+		}
+		if len(archived) == 1 {
+			continue
+		}
+		if attempts[entry.ScanType] > 1 {
+			sources[i].header = fmt.Sprintf("==> %s (%s), attempt %d <==\n", entry.ScanType, entry.ScannerName, entry.Attempt)
+		} else {
+			sources[i].header = fmt.Sprintf("==> %s (%s) <==\n", entry.ScanType, entry.ScannerName)
+		}
+	}
+
+	return sources
+}
+
+// spool writes the logs of the sources to the file and returns its size. Logs
+// are truncated once MaxBytes are written. A job without a pod is noted as
+// not available rather than failing the archive.
+func (a *LogArchiver) spool(ctx context.Context, file *os.File, sources []logSource) (int64, error) {
+	var size int64
+	for _, source := range sources {
+		if source.header != "" {
+			n, err := io.WriteString(file, source.header)
+			if err != nil {
+				return 0, fmt.Errorf("failed to write log spool file: %w", err)
+			}
+			size += int64(n)
+		}
+
+		truncated, n, err := a.spoolLog(ctx, file, source, a.config.MaxBytes-size)
+		size += n
+		if errors.Is(err, interfaces.ErrPodNotFound) {
+			notice, err := fmt.Fprintf(file, "[logs not available: %v]\n", err)
+			if err != nil {
+				return 0, fmt.Errorf("failed to write log spool file: %w", err)
+			}
+			size += int64(notice)
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		if truncated {
+			notice, err := fmt.Fprintf(file, "\n[log truncated after %d bytes]\n", a.config.MaxBytes)
+			if err != nil {
+				return 0, fmt.Errorf("failed to write log spool file: %w", err)
+			}
+			return size + int64(notice), nil
+		}
+	}
+
+	return size, nil
+}
+
+// spoolLog copies at most limit bytes of the log to the file and reports
+// whether the log was longer
+func (a *LogArchiver) spoolLog(ctx context.Context, file *os.File, source logSource, limit int64) (bool, int64, error) {
+	logs, err := source.open(ctx)
+	if err != nil {
+		return false, 0, err
+	}
+	defer logs.Close()
+
+	if limit <= 0 {
+		n, _ := logs.Read(make([]byte, 1))
+		return n > 0, 0, nil
+	}
+
+	size, err := io.Copy(file, io.LimitReader(logs, limit))
+	if err != nil {
+		return false, size, fmt.Errorf("failed to read logs: %w", err)
+	}
+	if size == limit {
+		if n, _ := logs.Read(make([]byte, 1)); n > 0 {
+			return true, size, nil
+		}
+	}
+
+	return false, size, nil
+}
@@ -0,0 +1,333 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/health"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/metrics"
+	log "github.com/sirupsen/logrus"
+)
+
+// Retention actions for partitions older than the retention window
+const (
+	PartitionActionArchive = "archive" // move the partition and its findings into the archive schema
+	PartitionActionDrop    = "drop"    // drop the partition and delete its findings
+)
+
+// partitionArtifactBatchSize is the number of artifacts deleted per storage call
+const partitionArtifactBatchSize = 500
+
+// PartitionMaintenanceConfig configures the partition maintenance worker
+type PartitionMaintenanceConfig struct {
+	Interval        time.Duration // How often partitions are checked
+	MonthsAhead     int           // Months after the current one that must have a partition
+	RetentionMonths int           // Partitions ending more than this many months ago are expired; 0 keeps all
+	RetentionAction string        // PartitionActionArchive or PartitionActionDrop
+	ArchiveSchema   string        // Schema archived partitions are moved into
+}
+
+// PartitionMaintainer keeps the scans table partitioned ahead of time, so
+// inserts never fail with "no partition found", and expires whole partitions
+// past the retention window instead of deleting scans row by row. The
+// storage artifacts of expired scans are deleted first, since nothing
+// references them afterwards. Only one replica maintains partitions at a
+// time; every replica reports coverage.
+type PartitionMaintainer struct {
+	partitionRepo interfaces.PartitionRepository
+	storageClient interfaces.StorageClient
+	config        PartitionMaintenanceConfig
+	logger        *log.Entry
+	stopChan      chan struct{}
+}
+
+// NewPartitionMaintainer creates a new partition maintenance worker
+func NewPartitionMaintainer(partitionRepo interfaces.PartitionRepository, storageClient interfaces.StorageClient, config PartitionMaintenanceConfig) *PartitionMaintainer {
+	return &PartitionMaintainer{
+		partitionRepo: partitionRepo,
+		storageClient: storageClient,
+		config:        config,
+		logger:        log.WithField("component", "partition-maintainer"),
+		stopChan:      make(chan struct{}),
+	}
+}
+
+// Start begins the maintenance loop
+func (m *PartitionMaintainer) Start(ctx context.Context) {
+	m.logger.WithFields(log.Fields{
+		"interval":         m.config.Interval,
+		"months_ahead":     m.config.MonthsAhead,
+		"retention_months": m.config.RetentionMonths,
+		"retention_action": m.config.RetentionAction,
+	}).Info("Starting partition maintenance worker")
+
+	ticker := time.NewTicker(m.config.Interval)
+	defer ticker.Stop()
+
+	// Run immediately on start
+	m.maintain(ctx)
+
+	for {
+		select {
+		case <-ticker.C:
+			m.maintain(ctx)
+		case <-m.stopChan:
+			m.logger.Info("Partition maintenance worker stopped")
+			return
+		case <-ctx.Done():
+			m.logger.Info("Partition maintenance worker context cancelled")
+			return
+		}
+	}
+}
+
+// Stop gracefully stops the worker
+func (m *PartitionMaintainer) Stop() {
+	close(m.stopChan)
+}
+
+// maintain creates missing partitions, expires old ones and reports coverage
+func (m *PartitionMaintainer) maintain(ctx context.Context) {
+	defer metrics.WorkerCycle("partition-maintainer").ObserveDuration()
+	health.Beat("partition-maintainer")
+
+	now := time.Now().UTC()
+
+	unlock, locked, err := m.partitionRepo.TryLock(ctx)
+	if err != nil {
+		m.logger.WithError(err).Error("Failed to acquire partition lock")
+		metrics.WorkerError("partition-maintainer")
+	} else if !locked {
+		m.logger.Debug("Partitions are maintained by another replica")
+	} else {
+		m.maintainLocked(ctx, now)
+		unlock()
+	}
+
+	partitions, err := m.partitionRepo.List(ctx)
+	if err != nil {
+		m.logger.WithError(err).Error("Failed to list partitions")
+		metrics.WorkerError("partition-maintainer")
+		return
+	}
+	m.observe(partitions, now, locked)
+}
+
+// maintainLocked runs while holding the partition lock
+func (m *PartitionMaintainer) maintainLocked(ctx context.Context, now time.Time) {
+	partitions, err := m.partitionRepo.List(ctx)
+	if err != nil {
+		m.logger.WithError(err).Error("Failed to list partitions")
+		metrics.WorkerError("partition-maintainer")
+		return
+	}
+
+	m.createAhead(ctx, partitions, now)
+
+	// Partitions detached in an earlier cycle whose archive or drop failed
+	m.resumeDetached(ctx)
+
+	if m.config.RetentionMonths > 0 {
+		m.expire(ctx, partitions, now)
+	}
+}
+
+// createAhead creates the partitions for the current month and the next MonthsAhead months
+func (m *PartitionMaintainer) createAhead(ctx context.Context, partitions []*domain.Partition, now time.Time) {
+	existing := make(map[string]bool, len(partitions))
+	for _, partition := range partitions {
+		existing[partition.Name] = true
+	}
+
+	currentMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i <= m.config.MonthsAhead; i++ {
+		month := currentMonth.AddDate(0, i, 0)
+		name := "scans_" + month.Format("2006_01")
+		if existing[name] || coveredBy(partitions, month.AddDate(0, 0, 14)) {
+			continue
+		}
+
+		if err := m.partitionRepo.CreateMonth(ctx, month); err != nil {
+			m.logger.WithError(err).WithField("partition", name).Error("Failed to create partition")
+			metrics.WorkerError("partition-maintainer")
+			continue
+		}
+
+		metrics.PartitionOperationsTotal.WithLabelValues("scans", "create").Inc()
+		m.logger.WithField("partition", name).Info("Created partition")
+	}
+}
+
+// expire detaches every partition that ends before the retention window, then
+// archives or drops it. Partitions with scans under legal hold are kept.
+func (m *PartitionMaintainer) expire(ctx context.Context, partitions []*domain.Partition, now time.Time) {
+	cutoff := now.AddDate(0, -m.config.RetentionMonths, 0)
+
+	for _, partition := range partitions {
+		if partition.IsDefault() || partition.To.After(cutoff) {
+			continue
+		}
+
+		select {
+		case <-m.stopChan:
+			return
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		// Archiving a partition's findings can take longer than the interval
+		health.Beat("partition-maintainer")
+		logger := m.logger.WithField("partition", partition.Name)
+
+		// A partition holding evidence stays attached until every hold is released
+		held, err := m.partitionRepo.HasHeldScans(ctx, partition.Name)
+		if err != nil {
+			logger.WithError(err).Error("Failed to check legal holds of partition")
+			metrics.WorkerError("partition-maintainer")
+			continue
+		}
+		if held {
+			logger.Warn("Keeping expired partition with scans under legal hold")
+			continue
+		}
+
+		// Artifacts are only known while the partition is attached; a
+		// partition whose artifacts can't all be deleted stays attached
+		if err := m.deleteArtifacts(ctx, partition.Name); err != nil {
+			logger.WithError(err).Error("Failed to delete artifacts of partition")
+			metrics.WorkerError("partition-maintainer")
+			continue
+		}
+
+		// Findings reference their scan, so they go first
+		var findings int
+		if m.config.RetentionAction == PartitionActionDrop {
+			findings, err = m.partitionRepo.DeleteFindings(ctx, partition.Name)
+		} else {
+			findings, err = m.partitionRepo.ArchiveFindings(ctx, partition.Name, m.config.ArchiveSchema)
+		}
+		if err != nil {
+			logger.WithError(err).Error("Failed to release findings of partition")
+			metrics.WorkerError("partition-maintainer")
+			continue
+		}
+		logger = logger.WithField("findings", findings)
+
+		if err := m.partitionRepo.Detach(ctx, partition); err != nil {
+			logger.WithError(err).Error("Failed to detach partition")
+			metrics.WorkerError("partition-maintainer")
+			continue
+		}
+		metrics.PartitionOperationsTotal.WithLabelValues("scans", "detach").Inc()
+
+		// A detached partition is no longer listed; one that fails to be
+		// archived or dropped is resumed from its record next cycle
+		m.finish(ctx, logger, partition.Name)
+	}
+}
+
+// deleteArtifacts deletes the storage artifacts of the partition's scans.
+// Deleted artifacts count as missing when this is retried.
+func (m *PartitionMaintainer) deleteArtifacts(ctx context.Context, name string) error {
+	artifactIDs, err := m.partitionRepo.ArtifactIDs(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	for start := 0; start < len(artifactIDs); start += partitionArtifactBatchSize {
+		health.Beat("partition-maintainer")
+		end := min(start+partitionArtifactBatchSize, len(artifactIDs))
+		if err := m.storageClient.DeleteArtifacts(ctx, artifactIDs[start:end]); err != nil {
+			return err
+		}
+	}
+
+	if len(artifactIDs) > 0 {
+		m.logger.WithFields(log.Fields{
+			"partition": name,
+			"artifacts": len(artifactIDs),
+		}).Info("Deleted artifacts of expired partition")
+	}
+	return nil
+}
+
+// resumeDetached archives or drops the partitions that were detached but not
+// archived or dropped
+func (m *PartitionMaintainer) resumeDetached(ctx context.Context) {
+	names, err := m.partitionRepo.ListDetached(ctx)
+	if err != nil {
+		m.logger.WithError(err).Error("Failed to list detached partitions")
+		metrics.WorkerError("partition-maintainer")
+		return
+	}
+
+	for _, name := range names {
+		health.Beat("partition-maintainer")
+		m.finish(ctx, m.logger.WithField("partition", name), name)
+	}
+}
+
+// finish archives or drops a detached partition, as the retention action says
+func (m *PartitionMaintainer) finish(ctx context.Context, logger *log.Entry, name string) {
+	switch m.config.RetentionAction {
+	case PartitionActionDrop:
+		if err := m.partitionRepo.Drop(ctx, name); err != nil {
+			logger.WithError(err).Error("Failed to drop detached partition")
+			metrics.WorkerError("partition-maintainer")
+			return
+		}
+		metrics.PartitionOperationsTotal.WithLabelValues("scans", "drop").Inc()
+		logger.Info("Dropped expired partition")
+
+	default:
+		if err := m.partitionRepo.Archive(ctx, name, m.config.ArchiveSchema); err != nil {
+			logger.WithError(err).Error("Failed to archive detached partition")
+			metrics.WorkerError("partition-maintainer")
+			return
+		}
+		metrics.PartitionOperationsTotal.WithLabelValues("scans", "archive").Inc()
+		logger.WithField("schema", m.config.ArchiveSchema).Info("Archived expired partition")
+	}
+}
+
+// observe reports the number of partitions and how far ahead they reach.
+// Only the replica holding the lock, if any, warns about short coverage, so
+// the warning isn't logged by every replica.
+func (m *PartitionMaintainer) observe(partitions []*domain.Partition, now time.Time, locked bool) {
+	metrics.Partitions.WithLabelValues("scans").Set(float64(len(partitions)))
+
+	coverage := coverageEnd(partitions, now).Sub(now)
+	if coverage < 0 {
+		coverage = 0
+	}
+	metrics.PartitionCoverage.WithLabelValues("scans").Set(coverage.Seconds())
+
+	if locked && coverage < 30*24*time.Hour {
+		m.logger.WithField("coverage", coverage).Warn("Scans partitions cover less than a month ahead")
+	}
+}
+
+// coveredBy returns true if a partition holds rows created at t
+func coveredBy(partitions []*domain.Partition, t time.Time) bool {
+	for _, partition := range partitions {
+		if partition.Covers(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// coverageEnd returns the end of the contiguous run of partitions covering
+// now, or now if no partition covers it. Partitions must be sorted.
+func coverageEnd(partitions []*domain.Partition, now time.Time) time.Time {
+	end := now
+	for _, partition := range partitions {
+		if partition.Covers(end) {
+			end = partition.To
+		}
+	}
+	return end
+}
@@ -0,0 +1,263 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/health"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/metrics"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/tracing"
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// scanDeletionLease is how long a claimed deletion is hidden from other
+// replicas. A replica that dies mid-deletion leaves it to be retried after the lease.
+const scanDeletionLease = 5 * time.Minute
+
+// ScanDeletionConfig configures the scan deletion worker
+type ScanDeletionConfig struct {
+	Interval       time.Duration // How often due deletions are claimed
+	BatchSize      int           // Maximum deletions claimed per cycle
+	MaxAttempts    int           // Attempts before a deletion is marked failed
+	InitialBackoff time.Duration // Delay before the first retry
+	MaxBackoff     time.Duration // Upper bound for the retry delay
+}
+
+// ScanDeleter removes scans marked deleting. Each scan's Kubernetes jobs and
+// artifacts (source archive and logs) are deleted first; both steps treat missing resources as deleted,
+// so a retry after a partial failure is safe. The scan and its findings are
+// then removed in one transaction together with the deletion record, and the
+// owning operation's progress is updated. A scan placed under legal hold after
+// its deletion started is left untouched and its deletion fails right away.
+type ScanDeleter struct {
+	operationRepo    interfaces.OperationRepository
+	scanRepo         interfaces.ScanRepository
+	subScanRepo      interfaces.SubScanRepository
+	legalHoldRepo    interfaces.LegalHoldRepository
+	storageClient    interfaces.StorageClient
+	jobDispatcher    interfaces.JobDispatcher
+	defaultNamespace string
+	config           ScanDeletionConfig
+	logger           *log.Entry
+	stopChan         chan struct{}
+}
+
+// NewScanDeleter creates a new scan deletion worker
+func NewScanDeleter(
+	operationRepo interfaces.OperationRepository,
+	scanRepo interfaces.ScanRepository,
+	subScanRepo interfaces.SubScanRepository,
+	legalHoldRepo interfaces.LegalHoldRepository,
+	storageClient interfaces.StorageClient,
+	jobDispatcher interfaces.JobDispatcher,
+	defaultNamespace string,
+	config ScanDeletionConfig,
+) *ScanDeleter {
+	if config.BatchSize < 1 {
+		config.BatchSize = 1
+	}
+	return &ScanDeleter{
+		operationRepo:    operationRepo,
+		scanRepo:         scanRepo,
+		subScanRepo:      subScanRepo,
+		legalHoldRepo:    legalHoldRepo,
+		storageClient:    storageClient,
+		jobDispatcher:    jobDispatcher,
+		defaultNamespace: defaultNamespace,
+		config:           config,
+		logger:           log.WithField("component", "scan-deleter"),
+		stopChan:         make(chan struct{}),
+	}
+}
+
+// Start begins the deletion loop
+func (d *ScanDeleter) Start(ctx context.Context) {
+	d.logger.WithFields(log.Fields{
+		"interval":     d.config.Interval,
+		"batch_size":   d.config.BatchSize,
+		"max_attempts": d.config.MaxAttempts,
+	}).Info("Starting scan deletion worker")
+
+	ticker := time.NewTicker(d.config.Interval)
+	defer ticker.Stop()
+
+	// Run immediately on start
+	d.deleteDue(ctx)
+
+	for {
+		select {
+		case <-ticker.C:
+			d.deleteDue(ctx)
+		case <-d.stopChan:
+			d.logger.Info("Scan deletion worker stopped")
+			return
+		case <-ctx.Done():
+			d.logger.Info("Scan deletion worker context cancelled")
+			return
+		}
+	}
+}
+
+// Stop gracefully stops the worker
+func (d *ScanDeleter) Stop() {
+	close(d.stopChan)
+}
+
+// deleteDue claims due deletions and processes them until none are left
+func (d *ScanDeleter) deleteDue(ctx context.Context) {
+	defer metrics.WorkerCycle("scan-deleter").ObserveDuration()
+
+	for {
+		health.Beat("scan-deleter")
+
+		deletions, err := d.operationRepo.ClaimDueScanDeletions(ctx, time.Now(), scanDeletionLease, d.config.BatchSize)
+		if err != nil {
+			d.logger.WithError(err).Error("Failed to claim scan deletions")
+			metrics.WorkerError("scan-deleter")
+			return
+		}
+
+		for _, deletion := range deletions {
+			d.process(ctx, deletion)
+		}
+
+		if len(deletions) < d.config.BatchSize {
+			return
+		}
+
+		select {
+		case <-d.stopChan:
+			return
+		case <-ctx.Done():
+			return
+		default:
+		}
+	}
+}
+
+// process deletes one scan and records the outcome
+func (d *ScanDeleter) process(ctx context.Context, deletion *domain.ScanDeletion) {
+	logger := d.logger.WithFields(log.Fields{
+		"scan_id":      deletion.ScanID.String(),
+		"operation_id": deletion.OperationID.String(),
+		"attempt":      deletion.Attempts + 1,
+	})
+
+	err := d.deleteScan(ctx, deletion)
+	if err == nil {
+		logger.Info("Deleted scan")
+		return
+	}
+
+	// Retrying can't succeed until the hold is released and the scan deleted
+	// again, so the scan goes back to the status it had
+	if errors.Is(err, domain.ErrLegalHold) {
+		logger.WithError(err).Warn("Refusing to delete scan under legal hold")
+		if err := d.operationRepo.RefuseScanDeletion(ctx, deletion, err.Error()); err != nil {
+			logger.WithError(err).Error("Failed to refuse scan deletion")
+		}
+		return
+	}
+
+	metrics.WorkerError("scan-deleter")
+	attempts := deletion.Attempts + 1
+	if attempts >= d.config.MaxAttempts {
+		logger.WithError(err).Error("Giving up on scan deletion")
+		if err := d.operationRepo.FailScanDeletion(ctx, deletion, err.Error()); err != nil {
+			logger.WithError(err).Error("Failed to mark scan deletion failed")
+		}
+		return
+	}
+
+	nextAttemptAt := time.Now().Add(d.backoff(attempts))
+	logger.WithError(err).WithField("next_attempt_at", nextAttemptAt).Warn("Scan deletion failed, will retry")
+	if err := d.operationRepo.RetryScanDeletion(ctx, deletion, err.Error(), nextAttemptAt); err != nil {
+		logger.WithError(err).Error("Failed to reschedule scan deletion")
+	}
+}
+
+// deleteScan removes the scan's job, artifacts and rows
+func (d *ScanDeleter) deleteScan(ctx context.Context, deletion *domain.ScanDeletion) (err error) {
+	ctx, span := tracing.Tracer.Start(ctx, "scan_deleter.delete_scan",
+		trace.WithAttributes(
+			attribute.String("cloudscan.scan_id", deletion.ScanID.String()),
+			attribute.String("cloudscan.operation_id", deletion.OperationID.String()),
+		))
+	defer func() { tracing.End(span, err) }()
+
+	scan, err := d.scanRepo.Get(ctx, deletion.ScanID)
+	if err != nil && !errors.Is(err, domain.ErrScanNotFound) {
+		return err
+	}
+
+	// A scan that is already gone has nothing left outside the database
+	if scan != nil {
+		hold, err := d.legalHoldRepo.GetActiveForScan(ctx, scan.ID)
+		if err != nil {
+			return err
+		}
+		if hold != nil {
+			return hold.Err()
+		}
+
+		if scan.JobName != nil && *scan.JobName != "" {
+			if err := d.jobDispatcher.DeleteJob(ctx, domain.JobNamespace(scan.JobNamespace, d.defaultNamespace), *scan.JobName); err != nil {
+				return err
+			}
+		}
+
+		subs, err := d.subScanRepo.ListByScan(ctx, scan.ID)
+		if err != nil {
+			return err
+		}
+		for _, sub := range subs {
+			if sub.JobName == nil {
+				continue
+			}
+			if err := d.jobDispatcher.DeleteJob(ctx, domain.JobNamespace(sub.JobNamespace, d.defaultNamespace), *sub.JobName); err != nil {
+				return err
+			}
+		}
+
+		artifactIDs := []string{}
+		if scan.SourceArchiveKey != nil && *scan.SourceArchiveKey != "" {
+			artifactIDs = append(artifactIDs, *scan.SourceArchiveKey)
+		}
+		if scan.LogsArtifactID != nil && *scan.LogsArtifactID != "" {
+			artifactIDs = append(artifactIDs, *scan.LogsArtifactID)
+		}
+
+		// Attempt logs not combined into the scan's archive yet
+		logs, err := d.subScanRepo.ListLogs(ctx, scan.ID)
+		if err != nil {
+			return err
+		}
+		for _, entry := range logs {
+			artifactIDs = append(artifactIDs, entry.ArtifactID)
+		}
+		if len(artifactIDs) > 0 {
+			if err := d.storageClient.DeleteArtifacts(ctx, artifactIDs); err != nil {
+				return err
+			}
+		}
+	}
+
+	return d.operationRepo.CompleteScanDeletion(ctx, deletion)
+}
+
+// backoff returns the delay before the given retry attempt
+func (d *ScanDeleter) backoff(attempts int) time.Duration {
+	delay := d.config.InitialBackoff
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if d.config.MaxBackoff > 0 && delay >= d.config.MaxBackoff {
+			return d.config.MaxBackoff
+		}
+	}
+	return delay
+}
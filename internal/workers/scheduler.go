@@ -0,0 +1,197 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/health"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/tracing"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// schedulerBatchSize bounds how many due schedules are processed per cycle
+const schedulerBatchSize = 100
+
+// Scheduler enqueues scans for recurring scan schedules when they are due
+type Scheduler struct {
+	scheduleRepo  interfaces.ScheduleRepository
+	scanRepo      interfaces.ScanRepository
+	interval      time.Duration
+	catchUpWindow time.Duration // missed runs older than this are skipped instead of enqueued
+	logger        *log.Entry
+	stopChan      chan struct{}
+}
+
+// NewScheduler creates a new scheduler worker
+func NewScheduler(
+	scheduleRepo interfaces.ScheduleRepository,
+	scanRepo interfaces.ScanRepository,
+	interval time.Duration,
+	catchUpWindow time.Duration,
+) *Scheduler {
+	return &Scheduler{
+		scheduleRepo:  scheduleRepo,
+		scanRepo:      scanRepo,
+		interval:      interval,
+		catchUpWindow: catchUpWindow,
+		logger:        log.WithField("component", "scheduler"),
+		stopChan:      make(chan struct{}),
+	}
+}
+
+// Start begins the scheduler's processing loop
+func (s *Scheduler) Start(ctx context.Context) {
+	s.logger.WithFields(log.Fields{
+		"interval":        s.interval,
+		"catch_up_window": s.catchUpWindow,
+	}).Info("Starting scheduler worker")
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	// Run immediately on start so runs missed during downtime are handled right away
+	s.schedule(ctx)
+
+	for {
+		select {
+		case <-ticker.C:
+			s.schedule(ctx)
+		case <-s.stopChan:
+			s.logger.Info("Scheduler worker stopped")
+			return
+		case <-ctx.Done():
+			s.logger.Info("Scheduler worker context cancelled")
+			return
+		}
+	}
+}
+
+// Stop gracefully stops the scheduler
+func (s *Scheduler) Stop() {
+	close(s.stopChan)
+}
+
+// schedule processes all due schedules
+func (s *Scheduler) schedule(ctx context.Context) {
+	s.logger.Debug("Starting schedule cycle")
+	health.Beat("scheduler")
+
+	now := time.Now()
+	schedules, err := s.scheduleRepo.ListDue(ctx, now, schedulerBatchSize)
+	if err != nil {
+		s.logger.WithError(err).Error("Failed to list due schedules")
+		return
+	}
+
+	if len(schedules) == 0 {
+		s.logger.Debug("No due schedules")
+		return
+	}
+
+	s.logger.WithField("count", len(schedules)).Info("Found due schedules")
+
+	for _, schedule := range schedules {
+		health.Beat("scheduler")
+		s.runSchedule(ctx, schedule, now)
+	}
+
+	s.logger.Debug("Schedule cycle completed")
+}
+
+// runSchedule claims a single due run and enqueues a scan for it.
+// Missed runs are coalesced: the schedule advances to its first activation after now,
+// so an orchestrator that was down for a week enqueues at most one scan per schedule.
+func (s *Scheduler) runSchedule(ctx context.Context, schedule *domain.ScanSchedule, now time.Time) {
+	logger := s.logger.WithFields(log.Fields{
+		"schedule_id": schedule.ID.String(),
+		"project_id":  schedule.ProjectID.String(),
+		"due_at":      schedule.NextRunAt,
+	})
+
+	dueAt := *schedule.NextRunAt
+	nextRun, err := schedule.NextRunAfter(now)
+	if err != nil {
+		logger.WithError(err).Error("Failed to compute next run time, skipping schedule")
+		return
+	}
+	logger = logger.WithField("next_run_at", nextRun)
+
+	// A skipped run is only claimed, so concurrent replicas don't enqueue it
+	skip := ""
+	if s.catchUpWindow > 0 && now.Sub(dueAt) > s.catchUpWindow {
+		skip = "Skipping missed schedule run outside catch-up window"
+	} else if s.previousRunActive(ctx, schedule) {
+		logger = logger.WithField("last_scan_id", schedule.LastScanID.String())
+		skip = "Previous scheduled scan still active, skipping run"
+	}
+	if skip != "" {
+		claimed, err := s.scheduleRepo.ClaimRun(ctx, schedule.ID, dueAt, nextRun)
+		if err != nil {
+			logger.WithError(err).Error("Failed to claim schedule run")
+			return
+		}
+		if !claimed {
+			logger.Debug("Schedule run already claimed by another replica")
+			return
+		}
+		logger.Info(skip)
+		return
+	}
+
+	// Root the trace of the scheduled scan here
+	ctx, span := tracing.Tracer.Start(ctx, "scheduler.enqueue_scan",
+		trace.WithAttributes(attribute.String("cloudscan.schedule_id", schedule.ID.String())),
+	)
+	defer span.End()
+
+	branch := schedule.Branch
+	repositoryURL := schedule.RepositoryURL
+	scan := &domain.Scan{
+		ID:             uuid.New(),
+		OrganizationID: schedule.OrganizationID,
+		ProjectID:      schedule.ProjectID,
+		UserID:         schedule.CreatedBy,
+		Status:         domain.ScanStatusQueued,
+		ScanTypes:      schedule.ScanTypes,
+		RepositoryURL:  &repositoryURL,
+		Branch:         &branch,
+		TraceParent:    tracing.TraceParent(ctx),
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+
+	// The claim, the scan and the run record commit together; a run whose
+	// scan can't be created stays due and is retried next cycle
+	claimed, err := s.scheduleRepo.EnqueueRun(ctx, schedule.ID, dueAt, nextRun, scan)
+	if err != nil {
+		logger.WithError(err).Error("Failed to enqueue scheduled scan")
+		tracing.SetError(span, err)
+		return
+	}
+	if !claimed {
+		logger.Debug("Schedule run already claimed by another replica")
+		return
+	}
+
+	logger.WithField("scan_id", scan.ID.String()).Info("Enqueued scheduled scan")
+}
+
+// previousRunActive reports whether the scan from the schedule's last run is still queued or running
+func (s *Scheduler) previousRunActive(ctx context.Context, schedule *domain.ScanSchedule) bool {
+	if schedule.LastScanID == nil {
+		return false
+	}
+
+	scan, err := s.scanRepo.Get(ctx, *schedule.LastScanID)
+	if err != nil {
+		// The previous scan may have been deleted; treat it as finished
+		return false
+	}
+
+	return !scan.IsTerminal()
+}
@@ -0,0 +1,68 @@
+package workers
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+)
+
+// detectStuckJob returns why an active job makes no progress, or an empty
+// reason if it does. Pods are judged by their age, so each retry's job gets
+// the full timeouts. lastHeartbeat is the runner's last callback, zero if it
+// never called back.
+func detectStuckJob(status *interfaces.JobStatus, policy domain.StuckScanPolicy, lastHeartbeat, now time.Time) (domain.FailureReason, string) {
+	pod := status.Pod
+
+	// Pod creation can be blocked, e.g. by a resource quota. Active pods that
+	// couldn't be listed are judged next time.
+	if pod == nil {
+		if status.Active == 0 && exceeded(policy.PendingTimeout, status.CreatedAt, now) {
+			return domain.FailureReasonUnschedulable, fmt.Sprintf("Job created no pod within %s", policy.PendingTimeout)
+		}
+		return "", ""
+	}
+
+	if isImagePullFailure(pod.WaitingReason) {
+		if exceeded(policy.ImagePullTimeout, pod.CreatedAt, now) {
+			return domain.FailureReasonImagePull, fmt.Sprintf("Runner image can't be pulled: %s", pod.WaitingMessage)
+		}
+		return "", ""
+	}
+
+	switch pod.Phase {
+	case "Pending":
+		if !exceeded(policy.PendingTimeout, pod.CreatedAt, now) {
+			return "", ""
+		}
+		if pod.Unschedulable {
+			return domain.FailureReasonUnschedulable, fmt.Sprintf("Pod unschedulable for %s: %s", policy.PendingTimeout, pod.SchedulingMessage)
+		}
+		message := fmt.Sprintf("Pod pending for %s", policy.PendingTimeout)
+		if pod.WaitingReason != "" {
+			message += fmt.Sprintf(": %s %s", pod.WaitingReason, pod.WaitingMessage)
+		}
+		return domain.FailureReasonPodPending, message
+
+	case "Running":
+		if pod.StartedAt == nil {
+			return "", ""
+		}
+		// The runner's first callback is due a heartbeat window after it started
+		since := *pod.StartedAt
+		if lastHeartbeat.After(since) {
+			since = lastHeartbeat
+		}
+		if exceeded(policy.HeartbeatTimeout, since, now) {
+			return domain.FailureReasonHeartbeatTimeout, fmt.Sprintf("Runner sent no heartbeat for %s", policy.HeartbeatTimeout)
+		}
+	}
+
+	return "", ""
+}
+
+// exceeded returns true if timeout is set and more than timeout passed since
+func exceeded(timeout time.Duration, since, now time.Time) bool {
+	return timeout > 0 && !since.IsZero() && now.Sub(since) > timeout
+}
@@ -2,17 +2,30 @@ package workers
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/health"
 	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/metrics"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/tracing"
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
-// Sweeper monitors Kubernetes job status and updates scan records
+// Sweeper monitors Kubernetes job status and updates scan records. Failed
+// jobs are classified; sub-scans whose job failed transiently are queued for
+// a retry by the dispatcher. Jobs that were lost or make no progress fail
+// like failed jobs.
 type Sweeper struct {
 	scanRepo         interfaces.ScanRepository
+	subScanRepo      interfaces.SubScanRepository
 	jobDispatcher    interfaces.JobDispatcher
+	retryPolicy      domain.RetryPolicy
+	stuckPolicy      domain.StuckScanPolicy
 	interval         time.Duration
 	defaultNamespace string
 	logger           *log.Entry
@@ -22,13 +35,19 @@ type Sweeper struct {
 // NewSweeper creates a new sweeper worker
 func NewSweeper(
 	scanRepo interfaces.ScanRepository,
+	subScanRepo interfaces.SubScanRepository,
 	jobDispatcher interfaces.JobDispatcher,
+	retryPolicy domain.RetryPolicy,
+	stuckPolicy domain.StuckScanPolicy,
 	interval time.Duration,
 	defaultNamespace string,
 ) *Sweeper {
 	return &Sweeper{
 		scanRepo:         scanRepo,
+		subScanRepo:      subScanRepo,
 		jobDispatcher:    jobDispatcher,
+		retryPolicy:      retryPolicy,
+		stuckPolicy:      stuckPolicy,
 		interval:         interval,
 		defaultNamespace: defaultNamespace,
 		logger:           log.WithField("component", "sweeper"),
@@ -68,6 +87,8 @@ func (s *Sweeper) Stop() {
 // sweep performs a single sweep operation
 func (s *Sweeper) sweep(ctx context.Context) {
 	s.logger.Debug("Starting sweep cycle")
+	defer metrics.WorkerCycle("sweeper").ObserveDuration()
+	health.Beat("sweeper")
 
 	// Query for active scans (queued or running)
 	queuedStatus := domain.ScanStatusQueued
@@ -76,12 +97,14 @@ func (s *Sweeper) sweep(ctx context.Context) {
 	queuedScans, err := s.scanRepo.List(ctx, interfaces.ScanFilter{Status: &queuedStatus})
 	if err != nil {
 		s.logger.WithError(err).Error("Failed to list queued scans")
+		metrics.WorkerError("sweeper")
 		return
 	}
 
 	runningScans, err := s.scanRepo.List(ctx, interfaces.ScanFilter{Status: &runningStatus})
 	if err != nil {
 		s.logger.WithError(err).Error("Failed to list running scans")
+		metrics.WorkerError("sweeper")
 		return
 	}
 
@@ -90,6 +113,7 @@ func (s *Sweeper) sweep(ctx context.Context) {
 
 	// Check each scan's job status
 	for _, scan := range allScans {
+		health.Beat("sweeper")
 		s.processScan(ctx, scan)
 	}
 
@@ -104,103 +128,276 @@ func (s *Sweeper) processScan(ctx context.Context, scan *domain.Scan) {
 		"status":   scan.Status,
 	})
 
-	// If no job name yet, skip (job not created yet)
+	// Scans dispatched with one job per scan type have no job of their own
 	if scan.JobName == nil || *scan.JobName == "" {
-		logger.Debug("Scan has no job name, skipping")
+		if scan.Status == domain.ScanStatusRunning {
+			s.processSubScans(ctx, logger, scan)
+		} else {
+			logger.Debug("Scan has no job name, skipping")
+		}
 		return
 	}
 
-	// Use scan's namespace or fall back to default namespace
-	jobNamespace := s.defaultNamespace
-	if scan.JobNamespace != nil && *scan.JobNamespace != "" {
-		jobNamespace = *scan.JobNamespace
-	}
+	jobNamespace := domain.JobNamespace(scan.JobNamespace, s.defaultNamespace)
 
-	// Get job status from Kubernetes
-	jobStatus, err := s.jobDispatcher.GetJobStatus(ctx, jobNamespace, *scan.JobName)
+	// Runner callbacks of these scans update the scan itself
+	outcome, err := s.checkJob(ctx, logger, jobNamespace, *scan.JobName, scan.UpdatedAt)
 	if err != nil {
 		logger.WithError(err).Warn("Failed to get job status")
+		metrics.WorkerError("sweeper")
+		return
+	}
+	newStatus := outcome.status
+	if newStatus == "" || newStatus == scan.Status {
 		return
 	}
 
+	// Record the status change in the scan's trace
+	ctx, span := tracing.Tracer.Start(tracing.WithTraceParent(ctx, scan.TraceParent), "sweeper.update_status",
+		trace.WithAttributes(
+			attribute.String("cloudscan.scan_id", scan.ID.String()),
+			attribute.String("cloudscan.scan_status", string(newStatus)),
+		),
+	)
+	defer span.End()
+
+	// Update scan status in database. Scans with a job of their own predate
+	// retries, so their failures are final.
+	scan.Status = newStatus
+	if outcome.errorMessage != nil {
+		scan.ErrorMessage = outcome.errorMessage
+	}
+	if newStatus == domain.ScanStatusFailed {
+		scan.FailureReason = outcome.failureReason
+		metrics.JobFailure(string(outcome.failureReason), false)
+	}
+
+	if newStatus == domain.ScanStatusCompleted || newStatus == domain.ScanStatusFailed {
+		now := time.Now()
+		scan.CompletedAt = &now
+	}
+
+	if err := s.scanRepo.Update(ctx, scan); err != nil {
+		logger.WithError(err).Error("Failed to update scan status")
+		metrics.WorkerError("sweeper")
+		tracing.SetError(span, err)
+		return
+	}
+
+	logger.WithField("new_status", newStatus).Info("Updated scan status")
+
+	if outcome.stuck {
+		s.deleteStuckJob(ctx, logger, jobNamespace, *scan.JobName)
+	}
+
+	if scan.IsTerminal() && scan.StartedAt != nil {
+		metrics.ScanDuration.WithLabelValues(string(scan.Status)).Observe(scan.Duration().Seconds())
+	}
+}
+
+// processSubScans checks the job of every unfinished sub-scan. The sub-scan
+// repository aggregates the scan's status once all of them finished.
+func (s *Sweeper) processSubScans(ctx context.Context, logger *log.Entry, scan *domain.Scan) {
+	subs, err := s.subScanRepo.ListByScan(ctx, scan.ID)
+	if err != nil {
+		logger.WithError(err).Error("Failed to list sub-scans")
+		metrics.WorkerError("sweeper")
+		return
+	}
+
+	for _, sub := range subs {
+		// Queued sub-scans wait for the dispatcher to retry them
+		if sub.IsTerminal() || sub.Status == domain.ScanStatusQueued || sub.JobName == nil {
+			continue
+		}
+		subLogger := logger.WithFields(log.Fields{
+			"scan_type": sub.ScanType,
+			"job_name":  *sub.JobName,
+			"attempt":   sub.Attempt,
+		})
+
+		var lastHeartbeat time.Time
+		if sub.LastHeartbeatAt != nil {
+			lastHeartbeat = *sub.LastHeartbeatAt
+		}
+
+		jobNamespace := domain.JobNamespace(sub.JobNamespace, s.defaultNamespace)
+		outcome, err := s.checkJob(ctx, subLogger, jobNamespace, *sub.JobName, lastHeartbeat)
+		if err != nil {
+			subLogger.WithError(err).Warn("Failed to get job status")
+			metrics.WorkerError("sweeper")
+			continue
+		}
+		newStatus := outcome.status
+		if newStatus == "" || newStatus == sub.Status {
+			continue
+		}
+
+		now := time.Now()
+		if newStatus == domain.ScanStatusFailed {
+			retried := sub.Fail(outcome.failureReason, *outcome.errorMessage, s.retryPolicy, now)
+			metrics.JobFailure(string(outcome.failureReason), retried)
+			if retried {
+				subLogger.WithFields(log.Fields{
+					"failure_reason": outcome.failureReason,
+					"retry_at":       sub.RetryAt,
+				}).Info("Job failed transiently, queued sub-scan for a retry")
+			}
+		} else {
+			sub.Status = newStatus
+			if sub.IsTerminal() {
+				sub.CompletedAt = &now
+			}
+		}
+
+		// A job removed after its runner reported the outcome, e.g. by its
+		// TTL, isn't lost: the report wins
+		updated, err := s.updateSubScan(ctx, scan, sub)
+		if errors.Is(err, domain.ErrSubScanNotRunning) || errors.Is(err, domain.ErrStaleAttempt) {
+			subLogger.WithError(err).Debug("Sub-scan changed since it was read, skipping")
+			continue
+		}
+		if err != nil {
+			subLogger.WithError(err).Error("Failed to update sub-scan status")
+			metrics.WorkerError("sweeper")
+			continue
+		}
+
+		subLogger.WithField("new_status", sub.Status).Info("Updated sub-scan status")
+
+		// A retry replaces the job too, but its pod shouldn't wait until then
+		if outcome.stuck {
+			s.deleteStuckJob(ctx, subLogger, jobNamespace, *sub.JobName)
+		}
+
+		if updated.IsTerminal() && !scan.IsTerminal() {
+			logger.WithField("new_status", updated.Status).Info("Updated scan status")
+			if updated.StartedAt != nil {
+				metrics.ScanDuration.WithLabelValues(string(updated.Status)).Observe(updated.Duration().Seconds())
+			}
+		}
+		scan = updated
+	}
+}
+
+// updateSubScan records a sub-scan's new status in the scan's trace, unless
+// the sub-scan stopped running the attempt it was read with
+func (s *Sweeper) updateSubScan(ctx context.Context, scan *domain.Scan, sub *domain.SubScan) (updated *domain.Scan, err error) {
+	ctx, span := tracing.Tracer.Start(tracing.WithTraceParent(ctx, scan.TraceParent), "sweeper.update_sub_scan_status",
+		trace.WithAttributes(
+			attribute.String("cloudscan.scan_id", scan.ID.String()),
+			attribute.String("cloudscan.scan_type", string(sub.ScanType)),
+			attribute.String("cloudscan.scan_status", string(sub.Status)),
+		),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	return s.subScanRepo.UpdateRunning(ctx, sub)
+}
+
+// jobOutcome is the state of a scan's or sub-scan's job
+type jobOutcome struct {
+	status        domain.ScanStatus // Empty while unchanged
+	errorMessage  *string           // Set if failed
+	failureReason domain.FailureReason
+	stuck         bool // Failed while still active, so the job must be deleted
+}
+
+// checkJob maps the job's status to a scan status and, for failed jobs, an
+// error message and the classified failure reason. A missing job is lost, and
+// an active job stuck pending or with a silent runner fails.
+func (s *Sweeper) checkJob(ctx context.Context, logger *log.Entry, jobNamespace, jobName string, lastHeartbeat time.Time) (*jobOutcome, error) {
+	// Get job status from Kubernetes
+	jobStatus, err := s.jobDispatcher.GetJobStatus(ctx, jobNamespace, jobName)
+	if errors.Is(err, interfaces.ErrJobNotFound) {
+		msg := fmt.Sprintf("Job %s no longer exists", jobName)
+		logger.WithField("failure_reason", domain.FailureReasonJobLost).Warn("Job lost")
+		return failedOutcome(msg, domain.FailureReasonJobLost, false), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
 	logger = logger.WithFields(log.Fields{
 		"job_active":    jobStatus.Active,
 		"job_succeeded": jobStatus.Succeeded,
 		"job_failed":    jobStatus.Failed,
 	})
 
-	// Update scan based on job status
-	var newStatus domain.ScanStatus
-	var errorMessage *string
-
 	if jobStatus.Succeeded > 0 {
 		// Job completed successfully
-		newStatus = domain.ScanStatusCompleted
 		logger.Info("Job completed successfully")
+		return &jobOutcome{status: domain.ScanStatusCompleted}, nil
+	}
 
-	} else if jobStatus.Failed > 0 {
-		// Job failed
-		newStatus = domain.ScanStatusFailed
+	if jobStatus.Failed > 0 {
+		var errorMessage string
 
 		// Try to get error message from job conditions
 		for _, cond := range jobStatus.Conditions {
 			if cond.Type == "Failed" && cond.Status == "True" {
-				msg := cond.Message
-				if msg == "" {
-					msg = cond.Reason
+				errorMessage = cond.Message
+				if errorMessage == "" {
+					errorMessage = cond.Reason
 				}
-				errorMessage = &msg
 				break
 			}
 		}
 
-		if errorMessage == nil {
+		if errorMessage == "" {
 			// Try to get logs
-			logs, err := s.jobDispatcher.GetJobLogs(ctx, jobNamespace, *scan.JobName)
+			logs, err := s.jobDispatcher.GetJobLogs(ctx, jobNamespace, jobName)
 			if err == nil && logs != "" {
 				// Take last 500 chars of logs as error message
 				if len(logs) > 500 {
 					logs = "..." + logs[len(logs)-500:]
 				}
-				errorMessage = &logs
+				errorMessage = logs
 			} else {
-				defaultMsg := "Job failed with unknown error"
-				errorMessage = &defaultMsg
+				errorMessage = "Job failed with unknown error"
 			}
 		}
 
-		logger.WithField("error", *errorMessage).Warn("Job failed")
-
-	} else if jobStatus.Active > 0 {
-		// Job is running
-		if scan.Status != domain.ScanStatusRunning {
-			newStatus = domain.ScanStatusRunning
-			logger.Info("Job is now running")
-		} else {
-			// Already running, no update needed
-			return
-		}
-	} else {
-		// Job exists but has no active/succeeded/failed pods - might be pending
-		logger.Debug("Job has no active/succeeded/failed pods, status unchanged")
-		return
+		failureReason := classifyJobFailure(jobStatus)
+		logger.WithFields(log.Fields{
+			"error":          errorMessage,
+			"failure_reason": failureReason,
+		}).Warn("Job failed")
+		return failedOutcome(errorMessage, failureReason, false), nil
 	}
 
-	// Update scan status in database
-	scan.Status = newStatus
-	if errorMessage != nil {
-		scan.ErrorMessage = errorMessage
+	if reason, message := detectStuckJob(jobStatus, s.stuckPolicy, lastHeartbeat, time.Now()); reason != "" {
+		logger.WithFields(log.Fields{
+			"error":          message,
+			"failure_reason": reason,
+		}).Warn("Job is stuck")
+		return failedOutcome(message, reason, true), nil
 	}
 
-	if newStatus == domain.ScanStatusCompleted || newStatus == domain.ScanStatusFailed {
-		now := time.Now()
-		scan.CompletedAt = &now
+	if jobStatus.Active > 0 {
+		return &jobOutcome{status: domain.ScanStatusRunning}, nil
 	}
 
-	if err := s.scanRepo.Update(ctx, scan); err != nil {
-		logger.WithError(err).Error("Failed to update scan status")
-		return
+	// Job exists but has no active/succeeded/failed pods - might be pending
+	logger.Debug("Job has no active/succeeded/failed pods, status unchanged")
+	return &jobOutcome{}, nil
+}
+
+// failedOutcome returns the outcome of a failed job
+func failedOutcome(message string, reason domain.FailureReason, stuck bool) *jobOutcome {
+	return &jobOutcome{
+		status:        domain.ScanStatusFailed,
+		errorMessage:  &message,
+		failureReason: reason,
+		stuck:         stuck,
 	}
+}
 
-	logger.WithField("new_status", newStatus).Info("Updated scan status")
+// deleteStuckJob deletes the job of a scan or sub-scan that failed while the
+// job was still active
+func (s *Sweeper) deleteStuckJob(ctx context.Context, logger *log.Entry, jobNamespace, jobName string) {
+	if err := s.jobDispatcher.DeleteJob(ctx, jobNamespace, jobName); err != nil {
+		logger.WithError(err).Warn("Failed to delete stuck job")
+		metrics.WorkerError("sweeper")
+	}
 }
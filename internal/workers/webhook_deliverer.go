@@ -0,0 +1,210 @@
+package workers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/domain"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/health"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/interfaces"
+	"github.com/cloud-scan/cloudscan-orchestrator/internal/webhooks"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+)
+
+// WebhookDeliveryConfig configures the webhook delivery worker
+type WebhookDeliveryConfig struct {
+	Interval       time.Duration           // How often to poll the outbox
+	BatchSize      int                     // Deliveries claimed per poll
+	Timeout        time.Duration           // HTTP timeout per attempt
+	MaxAttempts    int                     // Attempts before a delivery is marked failed
+	InitialBackoff time.Duration           // Delay before the first retry, doubled after each attempt
+	MaxBackoff     time.Duration           // Upper bound for the retry delay
+	Endpoints      webhooks.EndpointPolicy // Enforced on every connection
+}
+
+// WebhookDeliverer sends pending webhook deliveries from the outbox, signs them
+// with the subscription secret and retries failures with exponential backoff
+type WebhookDeliverer struct {
+	webhookRepo interfaces.WebhookRepository
+	config      WebhookDeliveryConfig
+	httpClient  *http.Client
+	logger      *log.Entry
+	stopChan    chan struct{}
+}
+
+// NewWebhookDeliverer creates a new webhook delivery worker
+func NewWebhookDeliverer(webhookRepo interfaces.WebhookRepository, config WebhookDeliveryConfig) *WebhookDeliverer {
+	if config.MaxAttempts < 1 {
+		config.MaxAttempts = 1
+	}
+	return &WebhookDeliverer{
+		webhookRepo: webhookRepo,
+		config:      config,
+		httpClient:  config.Endpoints.Client(config.Timeout),
+		logger:      log.WithField("component", "webhook-deliverer"),
+		stopChan:    make(chan struct{}),
+	}
+}
+
+// Start begins the delivery loop
+func (w *WebhookDeliverer) Start(ctx context.Context) {
+	w.logger.WithField("interval", w.config.Interval).Info("Starting webhook delivery worker")
+
+	ticker := time.NewTicker(w.config.Interval)
+	defer ticker.Stop()
+
+	// Run immediately on start
+	w.deliverDue(ctx)
+
+	for {
+		select {
+		case <-ticker.C:
+			w.deliverDue(ctx)
+		case <-w.stopChan:
+			w.logger.Info("Webhook delivery worker stopped")
+			return
+		case <-ctx.Done():
+			w.logger.Info("Webhook delivery worker context cancelled")
+			return
+		}
+	}
+}
+
+// Stop gracefully stops the delivery worker
+func (w *WebhookDeliverer) Stop() {
+	close(w.stopChan)
+}
+
+// deliverDue claims due deliveries and sends them concurrently
+func (w *WebhookDeliverer) deliverDue(ctx context.Context) {
+	health.Beat("webhook-deliverer")
+
+	// The lease keeps other replicas away while requests are in flight
+	lease := 2*w.config.Timeout + w.config.Interval
+
+	deliveries, err := w.webhookRepo.ClaimDueDeliveries(ctx, time.Now(), lease, w.config.BatchSize)
+	if err != nil {
+		w.logger.WithError(err).Error("Failed to claim webhook deliveries")
+		return
+	}
+
+	if len(deliveries) == 0 {
+		return
+	}
+
+	w.logger.WithField("count", len(deliveries)).Debug("Delivering webhooks")
+
+	var wg sync.WaitGroup
+	for _, delivery := range deliveries {
+		wg.Add(1)
+		go func(delivery *domain.WebhookDelivery) {
+			defer wg.Done()
+			w.deliver(ctx, delivery)
+		}(delivery)
+	}
+	wg.Wait()
+}
+
+// deliver sends a single delivery and records the attempt
+func (w *WebhookDeliverer) deliver(ctx context.Context, delivery *domain.WebhookDelivery) {
+	logger := w.logger.WithFields(log.Fields{
+		"delivery_id":     delivery.ID.String(),
+		"subscription_id": delivery.SubscriptionID.String(),
+		"event_type":      delivery.EventType,
+	})
+
+	attempt := w.send(ctx, delivery)
+	now := time.Now()
+
+	delivery.Attempts++
+	attempt.Attempt = delivery.Attempts
+	delivery.LastStatusCode = attempt.StatusCode
+	delivery.LastError = attempt.Error
+
+	switch {
+	case attempt.Succeeded():
+		delivery.Status = domain.WebhookDeliverySucceeded
+		delivery.DeliveredAt = &now
+		logger.Debug("Webhook delivered")
+	case delivery.Attempts >= w.config.MaxAttempts:
+		delivery.Status = domain.WebhookDeliveryFailed
+		logger.WithField("attempts", delivery.Attempts).Warn("Webhook delivery failed permanently")
+	default:
+		delivery.Status = domain.WebhookDeliveryPending
+		delivery.NextAttemptAt = now.Add(w.backoff(delivery.Attempts))
+		logger.WithFields(log.Fields{
+			"attempts":        delivery.Attempts,
+			"next_attempt_at": delivery.NextAttemptAt,
+		}).Info("Webhook delivery failed, will retry")
+	}
+
+	if err := w.webhookRepo.RecordAttempt(ctx, delivery, attempt); err != nil {
+		logger.WithError(err).Error("Failed to record webhook delivery attempt")
+	}
+}
+
+// send performs the HTTP request and returns the (unnumbered) attempt
+func (w *WebhookDeliverer) send(ctx context.Context, delivery *domain.WebhookDelivery) *domain.WebhookDeliveryAttempt {
+	start := time.Now()
+	attempt := &domain.WebhookDeliveryAttempt{
+		ID:          uuid.New(),
+		DeliveryID:  delivery.ID,
+		AttemptedAt: start,
+	}
+
+	fail := func(err error) *domain.WebhookDeliveryAttempt {
+		msg := err.Error()
+		attempt.Error = &msg
+		attempt.DurationMs = time.Since(start).Milliseconds()
+		return attempt
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return fail(fmt.Errorf("invalid request: %w", err))
+	}
+	if err := w.config.Endpoints.CheckScheme(req.URL); err != nil {
+		return fail(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "CloudScan-Webhooks/1.0")
+	req.Header.Set(webhooks.HeaderEvent, string(delivery.EventType))
+	req.Header.Set(webhooks.HeaderDelivery, delivery.ID.String())
+	req.Header.Set(webhooks.HeaderTimestamp, strconv.FormatInt(start.Unix(), 10))
+	req.Header.Set(webhooks.HeaderSignature, webhooks.Sign(delivery.Secret, start, delivery.Payload))
+
+	resp, err := w.httpClient.Do(req)
+	if err != nil {
+		return fail(err)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	attempt.StatusCode = &resp.StatusCode
+	attempt.DurationMs = time.Since(start).Milliseconds()
+	if !attempt.Succeeded() {
+		msg := fmt.Sprintf("endpoint returned status %d", resp.StatusCode)
+		attempt.Error = &msg
+	}
+
+	return attempt
+}
+
+// backoff returns the delay after the given number of failed attempts
+func (w *WebhookDeliverer) backoff(attempts int) time.Duration {
+	delay := w.config.InitialBackoff
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if w.config.MaxBackoff > 0 && delay >= w.config.MaxBackoff {
+			return w.config.MaxBackoff
+		}
+	}
+	return delay
+}
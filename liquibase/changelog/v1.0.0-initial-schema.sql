@@ -1,910 +0,0 @@
---liquibase formatted sql
-
---changeset cloudscan:1 labels:v1.0.0 context:schema splitStatements:false
---comment: CloudScan Orchestrator - Base Tables
-
-CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
-
--- =============================================================================
--- Organizations (Multi-tenant)
--- =============================================================================
-CREATE TABLE organizations (
-    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
-    name TEXT NOT NULL,
-    slug TEXT UNIQUE NOT NULL,
-    is_active BOOLEAN NOT NULL DEFAULT true,
-    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
-    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
-);
-
-CREATE INDEX idx_organizations_slug ON organizations(slug);
-CREATE INDEX idx_organizations_active ON organizations(is_active) WHERE is_active = true;
-
--- =============================================================================
--- Projects
--- =============================================================================
-CREATE TABLE projects (
-    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
-    organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
-    name TEXT NOT NULL,
-    slug TEXT NOT NULL,
-    description TEXT,
-    repository_url TEXT NOT NULL,
-    default_branch TEXT NOT NULL DEFAULT 'main',
-    is_active BOOLEAN NOT NULL DEFAULT true,
-    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
-    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
-    UNIQUE(organization_id, slug)
-);
-
-CREATE INDEX idx_projects_org ON projects(organization_id);
-CREATE INDEX idx_projects_active ON projects(is_active) WHERE is_active = true;
-
--- =============================================================================
--- Scans (Partitioned by created_at for performance)
--- =============================================================================
-CREATE TABLE scans (
-    id UUID NOT NULL,
-    organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
-    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
-    user_id UUID,
-    status TEXT NOT NULL CHECK (status IN ('queued', 'running', 'completed', 'failed', 'cancelled')),
-    scan_types TEXT[] NOT NULL,
-
-    -- Source code information
-    repository_url TEXT NOT NULL,
-    branch TEXT NOT NULL,
-    commit_sha TEXT,
-    source_archive_key TEXT,
-
-    -- Kubernetes job information
-    job_name TEXT,
-    job_namespace TEXT,
-
-    -- Results
-    findings_count INT NOT NULL DEFAULT 0,
-    critical_count INT NOT NULL DEFAULT 0,
-    high_count INT NOT NULL DEFAULT 0,
-    medium_count INT NOT NULL DEFAULT 0,
-    low_count INT NOT NULL DEFAULT 0,
-    started_at TIMESTAMP WITH TIME ZONE,
-    completed_at TIMESTAMP WITH TIME ZONE,
-    error_message TEXT,
-
-    -- Audit
-    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
-    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
-
-    PRIMARY KEY (id, created_at)
-) PARTITION BY RANGE (created_at);
-
--- Create partitions for current and future months
-CREATE TABLE scans_2025_01 PARTITION OF scans
-    FOR VALUES FROM ('2025-01-01') TO ('2025-02-01');
-
-CREATE TABLE scans_2025_02 PARTITION OF scans
-    FOR VALUES FROM ('2025-02-01') TO ('2025-03-01');
-
-CREATE TABLE scans_2025_03 PARTITION OF scans
-    FOR VALUES FROM ('2025-03-01') TO ('2025-04-01');
-
-CREATE TABLE scans_2025_04 PARTITION OF scans
-    FOR VALUES FROM ('2025-04-01') TO ('2025-05-01');
-
-CREATE TABLE scans_2025_05 PARTITION OF scans
-    FOR VALUES FROM ('2025-05-01') TO ('2025-06-01');
-
-
---changeset cloudscan:2 labels:v1.0.0 context:schema splitStatements:false
---comment: CloudScan Orchestrator - Scans Table
-
-CREATE TABLE scans_2025_06 PARTITION OF scans
-    FOR VALUES FROM ('2025-06-01') TO ('2025-07-01');
-
--- Indexes on partitioned table
-CREATE INDEX idx_scans_org ON scans(organization_id, created_at DESC);
-CREATE INDEX idx_scans_project ON scans(project_id, created_at DESC);
-CREATE INDEX idx_scans_user ON scans(user_id, created_at DESC);
-CREATE INDEX idx_scans_status ON scans(status, created_at DESC);
-CREATE INDEX idx_scans_job_name ON scans(job_name);
-
--- =============================================================================
--- Findings
--- =============================================================================
-
---changeset cloudscan:3 labels:v1.0.0 context:schema splitStatements:false
---comment: CloudScan Orchestrator - Findings and Audit Tables
-
-CREATE TABLE findings (
-    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
-    scan_id UUID NOT NULL,
-
-    -- Scanner information
-    scan_type TEXT NOT NULL CHECK (scan_type IN ('sast', 'sca', 'secrets', 'license')),
-    tool_name TEXT NOT NULL,
-    tool_version TEXT,
-
-    -- Finding details
-    title TEXT NOT NULL,
-    description TEXT,
-    severity TEXT NOT NULL CHECK (severity IN ('critical', 'high', 'medium', 'low', 'info')),
-
-    -- Location in code
-    file_path TEXT,
-    start_line INT,
-    end_line INT,
-    start_column INT,
-    end_column INT,
-    code_snippet TEXT,
-
-    -- Vulnerability details (SAST/SCA)
-    rule_id TEXT,
-    cwe_id TEXT,
-    cve_id TEXT,
-    cvss_score DECIMAL(3,1),
-    cvss_vector TEXT,
-
-    -- Dependency information (SCA)
-    package_name TEXT,
-    package_version TEXT,
-    fixed_version TEXT,
-
-    -- License information
-    license_name TEXT,
-    license_type TEXT,
-
-    -- Remediation
-    remediation TEXT,
-    "references" TEXT[],
-
-    -- Metadata
-    raw_output JSONB,
-    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
-);
-
-CREATE INDEX idx_findings_scan ON findings(scan_id);
-CREATE INDEX idx_findings_severity ON findings(severity, scan_id);
-CREATE INDEX idx_findings_type ON findings(scan_type, scan_id);
-CREATE INDEX idx_findings_cve ON findings(cve_id) WHERE cve_id IS NOT NULL;
-
--- =============================================================================
--- Audit Log
--- =============================================================================
-CREATE TABLE audit_logs (
-    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
-    organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
-    user_id UUID NOT NULL,
-    action TEXT NOT NULL,
-    resource_type TEXT NOT NULL,
-    resource_id UUID,
-    metadata JSONB,
-    ip_address INET,
-    user_agent TEXT,
-    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
-);
-
-CREATE INDEX idx_audit_logs_org ON audit_logs(organization_id, created_at DESC);
-CREATE INDEX idx_audit_logs_user ON audit_logs(user_id, created_at DESC);
-CREATE INDEX idx_audit_logs_resource ON audit_logs(resource_type, resource_id);
-
--- =============================================================================
-
---changeset cloudscan:4 labels:v1.0.0 context:schema splitStatements:false
---comment: CloudScan Orchestrator - Functions and Triggers
-
-CREATE OR REPLACE FUNCTION update_updated_at_column()
-RETURNS TRIGGER AS $BODY$
-BEGIN
-    NEW.updated_at = NOW();
-    RETURN NEW;
-END;
-$BODY$
-language 'plpgsql';
-
--- Triggers to auto-update updated_at
-CREATE TRIGGER update_organizations_updated_at BEFORE UPDATE ON organizations
-    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
-
-CREATE TRIGGER update_projects_updated_at BEFORE UPDATE ON projects
-    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
-
---changeset cloudscan:5 labels:v1.0.0 context:data
---comment: Insert sample data for development
-
--- Sample organization
-INSERT INTO organizations (id, name, slug) VALUES
-    ('550e8400-e29b-41d4-a716-446655440000', 'Demo Organization', 'demo-org');
-
--- Sample project
-INSERT INTO projects (id, organization_id, name, slug, repository_url, default_branch) VALUES
-    ('550e8400-e29b-41d4-a716-446655440001', '550e8400-e29b-41d4-a716-446655440000', 'Demo Project', 'demo-project', 'https://github.com/demo/repo', 'main');
-
---rollback DELETE FROM projects WHERE id = '550e8400-e29b-41d4-a716-446655440001';
---rollback DELETE FROM organizations WHERE id = '550e8400-e29b-41d4-a716-446655440000';
-
---changeset cloudscan:6 labels:v1.0.0 context:schema splitStatements:false
---comment: Add scan partitions for 2025-2075 (50 years)
-
--- Missing 2025 partitions (July-December)
-CREATE TABLE IF NOT EXISTS scans_2025_07 PARTITION OF scans FOR VALUES FROM ('2025-07-01') TO ('2025-08-01');
-CREATE TABLE IF NOT EXISTS scans_2025_08 PARTITION OF scans FOR VALUES FROM ('2025-08-01') TO ('2025-09-01');
-CREATE TABLE IF NOT EXISTS scans_2025_09 PARTITION OF scans FOR VALUES FROM ('2025-09-01') TO ('2025-10-01');
-CREATE TABLE IF NOT EXISTS scans_2025_10 PARTITION OF scans FOR VALUES FROM ('2025-10-01') TO ('2025-11-01');
-CREATE TABLE IF NOT EXISTS scans_2025_11 PARTITION OF scans FOR VALUES FROM ('2025-11-01') TO ('2025-12-01');
-CREATE TABLE IF NOT EXISTS scans_2025_12 PARTITION OF scans FOR VALUES FROM ('2025-12-01') TO ('2026-01-01');
-
--- 2026 partitions
-CREATE TABLE IF NOT EXISTS scans_2026_01 PARTITION OF scans FOR VALUES FROM ('2026-01-01') TO ('2026-02-01');
-CREATE TABLE IF NOT EXISTS scans_2026_02 PARTITION OF scans FOR VALUES FROM ('2026-02-01') TO ('2026-03-01');
-CREATE TABLE IF NOT EXISTS scans_2026_03 PARTITION OF scans FOR VALUES FROM ('2026-03-01') TO ('2026-04-01');
-CREATE TABLE IF NOT EXISTS scans_2026_04 PARTITION OF scans FOR VALUES FROM ('2026-04-01') TO ('2026-05-01');
-CREATE TABLE IF NOT EXISTS scans_2026_05 PARTITION OF scans FOR VALUES FROM ('2026-05-01') TO ('2026-06-01');
-CREATE TABLE IF NOT EXISTS scans_2026_06 PARTITION OF scans FOR VALUES FROM ('2026-06-01') TO ('2026-07-01');
-CREATE TABLE IF NOT EXISTS scans_2026_07 PARTITION OF scans FOR VALUES FROM ('2026-07-01') TO ('2026-08-01');
-CREATE TABLE IF NOT EXISTS scans_2026_08 PARTITION OF scans FOR VALUES FROM ('2026-08-01') TO ('2026-09-01');
-CREATE TABLE IF NOT EXISTS scans_2026_09 PARTITION OF scans FOR VALUES FROM ('2026-09-01') TO ('2026-10-01');
-CREATE TABLE IF NOT EXISTS scans_2026_10 PARTITION OF scans FOR VALUES FROM ('2026-10-01') TO ('2026-11-01');
-CREATE TABLE IF NOT EXISTS scans_2026_11 PARTITION OF scans FOR VALUES FROM ('2026-11-01') TO ('2026-12-01');
-CREATE TABLE IF NOT EXISTS scans_2026_12 PARTITION OF scans FOR VALUES FROM ('2026-12-01') TO ('2027-01-01');
-
--- 2027-2075 partitions (continuing for 48 more years)
-CREATE TABLE IF NOT EXISTS scans_2027_01 PARTITION OF scans FOR VALUES FROM ('2027-01-01') TO ('2027-02-01');
-CREATE TABLE IF NOT EXISTS scans_2027_02 PARTITION OF scans FOR VALUES FROM ('2027-02-01') TO ('2027-03-01');
-CREATE TABLE IF NOT EXISTS scans_2027_03 PARTITION OF scans FOR VALUES FROM ('2027-03-01') TO ('2027-04-01');
-CREATE TABLE IF NOT EXISTS scans_2027_04 PARTITION OF scans FOR VALUES FROM ('2027-04-01') TO ('2027-05-01');
-CREATE TABLE IF NOT EXISTS scans_2027_05 PARTITION OF scans FOR VALUES FROM ('2027-05-01') TO ('2027-06-01');
-CREATE TABLE IF NOT EXISTS scans_2027_06 PARTITION OF scans FOR VALUES FROM ('2027-06-01') TO ('2027-07-01');
-CREATE TABLE IF NOT EXISTS scans_2027_07 PARTITION OF scans FOR VALUES FROM ('2027-07-01') TO ('2027-08-01');
-CREATE TABLE IF NOT EXISTS scans_2027_08 PARTITION OF scans FOR VALUES FROM ('2027-08-01') TO ('2027-09-01');
-CREATE TABLE IF NOT EXISTS scans_2027_09 PARTITION OF scans FOR VALUES FROM ('2027-09-01') TO ('2027-10-01');
-CREATE TABLE IF NOT EXISTS scans_2027_10 PARTITION OF scans FOR VALUES FROM ('2027-10-01') TO ('2027-11-01');
-CREATE TABLE IF NOT EXISTS scans_2027_11 PARTITION OF scans FOR VALUES FROM ('2027-11-01') TO ('2027-12-01');
-CREATE TABLE IF NOT EXISTS scans_2027_12 PARTITION OF scans FOR VALUES FROM ('2027-12-01') TO ('2028-01-01');
-
--- 2028-2075 partitions (continuing through 2075)
-CREATE TABLE IF NOT EXISTS scans_2028_01 PARTITION OF scans FOR VALUES FROM ('2028-01-01') TO ('2028-02-01');
-CREATE TABLE IF NOT EXISTS scans_2028_02 PARTITION OF scans FOR VALUES FROM ('2028-02-01') TO ('2028-03-01');
-CREATE TABLE IF NOT EXISTS scans_2028_03 PARTITION OF scans FOR VALUES FROM ('2028-03-01') TO ('2028-04-01');
-CREATE TABLE IF NOT EXISTS scans_2028_04 PARTITION OF scans FOR VALUES FROM ('2028-04-01') TO ('2028-05-01');
-CREATE TABLE IF NOT EXISTS scans_2028_05 PARTITION OF scans FOR VALUES FROM ('2028-05-01') TO ('2028-06-01');
-CREATE TABLE IF NOT EXISTS scans_2028_06 PARTITION OF scans FOR VALUES FROM ('2028-06-01') TO ('2028-07-01');
-CREATE TABLE IF NOT EXISTS scans_2028_07 PARTITION OF scans FOR VALUES FROM ('2028-07-01') TO ('2028-08-01');
-CREATE TABLE IF NOT EXISTS scans_2028_08 PARTITION OF scans FOR VALUES FROM ('2028-08-01') TO ('2028-09-01');
-CREATE TABLE IF NOT EXISTS scans_2028_09 PARTITION OF scans FOR VALUES FROM ('2028-09-01') TO ('2028-10-01');
-CREATE TABLE IF NOT EXISTS scans_2028_10 PARTITION OF scans FOR VALUES FROM ('2028-10-01') TO ('2028-11-01');
-CREATE TABLE IF NOT EXISTS scans_2028_11 PARTITION OF scans FOR VALUES FROM ('2028-11-01') TO ('2028-12-01');
-CREATE TABLE IF NOT EXISTS scans_2028_12 PARTITION OF scans FOR VALUES FROM ('2028-12-01') TO ('2029-01-01');
-
-CREATE TABLE IF NOT EXISTS scans_2029_01 PARTITION OF scans FOR VALUES FROM ('2029-01-01') TO ('2029-02-01');
-CREATE TABLE IF NOT EXISTS scans_2029_02 PARTITION OF scans FOR VALUES FROM ('2029-02-01') TO ('2029-03-01');
-CREATE TABLE IF NOT EXISTS scans_2029_03 PARTITION OF scans FOR VALUES FROM ('2029-03-01') TO ('2029-04-01');
-CREATE TABLE IF NOT EXISTS scans_2029_04 PARTITION OF scans FOR VALUES FROM ('2029-04-01') TO ('2029-05-01');
-CREATE TABLE IF NOT EXISTS scans_2029_05 PARTITION OF scans FOR VALUES FROM ('2029-05-01') TO ('2029-06-01');
-CREATE TABLE IF NOT EXISTS scans_2029_06 PARTITION OF scans FOR VALUES FROM ('2029-06-01') TO ('2029-07-01');
-CREATE TABLE IF NOT EXISTS scans_2029_07 PARTITION OF scans FOR VALUES FROM ('2029-07-01') TO ('2029-08-01');
-CREATE TABLE IF NOT EXISTS scans_2029_08 PARTITION OF scans FOR VALUES FROM ('2029-08-01') TO ('2029-09-01');
-CREATE TABLE IF NOT EXISTS scans_2029_09 PARTITION OF scans FOR VALUES FROM ('2029-09-01') TO ('2029-10-01');
-CREATE TABLE IF NOT EXISTS scans_2029_10 PARTITION OF scans FOR VALUES FROM ('2029-10-01') TO ('2029-11-01');
-CREATE TABLE IF NOT EXISTS scans_2029_11 PARTITION OF scans FOR VALUES FROM ('2029-11-01') TO ('2029-12-01');
-CREATE TABLE IF NOT EXISTS scans_2029_12 PARTITION OF scans FOR VALUES FROM ('2029-12-01') TO ('2030-01-01');
-
-CREATE TABLE IF NOT EXISTS scans_2030_01 PARTITION OF scans FOR VALUES FROM ('2030-01-01') TO ('2030-02-01');
-CREATE TABLE IF NOT EXISTS scans_2030_02 PARTITION OF scans FOR VALUES FROM ('2030-02-01') TO ('2030-03-01');
-CREATE TABLE IF NOT EXISTS scans_2030_03 PARTITION OF scans FOR VALUES FROM ('2030-03-01') TO ('2030-04-01');
-CREATE TABLE IF NOT EXISTS scans_2030_04 PARTITION OF scans FOR VALUES FROM ('2030-04-01') TO ('2030-05-01');
-CREATE TABLE IF NOT EXISTS scans_2030_05 PARTITION OF scans FOR VALUES FROM ('2030-05-01') TO ('2030-06-01');
-CREATE TABLE IF NOT EXISTS scans_2030_06 PARTITION OF scans FOR VALUES FROM ('2030-06-01') TO ('2030-07-01');
-CREATE TABLE IF NOT EXISTS scans_2030_07 PARTITION OF scans FOR VALUES FROM ('2030-07-01') TO ('2030-08-01');
-CREATE TABLE IF NOT EXISTS scans_2030_08 PARTITION OF scans FOR VALUES FROM ('2030-08-01') TO ('2030-09-01');
-CREATE TABLE IF NOT EXISTS scans_2030_09 PARTITION OF scans FOR VALUES FROM ('2030-09-01') TO ('2030-10-01');
-CREATE TABLE IF NOT EXISTS scans_2030_10 PARTITION OF scans FOR VALUES FROM ('2030-10-01') TO ('2030-11-01');
-CREATE TABLE IF NOT EXISTS scans_2030_11 PARTITION OF scans FOR VALUES FROM ('2030-11-01') TO ('2030-12-01');
-CREATE TABLE IF NOT EXISTS scans_2030_12 PARTITION OF scans FOR VALUES FROM ('2030-12-01') TO ('2031-01-01');
-
-CREATE TABLE IF NOT EXISTS scans_2031_01 PARTITION OF scans FOR VALUES FROM ('2031-01-01') TO ('2031-02-01');
-CREATE TABLE IF NOT EXISTS scans_2031_02 PARTITION OF scans FOR VALUES FROM ('2031-02-01') TO ('2031-03-01');
-CREATE TABLE IF NOT EXISTS scans_2031_03 PARTITION OF scans FOR VALUES FROM ('2031-03-01') TO ('2031-04-01');
-CREATE TABLE IF NOT EXISTS scans_2031_04 PARTITION OF scans FOR VALUES FROM ('2031-04-01') TO ('2031-05-01');
-CREATE TABLE IF NOT EXISTS scans_2031_05 PARTITION OF scans FOR VALUES FROM ('2031-05-01') TO ('2031-06-01');
-CREATE TABLE IF NOT EXISTS scans_2031_06 PARTITION OF scans FOR VALUES FROM ('2031-06-01') TO ('2031-07-01');
-CREATE TABLE IF NOT EXISTS scans_2031_07 PARTITION OF scans FOR VALUES FROM ('2031-07-01') TO ('2031-08-01');
-CREATE TABLE IF NOT EXISTS scans_2031_08 PARTITION OF scans FOR VALUES FROM ('2031-08-01') TO ('2031-09-01');
-CREATE TABLE IF NOT EXISTS scans_2031_09 PARTITION OF scans FOR VALUES FROM ('2031-09-01') TO ('2031-10-01');
-CREATE TABLE IF NOT EXISTS scans_2031_10 PARTITION OF scans FOR VALUES FROM ('2031-10-01') TO ('2031-11-01');
-CREATE TABLE IF NOT EXISTS scans_2031_11 PARTITION OF scans FOR VALUES FROM ('2031-11-01') TO ('2031-12-01');
-CREATE TABLE IF NOT EXISTS scans_2031_12 PARTITION OF scans FOR VALUES FROM ('2031-12-01') TO ('2032-01-01');
-
-CREATE TABLE IF NOT EXISTS scans_2032_01 PARTITION OF scans FOR VALUES FROM ('2032-01-01') TO ('2032-02-01');
-CREATE TABLE IF NOT EXISTS scans_2032_02 PARTITION OF scans FOR VALUES FROM ('2032-02-01') TO ('2032-03-01');
-CREATE TABLE IF NOT EXISTS scans_2032_03 PARTITION OF scans FOR VALUES FROM ('2032-03-01') TO ('2032-04-01');
-CREATE TABLE IF NOT EXISTS scans_2032_04 PARTITION OF scans FOR VALUES FROM ('2032-04-01') TO ('2032-05-01');
-CREATE TABLE IF NOT EXISTS scans_2032_05 PARTITION OF scans FOR VALUES FROM ('2032-05-01') TO ('2032-06-01');
-CREATE TABLE IF NOT EXISTS scans_2032_06 PARTITION OF scans FOR VALUES FROM ('2032-06-01') TO ('2032-07-01');
-CREATE TABLE IF NOT EXISTS scans_2032_07 PARTITION OF scans FOR VALUES FROM ('2032-07-01') TO ('2032-08-01');
-CREATE TABLE IF NOT EXISTS scans_2032_08 PARTITION OF scans FOR VALUES FROM ('2032-08-01') TO ('2032-09-01');
-CREATE TABLE IF NOT EXISTS scans_2032_09 PARTITION OF scans FOR VALUES FROM ('2032-09-01') TO ('2032-10-01');
-CREATE TABLE IF NOT EXISTS scans_2032_10 PARTITION OF scans FOR VALUES FROM ('2032-10-01') TO ('2032-11-01');
-CREATE TABLE IF NOT EXISTS scans_2032_11 PARTITION OF scans FOR VALUES FROM ('2032-11-01') TO ('2032-12-01');
-CREATE TABLE IF NOT EXISTS scans_2032_12 PARTITION OF scans FOR VALUES FROM ('2032-12-01') TO ('2033-01-01');
-
-CREATE TABLE IF NOT EXISTS scans_2033_01 PARTITION OF scans FOR VALUES FROM ('2033-01-01') TO ('2033-02-01');
-CREATE TABLE IF NOT EXISTS scans_2033_02 PARTITION OF scans FOR VALUES FROM ('2033-02-01') TO ('2033-03-01');
-CREATE TABLE IF NOT EXISTS scans_2033_03 PARTITION OF scans FOR VALUES FROM ('2033-03-01') TO ('2033-04-01');
-CREATE TABLE IF NOT EXISTS scans_2033_04 PARTITION OF scans FOR VALUES FROM ('2033-04-01') TO ('2033-05-01');
-CREATE TABLE IF NOT EXISTS scans_2033_05 PARTITION OF scans FOR VALUES FROM ('2033-05-01') TO ('2033-06-01');
-CREATE TABLE IF NOT EXISTS scans_2033_06 PARTITION OF scans FOR VALUES FROM ('2033-06-01') TO ('2033-07-01');
-CREATE TABLE IF NOT EXISTS scans_2033_07 PARTITION OF scans FOR VALUES FROM ('2033-07-01') TO ('2033-08-01');
-CREATE TABLE IF NOT EXISTS scans_2033_08 PARTITION OF scans FOR VALUES FROM ('2033-08-01') TO ('2033-09-01');
-CREATE TABLE IF NOT EXISTS scans_2033_09 PARTITION OF scans FOR VALUES FROM ('2033-09-01') TO ('2033-10-01');
-CREATE TABLE IF NOT EXISTS scans_2033_10 PARTITION OF scans FOR VALUES FROM ('2033-10-01') TO ('2033-11-01');
-CREATE TABLE IF NOT EXISTS scans_2033_11 PARTITION OF scans FOR VALUES FROM ('2033-11-01') TO ('2033-12-01');
-CREATE TABLE IF NOT EXISTS scans_2033_12 PARTITION OF scans FOR VALUES FROM ('2033-12-01') TO ('2034-01-01');
-
-CREATE TABLE IF NOT EXISTS scans_2034_01 PARTITION OF scans FOR VALUES FROM ('2034-01-01') TO ('2034-02-01');
-CREATE TABLE IF NOT EXISTS scans_2034_02 PARTITION OF scans FOR VALUES FROM ('2034-02-01') TO ('2034-03-01');
-CREATE TABLE IF NOT EXISTS scans_2034_03 PARTITION OF scans FOR VALUES FROM ('2034-03-01') TO ('2034-04-01');
-CREATE TABLE IF NOT EXISTS scans_2034_04 PARTITION OF scans FOR VALUES FROM ('2034-04-01') TO ('2034-05-01');
-CREATE TABLE IF NOT EXISTS scans_2034_05 PARTITION OF scans FOR VALUES FROM ('2034-05-01') TO ('2034-06-01');
-CREATE TABLE IF NOT EXISTS scans_2034_06 PARTITION OF scans FOR VALUES FROM ('2034-06-01') TO ('2034-07-01');
-CREATE TABLE IF NOT EXISTS scans_2034_07 PARTITION OF scans FOR VALUES FROM ('2034-07-01') TO ('2034-08-01');
-CREATE TABLE IF NOT EXISTS scans_2034_08 PARTITION OF scans FOR VALUES FROM ('2034-08-01') TO ('2034-09-01');
-CREATE TABLE IF NOT EXISTS scans_2034_09 PARTITION OF scans FOR VALUES FROM ('2034-09-01') TO ('2034-10-01');
-CREATE TABLE IF NOT EXISTS scans_2034_10 PARTITION OF scans FOR VALUES FROM ('2034-10-01') TO ('2034-11-01');
-CREATE TABLE IF NOT EXISTS scans_2034_11 PARTITION OF scans FOR VALUES FROM ('2034-11-01') TO ('2034-12-01');
-CREATE TABLE IF NOT EXISTS scans_2034_12 PARTITION OF scans FOR VALUES FROM ('2034-12-01') TO ('2035-01-01');
-
-CREATE TABLE IF NOT EXISTS scans_2035_01 PARTITION OF scans FOR VALUES FROM ('2035-01-01') TO ('2035-02-01');
-CREATE TABLE IF NOT EXISTS scans_2035_02 PARTITION OF scans FOR VALUES FROM ('2035-02-01') TO ('2035-03-01');
-CREATE TABLE IF NOT EXISTS scans_2035_03 PARTITION OF scans FOR VALUES FROM ('2035-03-01') TO ('2035-04-01');
-CREATE TABLE IF NOT EXISTS scans_2035_04 PARTITION OF scans FOR VALUES FROM ('2035-04-01') TO ('2035-05-01');
-CREATE TABLE IF NOT EXISTS scans_2035_05 PARTITION OF scans FOR VALUES FROM ('2035-05-01') TO ('2035-06-01');
-CREATE TABLE IF NOT EXISTS scans_2035_06 PARTITION OF scans FOR VALUES FROM ('2035-06-01') TO ('2035-07-01');
-CREATE TABLE IF NOT EXISTS scans_2035_07 PARTITION OF scans FOR VALUES FROM ('2035-07-01') TO ('2035-08-01');
-CREATE TABLE IF NOT EXISTS scans_2035_08 PARTITION OF scans FOR VALUES FROM ('2035-08-01') TO ('2035-09-01');
-CREATE TABLE IF NOT EXISTS scans_2035_09 PARTITION OF scans FOR VALUES FROM ('2035-09-01') TO ('2035-10-01');
-CREATE TABLE IF NOT EXISTS scans_2035_10 PARTITION OF scans FOR VALUES FROM ('2035-10-01') TO ('2035-11-01');
-CREATE TABLE IF NOT EXISTS scans_2035_11 PARTITION OF scans FOR VALUES FROM ('2035-11-01') TO ('2035-12-01');
-CREATE TABLE IF NOT EXISTS scans_2035_12 PARTITION OF scans FOR VALUES FROM ('2035-12-01') TO ('2036-01-01');
-
-CREATE TABLE IF NOT EXISTS scans_2036_01 PARTITION OF scans FOR VALUES FROM ('2036-01-01') TO ('2036-02-01');
-CREATE TABLE IF NOT EXISTS scans_2036_02 PARTITION OF scans FOR VALUES FROM ('2036-02-01') TO ('2036-03-01');
-CREATE TABLE IF NOT EXISTS scans_2036_03 PARTITION OF scans FOR VALUES FROM ('2036-03-01') TO ('2036-04-01');
-CREATE TABLE IF NOT EXISTS scans_2036_04 PARTITION OF scans FOR VALUES FROM ('2036-04-01') TO ('2036-05-01');
-CREATE TABLE IF NOT EXISTS scans_2036_05 PARTITION OF scans FOR VALUES FROM ('2036-05-01') TO ('2036-06-01');
-CREATE TABLE IF NOT EXISTS scans_2036_06 PARTITION OF scans FOR VALUES FROM ('2036-06-01') TO ('2036-07-01');
-CREATE TABLE IF NOT EXISTS scans_2036_07 PARTITION OF scans FOR VALUES FROM ('2036-07-01') TO ('2036-08-01');
-CREATE TABLE IF NOT EXISTS scans_2036_08 PARTITION OF scans FOR VALUES FROM ('2036-08-01') TO ('2036-09-01');
-CREATE TABLE IF NOT EXISTS scans_2036_09 PARTITION OF scans FOR VALUES FROM ('2036-09-01') TO ('2036-10-01');
-CREATE TABLE IF NOT EXISTS scans_2036_10 PARTITION OF scans FOR VALUES FROM ('2036-10-01') TO ('2036-11-01');
-CREATE TABLE IF NOT EXISTS scans_2036_11 PARTITION OF scans FOR VALUES FROM ('2036-11-01') TO ('2036-12-01');
-CREATE TABLE IF NOT EXISTS scans_2036_12 PARTITION OF scans FOR VALUES FROM ('2036-12-01') TO ('2037-01-01');
-
-CREATE TABLE IF NOT EXISTS scans_2037_01 PARTITION OF scans FOR VALUES FROM ('2037-01-01') TO ('2037-02-01');
-CREATE TABLE IF NOT EXISTS scans_2037_02 PARTITION OF scans FOR VALUES FROM ('2037-02-01') TO ('2037-03-01');
-CREATE TABLE IF NOT EXISTS scans_2037_03 PARTITION OF scans FOR VALUES FROM ('2037-03-01') TO ('2037-04-01');
-CREATE TABLE IF NOT EXISTS scans_2037_04 PARTITION OF scans FOR VALUES FROM ('2037-04-01') TO ('2037-05-01');
-CREATE TABLE IF NOT EXISTS scans_2037_05 PARTITION OF scans FOR VALUES FROM ('2037-05-01') TO ('2037-06-01');
-CREATE TABLE IF NOT EXISTS scans_2037_06 PARTITION OF scans FOR VALUES FROM ('2037-06-01') TO ('2037-07-01');
-CREATE TABLE IF NOT EXISTS scans_2037_07 PARTITION OF scans FOR VALUES FROM ('2037-07-01') TO ('2037-08-01');
-CREATE TABLE IF NOT EXISTS scans_2037_08 PARTITION OF scans FOR VALUES FROM ('2037-08-01') TO ('2037-09-01');
-CREATE TABLE IF NOT EXISTS scans_2037_09 PARTITION OF scans FOR VALUES FROM ('2037-09-01') TO ('2037-10-01');
-CREATE TABLE IF NOT EXISTS scans_2037_10 PARTITION OF scans FOR VALUES FROM ('2037-10-01') TO ('2037-11-01');
-CREATE TABLE IF NOT EXISTS scans_2037_11 PARTITION OF scans FOR VALUES FROM ('2037-11-01') TO ('2037-12-01');
-CREATE TABLE IF NOT EXISTS scans_2037_12 PARTITION OF scans FOR VALUES FROM ('2037-12-01') TO ('2038-01-01');
-
-CREATE TABLE IF NOT EXISTS scans_2038_01 PARTITION OF scans FOR VALUES FROM ('2038-01-01') TO ('2038-02-01');
-CREATE TABLE IF NOT EXISTS scans_2038_02 PARTITION OF scans FOR VALUES FROM ('2038-02-01') TO ('2038-03-01');
-CREATE TABLE IF NOT EXISTS scans_2038_03 PARTITION OF scans FOR VALUES FROM ('2038-03-01') TO ('2038-04-01');
-CREATE TABLE IF NOT EXISTS scans_2038_04 PARTITION OF scans FOR VALUES FROM ('2038-04-01') TO ('2038-05-01');
-CREATE TABLE IF NOT EXISTS scans_2038_05 PARTITION OF scans FOR VALUES FROM ('2038-05-01') TO ('2038-06-01');
-CREATE TABLE IF NOT EXISTS scans_2038_06 PARTITION OF scans FOR VALUES FROM ('2038-06-01') TO ('2038-07-01');
-CREATE TABLE IF NOT EXISTS scans_2038_07 PARTITION OF scans FOR VALUES FROM ('2038-07-01') TO ('2038-08-01');
-CREATE TABLE IF NOT EXISTS scans_2038_08 PARTITION OF scans FOR VALUES FROM ('2038-08-01') TO ('2038-09-01');
-CREATE TABLE IF NOT EXISTS scans_2038_09 PARTITION OF scans FOR VALUES FROM ('2038-09-01') TO ('2038-10-01');
-CREATE TABLE IF NOT EXISTS scans_2038_10 PARTITION OF scans FOR VALUES FROM ('2038-10-01') TO ('2038-11-01');
-CREATE TABLE IF NOT EXISTS scans_2038_11 PARTITION OF scans FOR VALUES FROM ('2038-11-01') TO ('2038-12-01');
-CREATE TABLE IF NOT EXISTS scans_2038_12 PARTITION OF scans FOR VALUES FROM ('2038-12-01') TO ('2039-01-01');
-
-CREATE TABLE IF NOT EXISTS scans_2039_01 PARTITION OF scans FOR VALUES FROM ('2039-01-01') TO ('2039-02-01');
-CREATE TABLE IF NOT EXISTS scans_2039_02 PARTITION OF scans FOR VALUES FROM ('2039-02-01') TO ('2039-03-01');
-CREATE TABLE IF NOT EXISTS scans_2039_03 PARTITION OF scans FOR VALUES FROM ('2039-03-01') TO ('2039-04-01');
-CREATE TABLE IF NOT EXISTS scans_2039_04 PARTITION OF scans FOR VALUES FROM ('2039-04-01') TO ('2039-05-01');
-CREATE TABLE IF NOT EXISTS scans_2039_05 PARTITION OF scans FOR VALUES FROM ('2039-05-01') TO ('2039-06-01');
-CREATE TABLE IF NOT EXISTS scans_2039_06 PARTITION OF scans FOR VALUES FROM ('2039-06-01') TO ('2039-07-01');
-CREATE TABLE IF NOT EXISTS scans_2039_07 PARTITION OF scans FOR VALUES FROM ('2039-07-01') TO ('2039-08-01');
-CREATE TABLE IF NOT EXISTS scans_2039_08 PARTITION OF scans FOR VALUES FROM ('2039-08-01') TO ('2039-09-01');
-CREATE TABLE IF NOT EXISTS scans_2039_09 PARTITION OF scans FOR VALUES FROM ('2039-09-01') TO ('2039-10-01');
-CREATE TABLE IF NOT EXISTS scans_2039_10 PARTITION OF scans FOR VALUES FROM ('2039-10-01') TO ('2039-11-01');
-CREATE TABLE IF NOT EXISTS scans_2039_11 PARTITION OF scans FOR VALUES FROM ('2039-11-01') TO ('2039-12-01');
-CREATE TABLE IF NOT EXISTS scans_2039_12 PARTITION OF scans FOR VALUES FROM ('2039-12-01') TO ('2040-01-01');
-
-CREATE TABLE IF NOT EXISTS scans_2040_01 PARTITION OF scans FOR VALUES FROM ('2040-01-01') TO ('2040-02-01');
-CREATE TABLE IF NOT EXISTS scans_2040_02 PARTITION OF scans FOR VALUES FROM ('2040-02-01') TO ('2040-03-01');
-CREATE TABLE IF NOT EXISTS scans_2040_03 PARTITION OF scans FOR VALUES FROM ('2040-03-01') TO ('2040-04-01');
-CREATE TABLE IF NOT EXISTS scans_2040_04 PARTITION OF scans FOR VALUES FROM ('2040-04-01') TO ('2040-05-01');
-CREATE TABLE IF NOT EXISTS scans_2040_05 PARTITION OF scans FOR VALUES FROM ('2040-05-01') TO ('2040-06-01');
-CREATE TABLE IF NOT EXISTS scans_2040_06 PARTITION OF scans FOR VALUES FROM ('2040-06-01') TO ('2040-07-01');
-CREATE TABLE IF NOT EXISTS scans_2040_07 PARTITION OF scans FOR VALUES FROM ('2040-07-01') TO ('2040-08-01');
-CREATE TABLE IF NOT EXISTS scans_2040_08 PARTITION OF scans FOR VALUES FROM ('2040-08-01') TO ('2040-09-01');
-CREATE TABLE IF NOT EXISTS scans_2040_09 PARTITION OF scans FOR VALUES FROM ('2040-09-01') TO ('2040-10-01');
-CREATE TABLE IF NOT EXISTS scans_2040_10 PARTITION OF scans FOR VALUES FROM ('2040-10-01') TO ('2040-11-01');
-CREATE TABLE IF NOT EXISTS scans_2040_11 PARTITION OF scans FOR VALUES FROM ('2040-11-01') TO ('2040-12-01');
-CREATE TABLE IF NOT EXISTS scans_2040_12 PARTITION OF scans FOR VALUES FROM ('2040-12-01') TO ('2041-01-01');
-
-CREATE TABLE IF NOT EXISTS scans_2041_01 PARTITION OF scans FOR VALUES FROM ('2041-01-01') TO ('2041-02-01');
-CREATE TABLE IF NOT EXISTS scans_2041_02 PARTITION OF scans FOR VALUES FROM ('2041-02-01') TO ('2041-03-01');
-CREATE TABLE IF NOT EXISTS scans_2041_03 PARTITION OF scans FOR VALUES FROM ('2041-03-01') TO ('2041-04-01');
-CREATE TABLE IF NOT EXISTS scans_2041_04 PARTITION OF scans FOR VALUES FROM ('2041-04-01') TO ('2041-05-01');
-CREATE TABLE IF NOT EXISTS scans_2041_05 PARTITION OF scans FOR VALUES FROM ('2041-05-01') TO ('2041-06-01');
-CREATE TABLE IF NOT EXISTS scans_2041_06 PARTITION OF scans FOR VALUES FROM ('2041-06-01') TO ('2041-07-01');
-CREATE TABLE IF NOT EXISTS scans_2041_07 PARTITION OF scans FOR VALUES FROM ('2041-07-01') TO ('2041-08-01');
-CREATE TABLE IF NOT EXISTS scans_2041_08 PARTITION OF scans FOR VALUES FROM ('2041-08-01') TO ('2041-09-01');
-CREATE TABLE IF NOT EXISTS scans_2041_09 PARTITION OF scans FOR VALUES FROM ('2041-09-01') TO ('2041-10-01');
-CREATE TABLE IF NOT EXISTS scans_2041_10 PARTITION OF scans FOR VALUES FROM ('2041-10-01') TO ('2041-11-01');
-CREATE TABLE IF NOT EXISTS scans_2041_11 PARTITION OF scans FOR VALUES FROM ('2041-11-01') TO ('2041-12-01');
-CREATE TABLE IF NOT EXISTS scans_2041_12 PARTITION OF scans FOR VALUES FROM ('2041-12-01') TO ('2042-01-01');
-
-CREATE TABLE IF NOT EXISTS scans_2042_01 PARTITION OF scans FOR VALUES FROM ('2042-01-01') TO ('2042-02-01');
-CREATE TABLE IF NOT EXISTS scans_2042_02 PARTITION OF scans FOR VALUES FROM ('2042-02-01') TO ('2042-03-01');
-CREATE TABLE IF NOT EXISTS scans_2042_03 PARTITION OF scans FOR VALUES FROM ('2042-03-01') TO ('2042-04-01');
-CREATE TABLE IF NOT EXISTS scans_2042_04 PARTITION OF scans FOR VALUES FROM ('2042-04-01') TO ('2042-05-01');
-CREATE TABLE IF NOT EXISTS scans_2042_05 PARTITION OF scans FOR VALUES FROM ('2042-05-01') TO ('2042-06-01');
-CREATE TABLE IF NOT EXISTS scans_2042_06 PARTITION OF scans FOR VALUES FROM ('2042-06-01') TO ('2042-07-01');
-CREATE TABLE IF NOT EXISTS scans_2042_07 PARTITION OF scans FOR VALUES FROM ('2042-07-01') TO ('2042-08-01');
-CREATE TABLE IF NOT EXISTS scans_2042_08 PARTITION OF scans FOR VALUES FROM ('2042-08-01') TO ('2042-09-01');
-CREATE TABLE IF NOT EXISTS scans_2042_09 PARTITION OF scans FOR VALUES FROM ('2042-09-01') TO ('2042-10-01');
-CREATE TABLE IF NOT EXISTS scans_2042_10 PARTITION OF scans FOR VALUES FROM ('2042-10-01') TO ('2042-11-01');
-CREATE TABLE IF NOT EXISTS scans_2042_11 PARTITION OF scans FOR VALUES FROM ('2042-11-01') TO ('2042-12-01');
-CREATE TABLE IF NOT EXISTS scans_2042_12 PARTITION OF scans FOR VALUES FROM ('2042-12-01') TO ('2043-01-01');
-
-CREATE TABLE IF NOT EXISTS scans_2043_01 PARTITION OF scans FOR VALUES FROM ('2043-01-01') TO ('2043-02-01');
-CREATE TABLE IF NOT EXISTS scans_2043_02 PARTITION OF scans FOR VALUES FROM ('2043-02-01') TO ('2043-03-01');
-CREATE TABLE IF NOT EXISTS scans_2043_03 PARTITION OF scans FOR VALUES FROM ('2043-03-01') TO ('2043-04-01');
-CREATE TABLE IF NOT EXISTS scans_2043_04 PARTITION OF scans FOR VALUES FROM ('2043-04-01') TO ('2043-05-01');
-CREATE TABLE IF NOT EXISTS scans_2043_05 PARTITION OF scans FOR VALUES FROM ('2043-05-01') TO ('2043-06-01');
-CREATE TABLE IF NOT EXISTS scans_2043_06 PARTITION OF scans FOR VALUES FROM ('2043-06-01') TO ('2043-07-01');
-CREATE TABLE IF NOT EXISTS scans_2043_07 PARTITION OF scans FOR VALUES FROM ('2043-07-01') TO ('2043-08-01');
-CREATE TABLE IF NOT EXISTS scans_2043_08 PARTITION OF scans FOR VALUES FROM ('2043-08-01') TO ('2043-09-01');
-CREATE TABLE IF NOT EXISTS scans_2043_09 PARTITION OF scans FOR VALUES FROM ('2043-09-01') TO ('2043-10-01');
-CREATE TABLE IF NOT EXISTS scans_2043_10 PARTITION OF scans FOR VALUES FROM ('2043-10-01') TO ('2043-11-01');
-CREATE TABLE IF NOT EXISTS scans_2043_11 PARTITION OF scans FOR VALUES FROM ('2043-11-01') TO ('2043-12-01');
-CREATE TABLE IF NOT EXISTS scans_2043_12 PARTITION OF scans FOR VALUES FROM ('2043-12-01') TO ('2044-01-01');
-
-CREATE TABLE IF NOT EXISTS scans_2044_01 PARTITION OF scans FOR VALUES FROM ('2044-01-01') TO ('2044-02-01');
-CREATE TABLE IF NOT EXISTS scans_2044_02 PARTITION OF scans FOR VALUES FROM ('2044-02-01') TO ('2044-03-01');
-CREATE TABLE IF NOT EXISTS scans_2044_03 PARTITION OF scans FOR VALUES FROM ('2044-03-01') TO ('2044-04-01');
-CREATE TABLE IF NOT EXISTS scans_2044_04 PARTITION OF scans FOR VALUES FROM ('2044-04-01') TO ('2044-05-01');
-CREATE TABLE IF NOT EXISTS scans_2044_05 PARTITION OF scans FOR VALUES FROM ('2044-05-01') TO ('2044-06-01');
-CREATE TABLE IF NOT EXISTS scans_2044_06 PARTITION OF scans FOR VALUES FROM ('2044-06-01') TO ('2044-07-01');
-CREATE TABLE IF NOT EXISTS scans_2044_07 PARTITION OF scans FOR VALUES FROM ('2044-07-01') TO ('2044-08-01');
-CREATE TABLE IF NOT EXISTS scans_2044_08 PARTITION OF scans FOR VALUES FROM ('2044-08-01') TO ('2044-09-01');
-CREATE TABLE IF NOT EXISTS scans_2044_09 PARTITION OF scans FOR VALUES FROM ('2044-09-01') TO ('2044-10-01');
-CREATE TABLE IF NOT EXISTS scans_2044_10 PARTITION OF scans FOR VALUES FROM ('2044-10-01') TO ('2044-11-01');
-CREATE TABLE IF NOT EXISTS scans_2044_11 PARTITION OF scans FOR VALUES FROM ('2044-11-01') TO ('2044-12-01');
-CREATE TABLE IF NOT EXISTS scans_2044_12 PARTITION OF scans FOR VALUES FROM ('2044-12-01') TO ('2045-01-01');
-
-CREATE TABLE IF NOT EXISTS scans_2045_01 PARTITION OF scans FOR VALUES FROM ('2045-01-01') TO ('2045-02-01');
-CREATE TABLE IF NOT EXISTS scans_2045_02 PARTITION OF scans FOR VALUES FROM ('2045-02-01') TO ('2045-03-01');
-CREATE TABLE IF NOT EXISTS scans_2045_03 PARTITION OF scans FOR VALUES FROM ('2045-03-01') TO ('2045-04-01');
-CREATE TABLE IF NOT EXISTS scans_2045_04 PARTITION OF scans FOR VALUES FROM ('2045-04-01') TO ('2045-05-01');
-CREATE TABLE IF NOT EXISTS scans_2045_05 PARTITION OF scans FOR VALUES FROM ('2045-05-01') TO ('2045-06-01');
-CREATE TABLE IF NOT EXISTS scans_2045_06 PARTITION OF scans FOR VALUES FROM ('2045-06-01') TO ('2045-07-01');
-CREATE TABLE IF NOT EXISTS scans_2045_07 PARTITION OF scans FOR VALUES FROM ('2045-07-01') TO ('2045-08-01');
-CREATE TABLE IF NOT EXISTS scans_2045_08 PARTITION OF scans FOR VALUES FROM ('2045-08-01') TO ('2045-09-01');
-CREATE TABLE IF NOT EXISTS scans_2045_09 PARTITION OF scans FOR VALUES FROM ('2045-09-01') TO ('2045-10-01');
-CREATE TABLE IF NOT EXISTS scans_2045_10 PARTITION OF scans FOR VALUES FROM ('2045-10-01') TO ('2045-11-01');
-CREATE TABLE IF NOT EXISTS scans_2045_11 PARTITION OF scans FOR VALUES FROM ('2045-11-01') TO ('2045-12-01');
-CREATE TABLE IF NOT EXISTS scans_2045_12 PARTITION OF scans FOR VALUES FROM ('2045-12-01') TO ('2046-01-01');
-
-CREATE TABLE IF NOT EXISTS scans_2046_01 PARTITION OF scans FOR VALUES FROM ('2046-01-01') TO ('2046-02-01');
-CREATE TABLE IF NOT EXISTS scans_2046_02 PARTITION OF scans FOR VALUES FROM ('2046-02-01') TO ('2046-03-01');
-CREATE TABLE IF NOT EXISTS scans_2046_03 PARTITION OF scans FOR VALUES FROM ('2046-03-01') TO ('2046-04-01');
-CREATE TABLE IF NOT EXISTS scans_2046_04 PARTITION OF scans FOR VALUES FROM ('2046-04-01') TO ('2046-05-01');
-CREATE TABLE IF NOT EXISTS scans_2046_05 PARTITION OF scans FOR VALUES FROM ('2046-05-01') TO ('2046-06-01');
-CREATE TABLE IF NOT EXISTS scans_2046_06 PARTITION OF scans FOR VALUES FROM ('2046-06-01') TO ('2046-07-01');
-CREATE TABLE IF NOT EXISTS scans_2046_07 PARTITION OF scans FOR VALUES FROM ('2046-07-01') TO ('2046-08-01');
-CREATE TABLE IF NOT EXISTS scans_2046_08 PARTITION OF scans FOR VALUES FROM ('2046-08-01') TO ('2046-09-01');
-CREATE TABLE IF NOT EXISTS scans_2046_09 PARTITION OF scans FOR VALUES FROM ('2046-09-01') TO ('2046-10-01');
-CREATE TABLE IF NOT EXISTS scans_2046_10 PARTITION OF scans FOR VALUES FROM ('2046-10-01') TO ('2046-11-01');
-CREATE TABLE IF NOT EXISTS scans_2046_11 PARTITION OF scans FOR VALUES FROM ('2046-11-01') TO ('2046-12-01');
-CREATE TABLE IF NOT EXISTS scans_2046_12 PARTITION OF scans FOR VALUES FROM ('2046-12-01') TO ('2047-01-01');
-
-CREATE TABLE IF NOT EXISTS scans_2047_01 PARTITION OF scans FOR VALUES FROM ('2047-01-01') TO ('2047-02-01');
-CREATE TABLE IF NOT EXISTS scans_2047_02 PARTITION OF scans FOR VALUES FROM ('2047-02-01') TO ('2047-03-01');
-CREATE TABLE IF NOT EXISTS scans_2047_03 PARTITION OF scans FOR VALUES FROM ('2047-03-01') TO ('2047-04-01');
-CREATE TABLE IF NOT EXISTS scans_2047_04 PARTITION OF scans FOR VALUES FROM ('2047-04-01') TO ('2047-05-01');
-CREATE TABLE IF NOT EXISTS scans_2047_05 PARTITION OF scans FOR VALUES FROM ('2047-05-01') TO ('2047-06-01');
-CREATE TABLE IF NOT EXISTS scans_2047_06 PARTITION OF scans FOR VALUES FROM ('2047-06-01') TO ('2047-07-01');
-CREATE TABLE IF NOT EXISTS scans_2047_07 PARTITION OF scans FOR VALUES FROM ('2047-07-01') TO ('2047-08-01');
-CREATE TABLE IF NOT EXISTS scans_2047_08 PARTITION OF scans FOR VALUES FROM ('2047-08-01') TO ('2047-09-01');
-CREATE TABLE IF NOT EXISTS scans_2047_09 PARTITION OF scans FOR VALUES FROM ('2047-09-01') TO ('2047-10-01');
-CREATE TABLE IF NOT EXISTS scans_2047_10 PARTITION OF scans FOR VALUES FROM ('2047-10-01') TO ('2047-11-01');
-CREATE TABLE IF NOT EXISTS scans_2047_11 PARTITION OF scans FOR VALUES FROM ('2047-11-01') TO ('2047-12-01');
-CREATE TABLE IF NOT EXISTS scans_2047_12 PARTITION OF scans FOR VALUES FROM ('2047-12-01') TO ('2048-01-01');
-
-CREATE TABLE IF NOT EXISTS scans_2048_01 PARTITION OF scans FOR VALUES FROM ('2048-01-01') TO ('2048-02-01');
-CREATE TABLE IF NOT EXISTS scans_2048_02 PARTITION OF scans FOR VALUES FROM ('2048-02-01') TO ('2048-03-01');
-CREATE TABLE IF NOT EXISTS scans_2048_03 PARTITION OF scans FOR VALUES FROM ('2048-03-01') TO ('2048-04-01');
-CREATE TABLE IF NOT EXISTS scans_2048_04 PARTITION OF scans FOR VALUES FROM ('2048-04-01') TO ('2048-05-01');
-CREATE TABLE IF NOT EXISTS scans_2048_05 PARTITION OF scans FOR VALUES FROM ('2048-05-01') TO ('2048-06-01');
-CREATE TABLE IF NOT EXISTS scans_2048_06 PARTITION OF scans FOR VALUES FROM ('2048-06-01') TO ('2048-07-01');
-CREATE TABLE IF NOT EXISTS scans_2048_07 PARTITION OF scans FOR VALUES FROM ('2048-07-01') TO ('2048-08-01');
-CREATE TABLE IF NOT EXISTS scans_2048_08 PARTITION OF scans FOR VALUES FROM ('2048-08-01') TO ('2048-09-01');
-CREATE TABLE IF NOT EXISTS scans_2048_09 PARTITION OF scans FOR VALUES FROM ('2048-09-01') TO ('2048-10-01');
-CREATE TABLE IF NOT EXISTS scans_2048_10 PARTITION OF scans FOR VALUES FROM ('2048-10-01') TO ('2048-11-01');
-CREATE TABLE IF NOT EXISTS scans_2048_11 PARTITION OF scans FOR VALUES FROM ('2048-11-01') TO ('2048-12-01');
-CREATE TABLE IF NOT EXISTS scans_2048_12 PARTITION OF scans FOR VALUES FROM ('2048-12-01') TO ('2049-01-01');
-
-CREATE TABLE IF NOT EXISTS scans_2049_01 PARTITION OF scans FOR VALUES FROM ('2049-01-01') TO ('2049-02-01');
-CREATE TABLE IF NOT EXISTS scans_2049_02 PARTITION OF scans FOR VALUES FROM ('2049-02-01') TO ('2049-03-01');
-CREATE TABLE IF NOT EXISTS scans_2049_03 PARTITION OF scans FOR VALUES FROM ('2049-03-01') TO ('2049-04-01');
-CREATE TABLE IF NOT EXISTS scans_2049_04 PARTITION OF scans FOR VALUES FROM ('2049-04-01') TO ('2049-05-01');
-CREATE TABLE IF NOT EXISTS scans_2049_05 PARTITION OF scans FOR VALUES FROM ('2049-05-01') TO ('2049-06-01');
-CREATE TABLE IF NOT EXISTS scans_2049_06 PARTITION OF scans FOR VALUES FROM ('2049-06-01') TO ('2049-07-01');
-CREATE TABLE IF NOT EXISTS scans_2049_07 PARTITION OF scans FOR VALUES FROM ('2049-07-01') TO ('2049-08-01');
-CREATE TABLE IF NOT EXISTS scans_2049_08 PARTITION OF scans FOR VALUES FROM ('2049-08-01') TO ('2049-09-01');
-CREATE TABLE IF NOT EXISTS scans_2049_09 PARTITION OF scans FOR VALUES FROM ('2049-09-01') TO ('2049-10-01');
-CREATE TABLE IF NOT EXISTS scans_2049_10 PARTITION OF scans FOR VALUES FROM ('2049-10-01') TO ('2049-11-01');
-CREATE TABLE IF NOT EXISTS scans_2049_11 PARTITION OF scans FOR VALUES FROM ('2049-11-01') TO ('2049-12-01');
-CREATE TABLE IF NOT EXISTS scans_2049_12 PARTITION OF scans FOR VALUES FROM ('2049-12-01') TO ('2050-01-01');
-
-CREATE TABLE IF NOT EXISTS scans_2050_01 PARTITION OF scans FOR VALUES FROM ('2050-01-01') TO ('2050-02-01');
-CREATE TABLE IF NOT EXISTS scans_2050_02 PARTITION OF scans FOR VALUES FROM ('2050-02-01') TO ('2050-03-01');
-CREATE TABLE IF NOT EXISTS scans_2050_03 PARTITION OF scans FOR VALUES FROM ('2050-03-01') TO ('2050-04-01');
-CREATE TABLE IF NOT EXISTS scans_2050_04 PARTITION OF scans FOR VALUES FROM ('2050-04-01') TO ('2050-05-01');
-CREATE TABLE IF NOT EXISTS scans_2050_05 PARTITION OF scans FOR VALUES FROM ('2050-05-01') TO ('2050-06-01');
-CREATE TABLE IF NOT EXISTS scans_2050_06 PARTITION OF scans FOR VALUES FROM ('2050-06-01') TO ('2050-07-01');
-CREATE TABLE IF NOT EXISTS scans_2050_07 PARTITION OF scans FOR VALUES FROM ('2050-07-01') TO ('2050-08-01');
-CREATE TABLE IF NOT EXISTS scans_2050_08 PARTITION OF scans FOR VALUES FROM ('2050-08-01') TO ('2050-09-01');
-CREATE TABLE IF NOT EXISTS scans_2050_09 PARTITION OF scans FOR VALUES FROM ('2050-09-01') TO ('2050-10-01');
-CREATE TABLE IF NOT EXISTS scans_2050_10 PARTITION OF scans FOR VALUES FROM ('2050-10-01') TO ('2050-11-01');
-CREATE TABLE IF NOT EXISTS scans_2050_11 PARTITION OF scans FOR VALUES FROM ('2050-11-01') TO ('2050-12-01');
-CREATE TABLE IF NOT EXISTS scans_2050_12 PARTITION OF scans FOR VALUES FROM ('2050-12-01') TO ('2051-01-01');
-
-CREATE TABLE IF NOT EXISTS scans_2051_01 PARTITION OF scans FOR VALUES FROM ('2051-01-01') TO ('2051-02-01');
-CREATE TABLE IF NOT EXISTS scans_2051_02 PARTITION OF scans FOR VALUES FROM ('2051-02-01') TO ('2051-03-01');
-CREATE TABLE IF NOT EXISTS scans_2051_03 PARTITION OF scans FOR VALUES FROM ('2051-03-01') TO ('2051-04-01');
-CREATE TABLE IF NOT EXISTS scans_2051_04 PARTITION OF scans FOR VALUES FROM ('2051-04-01') TO ('2051-05-01');
-CREATE TABLE IF NOT EXISTS scans_2051_05 PARTITION OF scans FOR VALUES FROM ('2051-05-01') TO ('2051-06-01');
-CREATE TABLE IF NOT EXISTS scans_2051_06 PARTITION OF scans FOR VALUES FROM ('2051-06-01') TO ('2051-07-01');
-CREATE TABLE IF NOT EXISTS scans_2051_07 PARTITION OF scans FOR VALUES FROM ('2051-07-01') TO ('2051-08-01');
-CREATE TABLE IF NOT EXISTS scans_2051_08 PARTITION OF scans FOR VALUES FROM ('2051-08-01') TO ('2051-09-01');
-CREATE TABLE IF NOT EXISTS scans_2051_09 PARTITION OF scans FOR VALUES FROM ('2051-09-01') TO ('2051-10-01');
-CREATE TABLE IF NOT EXISTS scans_2051_10 PARTITION OF scans FOR VALUES FROM ('2051-10-01') TO ('2051-11-01');
-CREATE TABLE IF NOT EXISTS scans_2051_11 PARTITION OF scans FOR VALUES FROM ('2051-11-01') TO ('2051-12-01');
-CREATE TABLE IF NOT EXISTS scans_2051_12 PARTITION OF scans FOR VALUES FROM ('2051-12-01') TO ('2052-01-01');
-
-CREATE TABLE IF NOT EXISTS scans_2052_01 PARTITION OF scans FOR VALUES FROM ('2052-01-01') TO ('2052-02-01');
-CREATE TABLE IF NOT EXISTS scans_2052_02 PARTITION OF scans FOR VALUES FROM ('2052-02-01') TO ('2052-03-01');
-CREATE TABLE IF NOT EXISTS scans_2052_03 PARTITION OF scans FOR VALUES FROM ('2052-03-01') TO ('2052-04-01');
-CREATE TABLE IF NOT EXISTS scans_2052_04 PARTITION OF scans FOR VALUES FROM ('2052-04-01') TO ('2052-05-01');
-CREATE TABLE IF NOT EXISTS scans_2052_05 PARTITION OF scans FOR VALUES FROM ('2052-05-01') TO ('2052-06-01');
-CREATE TABLE IF NOT EXISTS scans_2052_06 PARTITION OF scans FOR VALUES FROM ('2052-06-01') TO ('2052-07-01');
-CREATE TABLE IF NOT EXISTS scans_2052_07 PARTITION OF scans FOR VALUES FROM ('2052-07-01') TO ('2052-08-01');
-CREATE TABLE IF NOT EXISTS scans_2052_08 PARTITION OF scans FOR VALUES FROM ('2052-08-01') TO ('2052-09-01');
-CREATE TABLE IF NOT EXISTS scans_2052_09 PARTITION OF scans FOR VALUES FROM ('2052-09-01') TO ('2052-10-01');
-CREATE TABLE IF NOT EXISTS scans_2052_10 PARTITION OF scans FOR VALUES FROM ('2052-10-01') TO ('2052-11-01');
-CREATE TABLE IF NOT EXISTS scans_2052_11 PARTITION OF scans FOR VALUES FROM ('2052-11-01') TO ('2052-12-01');
-CREATE TABLE IF NOT EXISTS scans_2052_12 PARTITION OF scans FOR VALUES FROM ('2052-12-01') TO ('2053-01-01');
-
-CREATE TABLE IF NOT EXISTS scans_2053_01 PARTITION OF scans FOR VALUES FROM ('2053-01-01') TO ('2053-02-01');
-CREATE TABLE IF NOT EXISTS scans_2053_02 PARTITION OF scans FOR VALUES FROM ('2053-02-01') TO ('2053-03-01');
-CREATE TABLE IF NOT EXISTS scans_2053_03 PARTITION OF scans FOR VALUES FROM ('2053-03-01') TO ('2053-04-01');
-CREATE TABLE IF NOT EXISTS scans_2053_04 PARTITION OF scans FOR VALUES FROM ('2053-04-01') TO ('2053-05-01');
-CREATE TABLE IF NOT EXISTS scans_2053_05 PARTITION OF scans FOR VALUES FROM ('2053-05-01') TO ('2053-06-01');
-CREATE TABLE IF NOT EXISTS scans_2053_06 PARTITION OF scans FOR VALUES FROM ('2053-06-01') TO ('2053-07-01');
-CREATE TABLE IF NOT EXISTS scans_2053_07 PARTITION OF scans FOR VALUES FROM ('2053-07-01') TO ('2053-08-01');
-CREATE TABLE IF NOT EXISTS scans_2053_08 PARTITION OF scans FOR VALUES FROM ('2053-08-01') TO ('2053-09-01');
-CREATE TABLE IF NOT EXISTS scans_2053_09 PARTITION OF scans FOR VALUES FROM ('2053-09-01') TO ('2053-10-01');
-CREATE TABLE IF NOT EXISTS scans_2053_10 PARTITION OF scans FOR VALUES FROM ('2053-10-01') TO ('2053-11-01');
-CREATE TABLE IF NOT EXISTS scans_2053_11 PARTITION OF scans FOR VALUES FROM ('2053-11-01') TO ('2053-12-01');
-CREATE TABLE IF NOT EXISTS scans_2053_12 PARTITION OF scans FOR VALUES FROM ('2053-12-01') TO ('2054-01-01');
-
-CREATE TABLE IF NOT EXISTS scans_2054_01 PARTITION OF scans FOR VALUES FROM ('2054-01-01') TO ('2054-02-01');
-CREATE TABLE IF NOT EXISTS scans_2054_02 PARTITION OF scans FOR VALUES FROM ('2054-02-01') TO ('2054-03-01');
-CREATE TABLE IF NOT EXISTS scans_2054_03 PARTITION OF scans FOR VALUES FROM ('2054-03-01') TO ('2054-04-01');
-CREATE TABLE IF NOT EXISTS scans_2054_04 PARTITION OF scans FOR VALUES FROM ('2054-04-01') TO ('2054-05-01');
-CREATE TABLE IF NOT EXISTS scans_2054_05 PARTITION OF scans FOR VALUES FROM ('2054-05-01') TO ('2054-06-01');
-CREATE TABLE IF NOT EXISTS scans_2054_06 PARTITION OF scans FOR VALUES FROM ('2054-06-01') TO ('2054-07-01');
-CREATE TABLE IF NOT EXISTS scans_2054_07 PARTITION OF scans FOR VALUES FROM ('2054-07-01') TO ('2054-08-01');
-CREATE TABLE IF NOT EXISTS scans_2054_08 PARTITION OF scans FOR VALUES FROM ('2054-08-01') TO ('2054-09-01');
-CREATE TABLE IF NOT EXISTS scans_2054_09 PARTITION OF scans FOR VALUES FROM ('2054-09-01') TO ('2054-10-01');
-CREATE TABLE IF NOT EXISTS scans_2054_10 PARTITION OF scans FOR VALUES FROM ('2054-10-01') TO ('2054-11-01');
-CREATE TABLE IF NOT EXISTS scans_2054_11 PARTITION OF scans FOR VALUES FROM ('2054-11-01') TO ('2054-12-01');
-CREATE TABLE IF NOT EXISTS scans_2054_12 PARTITION OF scans FOR VALUES FROM ('2054-12-01') TO ('2055-01-01');
-
-CREATE TABLE IF NOT EXISTS scans_2055_01 PARTITION OF scans FOR VALUES FROM ('2055-01-01') TO ('2055-02-01');
-CREATE TABLE IF NOT EXISTS scans_2055_02 PARTITION OF scans FOR VALUES FROM ('2055-02-01') TO ('2055-03-01');
-CREATE TABLE IF NOT EXISTS scans_2055_03 PARTITION OF scans FOR VALUES FROM ('2055-03-01') TO ('2055-04-01');
-CREATE TABLE IF NOT EXISTS scans_2055_04 PARTITION OF scans FOR VALUES FROM ('2055-04-01') TO ('2055-05-01');
-CREATE TABLE IF NOT EXISTS scans_2055_05 PARTITION OF scans FOR VALUES FROM ('2055-05-01') TO ('2055-06-01');
-CREATE TABLE IF NOT EXISTS scans_2055_06 PARTITION OF scans FOR VALUES FROM ('2055-06-01') TO ('2055-07-01');
-CREATE TABLE IF NOT EXISTS scans_2055_07 PARTITION OF scans FOR VALUES FROM ('2055-07-01') TO ('2055-08-01');
-CREATE TABLE IF NOT EXISTS scans_2055_08 PARTITION OF scans FOR VALUES FROM ('2055-08-01') TO ('2055-09-01');
-CREATE TABLE IF NOT EXISTS scans_2055_09 PARTITION OF scans FOR VALUES FROM ('2055-09-01') TO ('2055-10-01');
-CREATE TABLE IF NOT EXISTS scans_2055_10 PARTITION OF scans FOR VALUES FROM ('2055-10-01') TO ('2055-11-01');
-CREATE TABLE IF NOT EXISTS scans_2055_11 PARTITION OF scans FOR VALUES FROM ('2055-11-01') TO ('2055-12-01');
-CREATE TABLE IF NOT EXISTS scans_2055_12 PARTITION OF scans FOR VALUES FROM ('2055-12-01') TO ('2056-01-01');
-
-CREATE TABLE IF NOT EXISTS scans_2056_01 PARTITION OF scans FOR VALUES FROM ('2056-01-01') TO ('2056-02-01');
-CREATE TABLE IF NOT EXISTS scans_2056_02 PARTITION OF scans FOR VALUES FROM ('2056-02-01') TO ('2056-03-01');
-CREATE TABLE IF NOT EXISTS scans_2056_03 PARTITION OF scans FOR VALUES FROM ('2056-03-01') TO ('2056-04-01');
-CREATE TABLE IF NOT EXISTS scans_2056_04 PARTITION OF scans FOR VALUES FROM ('2056-04-01') TO ('2056-05-01');
-CREATE TABLE IF NOT EXISTS scans_2056_05 PARTITION OF scans FOR VALUES FROM ('2056-05-01') TO ('2056-06-01');
-CREATE TABLE IF NOT EXISTS scans_2056_06 PARTITION OF scans FOR VALUES FROM ('2056-06-01') TO ('2056-07-01');
-CREATE TABLE IF NOT EXISTS scans_2056_07 PARTITION OF scans FOR VALUES FROM ('2056-07-01') TO ('2056-08-01');
-CREATE TABLE IF NOT EXISTS scans_2056_08 PARTITION OF scans FOR VALUES FROM ('2056-08-01') TO ('2056-09-01');
-CREATE TABLE IF NOT EXISTS scans_2056_09 PARTITION OF scans FOR VALUES FROM ('2056-09-01') TO ('2056-10-01');
-CREATE TABLE IF NOT EXISTS scans_2056_10 PARTITION OF scans FOR VALUES FROM ('2056-10-01') TO ('2056-11-01');
-CREATE TABLE IF NOT EXISTS scans_2056_11 PARTITION OF scans FOR VALUES FROM ('2056-11-01') TO ('2056-12-01');
-CREATE TABLE IF NOT EXISTS scans_2056_12 PARTITION OF scans FOR VALUES FROM ('2056-12-01') TO ('2057-01-01');
-
-CREATE TABLE IF NOT EXISTS scans_2057_01 PARTITION OF scans FOR VALUES FROM ('2057-01-01') TO ('2057-02-01');
-CREATE TABLE IF NOT EXISTS scans_2057_02 PARTITION OF scans FOR VALUES FROM ('2057-02-01') TO ('2057-03-01');
-CREATE TABLE IF NOT EXISTS scans_2057_03 PARTITION OF scans FOR VALUES FROM ('2057-03-01') TO ('2057-04-01');
-CREATE TABLE IF NOT EXISTS scans_2057_04 PARTITION OF scans FOR VALUES FROM ('2057-04-01') TO ('2057-05-01');
-CREATE TABLE IF NOT EXISTS scans_2057_05 PARTITION OF scans FOR VALUES FROM ('2057-05-01') TO ('2057-06-01');
-CREATE TABLE IF NOT EXISTS scans_2057_06 PARTITION OF scans FOR VALUES FROM ('2057-06-01') TO ('2057-07-01');
-CREATE TABLE IF NOT EXISTS scans_2057_07 PARTITION OF scans FOR VALUES FROM ('2057-07-01') TO ('2057-08-01');
-CREATE TABLE IF NOT EXISTS scans_2057_08 PARTITION OF scans FOR VALUES FROM ('2057-08-01') TO ('2057-09-01');
-CREATE TABLE IF NOT EXISTS scans_2057_09 PARTITION OF scans FOR VALUES FROM ('2057-09-01') TO ('2057-10-01');
-CREATE TABLE IF NOT EXISTS scans_2057_10 PARTITION OF scans FOR VALUES FROM ('2057-10-01') TO ('2057-11-01');
-CREATE TABLE IF NOT EXISTS scans_2057_11 PARTITION OF scans FOR VALUES FROM ('2057-11-01') TO ('2057-12-01');
-CREATE TABLE IF NOT EXISTS scans_2057_12 PARTITION OF scans FOR VALUES FROM ('2057-12-01') TO ('2058-01-01');
-
-CREATE TABLE IF NOT EXISTS scans_2058_01 PARTITION OF scans FOR VALUES FROM ('2058-01-01') TO ('2058-02-01');
-CREATE TABLE IF NOT EXISTS scans_2058_02 PARTITION OF scans FOR VALUES FROM ('2058-02-01') TO ('2058-03-01');
-CREATE TABLE IF NOT EXISTS scans_2058_03 PARTITION OF scans FOR VALUES FROM ('2058-03-01') TO ('2058-04-01');
-CREATE TABLE IF NOT EXISTS scans_2058_04 PARTITION OF scans FOR VALUES FROM ('2058-04-01') TO ('2058-05-01');
-CREATE TABLE IF NOT EXISTS scans_2058_05 PARTITION OF scans FOR VALUES FROM ('2058-05-01') TO ('2058-06-01');
-CREATE TABLE IF NOT EXISTS scans_2058_06 PARTITION OF scans FOR VALUES FROM ('2058-06-01') TO ('2058-07-01');
-CREATE TABLE IF NOT EXISTS scans_2058_07 PARTITION OF scans FOR VALUES FROM ('2058-07-01') TO ('2058-08-01');
-CREATE TABLE IF NOT EXISTS scans_2058_08 PARTITION OF scans FOR VALUES FROM ('2058-08-01') TO ('2058-09-01');
-CREATE TABLE IF NOT EXISTS scans_2058_09 PARTITION OF scans FOR VALUES FROM ('2058-09-01') TO ('2058-10-01');
-CREATE TABLE IF NOT EXISTS scans_2058_10 PARTITION OF scans FOR VALUES FROM ('2058-10-01') TO ('2058-11-01');
-CREATE TABLE IF NOT EXISTS scans_2058_11 PARTITION OF scans FOR VALUES FROM ('2058-11-01') TO ('2058-12-01');
-CREATE TABLE IF NOT EXISTS scans_2058_12 PARTITION OF scans FOR VALUES FROM ('2058-12-01') TO ('2059-01-01');
-
-CREATE TABLE IF NOT EXISTS scans_2059_01 PARTITION OF scans FOR VALUES FROM ('2059-01-01') TO ('2059-02-01');
-CREATE TABLE IF NOT EXISTS scans_2059_02 PARTITION OF scans FOR VALUES FROM ('2059-02-01') TO ('2059-03-01');
-CREATE TABLE IF NOT EXISTS scans_2059_03 PARTITION OF scans FOR VALUES FROM ('2059-03-01') TO ('2059-04-01');
-CREATE TABLE IF NOT EXISTS scans_2059_04 PARTITION OF scans FOR VALUES FROM ('2059-04-01') TO ('2059-05-01');
-CREATE TABLE IF NOT EXISTS scans_2059_05 PARTITION OF scans FOR VALUES FROM ('2059-05-01') TO ('2059-06-01');
-CREATE TABLE IF NOT EXISTS scans_2059_06 PARTITION OF scans FOR VALUES FROM ('2059-06-01') TO ('2059-07-01');
-CREATE TABLE IF NOT EXISTS scans_2059_07 PARTITION OF scans FOR VALUES FROM ('2059-07-01') TO ('2059-08-01');
-CREATE TABLE IF NOT EXISTS scans_2059_08 PARTITION OF scans FOR VALUES FROM ('2059-08-01') TO ('2059-09-01');
-CREATE TABLE IF NOT EXISTS scans_2059_09 PARTITION OF scans FOR VALUES FROM ('2059-09-01') TO ('2059-10-01');
-CREATE TABLE IF NOT EXISTS scans_2059_10 PARTITION OF scans FOR VALUES FROM ('2059-10-01') TO ('2059-11-01');
-CREATE TABLE IF NOT EXISTS scans_2059_11 PARTITION OF scans FOR VALUES FROM ('2059-11-01') TO ('2059-12-01');
-CREATE TABLE IF NOT EXISTS scans_2059_12 PARTITION OF scans FOR VALUES FROM ('2059-12-01') TO ('2060-01-01');
-
-CREATE TABLE IF NOT EXISTS scans_2060_01 PARTITION OF scans FOR VALUES FROM ('2060-01-01') TO ('2060-02-01');
-CREATE TABLE IF NOT EXISTS scans_2060_02 PARTITION OF scans FOR VALUES FROM ('2060-02-01') TO ('2060-03-01');
-CREATE TABLE IF NOT EXISTS scans_2060_03 PARTITION OF scans FOR VALUES FROM ('2060-03-01') TO ('2060-04-01');
-CREATE TABLE IF NOT EXISTS scans_2060_04 PARTITION OF scans FOR VALUES FROM ('2060-04-01') TO ('2060-05-01');
-CREATE TABLE IF NOT EXISTS scans_2060_05 PARTITION OF scans FOR VALUES FROM ('2060-05-01') TO ('2060-06-01');
-CREATE TABLE IF NOT EXISTS scans_2060_06 PARTITION OF scans FOR VALUES FROM ('2060-06-01') TO ('2060-07-01');
-CREATE TABLE IF NOT EXISTS scans_2060_07 PARTITION OF scans FOR VALUES FROM ('2060-07-01') TO ('2060-08-01');
-CREATE TABLE IF NOT EXISTS scans_2060_08 PARTITION OF scans FOR VALUES FROM ('2060-08-01') TO ('2060-09-01');
-CREATE TABLE IF NOT EXISTS scans_2060_09 PARTITION OF scans FOR VALUES FROM ('2060-09-01') TO ('2060-10-01');
-CREATE TABLE IF NOT EXISTS scans_2060_10 PARTITION OF scans FOR VALUES FROM ('2060-10-01') TO ('2060-11-01');
-CREATE TABLE IF NOT EXISTS scans_2060_11 PARTITION OF scans FOR VALUES FROM ('2060-11-01') TO ('2060-12-01');
-CREATE TABLE IF NOT EXISTS scans_2060_12 PARTITION OF scans FOR VALUES FROM ('2060-12-01') TO ('2061-01-01');
-
-CREATE TABLE IF NOT EXISTS scans_2061_01 PARTITION OF scans FOR VALUES FROM ('2061-01-01') TO ('2061-02-01');
-CREATE TABLE IF NOT EXISTS scans_2061_02 PARTITION OF scans FOR VALUES FROM ('2061-02-01') TO ('2061-03-01');
-CREATE TABLE IF NOT EXISTS scans_2061_03 PARTITION OF scans FOR VALUES FROM ('2061-03-01') TO ('2061-04-01');
-CREATE TABLE IF NOT EXISTS scans_2061_04 PARTITION OF scans FOR VALUES FROM ('2061-04-01') TO ('2061-05-01');
-CREATE TABLE IF NOT EXISTS scans_2061_05 PARTITION OF scans FOR VALUES FROM ('2061-05-01') TO ('2061-06-01');
-CREATE TABLE IF NOT EXISTS scans_2061_06 PARTITION OF scans FOR VALUES FROM ('2061-06-01') TO ('2061-07-01');
-CREATE TABLE IF NOT EXISTS scans_2061_07 PARTITION OF scans FOR VALUES FROM ('2061-07-01') TO ('2061-08-01');
-CREATE TABLE IF NOT EXISTS scans_2061_08 PARTITION OF scans FOR VALUES FROM ('2061-08-01') TO ('2061-09-01');
-CREATE TABLE IF NOT EXISTS scans_2061_09 PARTITION OF scans FOR VALUES FROM ('2061-09-01') TO ('2061-10-01');
-CREATE TABLE IF NOT EXISTS scans_2061_10 PARTITION OF scans FOR VALUES FROM ('2061-10-01') TO ('2061-11-01');
-CREATE TABLE IF NOT EXISTS scans_2061_11 PARTITION OF scans FOR VALUES FROM ('2061-11-01') TO ('2061-12-01');
-CREATE TABLE IF NOT EXISTS scans_2061_12 PARTITION OF scans FOR VALUES FROM ('2061-12-01') TO ('2062-01-01');
-
-CREATE TABLE IF NOT EXISTS scans_2062_01 PARTITION OF scans FOR VALUES FROM ('2062-01-01') TO ('2062-02-01');
-CREATE TABLE IF NOT EXISTS scans_2062_02 PARTITION OF scans FOR VALUES FROM ('2062-02-01') TO ('2062-03-01');
-CREATE TABLE IF NOT EXISTS scans_2062_03 PARTITION OF scans FOR VALUES FROM ('2062-03-01') TO ('2062-04-01');
-CREATE TABLE IF NOT EXISTS scans_2062_04 PARTITION OF scans FOR VALUES FROM ('2062-04-01') TO ('2062-05-01');
-CREATE TABLE IF NOT EXISTS scans_2062_05 PARTITION OF scans FOR VALUES FROM ('2062-05-01') TO ('2062-06-01');
-CREATE TABLE IF NOT EXISTS scans_2062_06 PARTITION OF scans FOR VALUES FROM ('2062-06-01') TO ('2062-07-01');
-CREATE TABLE IF NOT EXISTS scans_2062_07 PARTITION OF scans FOR VALUES FROM ('2062-07-01') TO ('2062-08-01');
-CREATE TABLE IF NOT EXISTS scans_2062_08 PARTITION OF scans FOR VALUES FROM ('2062-08-01') TO ('2062-09-01');
-CREATE TABLE IF NOT EXISTS scans_2062_09 PARTITION OF scans FOR VALUES FROM ('2062-09-01') TO ('2062-10-01');
-CREATE TABLE IF NOT EXISTS scans_2062_10 PARTITION OF scans FOR VALUES FROM ('2062-10-01') TO ('2062-11-01');
-CREATE TABLE IF NOT EXISTS scans_2062_11 PARTITION OF scans FOR VALUES FROM ('2062-11-01') TO ('2062-12-01');
-CREATE TABLE IF NOT EXISTS scans_2062_12 PARTITION OF scans FOR VALUES FROM ('2062-12-01') TO ('2063-01-01');
-
-CREATE TABLE IF NOT EXISTS scans_2063_01 PARTITION OF scans FOR VALUES FROM ('2063-01-01') TO ('2063-02-01');
-CREATE TABLE IF NOT EXISTS scans_2063_02 PARTITION OF scans FOR VALUES FROM ('2063-02-01') TO ('2063-03-01');
-CREATE TABLE IF NOT EXISTS scans_2063_03 PARTITION OF scans FOR VALUES FROM ('2063-03-01') TO ('2063-04-01');
-CREATE TABLE IF NOT EXISTS scans_2063_04 PARTITION OF scans FOR VALUES FROM ('2063-04-01') TO ('2063-05-01');
-CREATE TABLE IF NOT EXISTS scans_2063_05 PARTITION OF scans FOR VALUES FROM ('2063-05-01') TO ('2063-06-01');
-CREATE TABLE IF NOT EXISTS scans_2063_06 PARTITION OF scans FOR VALUES FROM ('2063-06-01') TO ('2063-07-01');
-CREATE TABLE IF NOT EXISTS scans_2063_07 PARTITION OF scans FOR VALUES FROM ('2063-07-01') TO ('2063-08-01');
-CREATE TABLE IF NOT EXISTS scans_2063_08 PARTITION OF scans FOR VALUES FROM ('2063-08-01') TO ('2063-09-01');
-CREATE TABLE IF NOT EXISTS scans_2063_09 PARTITION OF scans FOR VALUES FROM ('2063-09-01') TO ('2063-10-01');
-CREATE TABLE IF NOT EXISTS scans_2063_10 PARTITION OF scans FOR VALUES FROM ('2063-10-01') TO ('2063-11-01');
-CREATE TABLE IF NOT EXISTS scans_2063_11 PARTITION OF scans FOR VALUES FROM ('2063-11-01') TO ('2063-12-01');
-CREATE TABLE IF NOT EXISTS scans_2063_12 PARTITION OF scans FOR VALUES FROM ('2063-12-01') TO ('2064-01-01');
-
-CREATE TABLE IF NOT EXISTS scans_2064_01 PARTITION OF scans FOR VALUES FROM ('2064-01-01') TO ('2064-02-01');
-CREATE TABLE IF NOT EXISTS scans_2064_02 PARTITION OF scans FOR VALUES FROM ('2064-02-01') TO ('2064-03-01');
-CREATE TABLE IF NOT EXISTS scans_2064_03 PARTITION OF scans FOR VALUES FROM ('2064-03-01') TO ('2064-04-01');
-CREATE TABLE IF NOT EXISTS scans_2064_04 PARTITION OF scans FOR VALUES FROM ('2064-04-01') TO ('2064-05-01');
-CREATE TABLE IF NOT EXISTS scans_2064_05 PARTITION OF scans FOR VALUES FROM ('2064-05-01') TO ('2064-06-01');
-CREATE TABLE IF NOT EXISTS scans_2064_06 PARTITION OF scans FOR VALUES FROM ('2064-06-01') TO ('2064-07-01');
-CREATE TABLE IF NOT EXISTS scans_2064_07 PARTITION OF scans FOR VALUES FROM ('2064-07-01') TO ('2064-08-01');
-CREATE TABLE IF NOT EXISTS scans_2064_08 PARTITION OF scans FOR VALUES FROM ('2064-08-01') TO ('2064-09-01');
-CREATE TABLE IF NOT EXISTS scans_2064_09 PARTITION OF scans FOR VALUES FROM ('2064-09-01') TO ('2064-10-01');
-CREATE TABLE IF NOT EXISTS scans_2064_10 PARTITION OF scans FOR VALUES FROM ('2064-10-01') TO ('2064-11-01');
-CREATE TABLE IF NOT EXISTS scans_2064_11 PARTITION OF scans FOR VALUES FROM ('2064-11-01') TO ('2064-12-01');
-CREATE TABLE IF NOT EXISTS scans_2064_12 PARTITION OF scans FOR VALUES FROM ('2064-12-01') TO ('2065-01-01');
-
-CREATE TABLE IF NOT EXISTS scans_2065_01 PARTITION OF scans FOR VALUES FROM ('2065-01-01') TO ('2065-02-01');
-CREATE TABLE IF NOT EXISTS scans_2065_02 PARTITION OF scans FOR VALUES FROM ('2065-02-01') TO ('2065-03-01');
-CREATE TABLE IF NOT EXISTS scans_2065_03 PARTITION OF scans FOR VALUES FROM ('2065-03-01') TO ('2065-04-01');
-CREATE TABLE IF NOT EXISTS scans_2065_04 PARTITION OF scans FOR VALUES FROM ('2065-04-01') TO ('2065-05-01');
-CREATE TABLE IF NOT EXISTS scans_2065_05 PARTITION OF scans FOR VALUES FROM ('2065-05-01') TO ('2065-06-01');
-CREATE TABLE IF NOT EXISTS scans_2065_06 PARTITION OF scans FOR VALUES FROM ('2065-06-01') TO ('2065-07-01');
-CREATE TABLE IF NOT EXISTS scans_2065_07 PARTITION OF scans FOR VALUES FROM ('2065-07-01') TO ('2065-08-01');
-CREATE TABLE IF NOT EXISTS scans_2065_08 PARTITION OF scans FOR VALUES FROM ('2065-08-01') TO ('2065-09-01');
-CREATE TABLE IF NOT EXISTS scans_2065_09 PARTITION OF scans FOR VALUES FROM ('2065-09-01') TO ('2065-10-01');
-CREATE TABLE IF NOT EXISTS scans_2065_10 PARTITION OF scans FOR VALUES FROM ('2065-10-01') TO ('2065-11-01');
-CREATE TABLE IF NOT EXISTS scans_2065_11 PARTITION OF scans FOR VALUES FROM ('2065-11-01') TO ('2065-12-01');
-CREATE TABLE IF NOT EXISTS scans_2065_12 PARTITION OF scans FOR VALUES FROM ('2065-12-01') TO ('2066-01-01');
-
-CREATE TABLE IF NOT EXISTS scans_2066_01 PARTITION OF scans FOR VALUES FROM ('2066-01-01') TO ('2066-02-01');
-CREATE TABLE IF NOT EXISTS scans_2066_02 PARTITION OF scans FOR VALUES FROM ('2066-02-01') TO ('2066-03-01');
-CREATE TABLE IF NOT EXISTS scans_2066_03 PARTITION OF scans FOR VALUES FROM ('2066-03-01') TO ('2066-04-01');
-CREATE TABLE IF NOT EXISTS scans_2066_04 PARTITION OF scans FOR VALUES FROM ('2066-04-01') TO ('2066-05-01');
-CREATE TABLE IF NOT EXISTS scans_2066_05 PARTITION OF scans FOR VALUES FROM ('2066-05-01') TO ('2066-06-01');
-CREATE TABLE IF NOT EXISTS scans_2066_06 PARTITION OF scans FOR VALUES FROM ('2066-06-01') TO ('2066-07-01');
-CREATE TABLE IF NOT EXISTS scans_2066_07 PARTITION OF scans FOR VALUES FROM ('2066-07-01') TO ('2066-08-01');
-CREATE TABLE IF NOT EXISTS scans_2066_08 PARTITION OF scans FOR VALUES FROM ('2066-08-01') TO ('2066-09-01');
-CREATE TABLE IF NOT EXISTS scans_2066_09 PARTITION OF scans FOR VALUES FROM ('2066-09-01') TO ('2066-10-01');
-CREATE TABLE IF NOT EXISTS scans_2066_10 PARTITION OF scans FOR VALUES FROM ('2066-10-01') TO ('2066-11-01');
-CREATE TABLE IF NOT EXISTS scans_2066_11 PARTITION OF scans FOR VALUES FROM ('2066-11-01') TO ('2066-12-01');
-CREATE TABLE IF NOT EXISTS scans_2066_12 PARTITION OF scans FOR VALUES FROM ('2066-12-01') TO ('2067-01-01');
-
-CREATE TABLE IF NOT EXISTS scans_2067_01 PARTITION OF scans FOR VALUES FROM ('2067-01-01') TO ('2067-02-01');
-CREATE TABLE IF NOT EXISTS scans_2067_02 PARTITION OF scans FOR VALUES FROM ('2067-02-01') TO ('2067-03-01');
-CREATE TABLE IF NOT EXISTS scans_2067_03 PARTITION OF scans FOR VALUES FROM ('2067-03-01') TO ('2067-04-01');
-CREATE TABLE IF NOT EXISTS scans_2067_04 PARTITION OF scans FOR VALUES FROM ('2067-04-01') TO ('2067-05-01');
-CREATE TABLE IF NOT EXISTS scans_2067_05 PARTITION OF scans FOR VALUES FROM ('2067-05-01') TO ('2067-06-01');
-CREATE TABLE IF NOT EXISTS scans_2067_06 PARTITION OF scans FOR VALUES FROM ('2067-06-01') TO ('2067-07-01');
-CREATE TABLE IF NOT EXISTS scans_2067_07 PARTITION OF scans FOR VALUES FROM ('2067-07-01') TO ('2067-08-01');
-CREATE TABLE IF NOT EXISTS scans_2067_08 PARTITION OF scans FOR VALUES FROM ('2067-08-01') TO ('2067-09-01');
-CREATE TABLE IF NOT EXISTS scans_2067_09 PARTITION OF scans FOR VALUES FROM ('2067-09-01') TO ('2067-10-01');
-CREATE TABLE IF NOT EXISTS scans_2067_10 PARTITION OF scans FOR VALUES FROM ('2067-10-01') TO ('2067-11-01');
-CREATE TABLE IF NOT EXISTS scans_2067_11 PARTITION OF scans FOR VALUES FROM ('2067-11-01') TO ('2067-12-01');
-CREATE TABLE IF NOT EXISTS scans_2067_12 PARTITION OF scans FOR VALUES FROM ('2067-12-01') TO ('2068-01-01');
-
-CREATE TABLE IF NOT EXISTS scans_2068_01 PARTITION OF scans FOR VALUES FROM ('2068-01-01') TO ('2068-02-01');
-CREATE TABLE IF NOT EXISTS scans_2068_02 PARTITION OF scans FOR VALUES FROM ('2068-02-01') TO ('2068-03-01');
-CREATE TABLE IF NOT EXISTS scans_2068_03 PARTITION OF scans FOR VALUES FROM ('2068-03-01') TO ('2068-04-01');
-CREATE TABLE IF NOT EXISTS scans_2068_04 PARTITION OF scans FOR VALUES FROM ('2068-04-01') TO ('2068-05-01');
-CREATE TABLE IF NOT EXISTS scans_2068_05 PARTITION OF scans FOR VALUES FROM ('2068-05-01') TO ('2068-06-01');
-CREATE TABLE IF NOT EXISTS scans_2068_06 PARTITION OF scans FOR VALUES FROM ('2068-06-01') TO ('2068-07-01');
-CREATE TABLE IF NOT EXISTS scans_2068_07 PARTITION OF scans FOR VALUES FROM ('2068-07-01') TO ('2068-08-01');
-CREATE TABLE IF NOT EXISTS scans_2068_08 PARTITION OF scans FOR VALUES FROM ('2068-08-01') TO ('2068-09-01');
-CREATE TABLE IF NOT EXISTS scans_2068_09 PARTITION OF scans FOR VALUES FROM ('2068-09-01') TO ('2068-10-01');
-CREATE TABLE IF NOT EXISTS scans_2068_10 PARTITION OF scans FOR VALUES FROM ('2068-10-01') TO ('2068-11-01');
-CREATE TABLE IF NOT EXISTS scans_2068_11 PARTITION OF scans FOR VALUES FROM ('2068-11-01') TO ('2068-12-01');
-CREATE TABLE IF NOT EXISTS scans_2068_12 PARTITION OF scans FOR VALUES FROM ('2068-12-01') TO ('2069-01-01');
-
-CREATE TABLE IF NOT EXISTS scans_2069_01 PARTITION OF scans FOR VALUES FROM ('2069-01-01') TO ('2069-02-01');
-CREATE TABLE IF NOT EXISTS scans_2069_02 PARTITION OF scans FOR VALUES FROM ('2069-02-01') TO ('2069-03-01');
-CREATE TABLE IF NOT EXISTS scans_2069_03 PARTITION OF scans FOR VALUES FROM ('2069-03-01') TO ('2069-04-01');
-CREATE TABLE IF NOT EXISTS scans_2069_04 PARTITION OF scans FOR VALUES FROM ('2069-04-01') TO ('2069-05-01');
-CREATE TABLE IF NOT EXISTS scans_2069_05 PARTITION OF scans FOR VALUES FROM ('2069-05-01') TO ('2069-06-01');
-CREATE TABLE IF NOT EXISTS scans_2069_06 PARTITION OF scans FOR VALUES FROM ('2069-06-01') TO ('2069-07-01');
-CREATE TABLE IF NOT EXISTS scans_2069_07 PARTITION OF scans FOR VALUES FROM ('2069-07-01') TO ('2069-08-01');
-CREATE TABLE IF NOT EXISTS scans_2069_08 PARTITION OF scans FOR VALUES FROM ('2069-08-01') TO ('2069-09-01');
-CREATE TABLE IF NOT EXISTS scans_2069_09 PARTITION OF scans FOR VALUES FROM ('2069-09-01') TO ('2069-10-01');
-CREATE TABLE IF NOT EXISTS scans_2069_10 PARTITION OF scans FOR VALUES FROM ('2069-10-01') TO ('2069-11-01');
-CREATE TABLE IF NOT EXISTS scans_2069_11 PARTITION OF scans FOR VALUES FROM ('2069-11-01') TO ('2069-12-01');
-CREATE TABLE IF NOT EXISTS scans_2069_12 PARTITION OF scans FOR VALUES FROM ('2069-12-01') TO ('2070-01-01');
-
-CREATE TABLE IF NOT EXISTS scans_2070_01 PARTITION OF scans FOR VALUES FROM ('2070-01-01') TO ('2070-02-01');
-CREATE TABLE IF NOT EXISTS scans_2070_02 PARTITION OF scans FOR VALUES FROM ('2070-02-01') TO ('2070-03-01');
-CREATE TABLE IF NOT EXISTS scans_2070_03 PARTITION OF scans FOR VALUES FROM ('2070-03-01') TO ('2070-04-01');
-CREATE TABLE IF NOT EXISTS scans_2070_04 PARTITION OF scans FOR VALUES FROM ('2070-04-01') TO ('2070-05-01');
-CREATE TABLE IF NOT EXISTS scans_2070_05 PARTITION OF scans FOR VALUES FROM ('2070-05-01') TO ('2070-06-01');
-CREATE TABLE IF NOT EXISTS scans_2070_06 PARTITION OF scans FOR VALUES FROM ('2070-06-01') TO ('2070-07-01');
-CREATE TABLE IF NOT EXISTS scans_2070_07 PARTITION OF scans FOR VALUES FROM ('2070-07-01') TO ('2070-08-01');
-CREATE TABLE IF NOT EXISTS scans_2070_08 PARTITION OF scans FOR VALUES FROM ('2070-08-01') TO ('2070-09-01');
-CREATE TABLE IF NOT EXISTS scans_2070_09 PARTITION OF scans FOR VALUES FROM ('2070-09-01') TO ('2070-10-01');
-CREATE TABLE IF NOT EXISTS scans_2070_10 PARTITION OF scans FOR VALUES FROM ('2070-10-01') TO ('2070-11-01');
-CREATE TABLE IF NOT EXISTS scans_2070_11 PARTITION OF scans FOR VALUES FROM ('2070-11-01') TO ('2070-12-01');
-CREATE TABLE IF NOT EXISTS scans_2070_12 PARTITION OF scans FOR VALUES FROM ('2070-12-01') TO ('2071-01-01');
-
-CREATE TABLE IF NOT EXISTS scans_2071_01 PARTITION OF scans FOR VALUES FROM ('2071-01-01') TO ('2071-02-01');
-CREATE TABLE IF NOT EXISTS scans_2071_02 PARTITION OF scans FOR VALUES FROM ('2071-02-01') TO ('2071-03-01');
-CREATE TABLE IF NOT EXISTS scans_2071_03 PARTITION OF scans FOR VALUES FROM ('2071-03-01') TO ('2071-04-01');
-CREATE TABLE IF NOT EXISTS scans_2071_04 PARTITION OF scans FOR VALUES FROM ('2071-04-01') TO ('2071-05-01');
-CREATE TABLE IF NOT EXISTS scans_2071_05 PARTITION OF scans FOR VALUES FROM ('2071-05-01') TO ('2071-06-01');
-CREATE TABLE IF NOT EXISTS scans_2071_06 PARTITION OF scans FOR VALUES FROM ('2071-06-01') TO ('2071-07-01');
-CREATE TABLE IF NOT EXISTS scans_2071_07 PARTITION OF scans FOR VALUES FROM ('2071-07-01') TO ('2071-08-01');
-CREATE TABLE IF NOT EXISTS scans_2071_08 PARTITION OF scans FOR VALUES FROM ('2071-08-01') TO ('2071-09-01');
-CREATE TABLE IF NOT EXISTS scans_2071_09 PARTITION OF scans FOR VALUES FROM ('2071-09-01') TO ('2071-10-01');
-CREATE TABLE IF NOT EXISTS scans_2071_10 PARTITION OF scans FOR VALUES FROM ('2071-10-01') TO ('2071-11-01');
-CREATE TABLE IF NOT EXISTS scans_2071_11 PARTITION OF scans FOR VALUES FROM ('2071-11-01') TO ('2071-12-01');
-CREATE TABLE IF NOT EXISTS scans_2071_12 PARTITION OF scans FOR VALUES FROM ('2071-12-01') TO ('2072-01-01');
-
-CREATE TABLE IF NOT EXISTS scans_2072_01 PARTITION OF scans FOR VALUES FROM ('2072-01-01') TO ('2072-02-01');
-CREATE TABLE IF NOT EXISTS scans_2072_02 PARTITION OF scans FOR VALUES FROM ('2072-02-01') TO ('2072-03-01');
-CREATE TABLE IF NOT EXISTS scans_2072_03 PARTITION OF scans FOR VALUES FROM ('2072-03-01') TO ('2072-04-01');
-CREATE TABLE IF NOT EXISTS scans_2072_04 PARTITION OF scans FOR VALUES FROM ('2072-04-01') TO ('2072-05-01');
-CREATE TABLE IF NOT EXISTS scans_2072_05 PARTITION OF scans FOR VALUES FROM ('2072-05-01') TO ('2072-06-01');
-CREATE TABLE IF NOT EXISTS scans_2072_06 PARTITION OF scans FOR VALUES FROM ('2072-06-01') TO ('2072-07-01');
-CREATE TABLE IF NOT EXISTS scans_2072_07 PARTITION OF scans FOR VALUES FROM ('2072-07-01') TO ('2072-08-01');
-CREATE TABLE IF NOT EXISTS scans_2072_08 PARTITION OF scans FOR VALUES FROM ('2072-08-01') TO ('2072-09-01');
-CREATE TABLE IF NOT EXISTS scans_2072_09 PARTITION OF scans FOR VALUES FROM ('2072-09-01') TO ('2072-10-01');
-CREATE TABLE IF NOT EXISTS scans_2072_10 PARTITION OF scans FOR VALUES FROM ('2072-10-01') TO ('2072-11-01');
-CREATE TABLE IF NOT EXISTS scans_2072_11 PARTITION OF scans FOR VALUES FROM ('2072-11-01') TO ('2072-12-01');
-CREATE TABLE IF NOT EXISTS scans_2072_12 PARTITION OF scans FOR VALUES FROM ('2072-12-01') TO ('2073-01-01');
-
-CREATE TABLE IF NOT EXISTS scans_2073_01 PARTITION OF scans FOR VALUES FROM ('2073-01-01') TO ('2073-02-01');
-CREATE TABLE IF NOT EXISTS scans_2073_02 PARTITION OF scans FOR VALUES FROM ('2073-02-01') TO ('2073-03-01');
-CREATE TABLE IF NOT EXISTS scans_2073_03 PARTITION OF scans FOR VALUES FROM ('2073-03-01') TO ('2073-04-01');
-CREATE TABLE IF NOT EXISTS scans_2073_04 PARTITION OF scans FOR VALUES FROM ('2073-04-01') TO ('2073-05-01');
-CREATE TABLE IF NOT EXISTS scans_2073_05 PARTITION OF scans FOR VALUES FROM ('2073-05-01') TO ('2073-06-01');
-CREATE TABLE IF NOT EXISTS scans_2073_06 PARTITION OF scans FOR VALUES FROM ('2073-06-01') TO ('2073-07-01');
-CREATE TABLE IF NOT EXISTS scans_2073_07 PARTITION OF scans FOR VALUES FROM ('2073-07-01') TO ('2073-08-01');
-CREATE TABLE IF NOT EXISTS scans_2073_08 PARTITION OF scans FOR VALUES FROM ('2073-08-01') TO ('2073-09-01');
-CREATE TABLE IF NOT EXISTS scans_2073_09 PARTITION OF scans FOR VALUES FROM ('2073-09-01') TO ('2073-10-01');
-CREATE TABLE IF NOT EXISTS scans_2073_10 PARTITION OF scans FOR VALUES FROM ('2073-10-01') TO ('2073-11-01');
-CREATE TABLE IF NOT EXISTS scans_2073_11 PARTITION OF scans FOR VALUES FROM ('2073-11-01') TO ('2073-12-01');
-CREATE TABLE IF NOT EXISTS scans_2073_12 PARTITION OF scans FOR VALUES FROM ('2073-12-01') TO ('2074-01-01');
-
-CREATE TABLE IF NOT EXISTS scans_2074_01 PARTITION OF scans FOR VALUES FROM ('2074-01-01') TO ('2074-02-01');
-CREATE TABLE IF NOT EXISTS scans_2074_02 PARTITION OF scans FOR VALUES FROM ('2074-02-01') TO ('2074-03-01');
-CREATE TABLE IF NOT EXISTS scans_2074_03 PARTITION OF scans FOR VALUES FROM ('2074-03-01') TO ('2074-04-01');
-CREATE TABLE IF NOT EXISTS scans_2074_04 PARTITION OF scans FOR VALUES FROM ('2074-04-01') TO ('2074-05-01');
-CREATE TABLE IF NOT EXISTS scans_2074_05 PARTITION OF scans FOR VALUES FROM ('2074-05-01') TO ('2074-06-01');
-CREATE TABLE IF NOT EXISTS scans_2074_06 PARTITION OF scans FOR VALUES FROM ('2074-06-01') TO ('2074-07-01');
-CREATE TABLE IF NOT EXISTS scans_2074_07 PARTITION OF scans FOR VALUES FROM ('2074-07-01') TO ('2074-08-01');
-CREATE TABLE IF NOT EXISTS scans_2074_08 PARTITION OF scans FOR VALUES FROM ('2074-08-01') TO ('2074-09-01');
-CREATE TABLE IF NOT EXISTS scans_2074_09 PARTITION OF scans FOR VALUES FROM ('2074-09-01') TO ('2074-10-01');
-CREATE TABLE IF NOT EXISTS scans_2074_10 PARTITION OF scans FOR VALUES FROM ('2074-10-01') TO ('2074-11-01');
-CREATE TABLE IF NOT EXISTS scans_2074_11 PARTITION OF scans FOR VALUES FROM ('2074-11-01') TO ('2074-12-01');
-CREATE TABLE IF NOT EXISTS scans_2074_12 PARTITION OF scans FOR VALUES FROM ('2074-12-01') TO ('2075-01-01');
-
-CREATE TABLE IF NOT EXISTS scans_2075_01 PARTITION OF scans FOR VALUES FROM ('2075-01-01') TO ('2075-02-01');
-CREATE TABLE IF NOT EXISTS scans_2075_02 PARTITION OF scans FOR VALUES FROM ('2075-02-01') TO ('2075-03-01');
-CREATE TABLE IF NOT EXISTS scans_2075_03 PARTITION OF scans FOR VALUES FROM ('2075-03-01') TO ('2075-04-01');
-CREATE TABLE IF NOT EXISTS scans_2075_04 PARTITION OF scans FOR VALUES FROM ('2075-04-01') TO ('2075-05-01');
-CREATE TABLE IF NOT EXISTS scans_2075_05 PARTITION OF scans FOR VALUES FROM ('2075-05-01') TO ('2075-06-01');
-CREATE TABLE IF NOT EXISTS scans_2075_06 PARTITION OF scans FOR VALUES FROM ('2075-06-01') TO ('2075-07-01');
-CREATE TABLE IF NOT EXISTS scans_2075_07 PARTITION OF scans FOR VALUES FROM ('2075-07-01') TO ('2075-08-01');
-CREATE TABLE IF NOT EXISTS scans_2075_08 PARTITION OF scans FOR VALUES FROM ('2075-08-01') TO ('2075-09-01');
-CREATE TABLE IF NOT EXISTS scans_2075_09 PARTITION OF scans FOR VALUES FROM ('2075-09-01') TO ('2075-10-01');
-CREATE TABLE IF NOT EXISTS scans_2075_10 PARTITION OF scans FOR VALUES FROM ('2075-10-01') TO ('2075-11-01');
-CREATE TABLE IF NOT EXISTS scans_2075_11 PARTITION OF scans FOR VALUES FROM ('2075-11-01') TO ('2075-12-01');
-CREATE TABLE IF NOT EXISTS scans_2075_12 PARTITION OF scans FOR VALUES FROM ('2075-12-01') TO ('2076-01-01');
-
---changeset cloudscan:7 labels:v1.0.0 context:schema
---comment: Make commit_sha nullable since it's optional (scan latest commit if not specified)
-
-ALTER TABLE scans ALTER COLUMN commit_sha DROP NOT NULL;
-
---changeset cloudscan:8 labels:v1.0.0 context:schema
---comment: Make user_id nullable for automated/system scans
-
-ALTER TABLE scans ALTER COLUMN user_id DROP NOT NULL;
-
---changeset cloudscan:9 labels:v1.0.0 context:schema
---comment: Remove FK constraints for microservices independence and make repository_url/branch nullable
-
--- Remove foreign key constraints (Gateway validates org/project before sending to Orchestrator)
-ALTER TABLE scans DROP CONSTRAINT IF EXISTS scans_organization_id_fkey;
-ALTER TABLE scans DROP CONSTRAINT IF EXISTS scans_project_id_fkey;
-ALTER TABLE projects DROP CONSTRAINT IF EXISTS projects_organization_id_fkey;
-ALTER TABLE audit_logs DROP CONSTRAINT IF EXISTS audit_logs_organization_id_fkey;
-
--- Make repository_url and branch nullable (for artifact-based scans)
-ALTER TABLE scans ALTER COLUMN repository_url DROP NOT NULL;
-ALTER TABLE scans ALTER COLUMN branch DROP NOT NULL;
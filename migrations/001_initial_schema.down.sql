@@ -7,5 +7,3 @@ DROP TABLE IF EXISTS projects CASCADE;
 DROP TABLE IF EXISTS organizations CASCADE;
 
 DROP FUNCTION IF EXISTS update_updated_at_column CASCADE;
-
-DROP EXTENSION IF EXISTS "uuid-ossp";
\ No newline at end of file
@@ -1,7 +1,9 @@
 -- CloudScan Orchestrator - Initial Schema
 -- PostgreSQL 15+
+--
+-- Organizations and projects are owned by the API Gateway, which validates them
+-- before calling the orchestrator, so scans carry no foreign keys to them.
 
--- Enable UUID extension
 CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
 
 -- =============================================================================
@@ -24,7 +26,7 @@ CREATE INDEX idx_organizations_active ON organizations(is_active) WHERE is_activ
 -- =============================================================================
 CREATE TABLE projects (
     id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
-    organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    organization_id UUID NOT NULL,
     name TEXT NOT NULL,
     slug TEXT NOT NULL,
     description TEXT,
@@ -44,16 +46,16 @@ CREATE INDEX idx_projects_active ON projects(is_active) WHERE is_active = true;
 -- =============================================================================
 CREATE TABLE scans (
     id UUID NOT NULL,
-    organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
-    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
-    user_id UUID NOT NULL,
+    organization_id UUID NOT NULL,
+    project_id UUID NOT NULL,
+    user_id UUID,
     status TEXT NOT NULL CHECK (status IN ('queued', 'running', 'completed', 'failed', 'cancelled')),
     scan_types TEXT[] NOT NULL,
 
     -- Source code information
-    repository_url TEXT NOT NULL,
-    branch TEXT NOT NULL,
-    commit_sha TEXT NOT NULL,
+    repository_url TEXT,
+    branch TEXT,
+    commit_sha TEXT,
     source_archive_key TEXT,
 
     -- Kubernetes job information
@@ -77,24 +79,22 @@ CREATE TABLE scans (
     PRIMARY KEY (id, created_at)
 ) PARTITION BY RANGE (created_at);
 
--- Create partitions for current and future months
-CREATE TABLE scans_2025_01 PARTITION OF scans
-    FOR VALUES FROM ('2025-01-01') TO ('2025-02-01');
-
-CREATE TABLE scans_2025_02 PARTITION OF scans
-    FOR VALUES FROM ('2025-02-01') TO ('2025-03-01');
-
-CREATE TABLE scans_2025_03 PARTITION OF scans
-    FOR VALUES FROM ('2025-03-01') TO ('2025-04-01');
-
-CREATE TABLE scans_2025_04 PARTITION OF scans
-    FOR VALUES FROM ('2025-04-01') TO ('2025-05-01');
-
-CREATE TABLE scans_2025_05 PARTITION OF scans
-    FOR VALUES FROM ('2025-05-01') TO ('2025-06-01');
-
-CREATE TABLE scans_2025_06 PARTITION OF scans
-    FOR VALUES FROM ('2025-06-01') TO ('2025-07-01');
+-- Monthly partitions from 2025-01 through 2075-12
+DO $BODY$
+DECLARE
+    month_start DATE := DATE '2025-01-01';
+BEGIN
+    WHILE month_start < DATE '2076-01-01' LOOP
+        EXECUTE format(
+            'CREATE TABLE IF NOT EXISTS %I PARTITION OF scans FOR VALUES FROM (%L) TO (%L)',
+            'scans_' || to_char(month_start, 'YYYY_MM'),
+            month_start,
+            month_start + INTERVAL '1 month'
+        );
+        month_start := month_start + INTERVAL '1 month';
+    END LOOP;
+END
+$BODY$;
 
 -- Indexes on partitioned table
 CREATE INDEX idx_scans_org ON scans(organization_id, created_at DESC);
@@ -146,7 +146,7 @@ CREATE TABLE findings (
 
     -- Remediation
     remediation TEXT,
-    references TEXT[],
+    "references" TEXT[],
 
     -- Metadata
     raw_output JSONB,
@@ -163,7 +163,7 @@ CREATE INDEX idx_findings_cve ON findings(cve_id) WHERE cve_id IS NOT NULL;
 -- =============================================================================
 CREATE TABLE audit_logs (
     id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
-    organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    organization_id UUID NOT NULL,
     user_id UUID NOT NULL,
     action TEXT NOT NULL,
     resource_type TEXT NOT NULL,
@@ -181,15 +181,14 @@ CREATE INDEX idx_audit_logs_resource ON audit_logs(resource_type, resource_id);
 -- =============================================================================
 -- Functions
 -- =============================================================================
-
--- Function to update updated_at timestamp
 CREATE OR REPLACE FUNCTION update_updated_at_column()
-RETURNS TRIGGER AS $$
+RETURNS TRIGGER AS $BODY$
 BEGIN
     NEW.updated_at = NOW();
     RETURN NEW;
 END;
-$$ language 'plpgsql';
+$BODY$
+language 'plpgsql';
 
 -- Triggers to auto-update updated_at
 CREATE TRIGGER update_organizations_updated_at BEFORE UPDATE ON organizations
@@ -197,15 +196,3 @@ CREATE TRIGGER update_organizations_updated_at BEFORE UPDATE ON organizations
 
 CREATE TRIGGER update_projects_updated_at BEFORE UPDATE ON projects
     FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
-
--- =============================================================================
--- Sample Data (for development)
--- =============================================================================
-
--- Sample organization
-INSERT INTO organizations (id, name, slug) VALUES
-    ('550e8400-e29b-41d4-a716-446655440000', 'Demo Organization', 'demo-org');
-
--- Sample project
-INSERT INTO projects (id, organization_id, name, slug, repository_url, default_branch) VALUES
-    ('550e8400-e29b-41d4-a716-446655440001', '550e8400-e29b-41d4-a716-446655440000', 'Demo Project', 'demo-project', 'https://github.com/demo/repo', 'main');
\ No newline at end of file
@@ -0,0 +1,32 @@
+-- Recurring scan schedules per project
+
+CREATE TABLE scan_schedules (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    organization_id UUID NOT NULL,
+    project_id UUID NOT NULL,
+
+    -- Schedule definition
+    cron_expression TEXT NOT NULL,
+    timezone TEXT NOT NULL DEFAULT 'UTC',
+    scan_types TEXT[] NOT NULL,
+    repository_url TEXT NOT NULL,
+    branch TEXT NOT NULL DEFAULT 'main',
+    enabled BOOLEAN NOT NULL DEFAULT true,
+
+    -- Run tracking
+    next_run_at TIMESTAMP WITH TIME ZONE,
+    last_run_at TIMESTAMP WITH TIME ZONE,
+    last_scan_id UUID,
+
+    -- Audit
+    created_by UUID,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_scan_schedules_org ON scan_schedules(organization_id);
+CREATE INDEX idx_scan_schedules_project ON scan_schedules(project_id);
+CREATE INDEX idx_scan_schedules_due ON scan_schedules(next_run_at) WHERE enabled = true;
+
+CREATE TRIGGER update_scan_schedules_updated_at BEFORE UPDATE ON scan_schedules
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_scans_project_commit;
+DROP TABLE IF EXISTS repository_integrations;
@@ -0,0 +1,31 @@
+-- Git repository integrations for webhook-triggered scans
+
+CREATE TABLE repository_integrations (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    organization_id UUID NOT NULL,
+    project_id UUID NOT NULL,
+
+    -- Repository
+    provider TEXT NOT NULL CHECK (provider IN ('github', 'gitlab', 'bitbucket')),
+    repository_url TEXT NOT NULL,  -- Normalized host/owner/repo
+    webhook_secret TEXT NOT NULL,
+
+    -- Scan configuration
+    scan_types TEXT[] NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT true,
+
+    -- Audit
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    UNIQUE (provider, repository_url)
+);
+
+CREATE INDEX idx_repository_integrations_org ON repository_integrations(organization_id);
+CREATE INDEX idx_repository_integrations_project ON repository_integrations(project_id);
+
+-- Webhook deduplication looks up scans of a project by commit
+CREATE INDEX idx_scans_project_commit ON scans(project_id, commit_sha) WHERE commit_sha IS NOT NULL;
+
+CREATE TRIGGER update_repository_integrations_updated_at BEFORE UPDATE ON repository_integrations
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
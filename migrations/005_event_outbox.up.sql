@@ -0,0 +1,25 @@
+-- Transactional event outbox relayed to the message broker, webhooks and commit statuses
+
+-- Written in the same transaction as the scan/finding change it describes
+CREATE TABLE event_outbox (
+    id UUID PRIMARY KEY,                 -- Event ID
+    sequence BIGSERIAL NOT NULL,         -- Relay order
+    event_type TEXT NOT NULL,
+    organization_id UUID NOT NULL,
+    payload JSONB NOT NULL,              -- JSON encoded event
+
+    -- Relay state
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    last_error TEXT,
+    published_at TIMESTAMP WITH TIME ZONE,
+
+    -- Audit
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_event_outbox_pending ON event_outbox(sequence) WHERE published_at IS NULL;
+CREATE INDEX idx_event_outbox_published ON event_outbox(published_at) WHERE published_at IS NOT NULL;
+
+-- The relay delivers events at least once; enqueue each event once per subscription
+CREATE UNIQUE INDEX idx_webhook_deliveries_subscription_event ON webhook_deliveries(subscription_id, event_id);
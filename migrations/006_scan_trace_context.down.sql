@@ -0,0 +1 @@
+ALTER TABLE scans DROP COLUMN trace_parent;
@@ -0,0 +1,3 @@
+-- Trace context of the request that created a scan, continued by background workers
+
+ALTER TABLE scans ADD COLUMN trace_parent TEXT; -- W3C traceparent, NULL when tracing is disabled
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_findings_unreconciled;
+CREATE INDEX IF NOT EXISTS idx_findings_scan ON findings(scan_id);
+DROP INDEX IF EXISTS idx_findings_scan_created;
+ALTER TABLE findings DROP CONSTRAINT IF EXISTS fk_findings_scan;
+ALTER TABLE findings DROP COLUMN IF EXISTS scan_created_at;
@@ -0,0 +1,15 @@
+-- Findings reference scans through the full (id, created_at) primary key of the
+-- partitioned scans table, so deleting a scan deletes its findings
+
+ALTER TABLE findings ADD COLUMN scan_created_at TIMESTAMP WITH TIME ZONE; -- created_at of the scan
+
+-- Existing findings keep a NULL scan_created_at, which the foreign key doesn't
+-- check. The finding reconciler fills it in batches and deletes findings whose
+-- scan no longer exists.
+ALTER TABLE findings ADD CONSTRAINT fk_findings_scan
+    FOREIGN KEY (scan_id, scan_created_at) REFERENCES scans (id, created_at) ON DELETE CASCADE;
+
+CREATE INDEX idx_findings_scan_created ON findings(scan_id, scan_created_at);
+DROP INDEX idx_findings_scan; -- covered by idx_findings_scan_created
+
+CREATE INDEX idx_findings_unreconciled ON findings(id) WHERE scan_created_at IS NULL;
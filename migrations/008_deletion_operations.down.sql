@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS scan_deletions;
+DROP TABLE IF EXISTS operations;
+UPDATE scans SET status = 'cancelled' WHERE status = 'deleting';
+ALTER TABLE scans DROP CONSTRAINT scans_status_check;
+ALTER TABLE scans ADD CONSTRAINT scans_status_check
+    CHECK (status IN ('queued', 'running', 'completed', 'failed', 'cancelled'));
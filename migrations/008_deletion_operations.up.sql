@@ -0,0 +1,48 @@
+-- Asynchronous scan deletion tracked by long-running operations
+
+ALTER TABLE scans DROP CONSTRAINT scans_status_check;
+ALTER TABLE scans ADD CONSTRAINT scans_status_check
+    CHECK (status IN ('queued', 'running', 'completed', 'failed', 'cancelled', 'deleting'));
+
+-- Long-running operation polled through GetOperation
+CREATE TABLE operations (
+    id UUID PRIMARY KEY,
+    type TEXT NOT NULL CHECK (type IN ('delete_scan', 'delete_project_scans')),
+    status TEXT NOT NULL DEFAULT 'running' CHECK (status IN ('running', 'succeeded', 'failed')),
+
+    -- Target
+    scan_id UUID,
+    project_id UUID,
+
+    -- Progress
+    total_items INT NOT NULL DEFAULT 0,
+    completed_items INT NOT NULL DEFAULT 0,
+    failed_items INT NOT NULL DEFAULT 0,
+    last_error TEXT,
+
+    -- Audit
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    completed_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX idx_operations_scan ON operations(scan_id) WHERE scan_id IS NOT NULL;
+
+CREATE TRIGGER update_operations_updated_at BEFORE UPDATE ON operations
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- A scan marked deleting, waiting for its job, artifacts and rows to be removed.
+-- The row is deleted in the same transaction as the scan.
+CREATE TABLE scan_deletions (
+    scan_id UUID PRIMARY KEY,
+    scan_created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    operation_id UUID NOT NULL REFERENCES operations(id) ON DELETE CASCADE,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'failed')),
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    last_error TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_scan_deletions_due ON scan_deletions(next_attempt_at) WHERE status = 'pending';
+CREATE INDEX idx_scan_deletions_operation ON scan_deletions(operation_id);
@@ -0,0 +1,7 @@
+-- Retention deletions still pending keep running as plain scan deletions
+UPDATE operations SET type = 'delete_scan' WHERE type = 'apply_retention';
+ALTER TABLE operations DROP CONSTRAINT operations_type_check;
+ALTER TABLE operations ADD CONSTRAINT operations_type_check
+    CHECK (type IN ('delete_scan', 'delete_project_scans'));
+DROP INDEX IF EXISTS idx_scans_project_branch;
+DROP TABLE IF EXISTS retention_policies;
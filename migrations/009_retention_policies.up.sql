@@ -0,0 +1,34 @@
+-- Scan retention policies per organization and project
+
+CREATE TABLE retention_policies (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    organization_id UUID NOT NULL,
+    project_id UUID,  -- NULL for the organization-wide policy
+
+    -- Rules; a scan is deleted only if no rule keeps it
+    keep_days INT NOT NULL DEFAULT 0 CHECK (keep_days >= 0),                       -- 0 disables
+    keep_last_per_branch INT NOT NULL DEFAULT 0 CHECK (keep_last_per_branch >= 0), -- 0 disables
+    keep_latest_default_branch BOOLEAN NOT NULL DEFAULT true,
+    default_branch TEXT,  -- Overrides projects.default_branch for project policies
+
+    -- Audit
+    created_by UUID,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    CHECK (keep_days > 0 OR keep_last_per_branch > 0)
+);
+
+CREATE UNIQUE INDEX idx_retention_policies_org ON retention_policies(organization_id) WHERE project_id IS NULL;
+CREATE UNIQUE INDEX idx_retention_policies_project ON retention_policies(organization_id, project_id) WHERE project_id IS NOT NULL;
+
+CREATE TRIGGER update_retention_policies_updated_at BEFORE UPDATE ON retention_policies
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- Ranks scans per branch when policies are applied
+CREATE INDEX idx_scans_project_branch ON scans(project_id, branch, created_at DESC);
+
+-- Deletions started by the cleaner
+ALTER TABLE operations DROP CONSTRAINT operations_type_check;
+ALTER TABLE operations ADD CONSTRAINT operations_type_check
+    CHECK (type IN ('delete_scan', 'delete_project_scans', 'apply_retention'));
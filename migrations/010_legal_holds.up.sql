@@ -0,0 +1,24 @@
+-- Legal holds freezing the scans of an organization, a project or a single scan
+
+CREATE TABLE legal_holds (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    organization_id UUID NOT NULL,
+    project_id UUID,  -- Set for project and scan holds
+    scan_id UUID,     -- Set for scan holds
+
+    -- Hold
+    reason TEXT NOT NULL,
+    requested_by UUID NOT NULL,
+    placed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    -- Release
+    released_at TIMESTAMP WITH TIME ZONE,
+    released_by UUID,
+    release_reason TEXT,
+
+    CHECK (scan_id IS NULL OR project_id IS NOT NULL),
+    CHECK ((released_at IS NULL) = (released_by IS NULL))
+);
+
+CREATE INDEX idx_legal_holds_active ON legal_holds(organization_id, project_id, scan_id) WHERE released_at IS NULL;
+CREATE INDEX idx_legal_holds_project ON legal_holds(project_id) WHERE released_at IS NULL;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_scans_logs_pending;
+
+ALTER TABLE scans
+    DROP COLUMN IF EXISTS logs_archive_claimed_until,
+    DROP COLUMN IF EXISTS logs_artifact_id;
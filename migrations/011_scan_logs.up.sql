@@ -0,0 +1,9 @@
+-- Runner logs archived as a storage artifact once the scan's job finishes
+
+ALTER TABLE scans
+    ADD COLUMN logs_artifact_id TEXT,
+    ADD COLUMN logs_archive_claimed_until TIMESTAMP WITH TIME ZONE;
+
+-- Finished scans whose logs still have to be archived
+CREATE INDEX idx_scans_logs_pending ON scans(completed_at)
+    WHERE logs_artifact_id IS NULL AND job_name IS NOT NULL;
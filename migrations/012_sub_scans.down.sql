@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS idx_scans_logs_pending;
+CREATE INDEX idx_scans_logs_pending ON scans(completed_at)
+    WHERE logs_artifact_id IS NULL AND job_name IS NOT NULL;
+
+DROP TABLE IF EXISTS sub_scans;
+
+UPDATE scans SET status = 'completed' WHERE status = 'partial';
+ALTER TABLE scans DROP CONSTRAINT scans_status_check;
+ALTER TABLE scans ADD CONSTRAINT scans_status_check
+    CHECK (status IN ('queued', 'running', 'completed', 'failed', 'cancelled', 'deleting'));
@@ -0,0 +1,37 @@
+-- Each requested scan type runs as its own job. The parent scan aggregates the
+-- sub-scans' outcomes and is partial if some of them failed.
+
+ALTER TABLE scans DROP CONSTRAINT scans_status_check;
+ALTER TABLE scans ADD CONSTRAINT scans_status_check
+    CHECK (status IN ('queued', 'running', 'completed', 'partial', 'failed', 'cancelled', 'deleting'));
+
+CREATE TABLE sub_scans (
+    scan_id UUID NOT NULL,
+    scan_created_at TIMESTAMP WITH TIME ZONE NOT NULL, -- created_at of the scan
+    scan_type VARCHAR(50) NOT NULL,
+    scanner_name VARCHAR(100) NOT NULL,
+    scanner_image TEXT NOT NULL,
+    status VARCHAR(50) NOT NULL CHECK (status IN ('queued', 'running', 'completed', 'failed', 'cancelled')),
+    job_name VARCHAR(255),
+    job_namespace VARCHAR(255),
+    findings_count INTEGER NOT NULL DEFAULT 0,
+    critical_count INTEGER NOT NULL DEFAULT 0,
+    high_count INTEGER NOT NULL DEFAULT 0,
+    medium_count INTEGER NOT NULL DEFAULT 0,
+    low_count INTEGER NOT NULL DEFAULT 0,
+    error_message TEXT,
+    started_at TIMESTAMP WITH TIME ZONE,
+    completed_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (scan_id, scan_type),
+    CONSTRAINT fk_sub_scans_scan
+        FOREIGN KEY (scan_id, scan_created_at) REFERENCES scans (id, created_at) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_sub_scans_scan_created ON sub_scans(scan_id, scan_created_at);
+CREATE INDEX idx_sub_scans_job_name ON sub_scans(job_name);
+
+-- Scans whose jobs are all sub-scan jobs have no job_name of their own
+DROP INDEX idx_scans_logs_pending;
+CREATE INDEX idx_scans_logs_pending ON scans(completed_at) WHERE logs_artifact_id IS NULL;
@@ -0,0 +1,2 @@
+DROP TRIGGER IF EXISTS update_scanners_updated_at ON scanners;
+DROP TABLE IF EXISTS scanners;
@@ -0,0 +1,29 @@
+-- Scanners managed at runtime. A row overrides the configured scanner of its
+-- scan type when SCANNER_REGISTRY_SOURCE is database.
+
+CREATE TABLE scanners (
+    scan_type VARCHAR(50) PRIMARY KEY CHECK (scan_type IN ('sast', 'sca', 'secrets', 'license')),
+    name VARCHAR(255) NOT NULL,
+
+    -- Image
+    image TEXT NOT NULL,
+    version VARCHAR(255) NOT NULL,
+    digest VARCHAR(255),  -- Pins the image, e.g. sha256:...
+
+    -- Container
+    args TEXT[] NOT NULL DEFAULT '{}',
+    env JSONB NOT NULL DEFAULT '{}',
+    requests_cpu VARCHAR(50),  -- Unset resources fall back to the runner's
+    requests_memory VARCHAR(50),
+    limits_cpu VARCHAR(50),
+    limits_memory VARCHAR(50),
+    timeout_seconds INTEGER CHECK (timeout_seconds > 0),  -- Unset falls back to JOB_DEADLINE_SECONDS
+
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE TRIGGER update_scanners_updated_at BEFORE UPDATE ON scanners
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,7 @@
+ALTER TABLE scans DROP COLUMN IF EXISTS job_settings;
+
+DROP TRIGGER IF EXISTS update_job_limits_updated_at ON job_limits;
+DROP TABLE IF EXISTS job_limits;
+
+DROP TRIGGER IF EXISTS update_job_profiles_updated_at ON job_profiles;
+DROP TABLE IF EXISTS job_profiles;
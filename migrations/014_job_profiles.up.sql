@@ -0,0 +1,39 @@
+-- Job settings per project and per scan, capped by organization limits
+
+CREATE TABLE job_profiles (
+    project_id UUID PRIMARY KEY,
+    organization_id UUID NOT NULL,
+    settings JSONB NOT NULL DEFAULT '{}',  -- domain.JobSettings
+
+    -- Audit
+    updated_by UUID,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_job_profiles_org ON job_profiles(organization_id);
+
+CREATE TRIGGER update_job_profiles_updated_at BEFORE UPDATE ON job_profiles
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- Organizations without limits use the service-wide JOB_MAX_* defaults
+CREATE TABLE job_limits (
+    organization_id UUID PRIMARY KEY,
+    max_cpu VARCHAR(50),  -- NULL is unlimited
+    max_memory VARCHAR(50),
+    max_ephemeral_storage VARCHAR(50),
+    max_deadline_seconds BIGINT NOT NULL DEFAULT 0 CHECK (max_deadline_seconds >= 0),  -- 0 is unlimited
+    max_backoff_limit INT CHECK (max_backoff_limit >= 0),
+    allowed_priority_classes TEXT[] NOT NULL DEFAULT '{}',
+
+    -- Audit
+    updated_by UUID,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE TRIGGER update_job_limits_updated_at BEFORE UPDATE ON job_limits
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- Settings of a single scan, overriding its project's profile
+ALTER TABLE scans ADD COLUMN job_settings JSONB;
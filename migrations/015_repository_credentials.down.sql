@@ -0,0 +1,2 @@
+DROP TRIGGER IF EXISTS update_repository_credentials_updated_at ON repository_credentials;
+DROP TABLE IF EXISTS repository_credentials;
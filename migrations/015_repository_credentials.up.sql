@@ -0,0 +1,29 @@
+-- Credentials for cloning the private repositories of a project's scans
+
+CREATE TABLE repository_credentials (
+    project_id UUID PRIMARY KEY,
+    organization_id UUID NOT NULL,
+    type TEXT NOT NULL CHECK (type IN ('deploy_key', 'https_token', 'github_app')),
+
+    -- Non-secret settings of the type
+    username TEXT,
+    known_hosts TEXT,
+    fingerprint TEXT,
+    github_app_id BIGINT,
+    github_installation_id BIGINT,
+
+    -- The secret, encrypted with a data key wrapped by the key manager's key_id
+    key_id TEXT NOT NULL,
+    wrapped_key BYTEA NOT NULL,
+    ciphertext BYTEA NOT NULL,
+
+    -- Audit
+    updated_by UUID,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_repository_credentials_org ON repository_credentials(organization_id);
+
+CREATE TRIGGER update_repository_credentials_updated_at BEFORE UPDATE ON repository_credentials
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
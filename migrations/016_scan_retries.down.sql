@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS idx_sub_scans_retry_at;
+
+-- Retries that were still queued fail with their last error
+UPDATE sub_scans SET status = 'failed', completed_at = NOW() WHERE status = 'queued' AND retry_at IS NOT NULL;
+
+ALTER TABLE sub_scans DROP COLUMN IF EXISTS retry_at;
+ALTER TABLE sub_scans DROP COLUMN IF EXISTS failure_reason;
+ALTER TABLE sub_scans DROP COLUMN IF EXISTS attempt;
+
+ALTER TABLE scans DROP COLUMN IF EXISTS failure_reason;
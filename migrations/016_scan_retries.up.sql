@@ -0,0 +1,11 @@
+-- Failed jobs are classified, and sub-scans that failed transiently are
+-- retried with a new job after a backoff.
+
+ALTER TABLE scans ADD COLUMN failure_reason VARCHAR(50);
+
+ALTER TABLE sub_scans ADD COLUMN attempt INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE sub_scans ADD COLUMN failure_reason VARCHAR(50);
+ALTER TABLE sub_scans ADD COLUMN retry_at TIMESTAMP WITH TIME ZONE;
+
+-- Retries due for dispatch
+CREATE INDEX idx_sub_scans_retry_at ON sub_scans(retry_at) WHERE status = 'queued';
@@ -0,0 +1 @@
+ALTER TABLE sub_scans DROP COLUMN IF EXISTS last_heartbeat_at;
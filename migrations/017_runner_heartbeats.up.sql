@@ -0,0 +1,4 @@
+-- Runner callbacks are heartbeats; the sweeper fails sub-scans whose runner
+-- stopped calling back.
+
+ALTER TABLE sub_scans ADD COLUMN last_heartbeat_at TIMESTAMP WITH TIME ZONE;
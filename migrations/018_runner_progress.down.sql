@@ -0,0 +1,2 @@
+ALTER TABLE sub_scans DROP COLUMN IF EXISTS progress;
+ALTER TABLE scans DROP COLUMN IF EXISTS progress;
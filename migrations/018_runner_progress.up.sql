@@ -0,0 +1,5 @@
+-- Runners report their progress; scans with a job per scan type combine the
+-- progress of their sub-scans.
+
+ALTER TABLE scans ADD COLUMN progress JSONB;
+ALTER TABLE sub_scans ADD COLUMN progress JSONB;
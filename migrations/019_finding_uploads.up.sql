@@ -0,0 +1,19 @@
+-- Runners stream findings in numbered batches. The last committed batch of
+-- each upload is recorded with the batch's findings, so a resumed upload
+-- skips batches that were already stored.
+
+CREATE TABLE finding_uploads (
+    scan_id UUID NOT NULL,
+    scan_created_at TIMESTAMP WITH TIME ZONE NOT NULL, -- created_at of the scan
+    scan_type VARCHAR(50) NOT NULL DEFAULT '', -- empty for scans without sub-scans
+    attempt INTEGER NOT NULL DEFAULT 1,
+    last_sequence BIGINT NOT NULL DEFAULT 0,
+    findings_count INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (scan_id, scan_type, attempt),
+    CONSTRAINT fk_finding_uploads_scan
+        FOREIGN KEY (scan_id, scan_created_at) REFERENCES scans (id, created_at) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_finding_uploads_scan_created ON finding_uploads(scan_id, scan_created_at);
@@ -0,0 +1,17 @@
+-- Runner callbacks carry a request ID. The ID is recorded in the transaction
+-- that applies the callback, with the response, so replays return the
+-- original response instead of applying the callback again.
+
+CREATE TABLE runner_requests (
+    scan_id UUID NOT NULL,
+    scan_created_at TIMESTAMP WITH TIME ZONE NOT NULL, -- created_at of the scan
+    request_id VARCHAR(128) NOT NULL,
+    method VARCHAR(100) NOT NULL,
+    response BYTEA, -- encoded response, NULL until recorded
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (scan_id, request_id),
+    CONSTRAINT fk_runner_requests_scan
+        FOREIGN KEY (scan_id, scan_created_at) REFERENCES scans (id, created_at) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_runner_requests_scan_created ON runner_requests(scan_id, scan_created_at);
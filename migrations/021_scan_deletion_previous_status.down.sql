@@ -0,0 +1 @@
+ALTER TABLE scan_deletions DROP COLUMN IF EXISTS previous_status;
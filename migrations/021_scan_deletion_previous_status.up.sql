@@ -0,0 +1,4 @@
+-- The status a scan had before it was marked deleting, restored when a legal
+-- hold refuses the deletion. NULL for deletions queued before it was recorded.
+
+ALTER TABLE scan_deletions ADD COLUMN previous_status TEXT;
@@ -0,0 +1,27 @@
+-- Commit statuses waiting to be reported to a Git provider. The event relay
+-- enqueues one delivery per finished scan event and client; the commit status
+-- notifier sends them and retries failures, so they survive restarts.
+
+CREATE TABLE commit_status_deliveries (
+    id UUID PRIMARY KEY,
+    event_id UUID NOT NULL,
+    scan_id UUID NOT NULL,
+    client TEXT NOT NULL, -- name of the commit status client, e.g. github
+    scan JSONB NOT NULL, -- snapshot of the finished scan from the event
+
+    -- Delivery state
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'succeeded', 'failed')),
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    last_error TEXT,
+    delivered_at TIMESTAMP WITH TIME ZONE,
+
+    -- Audit
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    UNIQUE (event_id, client)
+);
+
+CREATE INDEX idx_commit_status_deliveries_due ON commit_status_deliveries(next_attempt_at) WHERE status = 'pending';
+CREATE INDEX idx_commit_status_deliveries_scan ON commit_status_deliveries(scan_id);
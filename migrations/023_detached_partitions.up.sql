@@ -0,0 +1,8 @@
+-- Partitions the partition maintainer detached but hasn't archived or dropped
+-- yet. A detached partition is no longer listed as a partition of scans, so
+-- without this record a failed archive or drop would leave it behind.
+
+CREATE TABLE detached_partitions (
+    name TEXT PRIMARY KEY,
+    detached_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,3 @@
+ALTER TABLE sub_scans DROP COLUMN IF EXISTS logs_archive_claimed_until;
+
+DROP TABLE IF EXISTS sub_scan_logs;
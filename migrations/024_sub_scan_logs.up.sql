@@ -0,0 +1,20 @@
+-- The runner log of each attempt of a sub-scan, archived as a storage artifact
+-- once the attempt's job exits, before a retry or the job's TTL removes it.
+-- The archive of a finished scan combines the logs of its sub-scans.
+
+CREATE TABLE sub_scan_logs (
+    scan_id UUID NOT NULL,
+    scan_created_at TIMESTAMP WITH TIME ZONE NOT NULL, -- created_at of the scan
+    scan_type VARCHAR(50) NOT NULL,
+    attempt INTEGER NOT NULL,
+    scanner_name VARCHAR(100) NOT NULL,
+    artifact_id TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (scan_id, scan_type, attempt),
+    CONSTRAINT fk_sub_scan_logs_scan
+        FOREIGN KEY (scan_id, scan_created_at) REFERENCES scans (id, created_at) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_sub_scan_logs_scan_created ON sub_scan_logs(scan_id, scan_created_at);
+
+ALTER TABLE sub_scans ADD COLUMN logs_archive_claimed_until TIMESTAMP WITH TIME ZONE;
@@ -0,0 +1,13 @@
+// Package migrations embeds the versioned schema migrations into the binary.
+//
+// Each migration is a pair of files NNN_name.up.sql and NNN_name.down.sql.
+// Applied migrations must never be edited: their checksums are recorded and
+// verified on every run. Change the schema by adding a new version.
+package migrations
+
+import "embed"
+
+// FS holds the migration files
+//
+//go:embed *.sql
+var FS embed.FS